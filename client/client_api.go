@@ -29,6 +29,13 @@ type ModelInfo = models.ModelInfo
 
 type StateItem = generic.StateItem
 
+// Subscription selects configuration items (or whole models if name is empty)
+// for status notifications.
+type Subscription = generic.Subscription
+
+// Notification is a status notification of configuration item or state value.
+type Notification = generic.Notification
+
 // ConfigClient ...
 // Deprecated: use GenericClient instead
 type ConfigClient = GenericClient
@@ -50,6 +57,12 @@ type GenericClient interface {
 
 	// DumpState dumps actual running state.
 	DumpState() ([]*StateItem, error)
+
+	// Subscribe sends status notifications of items matching the subscriptions
+	// (all items if there are no subscriptions) to the channel, starting with
+	// the current status of the subscribed configuration items. It blocks until
+	// the context is done or the subscription fails.
+	Subscribe(ctx context.Context, ch chan<- *Notification, subscriptions ...*Subscription) error
 }
 
 // ChangeRequest is interface for config change request.
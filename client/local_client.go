@@ -16,6 +16,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/sirupsen/logrus"
 	"go.ligato.io/cn-infra/v2/datasync/kvdbsync/local"
@@ -97,6 +98,23 @@ func (c *client) DumpState() ([]*generic.StateItem, error) {
 	return nil, nil
 }
 
+func (c *client) Subscribe(ctx context.Context, ch chan<- *Notification, subscriptions ...*Subscription) error {
+	subscriber, ok := c.dispatcher.(orchestrator.Subscriber)
+	if !ok {
+		return errors.New("dispatcher does not support subscriptions")
+	}
+	return subscriber.Subscribe(ctx, subscriptions, func(resp *generic.SubscribeResponse) error {
+		for _, notif := range resp.GetNotifications() {
+			select {
+			case ch <- notif:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
 func (c *client) ChangeRequest() ChangeRequest {
 	return &changeRequest{txn: c.txnFactory.NewTxn(false)}
 }
@@ -0,0 +1,274 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remoteclient
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// seqNumResultKey is a key of the update result carrying sequence number of the transaction.
+const seqNumResultKey = "seqnum"
+
+// schedulerErrors are errors of scheduler recognized in status of failed requests.
+var schedulerErrors = []error{
+	kvs.ErrCombinedDownstreamResyncWithChange,
+	kvs.ErrRevertNotSupportedWithResync,
+	kvs.ErrClosedScheduler,
+	kvs.ErrTxnWaitCanceled,
+	kvs.ErrTxnQueueFull,
+}
+
+// ItemStatus describes status of configuration item.
+type ItemStatus struct {
+	// ID identifies the item.
+	ID *generic.Item_ID
+	// Key is a key of the item.
+	Key string
+	// Value is the item (nil for removed item or when the data are not known).
+	Value proto.Message
+	// State is a state of the value in the scheduler.
+	State kvscheduler.ValueState
+	// Message is an error of the last operation or details of the state
+	// (i.e. missing dependencies of pending value).
+	Message string
+	// Err is an error of the item mapped to the scheduler error type
+	// (nil if the item is not invalid or failed).
+	Err error
+}
+
+// ConfigItem is a configuration item with its status and labels.
+type ConfigItem struct {
+	ItemStatus
+	// Labels are user-defined labels of the item.
+	Labels map[string]string
+}
+
+// ItemResult is a result of change of configuration item.
+type ItemResult struct {
+	ItemStatus
+	// Op is the requested change of the item.
+	Op generic.UpdateResult_Operation
+}
+
+// ChangeResult is a result of change request.
+type ChangeResult struct {
+	// TxnSeqNum is a sequence number of the transaction which applied the change.
+	TxnSeqNum uint64
+	// Items are results of the changed items.
+	Items []*ItemResult
+}
+
+// Err returns error of the transaction with errors of the failed items
+// (nil if no item has failed).
+func (r *ChangeResult) Err() error {
+	var kvErrors []kvs.KeyWithError
+	for _, item := range r.Items {
+		if item.Err != nil {
+			kvErrors = append(kvErrors, kvs.KeyWithError{
+				Key:          item.Key,
+				TxnOperation: txnOperation(item.Op),
+				Error:        item.Err,
+			})
+		}
+	}
+	if len(kvErrors) == 0 {
+		return nil
+	}
+	return kvs.NewTransactionError(nil, kvErrors)
+}
+
+// LabelSelector selects items having all the labels with the given values.
+// Empty value selects items having the label with any value.
+type LabelSelector map[string]string
+
+// Matches returns true if the labels are selected by the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for name, value := range s {
+		v, ok := labels[name]
+		if !ok || (value != "" && v != value) {
+			return false
+		}
+	}
+	return true
+}
+
+// ChangeRequest is a request for changing configuration.
+type ChangeRequest struct {
+	client   *Client
+	req      *generic.SetConfigRequest
+	simulate bool
+	err      error
+}
+
+// Update appends updates for given items to the request.
+func (r *ChangeRequest) Update(items ...proto.Message) *ChangeRequest {
+	return r.UpdateWithLabels(nil, items...)
+}
+
+// UpdateWithLabels appends updates for given items with user-defined labels to the request.
+func (r *ChangeRequest) UpdateWithLabels(labels map[string]string, items ...proto.Message) *ChangeRequest {
+	if r.err != nil {
+		return r
+	}
+	for _, msg := range items {
+		item, err := r.client.marshalItem(msg)
+		if err != nil {
+			r.err = err
+			return r
+		}
+		r.req.Updates = append(r.req.Updates, &generic.UpdateItem{
+			Item:   item,
+			Labels: labels,
+		})
+	}
+	return r
+}
+
+// Delete appends deletes for given items to the request.
+func (r *ChangeRequest) Delete(items ...proto.Message) *ChangeRequest {
+	if r.err != nil {
+		return r
+	}
+	for _, msg := range items {
+		item, err := r.client.marshalItem(msg)
+		if err != nil {
+			r.err = err
+			return r
+		}
+		item.Data = nil // delete
+		r.req.Updates = append(r.req.Updates, &generic.UpdateItem{
+			Item: item,
+		})
+	}
+	return r
+}
+
+// Simulate requests simulation of the transaction before its execution.
+// Operations planned by the simulation are recorded with the transaction.
+func (r *ChangeRequest) Simulate() *ChangeRequest {
+	r.simulate = true
+	return r
+}
+
+// Send sends the request and returns results of the changed items. Request
+// failed because of the loss of connection is re-submitted with the same idempotency key,
+// so that the agent does not apply it again if only the reply was lost.
+// Failed transaction is returned as *kvs.TransactionError together with
+// the results.
+func (r *ChangeRequest) Send(ctx context.Context) (*ChangeResult, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	idempotencyKey := newIdempotencyKey()
+	var resp *generic.SetConfigResponse
+	err := r.client.invoke(ctx, func(ctx context.Context) (err error) {
+		ctx = r.client.outgoingContext(ctx, idempotencyKey, r.simulate)
+		resp, err = r.client.manager.SetConfig(ctx, r.req)
+		return err
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.FailedPrecondition {
+			return nil, err
+		}
+		// transaction has failed
+		for _, detail := range st.Details() {
+			if resp, ok := detail.(*generic.SetConfigResponse); ok {
+				result := changeResult(resp)
+				if err := result.Err(); err != nil {
+					return result, err
+				}
+				return result, kvs.NewTransactionError(schedulerError(st.Message()), nil)
+			}
+		}
+		return nil, kvs.NewTransactionError(schedulerError(st.Message()), nil)
+	}
+	return changeResult(resp), nil
+}
+
+// changeResult converts reply to change request.
+func changeResult(resp *generic.SetConfigResponse) *ChangeResult {
+	result := &ChangeResult{}
+	for _, res := range resp.GetResults() {
+		if res.GetKey() == seqNumResultKey {
+			result.TxnSeqNum, _ = strconv.ParseUint(res.GetStatus().GetMessage(), 10, 64)
+			continue
+		}
+		result.Items = append(result.Items, &ItemResult{
+			ItemStatus: *newItemStatus(res.GetId(), res.GetKey(), res.GetStatus()),
+			Op:         res.GetOp(),
+		})
+	}
+	return result
+}
+
+// newItemStatus converts status of item received from the agent.
+func newItemStatus(id *generic.Item_ID, key string, itemStatus *generic.ItemStatus) *ItemStatus {
+	state := kvscheduler.ValueState(kvscheduler.ValueState_value[itemStatus.GetStatus()])
+	return &ItemStatus{
+		ID:      id,
+		Key:     key,
+		State:   state,
+		Message: itemStatus.GetMessage(),
+		Err:     itemError(state, itemStatus.GetMessage()),
+	}
+}
+
+// itemError maps state of failed item to error.
+func itemError(state kvscheduler.ValueState, msg string) error {
+	switch state {
+	case kvscheduler.ValueState_INVALID:
+		return kvs.NewInvalidValueError(errors.New(msg))
+	case kvscheduler.ValueState_FAILED, kvscheduler.ValueState_RETRYING:
+		if msg == "" {
+			msg = "operation failed"
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// schedulerError returns error of scheduler with the message.
+func schedulerError(msg string) error {
+	for _, err := range schedulerErrors {
+		if err.Error() == msg {
+			return err
+		}
+	}
+	return errors.New(msg)
+}
+
+// txnOperation converts requested change of the item to the transaction operation.
+func txnOperation(op generic.UpdateResult_Operation) kvscheduler.TxnOperation {
+	switch op {
+	case generic.UpdateResult_CREATE:
+		return kvscheduler.TxnOperation_CREATE
+	case generic.UpdateResult_UPDATE:
+		return kvscheduler.TxnOperation_UPDATE
+	case generic.UpdateResult_DELETE:
+		return kvscheduler.TxnOperation_DELETE
+	}
+	return kvscheduler.TxnOperation_UNDEFINED
+}
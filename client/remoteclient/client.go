@@ -0,0 +1,252 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remoteclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+// Names of gRPC metadata keys recognized by the generic manager service
+// (see orchestrator/contextdecorator).
+const (
+	dataSrcMetadataKey        = "datasrc"
+	idempotencyKeyMetadataKey = "x-idempotency-key"
+	simulationMetadataKey     = "x-simulation"
+)
+
+const (
+	defaultRetryAttempts = 5
+	defaultRetryBackoff  = 200 * time.Millisecond
+	maxRetryBackoff      = 5 * time.Second
+)
+
+// Client is a typed client for the generic manager service. Compared to the client
+// returned by NewClientGRPC, it returns results for every changed item with errors
+// mapped to the scheduler error types, supports simulation and labels, re-submits
+// requests failed because of the loss of connection and allows to watch status
+// of configuration items.
+type Client struct {
+	manager       generic.ManagerServiceClient
+	modelRegistry models.Registry
+	dataSrc       string
+	retryAttempts int
+	retryBackoff  time.Duration
+}
+
+// Option customizes Client.
+type Option func(*Client)
+
+// WithModelRegistry sets registry of models used to marshal and unmarshal items
+// (models.DefaultRegistry by default).
+func WithModelRegistry(registry models.Registry) Option {
+	return func(c *Client) {
+		c.modelRegistry = registry
+	}
+}
+
+// WithDataSource sets data source of the configuration changed by the client
+// (data source "grpc" is used by the agent by default).
+func WithDataSource(dataSrc string) Option {
+	return func(c *Client) {
+		c.dataSrc = dataSrc
+	}
+}
+
+// WithRetry sets maximum number of attempts to re-submit request failed because
+// of the loss of connection and the initial backoff between attempts (doubled
+// with every attempt). Zero attempts disable re-submission.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retryAttempts = attempts
+		c.retryBackoff = backoff
+	}
+}
+
+// NewClient returns new typed client of the generic manager service using the given connection.
+// The connection to the agent is re-established by gRPC automatically.
+func NewClient(conn grpc.ClientConnInterface, options ...Option) *Client {
+	c := &Client{
+		manager:       generic.NewManagerServiceClient(conn),
+		modelRegistry: models.DefaultRegistry,
+		retryAttempts: defaultRetryAttempts,
+		retryBackoff:  defaultRetryBackoff,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Change returns new request for changing configuration.
+func (c *Client) Change() *ChangeRequest {
+	return &ChangeRequest{
+		client: c,
+		req:    &generic.SetConfigRequest{},
+	}
+}
+
+// Resync returns new request for replacing all configuration of the client's data
+// source with items added to the request.
+func (c *Client) Resync() *ChangeRequest {
+	return &ChangeRequest{
+		client: c,
+		req:    &generic.SetConfigRequest{OverwriteAll: true},
+	}
+}
+
+// GetConfig retrieves configuration items with labels matching the selector
+// (all items for empty selector) together with their status.
+func (c *Client) GetConfig(ctx context.Context, selector LabelSelector) ([]*ConfigItem, error) {
+	var resp *generic.GetConfigResponse
+	err := c.invoke(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.manager.GetConfig(ctx, &generic.GetConfigRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*ConfigItem
+	for _, configItem := range resp.GetItems() {
+		if !selector.Matches(configItem.GetLabels()) {
+			continue
+		}
+		st, err := c.itemStatus(configItem.GetItem(), configItem.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &ConfigItem{
+			ItemStatus: *st,
+			Labels:     configItem.GetLabels(),
+		})
+	}
+	return items, nil
+}
+
+// Watch starts watching status of configuration items selected by the subscriptions
+// (all items if there are no subscriptions). Watching starts with the current status
+// of the items. Subscription lost with the connection is re-established (starting
+// again with the current status) until the context is done or the watcher is closed.
+func (c *Client) Watch(ctx context.Context, subscriptions ...*generic.Subscription) *Watcher {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &Watcher{
+		statuses: make(chan *ItemStatus, 100),
+		cancel:   cancel,
+	}
+	go w.watch(ctx, c, subscriptions)
+	return w
+}
+
+// itemStatus converts item and its status received from the agent.
+func (c *Client) itemStatus(item *generic.Item, itemStatus *generic.ItemStatus) (*ItemStatus, error) {
+	st := newItemStatus(item.GetId(), "", itemStatus)
+	var err error
+	if item.GetData() != nil {
+		st.Value, err = models.UnmarshalItemUsingModelRegistry(item, c.modelRegistry)
+		if err != nil {
+			return nil, err
+		}
+		st.Key, err = models.GetKeyUsingModelRegistry(st.Value, c.modelRegistry)
+	} else {
+		st.Key, err = models.GetKeyForItemUsingModelRegistry(item, c.modelRegistry)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// marshalItem converts message to item using registry of the client.
+func (c *Client) marshalItem(msg proto.Message) (*generic.Item, error) {
+	return models.MarshalItemUsingModelRegistry(msg, c.modelRegistry)
+}
+
+// outgoingContext adds metadata of the request to the context.
+func (c *Client) outgoingContext(ctx context.Context, idempotencyKey string, simulate bool) context.Context {
+	var md []string
+	if c.dataSrc != "" {
+		md = append(md, dataSrcMetadataKey, c.dataSrc)
+	}
+	if idempotencyKey != "" {
+		md = append(md, idempotencyKeyMetadataKey, idempotencyKey)
+	}
+	if simulate {
+		md = append(md, simulationMetadataKey, "true")
+	}
+	if len(md) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, md...)
+}
+
+// invoke calls the request until it succeeds, fails with error other than the loss
+// of connection, the retry attempts are exhausted or the context is done.
+func (c *Client) invoke(ctx context.Context, call func(ctx context.Context) error) error {
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := call(ctx)
+		if err == nil || !isConnectionLost(err) || attempt >= c.retryAttempts {
+			return err
+		}
+		if err := sleep(ctx, backoff); err != nil {
+			return err
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// isConnectionLost returns true if the request failed because the agent
+// was not reachable.
+func isConnectionLost(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newIdempotencyKey generates key identifying request and its re-submissions.
+func newIdempotencyKey() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,148 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remoteclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+type mockManager struct {
+	generic.ManagerServiceClient
+
+	unavailable     int // number of calls failing because of lost connection
+	idempotencyKeys []string
+	simulations     []string
+	resp            *generic.SetConfigResponse
+	err             error
+}
+
+func (m *mockManager) SetConfig(ctx context.Context, _ *generic.SetConfigRequest, _ ...grpc.CallOption) (*generic.SetConfigResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	m.idempotencyKeys = append(m.idempotencyKeys, md.Get(idempotencyKeyMetadataKey)...)
+	m.simulations = append(m.simulations, md.Get(simulationMetadataKey)...)
+	if m.unavailable > 0 {
+		m.unavailable--
+		return nil, status.Error(codes.Unavailable, "connection lost")
+	}
+	return m.resp, m.err
+}
+
+func newMockClient(manager *mockManager) *Client {
+	return &Client{
+		manager:       manager,
+		modelRegistry: models.DefaultRegistry,
+		retryAttempts: 3,
+		retryBackoff:  time.Millisecond,
+	}
+}
+
+func TestSendResubmit(t *testing.T) {
+	RegisterTestingT(t)
+
+	manager := &mockManager{
+		unavailable: 2,
+		resp: &generic.SetConfigResponse{Results: []*generic.UpdateResult{
+			{Key: "seqnum", Status: &generic.ItemStatus{Message: "7"}},
+			{Key: "config/vpp/v2/interfaces/memif1", Op: generic.UpdateResult_CREATE,
+				Status: &generic.ItemStatus{Status: "PENDING", Message: "interface-exists"}},
+		}},
+	}
+	c := newMockClient(manager)
+
+	result, err := c.Change().Simulate().Send(context.Background())
+	Expect(err).ToNot(HaveOccurred())
+	Expect(result.TxnSeqNum).To(BeEquivalentTo(7))
+	Expect(result.Items).To(HaveLen(1))
+	Expect(result.Items[0].Key).To(Equal("config/vpp/v2/interfaces/memif1"))
+	Expect(result.Items[0].Op).To(Equal(generic.UpdateResult_CREATE))
+	Expect(result.Items[0].State).To(Equal(kvscheduler.ValueState_PENDING))
+	Expect(result.Items[0].Err).ToNot(HaveOccurred())
+	Expect(result.Err()).ToNot(HaveOccurred())
+
+	// request was re-submitted with the same idempotency key
+	Expect(manager.idempotencyKeys).To(HaveLen(3))
+	Expect(manager.idempotencyKeys[1]).To(Equal(manager.idempotencyKeys[0]))
+	Expect(manager.idempotencyKeys[2]).To(Equal(manager.idempotencyKeys[0]))
+	Expect(manager.simulations).To(Equal([]string{"true", "true", "true"}))
+
+	// retry attempts exhausted
+	manager.unavailable = 10
+	_, err = c.Change().Send(context.Background())
+	Expect(status.Code(err)).To(Equal(codes.Unavailable))
+}
+
+func TestSendFailedTxn(t *testing.T) {
+	RegisterTestingT(t)
+
+	resp := &generic.SetConfigResponse{Results: []*generic.UpdateResult{
+		{Key: "seqnum", Status: &generic.ItemStatus{Message: "8"}},
+		{Key: "config/vpp/v2/interfaces/memif1", Op: generic.UpdateResult_UPDATE,
+			Status: &generic.ItemStatus{Status: "FAILED", Message: "socket not found"}},
+		{Key: "config/vpp/v2/interfaces/memif2", Op: generic.UpdateResult_CREATE,
+			Status: &generic.ItemStatus{Status: "INVALID", Message: "mtu"}},
+		{Key: "config/vpp/v2/interfaces/memif3", Op: generic.UpdateResult_DELETE,
+			Status: &generic.ItemStatus{Status: "NONEXISTENT"}},
+	}}
+	st, err := status.New(codes.FailedPrecondition, "KeyErrors: [...]").WithDetails(resp)
+	Expect(err).ToNot(HaveOccurred())
+	c := newMockClient(&mockManager{err: st.Err()})
+
+	result, err := c.Change().Send(context.Background())
+	Expect(err).To(HaveOccurred())
+	Expect(result.TxnSeqNum).To(BeEquivalentTo(8))
+	Expect(result.Items).To(HaveLen(3))
+	Expect(result.Items[1].Err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+	Expect(result.Items[2].Err).ToNot(HaveOccurred())
+
+	txnErr, ok := err.(*kvs.TransactionError)
+	Expect(ok).To(BeTrue())
+	kvErrs := txnErr.GetKVErrors()
+	Expect(kvErrs).To(HaveLen(2))
+	Expect(kvErrs[0].Key).To(Equal("config/vpp/v2/interfaces/memif1"))
+	Expect(kvErrs[0].TxnOperation).To(Equal(kvscheduler.TxnOperation_UPDATE))
+	Expect(kvErrs[0].Error).To(MatchError("socket not found"))
+
+	// transaction rejected by the scheduler
+	c = newMockClient(&mockManager{err: status.Error(codes.FailedPrecondition, kvs.ErrTxnQueueFull.Error())})
+	_, err = c.Change().Send(context.Background())
+	txnErr, ok = err.(*kvs.TransactionError)
+	Expect(ok).To(BeTrue())
+	Expect(txnErr.GetTxnInitError()).To(Equal(kvs.ErrTxnQueueFull))
+}
+
+func TestLabelSelector(t *testing.T) {
+	RegisterTestingT(t)
+
+	labels := map[string]string{"app": "web", "tier": "frontend"}
+	Expect(LabelSelector(nil).Matches(labels)).To(BeTrue())
+	Expect(LabelSelector{"app": "web"}.Matches(labels)).To(BeTrue())
+	Expect(LabelSelector{"app": "web", "tier": ""}.Matches(labels)).To(BeTrue())
+	Expect(LabelSelector{"app": "db"}.Matches(labels)).To(BeFalse())
+	Expect(LabelSelector{"zone": ""}.Matches(labels)).To(BeFalse())
+	Expect(LabelSelector{"app": "web"}.Matches(nil)).To(BeFalse())
+}
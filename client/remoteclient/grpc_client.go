@@ -3,6 +3,7 @@ package remoteclient
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -202,6 +203,33 @@ func (c *grpcClient) DumpState() ([]*client.StateItem, error) {
 	return resp.GetItems(), nil
 }
 
+func (c *grpcClient) Subscribe(ctx context.Context, ch chan<- *client.Notification, subscriptions ...*client.Subscription) error {
+	stream, err := c.manager.Subscribe(ctx, &generic.SubscribeRequest{
+		Subscriptions: subscriptions,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		for _, notif := range resp.GetNotifications() {
+			select {
+			case ch <- notif:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
 type setConfigRequest struct {
 	client        generic.ManagerServiceClient
 	modelRegistry models.Registry
@@ -0,0 +1,123 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remoteclient
+
+import (
+	"context"
+	"io"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+// Watcher iterates over status of configuration items:
+//
+//	w := c.Watch(ctx)
+//	defer w.Close()
+//	for w.Next() {
+//		st := w.Status()
+//		...
+//	}
+//	if err := w.Err(); err != nil {
+//		...
+//	}
+type Watcher struct {
+	statuses chan *ItemStatus
+	cancel   context.CancelFunc
+	current  *ItemStatus
+	err      error
+}
+
+// Next waits for the next status and returns false when watching has ended
+// (see Err).
+func (w *Watcher) Next() bool {
+	st, ok := <-w.statuses
+	if !ok {
+		w.current = nil
+		return false
+	}
+	w.current = st
+	return true
+}
+
+// Status returns the status received by the last call of Next.
+func (w *Watcher) Status() *ItemStatus {
+	return w.current
+}
+
+// Err returns error which has ended watching (nil if the watcher was closed
+// or the context is done). It must be called only after Next returned false.
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+// Close stops watching.
+func (w *Watcher) Close() {
+	w.cancel()
+}
+
+// watch subscribes for status notifications until the context is done,
+// re-subscribing after the loss of connection.
+func (w *Watcher) watch(ctx context.Context, c *Client, subscriptions []*generic.Subscription) {
+	defer close(w.statuses)
+	backoff := c.retryBackoff
+	for {
+		received, err := w.subscribe(ctx, c, subscriptions)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil || !isConnectionLost(err) {
+			w.err = err
+			return
+		}
+		if received {
+			backoff = c.retryBackoff
+		}
+		if sleep(ctx, backoff) != nil {
+			return
+		}
+		backoff = nextBackoff(backoff)
+	}
+}
+
+// subscribe receives status notifications until the subscription ends
+// and returns true if any notification was received.
+func (w *Watcher) subscribe(ctx context.Context, c *Client, subscriptions []*generic.Subscription) (received bool, err error) {
+	stream, err := c.manager.Subscribe(c.outgoingContext(ctx, "", false), &generic.SubscribeRequest{
+		Subscriptions: subscriptions,
+	})
+	if err != nil {
+		return false, err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return received, nil
+		} else if err != nil {
+			return received, err
+		}
+		received = true
+		for _, notif := range resp.GetNotifications() {
+			st, err := c.itemStatus(notif.GetItem(), notif.GetStatus())
+			if err != nil {
+				return received, err
+			}
+			select {
+			case w.statuses <- st:
+			case <-ctx.Done():
+				return received, ctx.Err()
+			}
+		}
+	}
+}
@@ -19,6 +19,8 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -66,6 +68,14 @@ type PutDSL interface {
 	LinuxRoute(val *linux_l3.Route) PutDSL
 	// IptablesRuleChain adds request to create or update iptables rule chain.
 	IptablesRuleChain(val *linux_iptables.RuleChain) PutDSL
+	// TcQdisc adds request to create or update Linux tc qdisc.
+	TcQdisc(val *linux_tc.Qdisc) PutDSL
+	// TcClass adds request to create or update Linux tc class.
+	TcClass(val *linux_tc.Class) PutDSL
+	// TcFilter adds request to create or update Linux tc filter.
+	TcFilter(val *linux_tc.Filter) PutDSL
+	// Sysctl adds request to set Linux kernel parameter.
+	Sysctl(val *linux_sysctl.Sysctl) PutDSL
 
 	// VppInterface adds a request to create or update VPP network interface.
 	VppInterface(val *vpp_interfaces.Interface) PutDSL
@@ -122,6 +132,8 @@ type PutDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) PutDSL
 	// IPSecTunnelProtection adds request to create a new IPSec tunnel protection
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) PutDSL
+	// IPSecIKEv2Profile adds request to create a new IKEv2 profile
+	IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) PutDSL
 	// PuntIPRedirect adds request to create or update rule to punt L3 traffic via interface.
 	PuntIPRedirect(val *punt.IPRedirect) PutDSL
 	// PuntToHost adds request to create or update rule to punt L4 traffic to a host.
@@ -157,6 +169,14 @@ type DeleteDSL interface {
 	LinuxRoute(dstAddr, outIfaceName string) DeleteDSL
 	// IptablesRuleChain adds request to delete iptables rule chain.
 	IptablesRuleChain(name string) DeleteDSL
+	// TcQdisc adds request to delete Linux tc qdisc.
+	TcQdisc(iface string, handle uint32) DeleteDSL
+	// TcClass adds request to delete Linux tc class.
+	TcClass(iface string, qdisc, classID uint32) DeleteDSL
+	// TcFilter adds request to delete Linux tc filter.
+	TcFilter(iface string, qdisc, priority uint32) DeleteDSL
+	// Sysctl adds request to restore the original value of Linux kernel parameter.
+	Sysctl(val *linux_sysctl.Sysctl) DeleteDSL
 
 	// VppInterface adds a request to delete an existing VPP network interface.
 	VppInterface(ifaceName string) DeleteDSL
@@ -215,6 +235,8 @@ type DeleteDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) DeleteDSL
 	// IPSecTunnelProtection adds request to delete an IPSec tunnel protection from an interface
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) DeleteDSL
+	// IPSecIKEv2Profile adds request to delete an IKEv2 profile
+	IPSecIKEv2Profile(name string) DeleteDSL
 	// PuntIPRedirect adds request to delete a rule used to punt L3 traffic via interface.
 	PuntIPRedirect(l3Proto punt.L3Protocol, txInterface string) DeleteDSL
 	// PuntToHost adds request to delete a rule used to punt L4 traffic to a host.
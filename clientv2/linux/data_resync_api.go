@@ -19,6 +19,8 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -46,6 +48,14 @@ type DataResyncDSL interface {
 	LinuxRoute(route *linux_l3.Route) DataResyncDSL
 	// IptablesRuleChain adds iptables rule chain to the RESYNC request.
 	IptablesRuleChain(val *linux_iptables.RuleChain) DataResyncDSL
+	// TcQdisc adds Linux tc qdisc to the RESYNC request.
+	TcQdisc(val *linux_tc.Qdisc) DataResyncDSL
+	// TcClass adds Linux tc class to the RESYNC request.
+	TcClass(val *linux_tc.Class) DataResyncDSL
+	// TcFilter adds Linux tc filter to the RESYNC request.
+	TcFilter(val *linux_tc.Filter) DataResyncDSL
+	// Sysctl adds Linux kernel parameter to the RESYNC request.
+	Sysctl(val *linux_sysctl.Sysctl) DataResyncDSL
 
 	// VppInterface adds VPP interface to the RESYNC request.
 	VppInterface(intf *vpp_interfaces.Interface) DataResyncDSL
@@ -102,6 +112,8 @@ type DataResyncDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) DataResyncDSL
 	// IPSecTunnelProtection adds request to RESYNC an IPSec tunnel protection
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) DataResyncDSL
+	// IPSecIKEv2Profile adds request to RESYNC an IKEv2 profile
+	IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) DataResyncDSL
 	// PuntIPRedirect adds request to RESYNC a rule used to punt L3 traffic via interface.
 	PuntIPRedirect(val *punt.IPRedirect) DataResyncDSL
 	// PuntToHost adds request to RESYNC a rule used to punt L4 traffic to a host.
@@ -24,6 +24,8 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -105,6 +107,30 @@ func (dsl *PutDSL) IptablesRuleChain(val *linux_iptables.RuleChain) linuxclient.
 	return dsl
 }
 
+// TcQdisc adds request to create or update Linux tc qdisc.
+func (dsl *PutDSL) TcQdisc(val *linux_tc.Qdisc) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_tc.QdiscKey(val.Interface, val.Handle), val)
+	return dsl
+}
+
+// TcClass adds request to create or update Linux tc class.
+func (dsl *PutDSL) TcClass(val *linux_tc.Class) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_tc.ClassKey(val.Interface, val.Qdisc, val.ClassId), val)
+	return dsl
+}
+
+// TcFilter adds request to create or update Linux tc filter.
+func (dsl *PutDSL) TcFilter(val *linux_tc.Filter) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_tc.FilterKey(val.Interface, val.Qdisc, val.Priority), val)
+	return dsl
+}
+
+// Sysctl adds request to set Linux kernel parameter.
+func (dsl *PutDSL) Sysctl(val *linux_sysctl.Sysctl) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
 // VppInterface adds a request to create or update VPP network interface.
 func (dsl *PutDSL) VppInterface(val *interfaces.Interface) linuxclient.PutDSL {
 	dsl.vppPut.Interface(val)
@@ -264,6 +290,12 @@ func (dsl *PutDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) linuxclient
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to create a new IKEv2 profile
+func (dsl *PutDSL) IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) linuxclient.PutDSL {
+	dsl.vppPut.IPSecIKEv2Profile(profile)
+	return dsl
+}
+
 // PuntIPRedirect adds request to create or update rule to punt L3 traffic via interface.
 func (dsl *PutDSL) PuntIPRedirect(val *punt.IPRedirect) linuxclient.PutDSL {
 	dsl.vppPut.PuntIPRedirect(val)
@@ -341,6 +373,30 @@ func (dsl *DeleteDSL) IptablesRuleChain(name string) linuxclient.DeleteDSL {
 	return dsl
 }
 
+// TcQdisc adds request to delete Linux tc qdisc.
+func (dsl *DeleteDSL) TcQdisc(iface string, handle uint32) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.QdiscKey(iface, handle))
+	return dsl
+}
+
+// TcClass adds request to delete Linux tc class.
+func (dsl *DeleteDSL) TcClass(iface string, qdisc, classID uint32) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.ClassKey(iface, qdisc, classID))
+	return dsl
+}
+
+// TcFilter adds request to delete Linux tc filter.
+func (dsl *DeleteDSL) TcFilter(iface string, qdisc, priority uint32) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.FilterKey(iface, qdisc, priority))
+	return dsl
+}
+
+// Sysctl adds request to restore the original value of Linux kernel parameter.
+func (dsl *DeleteDSL) Sysctl(val *linux_sysctl.Sysctl) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(val))
+	return dsl
+}
+
 // VppInterface adds a request to delete an existing VPP network interface.
 func (dsl *DeleteDSL) VppInterface(ifaceName string) linuxclient.DeleteDSL {
 	dsl.vppDelete.Interface(ifaceName)
@@ -501,6 +557,12 @@ func (dsl *DeleteDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) linuxcli
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to delete an IKEv2 profile
+func (dsl *DeleteDSL) IPSecIKEv2Profile(name string) linuxclient.DeleteDSL {
+	dsl.vppDelete.IPSecIKEv2Profile(name)
+	return dsl
+}
+
 // PuntIPRedirect adds request to delete a rule used to punt L3 traffic via interface.
 func (dsl *DeleteDSL) PuntIPRedirect(l3Proto punt.L3Protocol, txInterface string) linuxclient.DeleteDSL {
 	dsl.vppDelete.PuntIPRedirect(l3Proto, txInterface)
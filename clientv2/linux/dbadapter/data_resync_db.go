@@ -24,6 +24,8 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -92,6 +94,42 @@ func (dsl *DataResyncDSL) IptablesRuleChain(val *linux_iptables.RuleChain) linux
 	return dsl
 }
 
+// TcQdisc adds Linux tc qdisc to the RESYNC request.
+func (dsl *DataResyncDSL) TcQdisc(val *linux_tc.Qdisc) linuxclient.DataResyncDSL {
+	key := linux_tc.QdiscKey(val.Interface, val.Handle)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// TcClass adds Linux tc class to the RESYNC request.
+func (dsl *DataResyncDSL) TcClass(val *linux_tc.Class) linuxclient.DataResyncDSL {
+	key := linux_tc.ClassKey(val.Interface, val.Qdisc, val.ClassId)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// TcFilter adds Linux tc filter to the RESYNC request.
+func (dsl *DataResyncDSL) TcFilter(val *linux_tc.Filter) linuxclient.DataResyncDSL {
+	key := linux_tc.FilterKey(val.Interface, val.Qdisc, val.Priority)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// Sysctl adds Linux kernel parameter to the RESYNC request.
+func (dsl *DataResyncDSL) Sysctl(val *linux_sysctl.Sysctl) linuxclient.DataResyncDSL {
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // VppInterface adds VPP interface to the RESYNC request.
 func (dsl *DataResyncDSL) VppInterface(intf *interfaces.Interface) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.Interface(intf)
@@ -258,6 +296,12 @@ func (dsl *DataResyncDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) linu
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to RESYNC an IKEv2 profile
+func (dsl *DataResyncDSL) IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) linuxclient.DataResyncDSL {
+	dsl.vppDataResync.IPSecIKEv2Profile(profile)
+	return dsl
+}
+
 // PuntIPRedirect adds request to RESYNC a rule used to punt L3 traffic via interface.
 func (dsl *DataResyncDSL) PuntIPRedirect(val *punt.IPRedirect) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.PuntIPRedirect(val)
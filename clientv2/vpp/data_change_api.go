@@ -96,6 +96,8 @@ type PutDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) PutDSL
 	// IPSecTunnelProtection adds request to create a new IPSec tunnel protection
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) PutDSL
+	// IPSecIKEv2Profile adds request to create a new IKEv2 profile
+	IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) PutDSL
 	// PuntIPRedirect adds request to create or update rule to punt L3 traffic via interface.
 	PuntIPRedirect(val *punt.IPRedirect) PutDSL
 	// PuntToHost adds request to create or update rule to punt L4 traffic to a host.
@@ -165,6 +167,8 @@ type DeleteDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) DeleteDSL
 	// IPSecTunnelProtection adds request to delete an IPSec tunnel protection from an interface
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) DeleteDSL
+	// IPSecIKEv2Profile adds request to delete an IKEv2 profile
+	IPSecIKEv2Profile(name string) DeleteDSL
 	// PuntIPRedirect adds request to delete a rule used to punt L3 traffic via interface.
 	PuntIPRedirect(l3Proto punt.L3Protocol, txInterface string) DeleteDSL
 	// PuntToHost adds request to delete a rule used to punt L4 traffic to a host.
@@ -76,6 +76,8 @@ type DataResyncDSL interface {
 	IPSecSP(sp *ipsec.SecurityPolicy) DataResyncDSL
 	// IPSecTunnelProtection adds request to RESYNC an IPSec tunnel protection
 	IPSecTunnelProtection(tp *ipsec.TunnelProtection) DataResyncDSL
+	// IPSecIKEv2Profile adds request to RESYNC an IKEv2 profile
+	IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) DataResyncDSL
 	// PuntIPRedirect adds request to RESYNC a rule used to punt L3 traffic via interface.
 	PuntIPRedirect(val *punt.IPRedirect) DataResyncDSL
 	// PuntToHost adds request to RESYNC a rule used to punt L4 traffic to a host.
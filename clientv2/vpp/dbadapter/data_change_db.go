@@ -204,6 +204,12 @@ func (dsl *PutDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) vppclient.P
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to create a new IKEv2 profile
+func (dsl *PutDSL) IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(profile), profile)
+	return dsl
+}
+
 // PuntIPRedirect adds request to create or update rule to punt L3 traffic via interface.
 func (dsl *PutDSL) PuntIPRedirect(val *punt.IPRedirect) vppclient.PutDSL {
 	dsl.parent.txn.Put(punt.IPRedirectKey(val.L3Protocol, val.TxInterface), val)
@@ -385,6 +391,12 @@ func (dsl *DeleteDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) vppclien
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to delete an IKEv2 profile
+func (dsl *DeleteDSL) IPSecIKEv2Profile(name string) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(ipsec.IKEv2ProfileKey(name))
+	return dsl
+}
+
 // PuntIPRedirect adds request to delete a rule used to punt L3 traffic via interface.
 func (dsl *DeleteDSL) PuntIPRedirect(l3Proto punt.L3Protocol, txInterface string) vppclient.DeleteDSL {
 	dsl.parent.txn.Delete(punt.IPRedirectKey(l3Proto, txInterface))
@@ -238,6 +238,14 @@ func (dsl *DataResyncDSL) IPSecTunnelProtection(tp *ipsec.TunnelProtection) vppc
 	return dsl
 }
 
+// IPSecIKEv2Profile adds request to RESYNC an IKEv2 profile
+func (dsl *DataResyncDSL) IPSecIKEv2Profile(profile *ipsec.IKEv2Profile) vppclient.DataResyncDSL {
+	key := models.Key(profile)
+	dsl.txn.Put(key, profile)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+	return dsl
+}
+
 // PuntIPRedirect adds request to RESYNC a rule used to punt L3 traffic via interface.
 func (dsl *DataResyncDSL) PuntIPRedirect(val *punt.IPRedirect) vppclient.DataResyncDSL {
 	key := punt.IPRedirectKey(val.L3Protocol, val.TxInterface)
@@ -182,6 +182,7 @@ func buildClientOptions(cfg *Config) []client.Opt {
 		client.WithHTTPPort(cfg.HTTPPort),
 		client.WithUserAgent(UserAgent()),
 		client.WithHTTPBasicAuth(cfg.HTTPBasicAuth),
+		client.WithBearerToken(cfg.BearerToken),
 		client.WithVersion(cfg.LigatoAPIVersion),
 		client.WithEtcdEndpoints(cfg.EtcdEndpoints),
 		client.WithEtcdDialTimeout(cfg.EtcdDialTimeout),
@@ -17,6 +17,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"time"
 
@@ -40,6 +41,8 @@ type Config struct {
 	GRPCPort         int           `json:"grpc-port"`
 	HTTPPort         int           `json:"http-port"`
 	HTTPBasicAuth    string        `json:"http-basic-auth"`
+	BearerToken      string        `json:"bearer-token"`
+	BearerTokenFile  string        `json:"bearer-token-file"`
 	Timeout          time.Duration `json:"timeout"`
 	EtcdEndpoints    []string      `json:"etcd-endpoints"`
 	EtcdDialTimeout  time.Duration `json:"etcd-dial-timeout"`
@@ -66,13 +69,24 @@ func MakeConfig() (*Config, error) {
 	cfg.GRPCSecure = adjustSecurity("gRPC", cfg.InsecureTLS, cfg.GRPCSecure)
 	cfg.HTTPSecure = adjustSecurity("HTTP", cfg.InsecureTLS, cfg.HTTPSecure)
 	cfg.KVDBSecure = adjustSecurity("KVDB", cfg.InsecureTLS, cfg.KVDBSecure)
+	if cfg.BearerToken == "" && cfg.BearerTokenFile != "" {
+		token, err := ioutil.ReadFile(cfg.BearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading bearer token file failed: %w", err)
+		}
+		cfg.BearerToken = strings.TrimSpace(string(token))
+	}
 
 	return cfg, nil
 }
 
 // DebugOutput returns Config as string to be used for debug output.
 func (c *Config) DebugOutput() string {
-	bConfig, err := json.MarshalIndent(c, "", " ")
+	debugCfg := *c
+	if debugCfg.BearerToken != "" {
+		debugCfg.BearerToken = "<hidden>"
+	}
+	bConfig, err := json.MarshalIndent(debugCfg, "", " ")
 	if err != nil {
 		return fmt.Sprintf("error while marshaling config to json: %v", err)
 	}
@@ -73,6 +73,14 @@ func (opts *ClientOptions) InstallFlags(flags *pflag.FlagSet) {
 	_ = viper.BindPFlag("http-basic-auth", flags.Lookup("http-basic-auth"))
 	_ = viper.BindEnv("http-basic-auth", "AGENTCTL_HTTP_BASIC_AUTH")
 
+	flags.String("bearer-token", "", "Bearer token (JWT) for HTTP and gRPC connection")
+	_ = viper.BindPFlag("bearer-token", flags.Lookup("bearer-token"))
+	_ = viper.BindEnv("bearer-token", "AGENTCTL_BEARER_TOKEN")
+
+	flags.String("bearer-token-file", "", "Path to file with bearer token (JWT) for HTTP and gRPC connection")
+	_ = viper.BindPFlag("bearer-token-file", flags.Lookup("bearer-token-file"))
+	_ = viper.BindEnv("bearer-token-file", "AGENTCTL_BEARER_TOKEN_FILE")
+
 	flags.Bool("insecure-tls", false, "Use TLS without server's certificate validation")
 	_ = viper.BindPFlag("insecure-tls", flags.Lookup("insecure-tls"))
 
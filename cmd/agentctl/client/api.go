@@ -11,10 +11,12 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/client"
 	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 // APIClient is an interface that clients that talk with a agent server must implement.
@@ -28,6 +30,7 @@ type APIClient interface {
 	GenericClient() (client.GenericClient, error)
 	ConfiguratorClient() (configurator.ConfiguratorServiceClient, error)
 	MetaServiceClient() (generic.MetaServiceClient, error)
+	NatSessionClient() (vpp_nat.NatSessionServiceClient, error)
 
 	AgentHost() string
 	Version() string
@@ -64,6 +67,7 @@ type SchedulerAPIClient interface {
 type VppAPIClient interface {
 	VppStatsAPIClient
 	VppRunCli(ctx context.Context, cmd string) (reply string, err error)
+	VppAPITrace(ctx context.Context, filter apitrace.Filter) ([]apitrace.Record, error)
 }
 
 // VppStatsAPIClient defines stats API client methods for the VPP
@@ -43,6 +43,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/pkg/debug"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 const (
@@ -91,6 +92,7 @@ type Client struct {
 	govppProxyClient *proxy.Client
 
 	customHTTPHeaders map[string]string
+	bearerToken       string
 	version           string
 	manualOverride    bool
 	negotiateVersion  bool
@@ -148,7 +150,7 @@ func (c *Client) Close() error {
 // GRPCConn returns configured gRPC client.
 func (c *Client) GRPCConn() (*grpc.ClientConn, error) {
 	if c.grpcClient == nil {
-		conn, err := connectGrpc(c.grpcAddr, c.grpcTLS)
+		conn, err := connectGrpc(c.grpcAddr, c.grpcTLS, c.bearerToken)
 		if err != nil {
 			return nil, err
 		}
@@ -183,6 +185,15 @@ func (c *Client) MetaServiceClient() (generic.MetaServiceClient, error) {
 	return generic.NewMetaServiceClient(conn), nil
 }
 
+// NatSessionClient creates new client for using NAT44 session service
+func (c *Client) NatSessionClient() (vpp_nat.NatSessionServiceClient, error) {
+	conn, err := c.GRPCConn()
+	if err != nil {
+		return nil, err
+	}
+	return vpp_nat.NewNatSessionServiceClient(conn), nil
+}
+
 // HTTPClient returns configured HTTP client.
 func (c *Client) HTTPClient() *http.Client {
 	if c.httpClient == nil {
@@ -296,13 +307,29 @@ func (c *Client) negotiateAPIVersionPing(p *types.Version) {
 	}
 }
 
-func connectGrpc(addr string, tc *tls.Config) (*grpc.ClientConn, error) {
-	dialOpt := grpc.WithInsecure()
+func connectGrpc(addr string, tc *tls.Config, token string) (*grpc.ClientConn, error) {
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
 	if tc != nil {
-		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(tc))
+		dialOpts[0] = grpc.WithTransportCredentials(credentials.NewTLS(tc))
+	}
+	if token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(bearerTokenCredentials(token)))
 	}
 	logging.Debugf("dialing grpc address: %v", addr)
-	return grpc.Dial(addr, dialOpt)
+	return grpc.Dial(addr, dialOpts...)
+}
+
+// bearerTokenCredentials sends bearer token in metadata of each gRPC call.
+type bearerTokenCredentials string
+
+func (t bearerTokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity returns false to allow sending the token over
+// insecure connection to local agent.
+func (t bearerTokenCredentials) RequireTransportSecurity() bool {
+	return false
 }
 
 func connectEtcd(endpoints []string, dialTimeout time.Duration, tc *tls.Config) (keyval.CoreBrokerWatcher, error) {
@@ -166,6 +166,17 @@ func WithHTTPBasicAuth(s string) Opt {
 	return WithHTTPHeader("Authorization", "Basic "+auth)
 }
 
+// WithBearerToken adds bearer token to HTTP headers and gRPC call metadata.
+func WithBearerToken(token string) Opt {
+	return func(c *Client) error {
+		if token == "" {
+			return nil
+		}
+		c.bearerToken = token
+		return WithHTTPHeader("Authorization", "Bearer "+token)(c)
+	}
+}
+
 // WithVersion overrides the client version with the specified one. If an empty
 // version is specified, the value will be ignored to allow version negotiation.
 func WithVersion(version string) Opt {
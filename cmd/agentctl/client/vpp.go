@@ -20,6 +20,8 @@ import (
 	"fmt"
 
 	"go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
 )
 
 func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err error) {
@@ -36,6 +38,20 @@ func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err e
 	return reply, nil
 }
 
+func (c *Client) VppAPITrace(ctx context.Context, filter apitrace.Filter) ([]apitrace.Record, error) {
+	resp, err := c.get(ctx, "/govppmux/trace", filter.Values(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET request failed: %v", err)
+	}
+	defer ensureReaderClosed(resp)
+
+	records, err := apitrace.ReadJSONLines(resp.body)
+	if err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return records, nil
+}
+
 func (c *Client) VppGetStats(ctx context.Context, typ string) error {
 	// TODO: implement more generic stats provider that goes beyond GoVPP StatsProvider (git.fd.io/govpp/api/stats.go)
 	//  and can dump any possible stats or all of them (just like in stats dump example in
@@ -20,12 +20,15 @@ import (
 	"io"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"go.ligato.io/vpp-agent/v3/client"
 	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
@@ -34,20 +37,27 @@ func NewValuesCommand(cli agentcli.Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "values [MODEL]",
 		Short: "Retrieve values from scheduler",
-		Args:  cobra.RangeArgs(0, 1),
+		Example: "Watch status changes of VPP interfaces" +
+			`{{.CommandPath}} values --watch vpp.interfaces`,
+		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Models = args
+			if opts.Watch {
+				return runValuesWatch(cli, opts)
+			}
 			return runValues(cli, opts)
 		},
 	}
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	flags.BoolVarP(&opts.Watch, "watch", "w", false, "Watch status changes of configuration items and state values")
 	return cmd
 }
 
 type ValuesOptions struct {
 	Models []string
 	Format string
+	Watch  bool
 }
 
 func runValues(cli agentcli.Cli, opts ValuesOptions) error {
@@ -93,6 +103,51 @@ func runValues(cli agentcli.Cli, opts ValuesOptions) error {
 	return nil
 }
 
+func runValuesWatch(cli agentcli.Cli, opts ValuesOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := cli.Client().GenericClient()
+	if err != nil {
+		return err
+	}
+	var subscriptions []*client.Subscription
+	for _, model := range opts.Models {
+		subscriptions = append(subscriptions, &client.Subscription{
+			Id: &generic.Item_ID{Model: model},
+		})
+	}
+
+	notifs := make(chan *client.Notification, 100)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- c.Subscribe(ctx, notifs, subscriptions...)
+	}()
+
+	w := tabwriter.NewWriter(cli.Out(), 10, 0, 3, ' ', 0)
+	if opts.Format == "" {
+		fmt.Fprintf(w, "TIME\tMODEL\tNAME\tSTATE\tMESSAGE\t\n")
+		w.Flush()
+	}
+	for {
+		select {
+		case notif := <-notifs:
+			if opts.Format != "" {
+				if err := formatAsTemplate(cli.Out(), opts.Format, notif); err != nil {
+					return err
+				}
+				continue
+			}
+			id := notif.GetItem().GetId()
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", time.Now().Format("15:04:05.000"),
+				id.GetModel(), id.GetName(), notif.GetStatus().GetStatus(), notif.GetStatus().GetMessage())
+			w.Flush()
+		case err := <-errCh:
+			return err
+		}
+	}
+}
+
 // printValuesTable prints values data using table format
 func printValuesTable(out io.Writer, status []*kvscheduler.BaseValueStatus) {
 	w := tabwriter.NewWriter(out, 10, 0, 3, ' ', 0)
@@ -17,11 +17,19 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
 
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 func NewVppCommand(cli agentcli.Cli) *cobra.Command {
@@ -32,6 +40,8 @@ func NewVppCommand(cli agentcli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		newVppCliCommand(cli),
 		newVppInfoCommand(cli),
+		newVppNatCommand(cli),
+		newVppTraceCommand(cli),
 	)
 	return cmd
 }
@@ -105,3 +115,329 @@ func runVppInfo(cli agentcli.Cli) error {
 
 	return nil
 }
+
+func newVppNatCommand(cli agentcli.Cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nat",
+		Short: "Inspect NAT44 state of VPP",
+	}
+	cmd.AddCommand(
+		newVppNatUsersCommand(cli),
+		newVppNatSessionsCommand(cli),
+		newVppNatDeleteSessionsCommand(cli),
+	)
+	return cmd
+}
+
+func newVppNatUsersCommand(cli agentcli.Cli) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "Retrieve inside addresses with active NAT44 sessions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVppNatUsers(cli, format)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().StringVarP(&format, "format", "f", "", "Format output")
+	return cmd
+}
+
+func runVppNatUsers(cli agentcli.Cli, format string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := cli.Client().NatSessionClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.DumpUsers(ctx, &vpp_nat.DumpUsersRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(format) == 0 {
+		printNatUsersTable(cli.Out(), resp.GetUsers())
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), format, resp.GetUsers())
+}
+
+// NatSessionsOptions selects NAT44 sessions by the inside/outside tuple.
+type NatSessionsOptions struct {
+	InsideIP    string
+	InsidePort  uint32
+	OutsideIP   string
+	OutsidePort uint32
+	Protocol    string
+	Vrf         uint32
+	Format      string
+}
+
+func (opts *NatSessionsOptions) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&opts.InsideIP, "inside-ip", "", "Inside IPv4 address of the session")
+	flags.Uint32Var(&opts.InsidePort, "inside-port", 0, "Inside port of the session")
+	flags.StringVar(&opts.OutsideIP, "outside-ip", "", "Outside IPv4 address of the session")
+	flags.Uint32Var(&opts.OutsidePort, "outside-port", 0, "Outside port of the session")
+	flags.StringVar(&opts.Protocol, "protocol", "", "Protocol of the session (tcp, udp or icmp)")
+	flags.Uint32Var(&opts.Vrf, "vrf", 0, "Inside VRF of the session")
+}
+
+// filter builds filter for NatSessionService from the options.
+func (opts *NatSessionsOptions) filter(flags *pflag.FlagSet) (*vpp_nat.Nat44SessionFilter, error) {
+	filter := &vpp_nat.Nat44SessionFilter{
+		InsideIpAddress:  opts.InsideIP,
+		InsidePort:       opts.InsidePort,
+		OutsideIpAddress: opts.OutsideIP,
+		OutsidePort:      opts.OutsidePort,
+		VrfId:            opts.Vrf,
+		MatchVrf:         flags.Changed("vrf"),
+	}
+	if opts.Protocol != "" {
+		protocol, ok := vpp_nat.DNat44_Protocol_value[strings.ToUpper(opts.Protocol)]
+		if !ok {
+			return nil, fmt.Errorf("unknown protocol %q (expected tcp, udp or icmp)", opts.Protocol)
+		}
+		filter.Protocol = vpp_nat.DNat44_Protocol(protocol)
+		filter.MatchProtocol = true
+	}
+	return filter, nil
+}
+
+func newVppNatSessionsCommand(cli agentcli.Cli) *cobra.Command {
+	var opts NatSessionsOptions
+	cmd := &cobra.Command{
+		Use:   "sessions",
+		Short: "Retrieve active NAT44 sessions",
+		Example: `
+# Retrieve all NAT44 sessions
+{{.CommandPath}}
+
+# Retrieve UDP sessions of the inside address 10.0.0.1
+{{.CommandPath}} --inside-ip 10.0.0.1 --protocol udp
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := opts.filter(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			return runVppNatSessions(cli, filter, opts.Format)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	opts.addFlags(flags)
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+func runVppNatSessions(cli agentcli.Cli, filter *vpp_nat.Nat44SessionFilter, format string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := cli.Client().NatSessionClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.DumpSessions(ctx, &vpp_nat.DumpSessionsRequest{Filter: filter})
+	if err != nil {
+		return err
+	}
+
+	if len(format) == 0 {
+		printNatSessionsTable(cli.Out(), resp.GetSessions())
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), format, resp.GetSessions())
+}
+
+func newVppNatDeleteSessionsCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		opts NatSessionsOptions
+		all  bool
+	)
+	cmd := &cobra.Command{
+		Use:   "delete-sessions",
+		Short: "Delete NAT44 sessions matching the filter",
+		Example: `
+# Delete sessions of the inside address 10.0.0.1 (e.g. after changing its static mapping)
+{{.CommandPath}} --inside-ip 10.0.0.1
+
+# Delete all NAT44 sessions
+{{.CommandPath}} --all
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := opts.filter(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if !all && proto.Equal(filter, &vpp_nat.Nat44SessionFilter{}) {
+				return fmt.Errorf("no filter defined, use --all to delete all sessions")
+			}
+			return runVppNatDeleteSessions(cli, filter, all, opts.Format)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	opts.addFlags(flags)
+	flags.BoolVar(&all, "all", false, "Delete all sessions if no filter is defined")
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+func runVppNatDeleteSessions(cli agentcli.Cli, filter *vpp_nat.Nat44SessionFilter, all bool, format string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := cli.Client().NatSessionClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.DeleteSessions(ctx, &vpp_nat.DeleteSessionsRequest{Filter: filter, All: all})
+	if err != nil {
+		return err
+	}
+
+	if len(format) == 0 {
+		fmt.Fprintf(cli.Out(), "Deleted %d NAT44 sessions\n", len(resp.GetDeleted()))
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), format, resp.GetDeleted())
+}
+
+type VppTraceOptions struct {
+	Filter apitrace.Filter
+	Txn    uint64
+	Format string
+	Export string
+}
+
+func newVppTraceCommand(cli agentcli.Cli) *cobra.Command {
+	var opts VppTraceOptions
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Retrieve traced binary API calls",
+		Long: `Retrieve binary API calls traced by the agent (requires api-trace enabled in govpp.conf).
+Each call is tagged with the transaction and the key of the value it was sent for.`,
+		Example: `
+# Show binary API calls of transaction 12
+{{.CommandPath}} --txn 12
+
+# Show the last 20 calls sent for interfaces
+{{.CommandPath}} --key config/vpp/v2/interfaces/ --limit 20
+
+# Export all traced calls to a file as JSON lines
+{{.CommandPath}} --export trace.jsonl
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("txn") {
+				opts.Filter.TxnSeqNum = &opts.Txn
+			}
+			return runVppTrace(cli, opts)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.Uint64Var(&opts.Txn, "txn", 0, "Sequence number of transaction which sent the calls")
+	flags.StringVar(&opts.Filter.Key, "key", "", "Key prefix of values for which the calls were sent")
+	flags.StringVar(&opts.Filter.Message, "message", "", "Name of the request message")
+	flags.Uint64Var(&opts.Filter.Since, "since", 0, "Sequence number of the first call")
+	flags.IntVar(&opts.Filter.Limit, "limit", 0, "Maximum number of the most recent calls")
+	flags.StringVarP(&opts.Export, "export", "o", "", "Export calls to file as JSON lines")
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+func runVppTrace(cli agentcli.Cli, opts VppTraceOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	records, err := cli.Client().VppAPITrace(ctx, opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	if opts.Export != "" {
+		f, err := os.Create(opts.Export)
+		if err != nil {
+			return err
+		}
+		if err := apitrace.WriteJSONLines(f, records); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(cli.Out(), "Exported %d calls to %s\n", len(records), opts.Export)
+		return nil
+	}
+	if len(opts.Format) == 0 {
+		printVppTraceTable(cli.Out(), records)
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), opts.Format, records)
+}
+
+// printVppTraceTable prints traced binary API calls using table format
+func printVppTraceTable(out io.Writer, records []apitrace.Record) {
+	w := tabwriter.NewWriter(out, 10, 0, 3, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "SEQ\tTIME\tTXN\tKEY\tMESSAGE\tRETVAL\tLATENCY\tERROR\t\n")
+	for _, r := range records {
+		var txn string
+		if r.TxnSeqNum != nil {
+			txn = fmt.Sprint(*r.TxnSeqNum)
+		}
+		message := r.Message
+		if r.Instance != "" {
+			message = fmt.Sprintf("%s (%s)", r.Message, r.Instance)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%v\t%s\t\n",
+			r.Seq, r.Timestamp.Format("15:04:05.000"), txn, r.Key, message,
+			r.Retval, r.Latency.Round(time.Microsecond), r.Error)
+	}
+}
+
+// printNatUsersTable prints NAT44 users using table format
+func printNatUsersTable(out io.Writer, users []*vpp_nat.Nat44User) {
+	w := tabwriter.NewWriter(out, 10, 0, 3, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "ADDRESS\tVRF\tSESSIONS\tSTATIC SESSIONS\t\n")
+	for _, user := range users {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t\n", user.IpAddress, user.VrfId, user.Sessions, user.StaticSessions)
+	}
+}
+
+// printNatSessionsTable prints NAT44 sessions using table format
+func printNatSessionsTable(out io.Writer, sessions []*vpp_nat.Nat44Session) {
+	w := tabwriter.NewWriter(out, 10, 0, 3, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "PROTOCOL\tINSIDE\tOUTSIDE\tEXTERNAL HOST\tVRF\tPACKETS\tBYTES\tFLAGS\t\n")
+	for _, s := range sessions {
+		var extHost string
+		if s.ExtHostAddress != "" {
+			extHost = fmt.Sprintf("%s:%d", s.ExtHostAddress, s.ExtHostPort)
+		}
+		var flags []string
+		if s.IsStatic {
+			flags = append(flags, "static")
+		}
+		if s.IsTwiceNat {
+			flags = append(flags, fmt.Sprintf("twice-nat(%s:%d)", s.ExtHostNatAddress, s.ExtHostNatPort))
+		}
+		fmt.Fprintf(w, "%s\t%s:%d\t%s:%d\t%s\t%d\t%d\t%d\t%s\t\n",
+			strings.ToLower(s.Protocol.String()),
+			s.InsideIpAddress, s.InsidePort,
+			s.OutsideIpAddress, s.OutsidePort,
+			extHost, s.VrfId, s.TotalPackets, s.TotalBytes,
+			strings.Join(flags, ","))
+	}
+}
@@ -33,6 +33,8 @@ import (
 	linux_iptablesplugin "go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin"
 	linux_l3plugin "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin"
 	linux_nsplugin "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	linux_sysctlplugin "go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin"
+	linux_tcplugin "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/localregistry"
@@ -41,12 +43,16 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/dnsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin"
@@ -117,6 +123,8 @@ func New() *VPPAgent {
 	ifplugin.DefaultPlugin.Watcher = watchers
 	ifplugin.DefaultPlugin.NotifyStates = ifStatePub
 	puntplugin.DefaultPlugin.PublishState = writers
+	ipsecplugin.DefaultPlugin.PublishState = writers
+	wireguardplugin.DefaultPlugin.PublishState = writers
 
 	// No stats publishers by default, use `vpp-ifplugin.conf` config
 	// ifplugin.DefaultPlugin.PublishStatistics = writers
@@ -175,36 +183,44 @@ func (a *VPPAgent) Close() error {
 
 // VPP contains all VPP plugins.
 type VPP struct {
-	ABFPlugin   *abfplugin.ABFPlugin
-	ACLPlugin   *aclplugin.ACLPlugin
-	DNSPlugin   *dnsplugin.DNSPlugin
-	IfPlugin    *ifplugin.IfPlugin
-	IPFIXPlugin *ipfixplugin.IPFIXPlugin
-	IPSecPlugin *ipsecplugin.IPSecPlugin
-	L2Plugin    *l2plugin.L2Plugin
-	L3Plugin    *l3plugin.L3Plugin
-	NATPlugin   *natplugin.NATPlugin
-	PuntPlugin  *puntplugin.PuntPlugin
-	STNPlugin   *stnplugin.STNPlugin
-	SRPlugin    *srplugin.SRPlugin
-	WgPlugin    *wireguardplugin.WgPlugin
+	ABFPlugin      *abfplugin.ABFPlugin
+	ACLPlugin      *aclplugin.ACLPlugin
+	CNATPlugin     *cnatplugin.CnatPlugin
+	ClassifyPlugin *classifyplugin.ClassifyPlugin
+	DNSPlugin      *dnsplugin.DNSPlugin
+	IfPlugin       *ifplugin.IfPlugin
+	IgmpPlugin     *igmpplugin.IgmpPlugin
+	IPFIXPlugin    *ipfixplugin.IPFIXPlugin
+	IPSecPlugin    *ipsecplugin.IPSecPlugin
+	L2Plugin       *l2plugin.L2Plugin
+	L3Plugin       *l3plugin.L3Plugin
+	LbPlugin       *lbplugin.LbPlugin
+	NATPlugin      *natplugin.NATPlugin
+	PuntPlugin     *puntplugin.PuntPlugin
+	STNPlugin      *stnplugin.STNPlugin
+	SRPlugin       *srplugin.SRPlugin
+	WgPlugin       *wireguardplugin.WgPlugin
 }
 
 func DefaultVPP() VPP {
 	return VPP{
-		ABFPlugin:   &abfplugin.DefaultPlugin,
-		ACLPlugin:   &aclplugin.DefaultPlugin,
-		DNSPlugin:   &dnsplugin.DefaultPlugin,
-		IfPlugin:    &ifplugin.DefaultPlugin,
-		IPFIXPlugin: &ipfixplugin.DefaultPlugin,
-		IPSecPlugin: &ipsecplugin.DefaultPlugin,
-		L2Plugin:    &l2plugin.DefaultPlugin,
-		L3Plugin:    &l3plugin.DefaultPlugin,
-		NATPlugin:   &natplugin.DefaultPlugin,
-		PuntPlugin:  &puntplugin.DefaultPlugin,
-		STNPlugin:   &stnplugin.DefaultPlugin,
-		SRPlugin:    &srplugin.DefaultPlugin,
-		WgPlugin:    &wireguardplugin.DefaultPlugin,
+		ABFPlugin:      &abfplugin.DefaultPlugin,
+		ACLPlugin:      &aclplugin.DefaultPlugin,
+		CNATPlugin:     &cnatplugin.DefaultPlugin,
+		ClassifyPlugin: &classifyplugin.DefaultPlugin,
+		DNSPlugin:      &dnsplugin.DefaultPlugin,
+		IfPlugin:       &ifplugin.DefaultPlugin,
+		IgmpPlugin:     &igmpplugin.DefaultPlugin,
+		IPFIXPlugin:    &ipfixplugin.DefaultPlugin,
+		IPSecPlugin:    &ipsecplugin.DefaultPlugin,
+		L2Plugin:       &l2plugin.DefaultPlugin,
+		L3Plugin:       &l3plugin.DefaultPlugin,
+		LbPlugin:       &lbplugin.DefaultPlugin,
+		NATPlugin:      &natplugin.DefaultPlugin,
+		PuntPlugin:     &puntplugin.DefaultPlugin,
+		STNPlugin:      &stnplugin.DefaultPlugin,
+		SRPlugin:       &srplugin.DefaultPlugin,
+		WgPlugin:       &wireguardplugin.DefaultPlugin,
 	}
 }
 
@@ -214,6 +230,8 @@ type Linux struct {
 	L3Plugin       *linux_l3plugin.L3Plugin
 	NSPlugin       *linux_nsplugin.NsPlugin
 	IPTablesPlugin *linux_iptablesplugin.IPTablesPlugin
+	TCPlugin       *linux_tcplugin.TCPlugin
+	SysctlPlugin   *linux_sysctlplugin.SysctlPlugin
 }
 
 func DefaultLinux() Linux {
@@ -222,5 +240,7 @@ func DefaultLinux() Linux {
 		L3Plugin:       &linux_l3plugin.DefaultPlugin,
 		NSPlugin:       &linux_nsplugin.DefaultPlugin,
 		IPTablesPlugin: &linux_iptablesplugin.DefaultPlugin,
+		TCPlugin:       &linux_tcplugin.DefaultPlugin,
+		SysctlPlugin:   &linux_sysctlplugin.DefaultPlugin,
 	}
 }
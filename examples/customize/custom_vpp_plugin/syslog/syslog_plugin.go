@@ -65,7 +65,7 @@ func (p *SyslogPlugin) Init() (err error) {
 	// Init and register KV descriptor
 	p.senderDescriptor = descriptor.NewSenderDescriptor(p.handler, p.Log)
 	senderDescriptor := adapter.NewSyslogSenderDescriptor(p.senderDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(senderDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, senderDescriptor)
 	if err != nil {
 		return err
 	}
@@ -108,4 +108,12 @@ type KnownModel interface {
 
 	// InstanceName computes message name for given proto message using name template (if present).
 	InstanceName(x interface{}) (string, error)
+
+	// Versions returns all model versions accepted for the model,
+	// starting with the current version of the model (Spec().Version).
+	Versions() []string
+
+	// ConvertVersion converts instance of the given model version
+	// into instance of the current model version.
+	ConvertVersion(version string, x proto.Message) (proto.Message, error)
 }
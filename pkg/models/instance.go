@@ -0,0 +1,89 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InstanceKeyPrefix is a prefix of keys scoped by dataplane (i.e. VPP) instance.
+// Keys without the prefix belong to the default instance.
+//
+// Example of key scoped by instance "vpp1":
+//
+//	instance/vpp1/config/vpp/v2/interfaces/memif1
+const InstanceKeyPrefix = "instance/"
+
+// DefaultInstance is the name referring to the default instance in keys scoped
+// by instance. It allows values of other instances to depend on values of the
+// default instance (such keys are resolved to keys without the instance scope).
+const DefaultInstance = "default"
+
+// InstanceKey returns the key scoped by the given instance. The key is returned
+// unchanged for the default instance (empty name). An error is returned if the key
+// is already scoped, so that it does not silently end up scoped by another instance
+// - keys referencing values of other instances are resolved by ScopeInstanceKey.
+func InstanceKey(instance, key string) (string, error) {
+	if strings.HasPrefix(key, InstanceKeyPrefix) {
+		return "", fmt.Errorf("key %q is already scoped by instance", key)
+	}
+	return instanceKey(instance, key), nil
+}
+
+func instanceKey(instance, key string) string {
+	if instance == "" {
+		return key
+	}
+	return InstanceKeyPrefix + instance + "/" + key
+}
+
+// DefaultInstanceKey returns the key referring to the value of the default instance
+// from values of other instances (see ScopeInstanceKey). The key is returned unchanged
+// if it already refers to the value of some instance.
+func DefaultInstanceKey(key string) string {
+	if strings.HasPrefix(key, InstanceKeyPrefix) {
+		return key
+	}
+	return instanceKey(DefaultInstance, key)
+}
+
+// ParseInstanceKey returns the instance of the given key (empty for the default
+// instance) and the key without the instance scope.
+func ParseInstanceKey(key string) (instance, unscoped string) {
+	if !strings.HasPrefix(key, InstanceKeyPrefix) {
+		return "", key
+	}
+	scoped := strings.TrimPrefix(key, InstanceKeyPrefix)
+	i := strings.Index(scoped, "/")
+	if i < 0 {
+		return "", key
+	}
+	if scoped[:i] == DefaultInstance {
+		return "", scoped[i+1:]
+	}
+	return scoped[:i], scoped[i+1:]
+}
+
+// ScopeInstanceKey returns the key of the value referenced from a value of the given
+// instance. Keys without the instance scope are scoped by the given instance, keys
+// scoped by the DefaultInstance are resolved to keys of the default instance and keys
+// scoped by other instances are returned unchanged.
+func ScopeInstanceKey(instance, key string) string {
+	if refInstance, unscoped := ParseInstanceKey(key); unscoped != key {
+		return instanceKey(refInstance, unscoped)
+	}
+	return instanceKey(instance, key)
+}
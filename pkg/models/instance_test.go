@@ -0,0 +1,79 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+func TestInstanceKey(t *testing.T) {
+	g := NewWithT(t)
+
+	const key = "config/vpp/v2/interfaces/memif1"
+
+	g.Expect(models.InstanceKey("", key)).To(Equal(key))
+	scoped, err := models.InstanceKey("vpp1", key)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(scoped).To(Equal("instance/vpp1/config/vpp/v2/interfaces/memif1"))
+
+	// already scoped key is rejected instead of being left scoped by other instance
+	_, err = models.InstanceKey("vpp2", scoped)
+	g.Expect(err).To(HaveOccurred())
+	_, err = models.InstanceKey("vpp1", scoped)
+	g.Expect(err).To(HaveOccurred())
+	_, err = models.InstanceKey("", scoped)
+	g.Expect(err).To(HaveOccurred())
+	_, err = models.InstanceKey("vpp1", models.DefaultInstanceKey(key))
+	g.Expect(err).To(HaveOccurred())
+
+	instance, unscoped := models.ParseInstanceKey(scoped)
+	g.Expect(instance).To(Equal("vpp1"))
+	g.Expect(unscoped).To(Equal(key))
+
+	instance, unscoped = models.ParseInstanceKey(key)
+	g.Expect(instance).To(BeEmpty())
+	g.Expect(unscoped).To(Equal(key))
+
+	instance, unscoped = models.ParseInstanceKey("instance/invalid")
+	g.Expect(instance).To(BeEmpty())
+	g.Expect(unscoped).To(Equal("instance/invalid"))
+}
+
+func TestScopeInstanceKey(t *testing.T) {
+	g := NewWithT(t)
+
+	const key = "config/vpp/v2/interfaces/memif1"
+
+	g.Expect(models.ScopeInstanceKey("", key)).To(Equal(key))
+	g.Expect(models.ScopeInstanceKey("vpp1", key)).To(Equal("instance/vpp1/" + key))
+	g.Expect(models.ScopeInstanceKey("vpp1", "instance/vpp2/"+key)).To(Equal("instance/vpp2/" + key))
+	g.Expect(models.ScopeInstanceKey("vpp1", "instance/vpp1/"+key)).To(Equal("instance/vpp1/" + key))
+
+	// key of the default instance referenced from other instance
+	defaultKey := models.DefaultInstanceKey(key)
+	g.Expect(defaultKey).To(Equal("instance/default/" + key))
+	g.Expect(models.ScopeInstanceKey("vpp1", defaultKey)).To(Equal(key))
+	g.Expect(models.ScopeInstanceKey("", defaultKey)).To(Equal(key))
+	g.Expect(models.DefaultInstanceKey(defaultKey)).To(Equal(defaultKey))
+	g.Expect(models.DefaultInstanceKey("instance/vpp2/" + key)).To(Equal("instance/vpp2/" + key))
+
+	instance, unscoped := models.ParseInstanceKey(defaultKey)
+	g.Expect(instance).To(BeEmpty())
+	g.Expect(unscoped).To(Equal(key))
+}
@@ -83,10 +83,18 @@ func UnmarshalItemUsingModelRegistry(item *api.Item, modelRegistry Registry) (pr
 	// LocallyKnownModel is used for proto message with go type generated from imported proto file and known
 	// at compile time, while RemotelyKnownModel can't produce such go typed instances (we know the name of go type,
 	// but can't produce it from remote information) so dynamic proto message must be enough (*dynamicpb.Message))
+	var msg proto.Message
 	if _, ok := model.(*LocallyKnownModel); ok {
-		return unmarshalItemDataAnyOfLocalModel(item.GetData().GetAny())
+		msg, err = unmarshalItemDataAnyOfLocalModel(item.GetData().GetAny())
+	} else {
+		msg, err = unmarshalItemDataAnyOfRemoteModel(item.GetData().GetAny(), modelRegistry.MessageTypeRegistry())
 	}
-	return unmarshalItemDataAnyOfRemoteModel(item.GetData().GetAny(), modelRegistry.MessageTypeRegistry())
+	if err != nil {
+		return nil, err
+	}
+
+	// convert item data of other model version into the current model version
+	return model.ConvertVersion(item.GetId().GetVersion(), msg)
 }
 
 // unmarshalItemDataAnyOfRemoteModel unmarshalls the generic data part of api.Item that has remote model.
@@ -128,6 +136,10 @@ func GetModelFromModelRegistryForItem(item *api.Item, modelRegistry Registry) (K
 		return nil, fmt.Errorf("can't find modelpath %v in provided "+
 			"models %+v", modelPath, modelRegistry)
 	}
+	if version := item.GetId().GetVersion(); version != "" && !isVersionSupported(model, version) {
+		return nil, fmt.Errorf("model %v does not support version %q (supported versions: %v)",
+			modelPath, version, model.Versions())
+	}
 	// TODO: check prefix in type url?
 	return model, nil
 }
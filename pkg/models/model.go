@@ -15,6 +15,7 @@
 package models
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -54,6 +55,7 @@ func (m *LocallyKnownModel) ModelDetail() *generic.ModelDetail {
 			{Key: "goType", Values: []string{m.GoType()}},
 			{Key: "pkgPath", Values: []string{m.PkgPath()}},
 			{Key: "protoFile", Values: []string{m.ProtoFile()}},
+			{Key: "versions", Values: m.Versions()},
 		},
 	}
 }
@@ -158,3 +160,31 @@ func (m *LocallyKnownModel) InstanceName(x interface{}) (string, error) {
 	}
 	return m.nameFunc(x)
 }
+
+// Versions returns all model versions accepted for the model,
+// starting with the current version of the model.
+func (m *LocallyKnownModel) Versions() []string {
+	versions := []string{m.spec.Version}
+	for _, v := range m.versions {
+		versions = append(versions, v.version)
+	}
+	return versions
+}
+
+// ConvertVersion converts instance of the given model version
+// into instance of the current model version.
+func (m *LocallyKnownModel) ConvertVersion(version string, x proto.Message) (proto.Message, error) {
+	if version == "" || version == m.spec.Version {
+		return x, nil
+	}
+	for _, v := range m.versions {
+		if v.version != version {
+			continue
+		}
+		if v.convert == nil || x == nil {
+			return x, nil
+		}
+		return v.convert(x)
+	}
+	return nil, fmt.Errorf("model %s does not support version %q", m.Name(), version)
+}
@@ -11,6 +11,7 @@ import (
 type modelOptions struct {
 	nameTemplate string
 	nameFunc     NameFunc
+	versions     []modelVersion
 }
 
 // ModelOption defines function type which sets model options.
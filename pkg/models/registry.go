@@ -230,6 +230,9 @@ func (r *LocalRegistry) Register(x interface{}, spec Spec, opts ...ModelOption)
 	for _, opt := range opts {
 		opt(&model.modelOptions)
 	}
+	if err := validateVersions(spec, model.versions); err != nil {
+		return nil, fmt.Errorf("versions validation for %s failed: %v", goType, err)
+	}
 
 	r.registeredModelsByGoType[goType] = model
 	r.modelNames[model.Name()] = model
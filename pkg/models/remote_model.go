@@ -132,6 +132,28 @@ func (m *RemotelyKnownModel) StripKeyPrefix(key string) string {
 	return key
 }
 
+// Versions returns all model versions accepted for the model,
+// starting with the current version of the model.
+func (m *RemotelyKnownModel) Versions() []string {
+	for _, opt := range m.model.Options {
+		if opt.Key == "versions" && len(opt.Values) > 0 {
+			return opt.Values
+		}
+	}
+	return []string{m.model.GetSpec().GetVersion()}
+}
+
+// ConvertVersion converts instance of the given model version into instance of the current model version.
+// Conversion functions of remote models are not known locally, so only instances of the current
+// version are accepted.
+func (m *RemotelyKnownModel) ConvertVersion(version string, x proto.Message) (proto.Message, error) {
+	if version == "" || version == m.model.GetSpec().GetVersion() {
+		return x, nil
+	}
+	return nil, errors.Errorf("conversion of remote model %s from version %q is not supported",
+		m.Name(), version)
+}
+
 // InstanceName computes message name for given proto message using name template (if present).
 func (m *RemotelyKnownModel) InstanceName(x interface{}) (string, error) {
 	message := protoMessageOf(x)
@@ -0,0 +1,140 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ConvertFunc converts instance of other model version into instance
+// of the current model version.
+type ConvertFunc func(x proto.Message) (proto.Message, error)
+
+type modelVersion struct {
+	version string
+	convert ConvertFunc
+}
+
+// WithVersion returns option for models which declares another (older or newer)
+// version of the model that is accepted and converted to the current version.
+// Instances of all model versions use the same proto message, the conversion
+// function typically moves values of deprecated fields into their replacements.
+// The nil conversion function accepts instances of the version unchanged.
+func WithVersion(version string, convert ConvertFunc) ModelOption {
+	return func(opts *modelOptions) {
+		opts.versions = append(opts.versions, modelVersion{
+			version: version,
+			convert: convert,
+		})
+	}
+}
+
+// validateVersions checks versions declared for the model with WithVersion.
+func validateVersions(spec Spec, versions []modelVersion) error {
+	seen := map[string]bool{spec.Version: true}
+	for _, v := range versions {
+		if !validVersion.MatchString(v.version) {
+			return fmt.Errorf("invalid version: %q", v.version)
+		}
+		if seen[v.version] {
+			return fmt.Errorf("version %q declared more than once", v.version)
+		}
+		seen[v.version] = true
+	}
+	return nil
+}
+
+// GetModelForVersionedKey returns model registered in DefaultRegistry which matches
+// key of any supported model version together with the version used in the key.
+func GetModelForVersionedKey(key string) (KnownModel, string, error) {
+	return GetModelFromRegistryForVersionedKey(key, DefaultRegistry)
+}
+
+// GetModelFromRegistryForVersionedKey returns model registered in modelRegistry which matches
+// key of any supported model version together with the version used in the key.
+func GetModelFromRegistryForVersionedKey(key string, modelRegistry Registry) (KnownModel, string, error) {
+	if model, err := modelRegistry.GetModelForKey(key); err == nil {
+		return model, model.Spec().Version, nil
+	}
+	for _, model := range modelRegistry.RegisteredModels() {
+		for _, version := range model.Versions()[1:] {
+			if _, valid := parseVersionedKey(model, version, key); valid {
+				return model, version, nil
+			}
+		}
+	}
+	return nil, "", fmt.Errorf("no registered model matches for key %v in any version", key)
+}
+
+// ToCurrentVersion converts key of any supported model version and its value into key
+// and value of the current model version. The value can be nil (e.g. for delete).
+// Keys not matching any registered model are returned unchanged.
+func ToCurrentVersion(key string, x proto.Message) (string, proto.Message, error) {
+	return ToCurrentVersionUsingModelRegistry(key, x, DefaultRegistry)
+}
+
+// ToCurrentVersionUsingModelRegistry converts key of any supported model version and its value
+// into key and value of the current model version (using given model registry).
+func ToCurrentVersionUsingModelRegistry(key string, x proto.Message, modelRegistry Registry) (string, proto.Message, error) {
+	model, version, err := GetModelFromRegistryForVersionedKey(key, modelRegistry)
+	if err != nil || version == model.Spec().Version {
+		return key, x, nil
+	}
+	name, _ := parseVersionedKey(model, version, key)
+	if x == nil {
+		return path.Join(model.KeyPrefix(), name), nil, nil
+	}
+	converted, err := model.ConvertVersion(version, x)
+	if err != nil {
+		return "", nil, err
+	}
+	name, err = model.InstanceName(converted)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot compute model instance name due to: %v (message %+v)", err, converted)
+	}
+	return path.Join(model.KeyPrefix(), name), converted, nil
+}
+
+// KeyPrefixForVersion returns key prefix used for instances of the given model version.
+func KeyPrefixForVersion(model KnownModel, version string) string {
+	spec := *model.Spec()
+	spec.Version = version
+	return keyPrefix(spec, model.NameTemplate() != "")
+}
+
+// isVersionSupported returns true if the model accepts instances of the given version.
+func isVersionSupported(model KnownModel, version string) bool {
+	for _, v := range model.Versions() {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVersionedKey parses the key of the given model version and returns item name
+// or returns empty name and valid as false if the key is not valid.
+func parseVersionedKey(model KnownModel, version string, key string) (name string, valid bool) {
+	hasTemplate := model.NameTemplate() != ""
+	name = strings.TrimPrefix(key, KeyPrefixForVersion(model, version))
+	if name != key && (name != "" || !hasTemplate) {
+		return name, true
+	}
+	return "", false
+}
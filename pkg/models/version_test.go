@@ -0,0 +1,158 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package models_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	. "go.ligato.io/vpp-agent/v3/pkg/models"
+	testmodel "go.ligato.io/vpp-agent/v3/pkg/models/testdata/proto"
+	api "go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+// convertBasicV1 moves value of the field used in v1 into the field used in v2.
+func convertBasicV1(x proto.Message) (proto.Message, error) {
+	old := x.(*testmodel.Basic)
+	return &testmodel.Basic{
+		Name:       old.Name,
+		ValueInt64: int64(old.ValueInt),
+	}, nil
+}
+
+func registerVersionedBasic() KnownModel {
+	return Register(&testmodel.Basic{}, Spec{
+		Module:  "module",
+		Version: "v2",
+		Type:    "basic",
+	}, WithVersion("v1", convertBasicV1), WithVersion("v3", nil))
+}
+
+func TestVersions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ResetDefaultRegistry()
+
+	model := registerVersionedBasic()
+	g.Expect(model.Versions()).To(Equal([]string{"v2", "v1", "v3"}))
+
+	var versions []string
+	for _, opt := range model.ModelDetail().GetOptions() {
+		if opt.GetKey() == "versions" {
+			versions = opt.GetValues()
+		}
+	}
+	g.Expect(versions).To(Equal([]string{"v2", "v1", "v3"}))
+}
+
+func TestRegisterInvalidVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ResetDefaultRegistry()
+
+	_, err := DefaultRegistry.Register(&testmodel.Basic{}, Spec{
+		Module:  "module",
+		Version: "v2",
+		Type:    "basic",
+	}, WithVersion("v2", nil))
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = DefaultRegistry.Register(&testmodel.Basic{}, Spec{
+		Module:  "module",
+		Version: "v2",
+		Type:    "basic",
+	}, WithVersion("1", nil))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestGetModelForVersionedKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ResetDefaultRegistry()
+
+	registerVersionedBasic()
+
+	model, version, err := GetModelForVersionedKey("config/module/v2/basic/a")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(model.Name()).To(Equal("module.basic"))
+	g.Expect(version).To(Equal("v2"))
+
+	model, version, err = GetModelForVersionedKey("config/module/v1/basic/a")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(model.Name()).To(Equal("module.basic"))
+	g.Expect(version).To(Equal("v1"))
+
+	_, _, err = GetModelForVersionedKey("config/module/v4/basic/a")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestToCurrentVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ResetDefaultRegistry()
+
+	registerVersionedBasic()
+
+	key, val, err := ToCurrentVersion("config/module/v1/basic/a", &testmodel.Basic{
+		Name:     "a",
+		ValueInt: 10,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal("config/module/v2/basic/a"))
+	g.Expect(proto.Equal(val, &testmodel.Basic{Name: "a", ValueInt64: 10})).To(BeTrue())
+
+	key, val, err = ToCurrentVersion("config/module/v1/basic/a", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal("config/module/v2/basic/a"))
+	g.Expect(val).To(BeNil())
+
+	current := &testmodel.Basic{Name: "b", ValueInt: 5}
+	key, val, err = ToCurrentVersion("config/module/v2/basic/b", current)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal("config/module/v2/basic/b"))
+	g.Expect(val).To(Equal(current))
+
+	key, val, err = ToCurrentVersion("config/unknown/v1/basic/b", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal("config/unknown/v1/basic/b"))
+	g.Expect(val).To(BeNil())
+}
+
+func TestUnmarshalItemOfOtherVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ResetDefaultRegistry()
+
+	registerVersionedBasic()
+
+	data, err := anypb.New(&testmodel.Basic{Name: "a", ValueInt: 10})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	item := &api.Item{
+		Id: &api.Item_ID{
+			Model:   "module.basic",
+			Name:    "a",
+			Version: "v1",
+		},
+		Data: &api.Data{
+			Union: &api.Data_Any{Any: data},
+		},
+	}
+	val, err := UnmarshalItem(item)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(proto.Equal(val, &testmodel.Basic{Name: "a", ValueInt64: 10})).To(BeTrue())
+
+	item.Id.Version = "v4"
+	_, err = UnmarshalItem(item)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,209 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/unrolled/render"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
+)
+
+var (
+	// ErrUnauthenticated is returned when the caller identity can't be established
+	// (i.e. invalid or expired bearer token).
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied is returned when the caller is not allowed to perform the operation.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// API defines authentication of REST and gRPC API callers and authorization
+// of operations they perform.
+type API interface {
+	// AuthenticateHTTP returns identity of the caller of the given HTTP request.
+	AuthenticateHTTP(req *http.Request) (*Identity, error)
+
+	// AuthenticateGRPC returns identity of the caller of the gRPC call with the given context.
+	AuthenticateGRPC(ctx context.Context) (*Identity, error)
+
+	// Authorize returns error wrapping ErrPermissionDenied if the identity
+	// is not allowed to perform the given operation.
+	Authorize(id *Identity, op Operation) error
+}
+
+// Access defines level of access required by an operation.
+type Access int
+
+const (
+	// ReadAccess is required to read configuration, state and statistics.
+	ReadAccess Access = iota
+	// WriteAccess is required to modify configuration.
+	WriteAccess
+	// AdminAccess is required to run VPP CLI commands, access binary API
+	// directly or trigger downstream resync.
+	AdminAccess
+)
+
+// String returns name of the access level.
+func (a Access) String() string {
+	switch a {
+	case ReadAccess:
+		return "read"
+	case WriteAccess:
+		return "write"
+	case AdminAccess:
+		return "admin"
+	}
+	return fmt.Sprintf("Access(%d)", int(a))
+}
+
+// Operation describes operation performed by API caller.
+type Operation struct {
+	// Name identifies operation in audit log (i.e. "GenericManager.SetConfig").
+	Name string
+	// Access is required access level.
+	Access Access
+	// Keys are keys of configuration items modified by WriteAccess operation.
+	Keys []string
+	// FullResync is true if WriteAccess operation replaces the whole configuration
+	// (and thus may remove any configuration item).
+	FullResync bool
+	// KeysAuthorizedLater is true if the keys of WriteAccess operation are not known
+	// yet and the caller authorizes them by another operation once they are. Without
+	// it, WriteAccess operation with no keys is permitted only to unrestricted writers.
+	KeysAuthorizedLater bool
+}
+
+// AuthMethod is method used to authenticate the API caller.
+type AuthMethod string
+
+const (
+	// AuthNone is used for callers that did not present any credentials.
+	AuthNone AuthMethod = "none"
+	// AuthClientCert is used for callers identified by verified TLS client certificate.
+	AuthClientCert AuthMethod = "mtls"
+	// AuthBearerToken is used for callers identified by bearer token (JWT).
+	AuthBearerToken AuthMethod = "token"
+)
+
+// Identity represents authenticated API caller.
+type Identity struct {
+	// Subject is name of the caller (common name of the client certificate
+	// or subject of the bearer token).
+	Subject string
+	// Method is authentication method used to identify the caller.
+	Method AuthMethod
+	// Roles are roles granted to the caller.
+	Roles []Role
+}
+
+// String returns identity description used in logs.
+func (id *Identity) String() string {
+	if id == nil {
+		return "<unknown>"
+	}
+	if id.Subject == "" {
+		return fmt.Sprintf("<anonymous> (%s)", id.Method)
+	}
+	return fmt.Sprintf("%s (%s)", id.Subject, id.Method)
+}
+
+type identityKey struct{}
+
+// WithIdentity returns context carrying the caller identity. Identity of authenticated
+// caller is also propagated via contextdecorator to be recorded with transactions.
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	if id != nil && id.Subject != "" {
+		ctx = contextdecorator.IdentityContext(ctx, id.String())
+	}
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns caller identity carried by the context.
+func IdentityFromContext(ctx context.Context) (id *Identity, ok bool) {
+	id, ok = ctx.Value(identityKey{}).(*Identity)
+	return
+}
+
+// AuthorizeGRPC authenticates the caller of gRPC call and authorizes the operation.
+// The returned error is gRPC status error, the returned context carries the caller identity.
+// If the API is nil, all operations are allowed.
+func AuthorizeGRPC(a API, ctx context.Context, op Operation) (context.Context, error) {
+	if a == nil {
+		return ctx, nil
+	}
+	id, err := a.AuthenticateGRPC(ctx)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := a.Authorize(id, op); err != nil {
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return WithIdentity(ctx, id), nil
+}
+
+// AuthorizeHTTP authenticates the caller of HTTP request and authorizes the operation.
+// The returned request carries the caller identity in its context. If the API is nil,
+// all operations are allowed.
+func AuthorizeHTTP(a API, req *http.Request, op Operation) (*http.Request, error) {
+	if a == nil {
+		return req, nil
+	}
+	id, err := a.AuthenticateHTTP(req)
+	if err != nil {
+		return req, err
+	}
+	if err := a.Authorize(id, op); err != nil {
+		return req, err
+	}
+	return req.WithContext(WithIdentity(req.Context(), id)), nil
+}
+
+// HTTPStatus returns HTTP status code for the error returned by API.
+func HTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrPermissionDenied):
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
+
+// WrapHTTPHandler returns HTTP handler provider that authorizes the operation
+// before the handler is invoked.
+func WrapHTTPHandler(a API, op Operation,
+	provider func(formatter *render.Render) http.HandlerFunc) func(formatter *render.Render) http.HandlerFunc {
+	if a == nil {
+		return provider
+	}
+	return func(formatter *render.Render) http.HandlerFunc {
+		handler := provider(formatter)
+		return func(w http.ResponseWriter, req *http.Request) {
+			req, err := AuthorizeHTTP(a, req, op)
+			if err != nil {
+				_ = formatter.JSON(w, HTTPStatus(err), err.Error())
+				return
+			}
+			handler(w, req)
+		}
+	}
+}
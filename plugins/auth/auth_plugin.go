@@ -0,0 +1,206 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// authorizationHeader is HTTP header (and gRPC metadata key) carrying bearer token.
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+
+	defaultRolesClaim = "roles"
+)
+
+// Config represents configuration of the authentication and authorization.
+type Config struct {
+	// Enabled turns on authentication and authorization (all operations are allowed if disabled).
+	Enabled bool `json:"enabled"`
+	// JWKSFile is path to JSON Web Key Set file with public keys used to verify bearer tokens.
+	// Bearer tokens are not accepted if not set.
+	JWKSFile string `json:"jwks-file"`
+	// TokenIssuer is required issuer ("iss" claim) of bearer tokens (not checked if empty).
+	TokenIssuer string `json:"token-issuer"`
+	// TokenAudience is required audience ("aud" claim) of bearer tokens (not checked if empty).
+	TokenAudience string `json:"token-audience"`
+	// RolesClaim is name of the bearer token claim with granted roles (default "roles").
+	RolesClaim string `json:"roles-claim"`
+	// Clients grants roles to clients identified by verified TLS client certificate.
+	Clients []ClientConfig `json:"clients"`
+	// AnonymousRoles are roles granted to callers without any credentials.
+	AnonymousRoles []string `json:"anonymous-roles"`
+}
+
+// ClientConfig grants roles to client identified by TLS client certificate.
+type ClientConfig struct {
+	// Subject is common name of the client certificate.
+	Subject string `json:"subject"`
+	// Roles granted to the client.
+	Roles []string `json:"roles"`
+}
+
+// Plugin authenticates callers of REST and gRPC API and authorizes operations they perform.
+type Plugin struct {
+	Deps
+
+	config         *Config
+	audit          logging.Logger
+	verifier       *tokenVerifier
+	clientRoles    map[string][]Role
+	anonymousRoles []Role
+}
+
+// Deps represents dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+}
+
+// Init loads the configuration and signing keys of bearer tokens.
+func (p *Plugin) Init() (err error) {
+	p.audit = p.Log.NewLogger("audit")
+
+	p.config = &Config{RolesClaim: defaultRolesClaim}
+	if _, err = p.Cfg.LoadValue(p.config); err != nil {
+		return err
+	}
+	if !p.config.Enabled {
+		p.Log.Info("Authentication of API callers is disabled")
+		return nil
+	}
+
+	p.clientRoles = make(map[string][]Role)
+	for _, client := range p.config.Clients {
+		if p.clientRoles[client.Subject], err = ParseRoles(client.Roles); err != nil {
+			return fmt.Errorf("client %q: %w", client.Subject, err)
+		}
+	}
+	if p.anonymousRoles, err = ParseRoles(p.config.AnonymousRoles); err != nil {
+		return fmt.Errorf("anonymous roles: %w", err)
+	}
+	if p.config.JWKSFile != "" {
+		p.verifier, err = newTokenVerifier(p.config.JWKSFile, p.config.TokenIssuer, p.config.TokenAudience)
+		if err != nil {
+			return fmt.Errorf("loading JWKS file %s failed: %w", p.config.JWKSFile, err)
+		}
+	}
+	p.Log.Infof("Authentication of API callers is enabled (bearer tokens: %t, client certificates: %d)",
+		p.verifier != nil, len(p.clientRoles))
+	return nil
+}
+
+// Close does nothing.
+func (p *Plugin) Close() error {
+	return nil
+}
+
+func (p *Plugin) enabled() bool {
+	return p.config != nil && p.config.Enabled
+}
+
+// AuthenticateHTTP returns identity of the caller of the given HTTP request.
+func (p *Plugin) AuthenticateHTTP(req *http.Request) (*Identity, error) {
+	return p.authenticate(req.Header.Get(authorizationHeader), req.TLS)
+}
+
+// AuthenticateGRPC returns identity of the caller of the gRPC call with the given context.
+func (p *Plugin) AuthenticateGRPC(ctx context.Context) (*Identity, error) {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	var state *tls.ConnectionState
+	if pr, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+	return p.authenticate(authorization, state)
+}
+
+func (p *Plugin) authenticate(authorization string, state *tls.ConnectionState) (*Identity, error) {
+	if !p.enabled() {
+		return &Identity{Method: AuthNone}, nil
+	}
+	if authorization != "" {
+		if !strings.HasPrefix(authorization, bearerPrefix) {
+			return nil, fmt.Errorf("%w: unsupported authorization scheme", ErrUnauthenticated)
+		}
+		return p.authenticateToken(strings.TrimPrefix(authorization, bearerPrefix))
+	}
+	if state != nil && len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0 {
+		return p.authenticateCert(state.VerifiedChains[0][0]), nil
+	}
+	return &Identity{Method: AuthNone, Roles: p.anonymousRoles}, nil
+}
+
+func (p *Plugin) authenticateToken(token string) (*Identity, error) {
+	if p.verifier == nil {
+		return nil, fmt.Errorf("%w: bearer tokens are not accepted", ErrUnauthenticated)
+	}
+	claims, err := p.verifier.Verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+	id := &Identity{Method: AuthBearerToken}
+	id.Subject, _ = claims["sub"].(string)
+	for _, s := range stringClaimValues(claims[p.config.RolesClaim]) {
+		role, err := ParseRole(s)
+		if err != nil {
+			p.Log.Warnf("ignoring role %q of token subject %q: %v", s, id.Subject, err)
+			continue
+		}
+		id.Roles = append(id.Roles, role)
+	}
+	return id, nil
+}
+
+func (p *Plugin) authenticateCert(cert *x509.Certificate) *Identity {
+	return &Identity{
+		Subject: cert.Subject.CommonName,
+		Method:  AuthClientCert,
+		Roles:   p.clientRoles[cert.Subject.CommonName],
+	}
+}
+
+// Authorize returns error wrapping ErrPermissionDenied if the identity
+// is not allowed to perform the given operation.
+func (p *Plugin) Authorize(id *Identity, op Operation) error {
+	if !p.enabled() {
+		return nil
+	}
+	if !id.Permits(op) {
+		p.audit.Warnf("DENIED %s access for %s: %s %v", op.Access, id, op.Name, op.Keys)
+		return fmt.Errorf("%w: %s is not allowed to perform %s (%s access)", ErrPermissionDenied, id, op.Name, op.Access)
+	}
+	if op.Access != ReadAccess {
+		p.audit.Infof("ALLOWED %s access for %s: %s %v", op.Access, id, op.Name, op.Keys)
+	}
+	return nil
+}
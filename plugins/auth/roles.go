@@ -0,0 +1,159 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// RoleName is name of the role granted to API caller.
+type RoleName string
+
+const (
+	// RoleReadOnly allows to read configuration, state and statistics.
+	RoleReadOnly RoleName = "read-only"
+	// RoleConfigWriter allows to read and modify configuration (optionally restricted
+	// to configuration items with given key prefixes).
+	RoleConfigWriter RoleName = "config-writer"
+	// RoleAdmin allows all operations.
+	RoleAdmin RoleName = "admin"
+)
+
+// Role is role granted to API caller.
+type Role struct {
+	Name RoleName
+	// KeyPrefixes restricts RoleConfigWriter to configuration items with keys
+	// starting with one of the prefixes (no restriction if empty).
+	KeyPrefixes []string
+}
+
+// String returns role in the format accepted by ParseRole.
+func (r Role) String() string {
+	if len(r.KeyPrefixes) == 0 {
+		return string(r.Name)
+	}
+	return string(r.Name) + ":" + strings.Join(r.KeyPrefixes, ",")
+}
+
+// ParseRole parses role from string in format "<role-name>[:<prefix>,<prefix>...]".
+// The prefix is either key prefix (i.e. "config/vpp/v2/interfaces/") or model name
+// (i.e. "vpp.interfaces") that is replaced with key prefix of the model.
+func ParseRole(s string) (Role, error) {
+	name, prefixes := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		name, prefixes = s[:i], s[i+1:]
+	}
+	role := Role{Name: RoleName(strings.TrimSpace(name))}
+	switch role.Name {
+	case RoleReadOnly, RoleAdmin:
+		if prefixes != "" {
+			return Role{}, fmt.Errorf("role %q does not support key prefixes", role.Name)
+		}
+	case RoleConfigWriter:
+		for _, prefix := range strings.Split(prefixes, ",") {
+			prefix = strings.TrimSpace(prefix)
+			if prefix == "" {
+				continue
+			}
+			if !strings.Contains(prefix, "/") {
+				model, err := models.GetModel(prefix)
+				if err != nil {
+					return Role{}, fmt.Errorf("role %q: %w", s, err)
+				}
+				prefix = model.KeyPrefix()
+			}
+			role.KeyPrefixes = append(role.KeyPrefixes, prefix)
+		}
+	default:
+		return Role{}, fmt.Errorf("unknown role %q", name)
+	}
+	return role, nil
+}
+
+// ParseRoles parses list of roles (see ParseRole).
+func ParseRoles(ss []string) ([]Role, error) {
+	var roles []Role
+	for _, s := range ss {
+		role, err := ParseRole(s)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func (r Role) permitsKey(key string) bool {
+	switch r.Name {
+	case RoleAdmin:
+		return true
+	case RoleConfigWriter:
+		if len(r.KeyPrefixes) == 0 {
+			return true
+		}
+		for _, prefix := range r.KeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r Role) isUnrestrictedWriter() bool {
+	return r.Name == RoleAdmin || (r.Name == RoleConfigWriter && len(r.KeyPrefixes) == 0)
+}
+
+// Permits returns true if the identity has role(s) allowing the operation.
+func (id *Identity) Permits(op Operation) bool {
+	if id == nil {
+		return false
+	}
+	hasRole := func(match func(Role) bool) bool {
+		for _, role := range id.Roles {
+			if match(role) {
+				return true
+			}
+		}
+		return false
+	}
+	switch op.Access {
+	case ReadAccess:
+		return len(id.Roles) > 0
+	case AdminAccess:
+		return hasRole(func(r Role) bool { return r.Name == RoleAdmin })
+	case WriteAccess:
+		if op.FullResync {
+			return hasRole(Role.isUnrestrictedWriter)
+		}
+		if len(op.Keys) == 0 {
+			if op.KeysAuthorizedLater {
+				return hasRole(func(r Role) bool { return r.Name == RoleAdmin || r.Name == RoleConfigWriter })
+			}
+			// nothing to check key prefixes against, fail closed
+			return hasRole(Role.isUnrestrictedWriter)
+		}
+		for _, key := range op.Keys {
+			if !hasRole(func(r Role) bool { return r.permitsKey(key) }) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseRole(t *testing.T) {
+	g := NewWithT(t)
+
+	role, err := ParseRole("read-only")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(role).To(Equal(Role{Name: RoleReadOnly}))
+
+	role, err = ParseRole("config-writer:config/vpp/v2/interfaces/, config/vpp/v2/route/")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(role.Name).To(Equal(RoleConfigWriter))
+	g.Expect(role.KeyPrefixes).To(Equal([]string{"config/vpp/v2/interfaces/", "config/vpp/v2/route/"}))
+	g.Expect(role.String()).To(Equal("config-writer:config/vpp/v2/interfaces/,config/vpp/v2/route/"))
+
+	_, err = ParseRole("admin:config/vpp/")
+	g.Expect(err).To(HaveOccurred())
+	_, err = ParseRole("superuser")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestIdentityPermits(t *testing.T) {
+	const (
+		ifaceKey = "config/vpp/v2/interfaces/loop1"
+		routeKey = "config/vpp/v2/route/vrf/0/dst/10.0.0.0/8/gw/"
+	)
+	reader := &Identity{Roles: []Role{{Name: RoleReadOnly}}}
+	writer := &Identity{Roles: []Role{{Name: RoleConfigWriter}}}
+	ifaceWriter := &Identity{Roles: []Role{{Name: RoleConfigWriter, KeyPrefixes: []string{"config/vpp/v2/interfaces/"}}}}
+	admin := &Identity{Roles: []Role{{Name: RoleAdmin}}}
+	nobody := &Identity{}
+
+	tests := []struct {
+		name string
+		id   *Identity
+		op   Operation
+		want bool
+	}{
+		{"reader reads", reader, Operation{Access: ReadAccess}, true},
+		{"reader writes", reader, Operation{Access: WriteAccess, Keys: []string{ifaceKey}}, false},
+		{"nobody reads", nobody, Operation{Access: ReadAccess}, false},
+		{"nil identity reads", nil, Operation{Access: ReadAccess}, false},
+		{"writer writes", writer, Operation{Access: WriteAccess, Keys: []string{ifaceKey, routeKey}}, true},
+		{"writer resyncs", writer, Operation{Access: WriteAccess, FullResync: true}, true},
+		{"writer runs CLI", writer, Operation{Access: AdminAccess}, false},
+		{"prefixed writer writes own key", ifaceWriter, Operation{Access: WriteAccess, Keys: []string{ifaceKey}}, true},
+		{"prefixed writer writes other key", ifaceWriter, Operation{Access: WriteAccess, Keys: []string{ifaceKey, routeKey}}, false},
+		{"prefixed writer resyncs", ifaceWriter, Operation{Access: WriteAccess, FullResync: true}, false},
+		{"prefixed writer writes without keys", ifaceWriter, Operation{Access: WriteAccess}, false},
+		{"prefixed writer writes keys authorized later", ifaceWriter, Operation{Access: WriteAccess, KeysAuthorizedLater: true}, true},
+		{"writer writes without keys", writer, Operation{Access: WriteAccess}, true},
+		{"reader writes keys authorized later", reader, Operation{Access: WriteAccess, KeysAuthorizedLater: true}, false},
+		{"prefixed writer reads", ifaceWriter, Operation{Access: ReadAccess}, true},
+		{"admin runs CLI", admin, Operation{Access: AdminAccess}, true},
+		{"admin resyncs", admin, Operation{Access: WriteAccess, FullResync: true}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.id.Permits(test.op); got != test.want {
+				t.Errorf("Permits() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clockSkew is tolerated difference between clocks of token issuer and the agent.
+const clockSkew = time.Minute
+
+// jsonWebKey is public key from JSON Web Key Set (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// signingKey is public key used to verify token signatures.
+type signingKey struct {
+	key crypto.PublicKey
+	// alg is algorithm the key is intended for (any if empty).
+	alg string
+}
+
+// parseJWKS parses JSON Web Key Set and returns signing keys indexed by key ID.
+func parseJWKS(data []byte) (map[string]signingKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	keys := make(map[string]signingKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q in JWKS: %w", jwk.Kid, err)
+		}
+		if jwk.Alg != "" {
+			algorithm, ok := tokenAlgorithms[jwk.Alg]
+			if !ok {
+				return nil, fmt.Errorf("unsupported algorithm %q of key %q in JWKS", jwk.Alg, jwk.Kid)
+			}
+			if algorithm.kty != jwk.Kty {
+				return nil, fmt.Errorf("algorithm %q does not match type %q of key %q in JWKS", jwk.Alg, jwk.Kty, jwk.Kid)
+			}
+		}
+		keys[jwk.Kid] = signingKey{key: key, alg: jwk.Alg}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no signing keys")
+	}
+	return keys, nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", jwk.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// tokenVerifier verifies bearer tokens (JWT) signed by keys from local JWKS file.
+// The file is reloaded when it changes, so that the keys can be rotated without
+// restarting the agent.
+type tokenVerifier struct {
+	jwksFile string
+	issuer   string
+	audience string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	modTime time.Time
+	keys    map[string]signingKey
+}
+
+func newTokenVerifier(jwksFile, issuer, audience string) (*tokenVerifier, error) {
+	v := &tokenVerifier{
+		jwksFile: jwksFile,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}
+	if _, err := v.signingKeys(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (v *tokenVerifier) signingKeys() (map[string]signingKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	info, err := os.Stat(v.jwksFile)
+	if err != nil {
+		return nil, err
+	}
+	if v.keys != nil && info.ModTime().Equal(v.modTime) {
+		return v.keys, nil
+	}
+	data, err := ioutil.ReadFile(v.jwksFile)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	v.keys, v.modTime = keys, info.ModTime()
+	return keys, nil
+}
+
+// Verify verifies the token signature and validity and returns its claims.
+func (v *tokenVerifier) Verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+
+	keys, err := v.signingKeys()
+	if err != nil {
+		return nil, fmt.Errorf("can't load signing keys: %w", err)
+	}
+	key, ok := keys[header.Kid]
+	if !ok && header.Kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			key, ok = k, true
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", header.Kid)
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *tokenVerifier) validateClaims(claims map[string]interface{}) error {
+	now := v.now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("token has no expiration time")
+	}
+	if now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return errors.New("token is not valid yet")
+	}
+	if v.issuer != "" && claims["iss"] != v.issuer {
+		return fmt.Errorf("unexpected token issuer %v", claims["iss"])
+	}
+	if v.audience != "" && !stringClaimContains(claims["aud"], v.audience) {
+		return fmt.Errorf("token is not issued for audience %q", v.audience)
+	}
+	return nil
+}
+
+// stringClaimContains returns true if the claim is either the given string or list containing it.
+func stringClaimContains(claim interface{}, s string) bool {
+	for _, v := range stringClaimValues(claim) {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// stringClaimValues returns values of claim that is either list of strings or space-separated string.
+func stringClaimValues(claim interface{}) []string {
+	switch c := claim.(type) {
+	case string:
+		return strings.Fields(c)
+	case []interface{}:
+		var values []string
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// tokenAlgorithm describes supported JWS algorithm (RFC 7518).
+type tokenAlgorithm struct {
+	hash crypto.Hash
+	kty  string // required key type
+	crv  string // required curve of EC key
+	pss  bool   // RSASSA-PSS instead of RSASSA-PKCS1-v1_5
+}
+
+// tokenAlgorithms are supported JWS algorithms by their exact names.
+var tokenAlgorithms = map[string]tokenAlgorithm{
+	"RS256": {hash: crypto.SHA256, kty: "RSA"},
+	"RS384": {hash: crypto.SHA384, kty: "RSA"},
+	"RS512": {hash: crypto.SHA512, kty: "RSA"},
+	"PS256": {hash: crypto.SHA256, kty: "RSA", pss: true},
+	"PS384": {hash: crypto.SHA384, kty: "RSA", pss: true},
+	"PS512": {hash: crypto.SHA512, kty: "RSA", pss: true},
+	"ES256": {hash: crypto.SHA256, kty: "EC", crv: "P-256"},
+	"ES384": {hash: crypto.SHA384, kty: "EC", crv: "P-384"},
+	"ES512": {hash: crypto.SHA512, kty: "EC", crv: "P-521"},
+}
+
+// verifySignature verifies the token signature. The algorithm from the token header must
+// be supported, must match type (and curve) of the key and the algorithm the key is
+// restricted to in JWKS (if any).
+func verifySignature(alg string, key signingKey, signingInput string, signature []byte) error {
+	algorithm, ok := tokenAlgorithms[alg]
+	if !ok {
+		return fmt.Errorf("unsupported token algorithm %q", alg)
+	}
+	if key.alg != "" && key.alg != alg {
+		return fmt.Errorf("token algorithm %q does not match key algorithm %q", alg, key.alg)
+	}
+	h := algorithm.hash.New()
+	h.Write([]byte(signingInput))
+	digest := h.Sum(nil)
+
+	switch pub := key.key.(type) {
+	case *rsa.PublicKey:
+		if algorithm.kty != "RSA" {
+			return fmt.Errorf("key type does not match token algorithm %q", alg)
+		}
+		var err error
+		if algorithm.pss {
+			err = rsa.VerifyPSS(pub, algorithm.hash, digest, signature, nil)
+		} else {
+			err = rsa.VerifyPKCS1v15(pub, algorithm.hash, digest, signature)
+		}
+		if err != nil {
+			return errors.New("invalid token signature")
+		}
+	case *ecdsa.PublicKey:
+		if algorithm.kty != "EC" || pub.Curve.Params().Name != algorithm.crv {
+			return fmt.Errorf("key type does not match token algorithm %q", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid token signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid token signature")
+		}
+	default:
+		return fmt.Errorf("key type does not match token algorithm %q", alg)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signToken(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signingInput := b64(header) + "." + b64(payload)
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signingInput + "." + b64(signature)
+}
+
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "kid": "rsa1", "use": "sig", "alg": "RS256",
+				"n": b64(rsaKey.N.Bytes()),
+				"e": b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC", "kid": "ec1", "crv": "P-256",
+				"x": b64(ecKey.X.FillBytes(make([]byte, 32))),
+				"y": b64(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+		},
+	}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestTokenVerifier(t *testing.T) {
+	g := NewWithT(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ToNot(HaveOccurred())
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	verifier, err := newTokenVerifier(writeJWKS(t, rsaKey, ecKey), "issuer", "vpp-agent")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	now := time.Now()
+	claims := func(modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub":   "portal",
+			"iss":   "issuer",
+			"aud":   []string{"vpp-agent", "other"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"roles": []string{"config-writer"},
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+
+	verified, err := verifier.Verify(signToken(t, "RS256", "rsa1", rsaKey, claims(nil)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(verified).To(HaveKeyWithValue("sub", "portal"))
+	g.Expect(stringClaimValues(verified["roles"])).To(Equal([]string{"config-writer"}))
+
+	_, err = verifier.Verify(signToken(t, "ES256", "ec1", ecKey, claims(nil)))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// signed by unknown key
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa1", otherKey, claims(nil)))
+	g.Expect(err).To(MatchError("invalid token signature"))
+	// unknown key ID
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa2", rsaKey, claims(nil)))
+	g.Expect(err).To(HaveOccurred())
+	// algorithm does not match key
+	_, err = verifier.Verify(signToken(t, "ES256", "rsa1", ecKey, claims(nil)))
+	g.Expect(err).To(HaveOccurred())
+	// unsigned token
+	_, err = verifier.Verify(signToken(t, "none", "rsa1", rsaKey, claims(nil)))
+	g.Expect(err).To(HaveOccurred())
+	// malformed algorithms
+	for _, alg := range []string{"SSR256", "PPP256", "RSES256", "256", "rs256"} {
+		_, err = verifier.Verify(signToken(t, alg, "rsa1", rsaKey, claims(nil)))
+		g.Expect(err).To(MatchError(ContainSubstring("unsupported token algorithm")), alg)
+	}
+	// algorithm does not match algorithm of the key in JWKS
+	_, err = verifier.Verify(signToken(t, "PS256", "rsa1", rsaKey, claims(nil)))
+	g.Expect(err).To(MatchError(ContainSubstring("does not match key algorithm")))
+	// algorithm does not match curve of the key
+	_, err = verifier.Verify(signToken(t, "ES384", "ec1", ecKey, claims(nil)))
+	g.Expect(err).To(MatchError(ContainSubstring("key type does not match")))
+
+	// expired
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+		c["exp"] = now.Add(-time.Hour).Unix()
+	})))
+	g.Expect(err).To(MatchError("token is expired"))
+	// no expiration
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+		delete(c, "exp")
+	})))
+	g.Expect(err).To(HaveOccurred())
+	// wrong issuer
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+		c["iss"] = "someone"
+	})))
+	g.Expect(err).To(HaveOccurred())
+	// wrong audience
+	_, err = verifier.Verify(signToken(t, "RS256", "rsa1", rsaKey, claims(func(c map[string]interface{}) {
+		c["aud"] = "other"
+	})))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestParseJWKSAlgorithm(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := parseJWKS([]byte(`{"keys":[{"kty":"RSA","kid":"k1","alg":"ES256","n":"AQAB","e":"AQAB"}]}`))
+	g.Expect(err).To(MatchError(ContainSubstring("does not match type")))
+
+	_, err = parseJWKS([]byte(`{"keys":[{"kty":"RSA","kid":"k1","alg":"SSR256","n":"AQAB","e":"AQAB"}]}`))
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported algorithm")))
+
+	keys, err := parseJWKS([]byte(`{"keys":[{"kty":"RSA","kid":"k1","alg":"PS384","n":"AQAB","e":"AQAB"}]}`))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(keys["k1"].alg).To(Equal("PS384"))
+}
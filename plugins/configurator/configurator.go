@@ -31,6 +31,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/pkg/util"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
@@ -54,20 +55,31 @@ type configuratorServer struct {
 
 	log      logging.Logger
 	dispatch orchestrator.Dispatcher
+	auth     auth.API
 }
 
 func (svc *configuratorServer) Dump(ctx context.Context, req *pb.DumpRequest) (*pb.DumpResponse, error) {
+	if err := svc.authorizeRead(ctx, "Configurator.Dump"); err != nil {
+		return nil, err
+	}
 	return svc.dumpService.Dump(ctx, req)
 }
 
 func (svc *configuratorServer) Notify(from *pb.NotifyRequest, server pb.ConfiguratorService_NotifyServer) error {
+	if err := svc.authorizeRead(server.Context(), "Configurator.Notify"); err != nil {
+		return err
+	}
 	return svc.notifyService.Notify(from, server)
 }
 
 // Get retrieves actual configuration data.
-func (svc *configuratorServer) Get(context.Context, *pb.GetRequest) (*pb.GetResponse, error) {
+func (svc *configuratorServer) Get(ctx context.Context, _ *pb.GetRequest) (*pb.GetResponse, error) {
 	defer trackOperation("Get")()
 
+	if err := svc.authorizeRead(ctx, "Configurator.Get"); err != nil {
+		return nil, err
+	}
+
 	config := newConfig()
 
 	util.PlaceProtos(svc.dispatch.ListData(),
@@ -109,22 +121,25 @@ func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest
 		})
 	}
 
+	ctx, err := svc.authorizeWrite(ctx, "Configurator.Update", kvPairs, req.FullResync)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.FullResync {
 		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 	}
 
-	md, hasMeta := metadata.FromIncomingContext(ctx)
-	if hasMeta && len(md["datasrc"]) == 1 {
-		ctx = contextdecorator.DataSrcContext(ctx, md["datasrc"][0])
-	} else {
-		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
-	}
+	ctx = contextdecorator.GRPCContext(ctx)
 	results, err := svc.dispatch.PushData(ctx, kvPairs)
 
 	header := map[string]string{}
 	if seqNum := svc.extractTxnSeqNum(results); seqNum >= 0 {
 		header["seqnum"] = fmt.Sprint(seqNum)
 	}
+	if requestID, ok := contextdecorator.RequestIDFromContext(ctx); ok {
+		header[contextdecorator.RequestIDMetadataKey] = requestID
+	}
 	if err := grpc.SetHeader(ctx, metadata.New(header)); err != nil {
 		logging.Warnf("sending grpc header failed: %v", err)
 	}
@@ -189,18 +204,21 @@ func (svc *configuratorServer) Delete(ctx context.Context, req *pb.DeleteRequest
 		})
 	}
 
-	md, hasMeta := metadata.FromIncomingContext(ctx)
-	if hasMeta && len(md["datasrc"]) == 1 {
-		ctx = contextdecorator.DataSrcContext(ctx, md["datasrc"][0])
-	} else {
-		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
+	ctx, err := svc.authorizeWrite(ctx, "Configurator.Delete", kvPairs, false)
+	if err != nil {
+		return nil, err
 	}
+
+	ctx = contextdecorator.GRPCContext(ctx)
 	results, err := svc.dispatch.PushData(ctx, kvPairs)
 
 	header := map[string]string{}
 	if seqNum := svc.extractTxnSeqNum(results); seqNum >= 0 {
 		header["seqnum"] = fmt.Sprint(seqNum)
 	}
+	if requestID, ok := contextdecorator.RequestIDFromContext(ctx); ok {
+		header[contextdecorator.RequestIDMetadataKey] = requestID
+	}
 	if err := grpc.SendHeader(ctx, metadata.New(header)); err != nil {
 		logging.Warnf("sending grpc header failed: %v", err)
 	}
@@ -239,6 +257,27 @@ func (svc *configuratorServer) Delete(ctx context.Context, req *pb.DeleteRequest
 	return &pb.DeleteResponse{}, nil
 }
 
+// authorizeRead authorizes caller of gRPC call to read configuration or state.
+func (svc *configuratorServer) authorizeRead(ctx context.Context, name string) error {
+	_, err := auth.AuthorizeGRPC(svc.auth, ctx, auth.Operation{Name: name, Access: auth.ReadAccess})
+	return err
+}
+
+// authorizeWrite authorizes caller of gRPC call to modify the configuration items.
+func (svc *configuratorServer) authorizeWrite(ctx context.Context, name string, kvPairs []orchestrator.KeyVal,
+	fullResync bool) (context.Context, error) {
+	keys := make([]string, 0, len(kvPairs))
+	for _, kv := range kvPairs {
+		keys = append(keys, kv.Key)
+	}
+	return auth.AuthorizeGRPC(svc.auth, ctx, auth.Operation{
+		Name:       name,
+		Access:     auth.WriteAccess,
+		Keys:       keys,
+		FullResync: fullResync,
+	})
+}
+
 func (svc *configuratorServer) listPending(keys []string) []string {
 	var pending []string
 	for _, key := range keys {
@@ -0,0 +1,187 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package configurator
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// natSessionService implements NatSessionService providing access
+// to the NAT44 session table of VPP.
+type natSessionService struct {
+	vpp_nat.UnimplementedNatSessionServiceServer
+
+	log  logging.Logger
+	auth auth.API
+
+	mux        sync.Mutex
+	natHandler natvppcalls.NatVppAPI
+}
+
+// DumpUsers returns NAT44 users.
+func (svc *natSessionService) DumpUsers(ctx context.Context, req *vpp_nat.DumpUsersRequest) (*vpp_nat.DumpUsersResponse, error) {
+	defer trackOperation("DumpNatUsers")()
+
+	if _, err := auth.AuthorizeGRPC(svc.auth, ctx, auth.Operation{Name: "NatSessionService.DumpUsers", Access: auth.ReadAccess}); err != nil {
+		return nil, err
+	}
+
+	if svc.natHandler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP NAT handler is not available")
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	users, err := svc.natHandler.Nat44UsersDump()
+	if err != nil {
+		svc.log.Errorf("Nat44UsersDump failed: %v", err)
+		return nil, err
+	}
+	return &vpp_nat.DumpUsersResponse{Users: users}, nil
+}
+
+// DumpSessions returns NAT44 sessions matching the filter.
+func (svc *natSessionService) DumpSessions(ctx context.Context, req *vpp_nat.DumpSessionsRequest) (*vpp_nat.DumpSessionsResponse, error) {
+	defer trackOperation("DumpNatSessions")()
+
+	if _, err := auth.AuthorizeGRPC(svc.auth, ctx, auth.Operation{Name: "NatSessionService.DumpSessions", Access: auth.ReadAccess}); err != nil {
+		return nil, err
+	}
+
+	if err := validateNat44SessionFilter(req.GetFilter()); err != nil {
+		return nil, err
+	}
+	if svc.natHandler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP NAT handler is not available")
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	sessions, err := svc.matchingSessions(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	return &vpp_nat.DumpSessionsResponse{Sessions: sessions}, nil
+}
+
+// DeleteSessions deletes NAT44 sessions matching the filter.
+func (svc *natSessionService) DeleteSessions(ctx context.Context, req *vpp_nat.DeleteSessionsRequest) (*vpp_nat.DeleteSessionsResponse, error) {
+	defer trackOperation("DeleteNatSessions")()
+
+	if _, err := auth.AuthorizeGRPC(svc.auth, ctx, auth.Operation{Name: "NatSessionService.DeleteSessions", Access: auth.AdminAccess}); err != nil {
+		return nil, err
+	}
+
+	if err := validateNat44SessionFilter(req.GetFilter()); err != nil {
+		return nil, err
+	}
+	// empty filter matches all sessions, flushing the whole table must be requested explicitly
+	if !req.GetAll() && isEmptyNat44SessionFilter(req.GetFilter()) {
+		return nil, status.Error(codes.InvalidArgument, "no session filter defined (set all to delete all sessions)")
+	}
+	if svc.natHandler == nil {
+		return nil, status.Error(codes.Unavailable, "VPP NAT handler is not available")
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	sessions, err := svc.matchingSessions(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vpp_nat.DeleteSessionsResponse{}
+	for _, session := range sessions {
+		if err := svc.natHandler.DelNat44Session(session); err != nil {
+			svc.log.Errorf("DelNat44Session failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to delete NAT44 session %s:%d (%d sessions deleted before): %v",
+				session.InsideIpAddress, session.InsidePort, len(resp.Deleted), err)
+		}
+		resp.Deleted = append(resp.Deleted, session)
+	}
+	svc.log.Debugf("deleted %d NAT44 sessions", len(resp.Deleted))
+	return resp, nil
+}
+
+func (svc *natSessionService) matchingSessions(filter *vpp_nat.Nat44SessionFilter) ([]*vpp_nat.Nat44Session, error) {
+	sessions, err := svc.natHandler.Nat44SessionsDump()
+	if err != nil {
+		svc.log.Errorf("Nat44SessionsDump failed: %v", err)
+		return nil, err
+	}
+	var matching []*vpp_nat.Nat44Session
+	for _, session := range sessions {
+		if nat44SessionMatches(filter, session) {
+			matching = append(matching, session)
+		}
+	}
+	return matching, nil
+}
+
+// validateNat44SessionFilter checks addresses used in the filter.
+func validateNat44SessionFilter(filter *vpp_nat.Nat44SessionFilter) error {
+	if addr := filter.GetInsideIpAddress(); addr != "" && net.ParseIP(addr).To4() == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid inside IPv4 address: %q", addr)
+	}
+	if addr := filter.GetOutsideIpAddress(); addr != "" && net.ParseIP(addr).To4() == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid outside IPv4 address: %q", addr)
+	}
+	return nil
+}
+
+// isEmptyNat44SessionFilter returns true if the filter has no field set (i.e. matches all sessions).
+func isEmptyNat44SessionFilter(filter *vpp_nat.Nat44SessionFilter) bool {
+	return filter == nil || proto.Equal(filter, &vpp_nat.Nat44SessionFilter{})
+}
+
+// nat44SessionMatches returns true if the session matches all fields set in the filter.
+func nat44SessionMatches(filter *vpp_nat.Nat44SessionFilter, session *vpp_nat.Nat44Session) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.InsideIpAddress != "" && !net.ParseIP(filter.InsideIpAddress).Equal(net.ParseIP(session.InsideIpAddress)) {
+		return false
+	}
+	if filter.InsidePort != 0 && filter.InsidePort != session.InsidePort {
+		return false
+	}
+	if filter.OutsideIpAddress != "" && !net.ParseIP(filter.OutsideIpAddress).Equal(net.ParseIP(session.OutsideIpAddress)) {
+		return false
+	}
+	if filter.OutsidePort != 0 && filter.OutsidePort != session.OutsidePort {
+		return false
+	}
+	if filter.MatchProtocol && filter.Protocol != session.Protocol {
+		return false
+	}
+	if filter.MatchVrf && filter.VrfId != session.VrfId {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package configurator
+
+import (
+	"context"
+	"testing"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestNat44SessionMatches(t *testing.T) {
+	session := &vpp_nat.Nat44Session{
+		InsideIpAddress:  "10.0.0.1",
+		InsidePort:       8080,
+		OutsideIpAddress: "192.168.10.1",
+		OutsidePort:      1024,
+		Protocol:         vpp_nat.DNat44_UDP,
+		VrfId:            1,
+	}
+	tests := []struct {
+		name   string
+		filter *vpp_nat.Nat44SessionFilter
+		want   bool
+	}{
+		{
+			name:   "no filter",
+			filter: nil,
+			want:   true,
+		},
+		{
+			name:   "empty filter",
+			filter: &vpp_nat.Nat44SessionFilter{},
+			want:   true,
+		},
+		{
+			name: "inside tuple",
+			filter: &vpp_nat.Nat44SessionFilter{
+				InsideIpAddress: "10.0.0.1",
+				InsidePort:      8080,
+			},
+			want: true,
+		},
+		{
+			name: "different inside port",
+			filter: &vpp_nat.Nat44SessionFilter{
+				InsideIpAddress: "10.0.0.1",
+				InsidePort:      8081,
+			},
+			want: false,
+		},
+		{
+			name: "outside address",
+			filter: &vpp_nat.Nat44SessionFilter{
+				OutsideIpAddress: "192.168.10.1",
+				OutsidePort:      1024,
+			},
+			want: true,
+		},
+		{
+			name: "different outside address",
+			filter: &vpp_nat.Nat44SessionFilter{
+				OutsideIpAddress: "192.168.10.2",
+			},
+			want: false,
+		},
+		{
+			name: "protocol not matched by default",
+			filter: &vpp_nat.Nat44SessionFilter{
+				Protocol: vpp_nat.DNat44_TCP,
+			},
+			want: true,
+		},
+		{
+			name: "different protocol",
+			filter: &vpp_nat.Nat44SessionFilter{
+				Protocol:      vpp_nat.DNat44_TCP,
+				MatchProtocol: true,
+			},
+			want: false,
+		},
+		{
+			name: "default VRF",
+			filter: &vpp_nat.Nat44SessionFilter{
+				VrfId:    0,
+				MatchVrf: true,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nat44SessionMatches(tt.filter, session); got != tt.want {
+				t.Errorf("nat44SessionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// mockNatHandler keeps NAT44 sessions in memory.
+type mockNatHandler struct {
+	natvppcalls.NatVppAPI
+	sessions []*vpp_nat.Nat44Session
+}
+
+func (h *mockNatHandler) Nat44SessionsDump() ([]*vpp_nat.Nat44Session, error) {
+	return h.sessions, nil
+}
+
+func (h *mockNatHandler) DelNat44Session(session *vpp_nat.Nat44Session) error {
+	for i, s := range h.sessions {
+		if s == session {
+			h.sessions = append(h.sessions[:i], h.sessions[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func TestDeleteSessions(t *testing.T) {
+	handler := &mockNatHandler{sessions: []*vpp_nat.Nat44Session{
+		{InsideIpAddress: "10.0.0.1", InsidePort: 8080},
+		{InsideIpAddress: "10.0.0.2", InsidePort: 8080},
+		{InsideIpAddress: "10.0.0.3", InsidePort: 8080},
+	}}
+	svc := &natSessionService{
+		log:        logrus.NewLogger("test-log"),
+		natHandler: handler,
+	}
+	ctx := context.Background()
+
+	// empty filter is rejected unless all sessions are requested explicitly
+	for _, filter := range []*vpp_nat.Nat44SessionFilter{nil, {}} {
+		_, err := svc.DeleteSessions(ctx, &vpp_nat.DeleteSessionsRequest{Filter: filter})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("DeleteSessions() with empty filter: error = %v, want InvalidArgument", err)
+		}
+	}
+	if len(handler.sessions) != 3 {
+		t.Fatalf("sessions deleted with empty filter")
+	}
+
+	resp, err := svc.DeleteSessions(ctx, &vpp_nat.DeleteSessionsRequest{
+		Filter: &vpp_nat.Nat44SessionFilter{InsideIpAddress: "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatalf("DeleteSessions() error = %v", err)
+	}
+	if len(resp.Deleted) != 1 || len(handler.sessions) != 2 {
+		t.Fatalf("DeleteSessions() deleted %d sessions, want 1", len(resp.Deleted))
+	}
+
+	resp, err = svc.DeleteSessions(ctx, &vpp_nat.DeleteSessionsRequest{All: true})
+	if err != nil {
+		t.Fatalf("DeleteSessions() error = %v", err)
+	}
+	if len(resp.Deleted) != 2 || len(handler.sessions) != 0 {
+		t.Fatalf("DeleteSessions() deleted %d sessions, want 2", len(resp.Deleted))
+	}
+}
@@ -15,6 +15,7 @@
 package configurator
 
 import (
+	"context"
 	"log"
 	"sync"
 	"sync/atomic"
@@ -46,6 +47,12 @@ type notifyService struct {
 
 // Notify returns all required VPP notifications (or those available in the buffer) in the same order as they were received
 func (svc *notifyService) Notify(from *pb.NotifyRequest, server pb.ConfiguratorService_NotifyServer) error {
+	return svc.watch(server.Context(), from, server.Send)
+}
+
+// watch sends all required notifications (or those available in the buffer) followed by new
+// notifications using the send callback, until the context is done or sending fails.
+func (svc *notifyService) watch(ctx context.Context, from *pb.NotifyRequest, send func(*pb.NotifyResponse) error) error {
 	svc.mx.RLock()
 
 	// Copy requested index locally
@@ -62,7 +69,7 @@ func (svc *notifyService) Notify(from *pb.NotifyRequest, server pb.ConfiguratorS
 		if !isFilter(entry.GetNotification(), from.Filters) {
 			continue
 		}
-		if err := server.Send(entry); err != nil {
+		if err := send(entry); err != nil {
 			svc.mx.RUnlock()
 			svc.log.Warnf("Notify send error: %v", err)
 			return err
@@ -79,8 +86,8 @@ func (svc *notifyService) Notify(from *pb.NotifyRequest, server pb.ConfiguratorS
 
 	select {
 	case svc.watchs <- w:
-	case <-server.Context().Done():
-		return server.Context().Err()
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 
 	defer func() {
@@ -90,12 +97,12 @@ func (svc *notifyService) Notify(from *pb.NotifyRequest, server pb.ConfiguratorS
 	for {
 		select {
 		case n := <-w.notifs:
-			if err := server.Send(n); err != nil {
+			if err := send(n); err != nil {
 				svc.log.Warnf("Notify send error: %v", err)
 				return err
 			}
-		case <-server.Context().Done():
-			return server.Context().Err()
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 }
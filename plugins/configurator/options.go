@@ -18,6 +18,7 @@ import (
 	"go.ligato.io/cn-infra/v2/rpc/grpc"
 	"go.ligato.io/cn-infra/v2/servicelabel"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	linuxifplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
@@ -38,6 +39,7 @@ func NewPlugin(opts ...Option) *Plugin {
 
 	p.PluginName = "configurator"
 	p.GRPCServer = &grpc.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 	p.Dispatch = &orchestrator.DefaultPlugin
 	p.VPP = &govppmux.DefaultPlugin
 	p.ServiceLabel = &servicelabel.DefaultPlugin
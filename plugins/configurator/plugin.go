@@ -15,11 +15,14 @@
 package configurator
 
 import (
+	"context"
+
 	"go.ligato.io/cn-infra/v2/infra"
 	"go.ligato.io/cn-infra/v2/rpc/grpc"
 	"go.ligato.io/cn-infra/v2/servicelabel"
 	"google.golang.org/protobuf/proto"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	iflinuxplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
@@ -43,6 +46,7 @@ import (
 	pb "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 // Default Go routine count for linux configuration retrieval
@@ -53,12 +57,14 @@ type Plugin struct {
 	Deps
 
 	configurator configuratorServer
+	natSessions  natSessionService
 }
 
 // Deps - dependencies of Plugin
 type Deps struct {
 	infra.PluginDeps
 	GRPCServer    grpc.Server
+	Auth          auth.API
 	Dispatch      orchestrator.Dispatcher
 	VPP           govppmux.API
 	ServiceLabel  servicelabel.ReaderAPI
@@ -77,7 +83,10 @@ func (p *Plugin) Init() error {
 	p.configurator.dumpService.log = p.Log.NewLogger("dump")
 	p.configurator.notifyService.log = p.Log.NewLogger("notify")
 	p.configurator.notifyService.init()
+	p.natSessions.log = p.Log.NewLogger("nat-sessions")
 	p.configurator.dispatch = p.Dispatch
+	p.configurator.auth = p.Auth
+	p.natSessions.auth = p.Auth
 
 	if err := p.initHandlers(); err != nil {
 		return err
@@ -86,6 +95,7 @@ func (p *Plugin) Init() error {
 	grpcServer := p.GRPCServer.GetServer()
 	if grpcServer != nil {
 		pb.RegisterConfiguratorServiceServer(grpcServer, &p.configurator)
+		vpp_nat.RegisterNatSessionServiceServer(grpcServer, &p.natSessions)
 	}
 
 	if p.VPPIfPlugin != nil {
@@ -121,6 +131,13 @@ func (p *Plugin) sendNotification(notification proto.Message) {
 	}
 }
 
+// WatchNotifications sends VPP and Linux notifications matching the request (starting
+// with those available in the buffer from the requested index) using the send callback.
+// It blocks until the context is done or sending fails.
+func (p *Plugin) WatchNotifications(ctx context.Context, req *pb.NotifyRequest, send func(*pb.NotifyResponse) error) error {
+	return p.configurator.notifyService.watch(ctx, req, send)
+}
+
 // Close does nothing.
 func (p *Plugin) Close() error {
 	return nil
@@ -164,8 +181,10 @@ func (p *Plugin) initHandlers() (err error) {
 	if p.configurator.aclHandler == nil {
 		p.Log.Info("VPP ACL handler is not available, it will be skipped")
 	}
-	p.configurator.natHandler = natvppcalls.CompatibleNatVppHandler(p.VPP, ifIndexes, dhcpIndexes, p.Log)
-	if p.configurator.natHandler == nil {
+	natHandler := natvppcalls.CompatibleNatVppHandler(p.VPP, ifIndexes, dhcpIndexes, p.Log)
+	p.configurator.natHandler = natHandler
+	p.natSessions.natHandler = natHandler
+	if natHandler == nil {
 		p.Log.Info("VPP NAT handler is not available, it will be skipped")
 	}
 	p.configurator.puntHandler = puntvppcalls.CompatiblePuntVppHandler(p.VPP, ifIndexes, p.Log)
@@ -0,0 +1,248 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package apitrace defines records of traced VPP binary API calls, bounded
+// buffer keeping the most recent records and their export to JSON lines.
+package apitrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JSONLinesContentType is content type of records exported as JSON lines.
+const JSONLinesContentType = "application/x-ndjson"
+
+// Names of URL parameters selecting records (see Filter).
+const (
+	SinceParamName   = "since"
+	TxnParamName     = "txn"
+	KeyParamName     = "key"
+	MessageParamName = "message"
+	LimitParamName   = "limit"
+)
+
+// Record is a record of binary API request and its reply.
+type Record struct {
+	// Seq is a sequence number of the record.
+	Seq uint64 `json:"seq"`
+	// Instance is a name of the VPP instance (empty for the default instance).
+	Instance string `json:"instance,omitempty"`
+	// Timestamp is time when the request was sent.
+	Timestamp time.Time `json:"timestamp"`
+	// Message is name of the request message.
+	Message string `json:"message"`
+	// CRC is CRC of the request message.
+	CRC string `json:"crc"`
+	// Request contains fields of the request message.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Reply is name of the reply message (the last reply for multi-requests).
+	Reply string `json:"reply,omitempty"`
+	// ReplyData contains fields of the reply message (not recorded for multi-requests).
+	ReplyData json.RawMessage `json:"replyData,omitempty"`
+	// Replies is a number of replies received for multi-request.
+	Replies int `json:"replies,omitempty"`
+	// Retval is return value of the reply.
+	Retval int32 `json:"retval"`
+	// Error describes failed request.
+	Error string `json:"error,omitempty"`
+	// Latency is time from sending the request until receiving the (last) reply.
+	Latency time.Duration `json:"latency"`
+
+	// TxnSeqNum is a sequence number of the scheduler transaction which sent the request.
+	TxnSeqNum *uint64 `json:"txnSeqNum,omitempty"`
+	// Descriptor is a name of the descriptor which sent the request.
+	Descriptor string `json:"descriptor,omitempty"`
+	// Operation is the descriptor operation which sent the request.
+	Operation string `json:"operation,omitempty"`
+	// Key is a key of the value for which the request was sent.
+	Key string `json:"key,omitempty"`
+}
+
+// Filter selects records.
+type Filter struct {
+	// Since selects records with sequence number greater or equal.
+	Since uint64
+	// TxnSeqNum selects records of the transaction.
+	TxnSeqNum *uint64
+	// Key selects records sent for values with keys starting with the prefix.
+	Key string
+	// Message selects records of requests with the message name.
+	Message string
+	// Limit selects only the given number of the most recent records.
+	Limit int
+}
+
+// Match returns true if the record is selected by the filter (Limit is not considered).
+func (f Filter) Match(r *Record) bool {
+	if r.Seq < f.Since {
+		return false
+	}
+	if f.TxnSeqNum != nil && (r.TxnSeqNum == nil || *r.TxnSeqNum != *f.TxnSeqNum) {
+		return false
+	}
+	if f.Key != "" && !strings.HasPrefix(r.Key, f.Key) {
+		return false
+	}
+	if f.Message != "" && r.Message != f.Message {
+		return false
+	}
+	return true
+}
+
+// Values returns URL parameters representing the filter.
+func (f Filter) Values() url.Values {
+	query := url.Values{}
+	if f.Since > 0 {
+		query.Set(SinceParamName, strconv.FormatUint(f.Since, 10))
+	}
+	if f.TxnSeqNum != nil {
+		query.Set(TxnParamName, strconv.FormatUint(*f.TxnSeqNum, 10))
+	}
+	if f.Key != "" {
+		query.Set(KeyParamName, f.Key)
+	}
+	if f.Message != "" {
+		query.Set(MessageParamName, f.Message)
+	}
+	if f.Limit > 0 {
+		query.Set(LimitParamName, strconv.Itoa(f.Limit))
+	}
+	return query
+}
+
+// ParseFilter parses filter from URL parameters.
+func ParseFilter(query url.Values) (f Filter, err error) {
+	if since := query.Get(SinceParamName); since != "" {
+		if f.Since, err = strconv.ParseUint(since, 10, 64); err != nil {
+			return f, fmt.Errorf("invalid %s: %w", SinceParamName, err)
+		}
+	}
+	if txn := query.Get(TxnParamName); txn != "" {
+		seqNum, err := strconv.ParseUint(txn, 10, 64)
+		if err != nil {
+			return f, fmt.Errorf("invalid %s: %w", TxnParamName, err)
+		}
+		f.TxnSeqNum = &seqNum
+	}
+	if limit := query.Get(LimitParamName); limit != "" {
+		if f.Limit, err = strconv.Atoi(limit); err != nil {
+			return f, fmt.Errorf("invalid %s: %w", LimitParamName, err)
+		}
+	}
+	f.Key = query.Get(KeyParamName)
+	f.Message = query.Get(MessageParamName)
+	return f, nil
+}
+
+// Buffer keeps the most recent records in a ring buffer. All records
+// can be exported as they are added.
+type Buffer struct {
+	mu      sync.Mutex
+	records []Record
+	size    int
+	nextSeq uint64
+	export  *Exporter
+}
+
+// NewBuffer returns buffer keeping up to size most recent records.
+func NewBuffer(size int) *Buffer {
+	return &Buffer{
+		records: make([]Record, 0, size),
+		size:    size,
+		nextSeq: 1,
+	}
+}
+
+// SetExport sets exporter of the added records.
+func (b *Buffer) SetExport(export *Exporter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.export = export
+}
+
+// Add numbers the record and adds it to the buffer (replacing the oldest one
+// if the buffer is full). The record is only queued for export, therefore
+// adding never blocks on writing the export.
+func (b *Buffer) Add(r Record) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r.Seq = b.nextSeq
+	b.nextSeq++
+	if len(b.records) < b.size {
+		b.records = append(b.records, r)
+	} else if b.size > 0 {
+		b.records[(r.Seq-1)%uint64(b.size)] = r
+	}
+	if b.export != nil {
+		b.export.Export(r)
+	}
+}
+
+// Query returns buffered records selected by the filter ordered by their sequence numbers.
+func (b *Buffer) Query(f Filter) []Record {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []Record
+	n := uint64(len(b.records))
+	for seq := b.nextSeq - n; seq < b.nextSeq; seq++ {
+		r := &b.records[(seq-1)%uint64(b.size)]
+		if f.Match(r) {
+			records = append(records, *r)
+		}
+	}
+	if f.Limit > 0 && len(records) > f.Limit {
+		records = records[len(records)-f.Limit:]
+	}
+	return records
+}
+
+// WriteJSONLines writes records as JSON lines (one JSON-encoded record per line).
+func WriteJSONLines(w io.Writer, records []Record) error {
+	enc := json.NewEncoder(w)
+	for i := range records {
+		if err := enc.Encode(&records[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadJSONLines reads records written as JSON lines.
+func ReadJSONLines(r io.Reader) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
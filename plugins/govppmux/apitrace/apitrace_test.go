@@ -0,0 +1,134 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package apitrace_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
+)
+
+func txnSeqNum(n uint64) *uint64 {
+	return &n
+}
+
+func TestBuffer(t *testing.T) {
+	g := NewWithT(t)
+
+	exportFile := filepath.Join(t.TempDir(), "api-trace.jsonl")
+	export, err := apitrace.NewExporter(exportFile, 0, 0, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	b := apitrace.NewBuffer(3)
+	b.SetExport(export)
+
+	b.Add(apitrace.Record{Message: "show_version"})
+	b.Add(apitrace.Record{Message: "sw_interface_set_flags", TxnSeqNum: txnSeqNum(1),
+		Key: "config/vpp/v2/interfaces/memif1", Request: json.RawMessage(`{"SwIfIndex":1}`)})
+	b.Add(apitrace.Record{Message: "sw_interface_add_del_address", TxnSeqNum: txnSeqNum(1),
+		Key: "vpp/interface/memif1/address/static/10.0.0.1/24"})
+	b.Add(apitrace.Record{Message: "sw_interface_set_flags", TxnSeqNum: txnSeqNum(2),
+		Key: "config/vpp/v2/interfaces/memif2"})
+
+	// the oldest record is replaced
+	records := b.Query(apitrace.Filter{})
+	g.Expect(records).To(HaveLen(3))
+	g.Expect(records[0].Seq).To(BeEquivalentTo(2))
+	g.Expect(records[2].Seq).To(BeEquivalentTo(4))
+
+	records = b.Query(apitrace.Filter{TxnSeqNum: txnSeqNum(1)})
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[0].Message).To(Equal("sw_interface_set_flags"))
+
+	records = b.Query(apitrace.Filter{Key: "config/vpp/v2/interfaces/"})
+	g.Expect(records).To(HaveLen(2))
+
+	records = b.Query(apitrace.Filter{Message: "sw_interface_set_flags", Limit: 1})
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0].Seq).To(BeEquivalentTo(4))
+
+	records = b.Query(apitrace.Filter{Since: 4})
+	g.Expect(records).To(HaveLen(1))
+
+	// all records are exported
+	g.Expect(export.Close()).To(Succeed())
+	exported := readExport(g, exportFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(exported).To(HaveLen(4))
+	g.Expect(exported[0].Message).To(Equal("show_version"))
+	g.Expect(exported[1].Request).To(MatchJSON(`{"SwIfIndex":1}`))
+	g.Expect(*exported[3].TxnSeqNum).To(BeEquivalentTo(2))
+}
+
+func TestExportRotation(t *testing.T) {
+	g := NewWithT(t)
+
+	record := apitrace.Record{Message: "sw_interface_dump"}
+	var line bytes.Buffer
+	g.Expect(apitrace.WriteJSONLines(&line, []apitrace.Record{record})).To(Succeed())
+
+	// the file is rotated after every two records
+	exportFile := filepath.Join(t.TempDir(), "api-trace.jsonl")
+	export, err := apitrace.NewExporter(exportFile, int64(2*line.Len()), 0, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	for i := 0; i < 5; i++ {
+		export.Export(record)
+	}
+	g.Expect(export.Close()).To(Succeed())
+	g.Expect(export.Dropped()).To(BeZero())
+
+	g.Expect(readExport(g, exportFile)).To(HaveLen(1))
+	g.Expect(readExport(g, exportFile+".1")).To(HaveLen(2))
+
+	// records exported after close are ignored
+	export.Export(record)
+	g.Expect(readExport(g, exportFile)).To(HaveLen(1))
+}
+
+func readExport(g *WithT, path string) []apitrace.Record {
+	f, err := os.Open(path)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer f.Close()
+	records, err := apitrace.ReadJSONLines(f)
+	g.Expect(err).ToNot(HaveOccurred())
+	return records
+}
+
+func TestFilterValues(t *testing.T) {
+	g := NewWithT(t)
+
+	filter := apitrace.Filter{
+		Since:     10,
+		TxnSeqNum: txnSeqNum(0),
+		Key:       "config/vpp/",
+		Message:   "sw_interface_dump",
+		Limit:     5,
+	}
+	parsed, err := apitrace.ParseFilter(filter.Values())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(parsed).To(Equal(filter))
+
+	parsed, err = apitrace.ParseFilter(apitrace.Filter{}.Values())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(parsed).To(Equal(apitrace.Filter{}))
+
+	_, err = apitrace.ParseFilter(map[string][]string{apitrace.TxnParamName: {"x"}})
+	g.Expect(err).To(HaveOccurred())
+}
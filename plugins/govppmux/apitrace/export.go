@@ -0,0 +1,164 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package apitrace
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultExportQueueSize is a number of records waiting to be exported
+// before new records are dropped.
+const DefaultExportQueueSize = 1000
+
+// rotatedSuffix is appended to the export file when it is rotated.
+const rotatedSuffix = ".1"
+
+// Exporter appends records to a file as JSON lines. Records are written by
+// a background goroutine, so that the traced requests are never blocked on
+// the file. Records which do not fit into the queue are dropped. The file is
+// rotated after it reaches the maximum size: the previous file is kept with
+// ".1" suffix (replacing the older one) and the records continue in a new file.
+type Exporter struct {
+	path    string
+	maxSize int64
+	onError func(error)
+
+	mu      sync.Mutex
+	closed  bool
+	queue   chan Record
+	dropped uint64
+	done    chan struct{}
+
+	// used only by the writer goroutine
+	file *os.File
+	size int64
+}
+
+// NewExporter opens (or creates) the export file and starts the writer.
+// The maxSize limits size of the file in bytes (zero disables rotation),
+// the queueSize limits records waiting to be written (zero selects the default)
+// and onError (optional) is called for every failed write.
+func NewExporter(path string, maxSize int64, queueSize int, onError func(error)) (*Exporter, error) {
+	if queueSize <= 0 {
+		queueSize = DefaultExportQueueSize
+	}
+	e := &Exporter{
+		path:    path,
+		maxSize: maxSize,
+		onError: onError,
+		queue:   make(chan Record, queueSize),
+		done:    make(chan struct{}),
+	}
+	if err := e.open(); err != nil {
+		return nil, err
+	}
+	go e.writer()
+	return e, nil
+}
+
+// Export queues the record for writing. The record is dropped if the queue
+// is full or the exporter is closed.
+func (e *Exporter) Export(r Record) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.closed {
+		return
+	}
+	select {
+	case e.queue <- r:
+	default:
+		atomic.AddUint64(&e.dropped, 1)
+	}
+}
+
+// Dropped returns number of records dropped because the queue was full.
+func (e *Exporter) Dropped() uint64 {
+	return atomic.LoadUint64(&e.dropped)
+}
+
+// Close writes the queued records and closes the export file.
+func (e *Exporter) Close() error {
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return nil
+	}
+	e.closed = true
+	close(e.queue)
+	e.mu.Unlock()
+
+	<-e.done
+	return e.file.Close()
+}
+
+func (e *Exporter) writer() {
+	defer close(e.done)
+
+	var line bytes.Buffer
+	for r := range e.queue {
+		line.Reset()
+		if err := WriteJSONLines(&line, []Record{r}); err != nil {
+			e.reportError(err)
+			continue
+		}
+		if e.maxSize > 0 && e.size > 0 && e.size+int64(line.Len()) > e.maxSize {
+			if err := e.rotate(); err != nil {
+				e.reportError(err)
+				continue
+			}
+		}
+		n, err := e.file.Write(line.Bytes())
+		e.size += int64(n)
+		if err != nil {
+			e.reportError(err)
+		}
+	}
+}
+
+func (e *Exporter) open() error {
+	f, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	e.file = f
+	e.size = info.Size()
+	return nil
+}
+
+func (e *Exporter) rotate() error {
+	if err := e.file.Close(); err != nil {
+		return err
+	}
+	renameErr := os.Rename(e.path, e.path+rotatedSuffix)
+	if err := e.open(); err != nil {
+		return err
+	}
+	return renameErr
+}
+
+func (e *Exporter) reportError(err error) {
+	if e.onError != nil {
+		e.onError(err)
+	}
+}
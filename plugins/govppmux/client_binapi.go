@@ -29,7 +29,10 @@ func (p *Plugin) NewStream(ctx context.Context, options ...govppapi.StreamOption
 }
 
 func (p *Plugin) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
-	return p.vppConn.Invoke(ctx, req, reply)
+	tc := p.apiTracer.start("", false)
+	err := p.vppConn.Invoke(ctx, req, reply)
+	tc.done(req, reply, err)
+	return err
 }
 
 // NewAPIChannel returns a new API channel for communication with VPP via govpp core.
@@ -43,7 +46,7 @@ func (p *Plugin) NewAPIChannel() (govppapi.Channel, error) {
 		p.config.RetryRequestCount,
 		p.config.RetryRequestTimeout,
 	}
-	return newGovppChan(ch, retryCfg), nil
+	return newGovppChan(ch, retryCfg).withTrace(p.apiTracer, ""), nil
 }
 
 // NewAPIChannelBuffered returns a new API channel for communication with VPP via govpp core.
@@ -57,7 +60,7 @@ func (p *Plugin) NewAPIChannelBuffered(reqChanBufSize, replyChanBufSize int) (go
 		p.config.RetryRequestCount,
 		p.config.RetryRequestTimeout,
 	}
-	return newGovppChan(ch, retryCfg), nil
+	return newGovppChan(ch, retryCfg).withTrace(p.apiTracer, ""), nil
 }
 
 // goVppChan implements govpp channel interface. Instance is returned by NewAPIChannel() or NewAPIChannelBuffered(),
@@ -67,6 +70,9 @@ type goVppChan struct {
 	govppapi.Channel
 	// Retry data
 	retry retryConfig
+	// API trace (nil if disabled)
+	tracer   *apiTracer
+	instance string
 }
 
 func newGovppChan(ch govppapi.Channel, retryCfg retryConfig) *goVppChan {
@@ -78,6 +84,13 @@ func newGovppChan(ch govppapi.Channel, retryCfg retryConfig) *goVppChan {
 	return govppChan
 }
 
+// withTrace enables tracing of requests sent to the VPP instance.
+func (c *goVppChan) withTrace(tracer *apiTracer, instance string) *goVppChan {
+	c.tracer = tracer
+	c.instance = instance
+	return c
+}
+
 func (c *goVppChan) Close() {
 	c.Channel.Close()
 	reportChannelsClosed()
@@ -103,6 +116,7 @@ type govppRequestCtx struct {
 
 	retry retryConfig
 	start time.Time
+	trace *traceCtx
 }
 
 // govppMultirequestCtx is custom govpp MultiRequestCtx.
@@ -116,6 +130,7 @@ type govppMultirequestCtx struct {
 	requestMsg govppapi.Message
 
 	start time.Time
+	trace *traceCtx
 }
 
 // SendRequest sends asynchronous request to the vpp and receives context used to receive reply.
@@ -125,6 +140,7 @@ func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
 	trace.Log(ctx, "messageName", request.GetMessageName())
 
 	start := time.Now()
+	tc := c.tracer.start(c.instance, false)
 	// Send request now and wait for context
 	requestCtx := c.Channel.SendRequest(request)
 
@@ -139,6 +155,7 @@ func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
 		requestMsg:  request,
 		retry:       c.retry,
 		start:       start,
+		trace:       tc,
 	}
 }
 
@@ -171,6 +188,7 @@ func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
 		reportRequestSuccess(r.requestMsg, r.start)
 		reportRepliesReceived(reply)
 	}
+	r.trace.done(r.requestMsg, reply, err)
 	return err
 }
 
@@ -180,6 +198,7 @@ func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiReq
 	trace.Log(ctx, "msgName", request.GetMessageName())
 
 	start := time.Now()
+	tc := c.tracer.start(c.instance, true)
 	// Send request now and wait for context
 	requestCtx := c.Channel.SendMultiRequest(request)
 
@@ -192,6 +211,7 @@ func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiReq
 		requestCtx: requestCtx,
 		requestMsg: request,
 		start:      start,
+		trace:      tc,
 	}
 }
 
@@ -206,8 +226,10 @@ func (r *govppMultirequestCtx) ReceiveReply(reply govppapi.Message) (bool, error
 		} else {
 			reportRequestSuccess(r.requestMsg, r.start)
 		}
+		r.trace.done(r.requestMsg, reply, err)
 	} else {
 		reportRepliesReceived(reply)
+		r.trace.replyReceived()
 	}
 	return last, err
 }
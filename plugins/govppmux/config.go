@@ -14,7 +14,11 @@
 
 package govppmux
 
-import "time"
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 // Config defines configurable parameters for govppmux plugin.
 type Config struct {
@@ -57,10 +61,62 @@ type Config struct {
 	HealthCheckReplyTimeout  time.Duration `json:"health-check-reply-timeout"`
 	HealthCheckThreshold     int           `json:"health-check-threshold"`
 
+	// Instances defines connections to additional VPP instances managed by the agent
+	// (the connection defined above is used for the default instance).
+	Instances []InstanceConfig `json:"instances"`
+
+	// APITrace configures tracing of binary API calls.
+	APITrace APITraceConfig `json:"api-trace"`
+
 	// DEPRECATED: TraceEnabled is obsolete and used only in older versions.
 	TraceEnabled bool `json:"trace-enabled"`
 }
 
+// APITraceConfig defines tracing of binary API calls.
+type APITraceConfig struct {
+	// Enabled enables recording of binary API requests and replies tagged with
+	// the scheduler transaction and value which sent the request.
+	Enabled bool `json:"enabled"`
+
+	// BufferSize is a number of the most recent records kept in memory.
+	BufferSize int `json:"buffer-size"`
+
+	// ExportFile is a path to file where all records are appended as JSON lines.
+	ExportFile string `json:"export-file"`
+
+	// ExportMaxSize is a size of the export file in bytes after which the file
+	// is rotated (zero disables the rotation).
+	ExportMaxSize int64 `json:"export-max-size"`
+}
+
+func (c APITraceConfig) validate() error {
+	if c.BufferSize < 0 {
+		return errors.Errorf("invalid api-trace buffer-size %d: must not be negative", c.BufferSize)
+	}
+	if c.ExportMaxSize < 0 {
+		return errors.Errorf("invalid api-trace export-max-size %d: must not be negative", c.ExportMaxSize)
+	}
+	return nil
+}
+
+// InstanceConfig defines connection to additional VPP instance.
+type InstanceConfig struct {
+	// Name identifies the VPP instance (i.e. in keys scoped by instance).
+	Name string `json:"name"`
+
+	// Connect to VPP for configuration requests via the shared memory instead of through the socket.
+	ConnectViaShm bool `json:"connect-via-shm"`
+
+	// ShmPrefix defines prefix prepended to the name used for shared memory (SHM) segments.
+	ShmPrefix string `json:"shm-prefix"`
+
+	// BinAPISocketPath defines path to the binapi socket file.
+	BinAPISocketPath string `json:"binapi-socket-path"`
+
+	// StatsSocketPath defines path to the stats socket file.
+	StatsSocketPath string `json:"stats-socket-path"`
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		ReconnectResync:          true,
@@ -71,6 +127,10 @@ func DefaultConfig() *Config {
 		RetryRequestTimeout:      500 * time.Millisecond,
 		RetryConnectTimeout:      time.Second,
 		ProxyEnabled:             true,
+		APITrace: APITraceConfig{
+			BufferSize:    10000,
+			ExportMaxSize: 100 * 1024 * 1024,
+		},
 	}
 }
 
@@ -84,5 +144,8 @@ func (p *Plugin) loadConfig() (*Config, error) {
 	} else {
 		p.Log.Debugf("config file %q not found, using default config", p.Cfg.GetConfigName())
 	}
+	if err := cfg.APITrace.validate(); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
@@ -0,0 +1,388 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.fd.io/govpp/adapter"
+	govppapi "go.fd.io/govpp/api"
+	govpp "go.fd.io/govpp/core"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+)
+
+var (
+	_ MultiInstanceAPI = (*Plugin)(nil)
+	_ API              = (*vppInstance)(nil)
+)
+
+// vppInstance is a connection to additional (named) VPP instance managed by the agent.
+type vppInstance struct {
+	name   string
+	config InstanceConfig
+	retry  retryConfig
+	log    logging.Logger
+	tracer *apiTracer
+
+	conn          *govpp.Connection
+	connChan      chan govpp.ConnectionEvent
+	binapiVersion vpp.Version
+	lastConnErr   error
+	recovery      connRecovery
+	descriptors   instanceDescriptors
+
+	apiChanMu sync.Mutex
+	apiChan   govppapi.Channel
+
+	statsMu      sync.Mutex
+	statsAdapter adapter.StatsAPI
+	statsConn    *govpp.StatsConnection
+
+	infoMu  sync.Mutex
+	vppInfo VPPInfo
+
+	onConnectMu sync.Mutex
+	onConnects  []func()
+}
+
+// connectInstances connects to all additional VPP instances from the configuration.
+func (p *Plugin) connectInstances() error {
+	p.instances = make(map[string]*vppInstance)
+	for _, cfg := range p.config.Instances {
+		if cfg.Name == "" || cfg.Name == models.DefaultInstance || strings.Contains(cfg.Name, "/") {
+			return errors.Errorf("invalid VPP instance name %q", cfg.Name)
+		}
+		if _, dup := p.instances[cfg.Name]; dup {
+			return errors.Errorf("duplicate VPP instance %q", cfg.Name)
+		}
+		inst := &vppInstance{
+			name:     cfg.Name,
+			config:   cfg,
+			recovery: connRecovery{instance: cfg.Name},
+			retry:    retryConfig{p.config.RetryRequestCount, p.config.RetryRequestTimeout},
+			tracer:   p.apiTracer,
+			log:      p.Log.NewLogger(cfg.Name),
+		}
+		if err := inst.connect(p.config); err != nil {
+			return errors.WithMessagef(err, "connecting to VPP instance %q failed", cfg.Name)
+		}
+		p.instances[cfg.Name] = inst
+	}
+	return nil
+}
+
+// Instance returns API of the VPP instance with the given name (default instance for empty name).
+func (p *Plugin) Instance(name string) (API, error) {
+	if name == "" {
+		return p, nil
+	}
+	inst, ok := p.instances[name]
+	if !ok {
+		return nil, errors.Errorf("unknown VPP instance %q", name)
+	}
+	return inst, nil
+}
+
+// Instances returns names of the additional VPP instances.
+func (p *Plugin) Instances() []string {
+	names := make([]string, 0, len(p.instances))
+	for name := range p.instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (inst *vppInstance) connect(config *Config) (err error) {
+	startTime := time.Now()
+	inst.log.Debugf("connecting to VPP instance %s..", inst.name)
+
+	useShm := inst.config.ConnectViaShm || inst.config.ShmPrefix != ""
+	address := inst.config.BinAPISocketPath
+	if useShm {
+		address = inst.config.ShmPrefix
+	}
+	inst.conn, inst.connChan, err = govpp.AsyncConnect(NewVppAdapter(address, useShm),
+		config.RetryConnectCount, config.RetryConnectTimeout)
+	if err != nil {
+		return err
+	}
+	if err := waitForConnected(inst.connChan, inst.log); err != nil {
+		return err
+	}
+	inst.log.Debugf("connection to VPP instance %s established (took %s)",
+		inst.name, time.Since(startTime).Round(time.Millisecond))
+
+	if _, err := inst.updateVPPInfo(); err != nil {
+		return errors.WithMessage(err, "retrieving VPP info failed")
+	}
+
+	statsSocket := inst.config.StatsSocketPath
+	if statsSocket == "" {
+		statsSocket = adapter.DefaultStatsSocket
+	}
+	if inst.statsAdapter = NewStatsAdapter(statsSocket); inst.statsAdapter == nil {
+		inst.log.Warnf("Unable to connect to the VPP statistics socket, nil stats adapter")
+	} else if inst.statsConn, err = govpp.ConnectStats(inst.statsAdapter); err != nil {
+		inst.log.Warnf("Unable to connect to the VPP statistics socket, %v", err)
+		inst.statsAdapter = nil
+	}
+	return nil
+}
+
+func (inst *vppInstance) disconnect() {
+	if inst.conn != nil {
+		inst.conn.Disconnect()
+	}
+	if inst.statsConn != nil {
+		inst.statsConn.Disconnect()
+	}
+}
+
+func (inst *vppInstance) updateVPPInfo() (recovery *RecoveryEvent, err error) {
+	inst.apiChanMu.Lock()
+	inst.apiChan, err = inst.conn.NewAPIChannel()
+	inst.apiChanMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if inst.binapiVersion, err = binapi.CompatibleVersion(inst.apiChan); err != nil {
+		return nil, err
+	}
+	handler, err := vppcalls.NewHandler(inst)
+	if err != nil {
+		return nil, errors.New("no compatible VPP handler found")
+	}
+
+	ctx := context.TODO()
+	ver, err := handler.GetVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := handler.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plugins, err := handler.GetPlugins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inst.log.WithFields(logging.Fields{
+		"PID":      session.PID,
+		"ClientID": session.ClientIdx,
+	}).Infof("VPP instance %s version: %v", inst.name, ver.Version)
+
+	inst.infoMu.Lock()
+	inst.vppInfo = VPPInfo{
+		Connected:   true,
+		VersionInfo: *ver,
+		SessionInfo: *session,
+		Plugins:     plugins,
+	}
+	inst.infoMu.Unlock()
+
+	recovery = inst.recovery.connected(session, time.Now())
+	if recovery != nil && recovery.Restarted {
+		inst.onConnect()
+	}
+	return recovery, nil
+}
+
+// handleInstanceEvents handles connection events of the VPP instance.
+func (p *Plugin) handleInstanceEvents(ctx context.Context, inst *vppInstance) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case event, ok := <-inst.connChan:
+			if !ok {
+				inst.recovery.lost(time.Now())
+				inst.lastConnErr = errors.Errorf("VPP instance %s connection state channel closed", inst.name)
+				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.Error, inst.lastConnErr)
+				return
+			}
+			inst.log.Debugf("VPP instance %s connection state changed: %+v", inst.name, event)
+
+			switch event.State {
+			case govpp.Connected:
+				recovery, err := inst.updateVPPInfo()
+				if err != nil {
+					inst.log.Errorf("updating VPP info failed: %v", err)
+				}
+				if recovery != nil {
+					p.recover(recovery, &inst.descriptors)
+				}
+				inst.lastConnErr = nil
+				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.OK, nil)
+			case govpp.Failed, govpp.Disconnected, govpp.NotResponding:
+				inst.infoMu.Lock()
+				inst.vppInfo.Connected = false
+				inst.infoMu.Unlock()
+
+				inst.recovery.lost(time.Now())
+				inst.lastConnErr = errors.Errorf("VPP instance %s connection lost (event: %+v)", inst.name, event)
+				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.Error, inst.lastConnErr)
+			default:
+				inst.log.Warnf("unknown VPP connection state: %+v", event)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// waitForConnected waits for Connected event from govpp
+func waitForConnected(connChan chan govpp.ConnectionEvent, log logging.Logger) error {
+	for {
+		event, ok := <-connChan
+		if !ok {
+			return errors.Errorf("VPP connection state channel closed")
+		}
+		switch event.State {
+		case govpp.Connected:
+			return nil
+		case govpp.Failed, govpp.Disconnected:
+			return errors.Errorf("unable to establish connection to VPP (%v)", event.Error)
+		default:
+			log.Debugf("VPP connection state: %+v", event)
+		}
+	}
+}
+
+// VPPInfo returns information about VPP session.
+func (inst *vppInstance) VPPInfo() VPPInfo {
+	inst.infoMu.Lock()
+	defer inst.infoMu.Unlock()
+	return inst.vppInfo
+}
+
+func (inst *vppInstance) NewStream(ctx context.Context, options ...govppapi.StreamOption) (govppapi.Stream, error) {
+	return inst.conn.NewStream(ctx, options...)
+}
+
+func (inst *vppInstance) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
+	tc := inst.tracer.start(inst.name, false)
+	err := inst.conn.Invoke(ctx, req, reply)
+	tc.done(req, reply, err)
+	return err
+}
+
+func (inst *vppInstance) NewAPIChannel() (govppapi.Channel, error) {
+	ch, err := inst.conn.NewAPIChannel()
+	if err != nil {
+		return nil, err
+	}
+	return newGovppChan(ch, inst.retry).withTrace(inst.tracer, inst.name), nil
+}
+
+func (inst *vppInstance) CheckCompatiblity(msgs ...govppapi.Message) error {
+	inst.apiChanMu.Lock()
+	defer inst.apiChanMu.Unlock()
+	if inst.apiChan == nil {
+		return fmt.Errorf("VPP instance %s is not connected", inst.name)
+	}
+	return inst.apiChan.CheckCompatiblity(msgs...)
+}
+
+func (inst *vppInstance) Stats() govppapi.StatsProvider {
+	if inst.statsConn == nil {
+		return nil
+	}
+	return inst
+}
+
+func (inst *vppInstance) IsPluginLoaded(plugin string) bool {
+	inst.infoMu.Lock()
+	defer inst.infoMu.Unlock()
+	for _, p := range inst.vppInfo.Plugins {
+		if p.Name == plugin {
+			return true
+		}
+	}
+	return false
+}
+
+func (inst *vppInstance) BinapiVersion() vpp.Version {
+	return inst.binapiVersion
+}
+
+func (inst *vppInstance) OnReconnect(fn func()) {
+	inst.onConnectMu.Lock()
+	defer inst.onConnectMu.Unlock()
+	inst.onConnects = append(inst.onConnects, fn)
+}
+
+// RegisterKVDescriptor registers descriptors of values configured in the VPP instance.
+func (inst *vppInstance) RegisterKVDescriptor(scheduler kvs.KVScheduler, descriptors ...*kvs.KVDescriptor) error {
+	return inst.descriptors.register(scheduler, descriptors...)
+}
+
+func (inst *vppInstance) onConnect() {
+	inst.onConnectMu.Lock()
+	defer inst.onConnectMu.Unlock()
+	for _, fn := range inst.onConnects {
+		fn()
+	}
+}
+
+func (inst *vppInstance) GetSystemStats(stats *govppapi.SystemStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetSystemStats(stats)
+}
+
+func (inst *vppInstance) GetNodeStats(stats *govppapi.NodeStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetNodeStats(stats)
+}
+
+func (inst *vppInstance) GetInterfaceStats(stats *govppapi.InterfaceStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetInterfaceStats(stats)
+}
+
+func (inst *vppInstance) GetErrorStats(stats *govppapi.ErrorStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetErrorStats(stats)
+}
+
+func (inst *vppInstance) GetBufferStats(stats *govppapi.BufferStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetBufferStats(stats)
+}
+
+func (inst *vppInstance) GetMemoryStats(stats *govppapi.MemoryStats) error {
+	inst.statsMu.Lock()
+	defer inst.statsMu.Unlock()
+	return inst.statsConn.GetMemoryStats(stats)
+}
@@ -15,6 +15,7 @@
 package govppmux
 
 import (
+	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -89,6 +90,25 @@ var (
 	},
 		[]string{"message"},
 	)
+	vppRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ligato",
+		Subsystem: "govppmux",
+		Name:      "vpp_restarts_total",
+		Help:      "The total number of detected VPP restarts.",
+	},
+		[]string{"instance"},
+	)
+	recoveryDurationSec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ligato",
+		Subsystem: "govppmux",
+		Name:      "recovery_duration_seconds",
+		Help:      "Bucketed histogram of time from the loss of connection to VPP until its configuration was recovered.",
+		// lowest bucket start of upper bound 0.1 sec with factor 2
+		// highest bucket start of 0.1 sec * 2^12 == 409.6 sec
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 13),
+	},
+		[]string{"instance", "restarted"},
+	)
 )
 
 func init() {
@@ -100,6 +120,8 @@ func init() {
 	prometheus.MustRegister(requestsFailed)
 	prometheus.MustRegister(repliesReceived)
 	prometheus.MustRegister(successfulRequestHandlingSec)
+	prometheus.MustRegister(vppRestarts)
+	prometheus.MustRegister(recoveryDurationSec)
 }
 
 func reportChannelsOpened() {
@@ -165,3 +187,11 @@ func reportRepliesReceived(reply govppapi.Message) {
 	atomic.AddUint64(&stats.RequestReplies, 1)
 	trackMsgReply(reply.GetMessageName())
 }
+
+func reportRestart(instance string) {
+	vppRestarts.WithLabelValues(instance).Inc()
+}
+
+func reportRecovery(instance string, restarted bool, took time.Duration) {
+	recoveryDurationSec.WithLabelValues(instance, strconv.FormatBool(restarted)).Observe(took.Seconds())
+}
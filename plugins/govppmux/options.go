@@ -18,6 +18,9 @@ import (
 	"go.ligato.io/cn-infra/v2/datasync/resync"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
+
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
 )
 
 // DefaultPlugin is default instance of Plugin
@@ -29,8 +32,10 @@ func NewPlugin(opts ...Option) *Plugin {
 
 	p.PluginName = "govpp"
 	p.HTTPHandlers = &rest.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 	p.StatusCheck = &statuscheck.DefaultPlugin
 	p.Resync = &resync.DefaultPlugin
+	p.KVScheduler = &kvscheduler.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
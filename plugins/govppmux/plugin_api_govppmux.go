@@ -16,6 +16,7 @@ package govppmux
 
 import (
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 )
 
@@ -24,9 +25,24 @@ type API interface {
 	// VPPInfo returns VPP information which is retrieved immediatelly after connecting to VPP.
 	VPPInfo() VPPInfo
 
+	// RegisterKVDescriptor registers descriptors of values configured in this VPP
+	// instance with the scheduler. Values of these descriptors are considered lost
+	// after restart of the instance and they are replayed once it reconnects.
+	RegisterKVDescriptor(scheduler kvs.KVScheduler, descriptors ...*kvs.KVDescriptor) error
+
 	vpp.Client
 }
 
+// MultiInstanceAPI provides access to additional VPP instances managed by the agent.
+type MultiInstanceAPI interface {
+	API
+
+	// Instance returns API of the VPP instance with the given name (default instance for empty name).
+	Instance(name string) (API, error)
+	// Instances returns names of the additional VPP instances.
+	Instances() []string
+}
+
 // VPPInfo defines retrieved information about the connected VPP instance.
 type VPPInfo struct {
 	Connected bool
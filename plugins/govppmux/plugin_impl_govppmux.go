@@ -37,13 +37,16 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2210"
 )
 
 var (
@@ -64,7 +67,13 @@ type Plugin struct {
 	vppConChan    chan govpp.ConnectionEvent
 	lastConnErr   error
 	vppapiChan    govppapi.Channel
-	lastPID       uint32
+	recovery      connRecovery
+	descriptors   instanceDescriptors
+
+	recoveryWatchers
+
+	// API trace (nil if disabled)
+	apiTracer *apiTracer
 
 	onnConnectMu sync.Mutex
 	onConnects   []func()
@@ -75,6 +84,9 @@ type Plugin struct {
 
 	proxy *proxy.Server
 
+	// additional VPP instances
+	instances map[string]*vppInstance
+
 	// infoMu synchonizes access to fields
 	// vppInfo and lastEvent
 	infoMu    sync.Mutex
@@ -89,8 +101,10 @@ type Plugin struct {
 type Deps struct {
 	infra.PluginDeps
 	HTTPHandlers rest.HTTPHandlers
+	Auth         auth.API
 	StatusCheck  statuscheck.PluginStatusWriter
 	Resync       *resync.Plugin
+	KVScheduler  kvs.KVScheduler
 }
 
 // Init is the entry point called by Agent Core. A single binary-API connection to VPP is established.
@@ -100,6 +114,12 @@ func (p *Plugin) Init() (err error) {
 	}
 	p.Log.Debugf("config: %+v", p.config)
 
+	if p.config.APITrace.Enabled {
+		if p.apiTracer, err = newAPITracer(p.config.APITrace, p.KVScheduler, p.Log); err != nil {
+			return err
+		}
+	}
+
 	// set GoVPP config
 	govpp.HealthCheckProbeInterval = p.config.HealthCheckProbeInterval
 	govpp.HealthCheckReplyTimeout = p.config.HealthCheckReplyTimeout
@@ -145,7 +165,7 @@ func (p *Plugin) Init() (err error) {
 	took := time.Since(startTime)
 	p.Log.Debugf("connection to VPP established (took %s)", took.Round(time.Millisecond))
 
-	if err := p.updateVPPInfo(); err != nil {
+	if _, err := p.updateVPPInfo(); err != nil {
 		return errors.WithMessage(err, "retrieving VPP info failed")
 	}
 
@@ -178,6 +198,11 @@ func (p *Plugin) Init() (err error) {
 		}
 	}
 
+	// Connect to additional VPP instances
+	if err := p.connectInstances(); err != nil {
+		return err
+	}
+
 	// register REST API handlers
 	p.registerHandlers(p.HTTPHandlers)
 
@@ -186,20 +211,7 @@ func (p *Plugin) Init() (err error) {
 
 // waitForConnectionEvent waits for Connected event from govpp
 func (p *Plugin) waitForConnectionEvent(vppConChan chan govpp.ConnectionEvent) error {
-	for {
-		event, ok := <-vppConChan
-		if !ok {
-			return errors.Errorf("VPP connection state channel closed")
-		}
-		if event.State == govpp.Connected {
-			break
-		} else if event.State == govpp.Failed || event.State == govpp.Disconnected {
-			return errors.Errorf("unable to establish connection to VPP (%v)", event.Error)
-		} else {
-			p.Log.Debugf("VPP connection state: %+v", event)
-		}
-	}
-	return nil
+	return waitForConnected(vppConChan, p.Log)
 }
 
 func (p *Plugin) hackForBugInGoVPPMessageCache(address string, useShm bool) error {
@@ -262,6 +274,10 @@ func (p *Plugin) AfterInit() error {
 
 	p.wg.Add(1)
 	go p.handleVPPConnectionEvents(ctx)
+	for _, inst := range p.instances {
+		p.wg.Add(1)
+		go p.handleInstanceEvents(ctx, inst)
+	}
 
 	return nil
 }
@@ -272,6 +288,9 @@ func (p *Plugin) Close() error {
 	p.wg.Wait()
 
 	defer func() {
+		for _, inst := range p.instances {
+			inst.disconnect()
+		}
 		if p.vppConn != nil {
 			p.vppConn.Disconnect()
 		}
@@ -280,6 +299,11 @@ func (p *Plugin) Close() error {
 				p.Log.Errorf("VPP statistics socket adapter disconnect error: %v", err)
 			}
 		}
+		if p.apiTracer != nil {
+			if err := p.apiTracer.close(); err != nil {
+				p.Log.Errorf("closing API trace export file failed: %v", err)
+			}
+		}
 	}()
 
 	if p.proxy != nil {
@@ -337,23 +361,25 @@ func (p *Plugin) IsPluginLoaded(plugin string) bool {
 	return false
 }
 
-func (p *Plugin) updateVPPInfo() (err error) {
+// updateVPPInfo retrieves information about the connected VPP. Event describing
+// recovery is returned if VPP was disconnected or restarted before.
+func (p *Plugin) updateVPPInfo() (recovery *RecoveryEvent, err error) {
 	if p.vppConn == nil {
-		return fmt.Errorf("VPP connection is nil")
+		return nil, fmt.Errorf("VPP connection is nil")
 	}
 
 	p.vppapiChan, err = p.vppConn.NewAPIChannel()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	p.binapiVersion, err = binapi.CompatibleVersion(p.vppapiChan)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	p.vpeHandler, err = vppcalls.NewHandler(p)
 	if err != nil {
-		return errors.New("no compatible VPP handler found")
+		return nil, errors.New("no compatible VPP handler found")
 	}
 
 	ctx := context.TODO()
@@ -374,11 +400,11 @@ func (p *Plugin) updateVPPInfo() (err error) {
 
 	ver, err := p.vpeHandler.GetVersion(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	session, err := p.vpeHandler.GetSession(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	p.Log.WithFields(logging.Fields{
 		"PID":      session.PID,
@@ -387,13 +413,13 @@ func (p *Plugin) updateVPPInfo() (err error) {
 
 	modules, err := p.vpeHandler.GetModules(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	p.Log.Debugf("VPP has %d core modules: %v", len(modules), modules)
 
 	plugins, err := p.vpeHandler.GetPlugins(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// sort plugins by name
 	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
@@ -412,13 +438,12 @@ func (p *Plugin) updateVPPInfo() (err error) {
 	}
 	p.infoMu.Unlock()
 
-	if p.lastPID != 0 && p.lastPID != session.PID {
-		p.Log.Warnf("VPP has restarted (previous PID: %d)", p.lastPID)
+	recovery = p.recovery.connected(session, time.Now())
+	if recovery != nil && recovery.Restarted {
 		p.onConnect()
 	}
-	p.lastPID = session.PID
 
-	return nil
+	return recovery, nil
 }
 
 func (p *Plugin) OnReconnect(fn func()) {
@@ -427,6 +452,11 @@ func (p *Plugin) OnReconnect(fn func()) {
 	p.onConnects = append(p.onConnects, fn)
 }
 
+// RegisterKVDescriptor registers descriptors of values configured in the default VPP instance.
+func (p *Plugin) RegisterKVDescriptor(scheduler kvs.KVScheduler, descriptors ...*kvs.KVDescriptor) error {
+	return p.descriptors.register(scheduler, descriptors...)
+}
+
 func (p *Plugin) onConnect() {
 	p.onnConnectMu.Lock()
 	defer p.onnConnectMu.Unlock()
@@ -443,6 +473,7 @@ func (p *Plugin) handleVPPConnectionEvents(ctx context.Context) {
 		select {
 		case event, ok := <-p.vppConChan:
 			if !ok {
+				p.recovery.lost(time.Now())
 				p.lastConnErr = errors.Errorf("VPP connection state channel closed")
 				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.Error, p.lastConnErr)
 				return
@@ -451,25 +482,21 @@ func (p *Plugin) handleVPPConnectionEvents(ctx context.Context) {
 			p.Log.Debugf("VPP connection state changed: %+v", event)
 
 			if event.State == govpp.Connected {
-				if err := p.updateVPPInfo(); err != nil {
+				recovery, err := p.updateVPPInfo()
+				if err != nil {
 					p.Log.Errorf("updating VPP info failed: %v", err)
 				}
-
-				if p.config.ReconnectResync && p.lastConnErr != nil {
-					p.Log.Info("Starting resync after VPP reconnect")
-					if p.Resync != nil {
-						p.Resync.DoResync()
-						p.lastConnErr = nil
-					} else {
-						p.Log.Warn("Expected resync after VPP reconnect could not start because of missing Resync plugin")
-					}
+				if recovery != nil {
+					p.recover(recovery, &p.descriptors)
 				}
+				p.lastConnErr = nil
 				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.OK, nil)
 			} else if event.State == govpp.Failed || event.State == govpp.Disconnected {
 				p.infoMu.Lock()
 				p.vppInfo.Connected = false
 				p.infoMu.Unlock()
 
+				p.recovery.lost(time.Now())
 				p.lastConnErr = errors.Errorf("VPP connection lost (event: %+v)", event)
 				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.Error, p.lastConnErr)
 
@@ -484,6 +511,7 @@ func (p *Plugin) handleVPPConnectionEvents(ctx context.Context) {
 				p.vppInfo.Connected = false
 				p.infoMu.Unlock()
 
+				p.recovery.lost(time.Now())
 				p.lastConnErr = errors.Errorf("VPP is not responding (event: %+v)", event)
 				p.StatusCheck.ReportStateChange(p.PluginName, statuscheck.Error, p.lastConnErr)
 			} else {
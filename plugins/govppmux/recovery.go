@@ -0,0 +1,222 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+// bootTimeTolerance is a maximum difference between boot times of VPP computed
+// from its uptime before and after reconnect for which VPP is not considered
+// to be restarted (the uptime is reported with a limited precision and the time
+// of the request is not exact either).
+const bootTimeTolerance = 2 * time.Second
+
+var _ RecoveryWatcher = (*Plugin)(nil)
+
+// RecoveryEvent describes recovery from the loss of connection to VPP instance.
+type RecoveryEvent struct {
+	// Instance is a name of the VPP instance (empty for the default instance).
+	Instance string `json:"instance,omitempty"`
+	// Restarted is true if VPP has restarted while disconnected, false
+	// for transient disconnects.
+	Restarted bool `json:"restarted"`
+	// PreviousPID is PID of VPP before the connection was lost.
+	PreviousPID uint32 `json:"previousPid"`
+	// PID is PID of VPP after reconnect.
+	PID uint32 `json:"pid"`
+	// Disconnected is time when the connection was lost.
+	Disconnected time.Time `json:"disconnected"`
+	// Reconnected is time when the connection was established again.
+	Reconnected time.Time `json:"reconnected"`
+	// Recovered is time when the configuration of VPP was recovered.
+	Recovered time.Time `json:"recovered"`
+	// Duration is the time from the loss of connection until recovery.
+	Duration time.Duration `json:"duration"`
+	// Replayed is true if the lost configuration was replayed by scheduler.
+	Replayed bool `json:"replayed"`
+	// Error describes failed recovery.
+	Error string `json:"error,omitempty"`
+}
+
+// RecoveryWatcher allows to watch recovery from the loss of connection to VPP.
+type RecoveryWatcher interface {
+	// WatchRecovery sends events describing every recovery from the loss
+	// of connection to VPP into the given channel. The events are not sent
+	// if the channel is not ready to receive them.
+	WatchRecovery(ch chan<- *RecoveryEvent)
+}
+
+// connRecovery tracks the loss of connection to VPP instance and detects
+// restarts of VPP by comparing PIDs and boot times of VPP sessions.
+type connRecovery struct {
+	instance string
+
+	disconnected time.Time // zero while connected
+	pid          uint32
+	bootTime     time.Time
+}
+
+// lost records the loss of connection (the first one if repeated).
+func (r *connRecovery) lost(now time.Time) {
+	if r.disconnected.IsZero() {
+		r.disconnected = now
+	}
+}
+
+// connected records session of the connected VPP and returns event for the recovery
+// if the connection was lost previously. Restart is detected if PID of VPP has changed
+// or if VPP has booted again (PID of VPP running in container is usually the same).
+func (r *connRecovery) connected(session *vppcalls.SessionInfo, now time.Time) *RecoveryEvent {
+	bootTime := now.Add(-time.Duration(session.Uptime * float64(time.Second)))
+	var event *RecoveryEvent
+	if r.pid != 0 {
+		restarted := r.pid != session.PID
+		if diff := bootTime.Sub(r.bootTime); diff > bootTimeTolerance || diff < -bootTimeTolerance {
+			restarted = true
+		}
+		if restarted || !r.disconnected.IsZero() {
+			event = &RecoveryEvent{
+				Instance:     r.instance,
+				Restarted:    restarted,
+				PreviousPID:  r.pid,
+				PID:          session.PID,
+				Disconnected: r.disconnected,
+				Reconnected:  now,
+			}
+			if event.Disconnected.IsZero() {
+				// restart detected without noticing the loss of connection
+				event.Disconnected = now
+			}
+		}
+	}
+	r.pid = session.PID
+	r.bootTime = bootTime
+	r.disconnected = time.Time{}
+	return event
+}
+
+// recoveryWatchers dispatches recovery events to the watchers.
+type recoveryWatchers struct {
+	mu       sync.Mutex
+	watchers []chan<- *RecoveryEvent
+}
+
+// WatchRecovery sends recovery events into the given channel.
+func (w *recoveryWatchers) WatchRecovery(ch chan<- *RecoveryEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.watchers = append(w.watchers, ch)
+}
+
+func (w *recoveryWatchers) notify(event *RecoveryEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, ch := range w.watchers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// recover recovers configuration of VPP instance after reconnect. After restart
+// of VPP, all values of the instance are marked as lost and replayed by scheduler
+// in the order given by dependencies, without retrieving state of other data planes
+// and without resync of NB. After transient disconnect the state of VPP is only
+// refreshed to recover from operations failed while disconnected.
+// The legacy full resync is used if the scheduler is not available.
+func (p *Plugin) recover(event *RecoveryEvent, descriptors *instanceDescriptors) {
+	name := "VPP"
+	if event.Instance != "" {
+		name = fmt.Sprintf("VPP instance %s", event.Instance)
+	}
+	if event.Restarted {
+		reportRestart(event.Instance)
+		p.Log.Warnf("%s has restarted (previous PID: %d, PID: %d)", name, event.PreviousPID, event.PID)
+	} else {
+		p.Log.Infof("%s has reconnected (disconnected for %v)", name,
+			event.Reconnected.Sub(event.Disconnected).Round(time.Millisecond))
+	}
+
+	if p.config.ReconnectResync {
+		if p.KVScheduler != nil {
+			ctx := kvs.WithResync(context.Background(), kvs.DownstreamResync, false)
+			if event.Restarted {
+				ctx = kvs.WithLostValues(ctx, descriptors.lostValuesSelector())
+				ctx = kvs.WithDescription(ctx, fmt.Sprintf("replay after restart of %s", name))
+			} else {
+				ctx = kvs.WithDescription(ctx, fmt.Sprintf("refresh after reconnect of %s", name))
+			}
+			ctx = kvs.WithOrigin(ctx, kvs.TxnOrigin{DataSource: "govppmux"})
+			p.Log.Infof("Starting %s after reconnect of %s", kvs.DownstreamResync, name)
+			if _, err := p.KVScheduler.StartNBTransaction().Commit(ctx); err != nil {
+				p.Log.Errorf("recovery of %s failed: %v", name, err)
+				event.Error = err.Error()
+			}
+			event.Replayed = event.Restarted
+		} else if p.Resync != nil {
+			p.Log.Infof("Starting resync after reconnect of %s", name)
+			p.Resync.DoResync()
+		} else {
+			p.Log.Warnf("Expected resync after reconnect of %s could not start because of missing Resync plugin", name)
+		}
+	}
+
+	event.Recovered = time.Now()
+	event.Duration = event.Recovered.Sub(event.Disconnected)
+	reportRecovery(event.Instance, event.Restarted, event.Duration)
+	p.Log.Infof("%s recovered in %v", name, event.Duration.Round(time.Millisecond))
+	p.recoveryWatchers.notify(event)
+}
+
+// instanceDescriptors is a set of descriptors registered for VPP instance.
+type instanceDescriptors struct {
+	mu          sync.Mutex
+	descriptors []*kvs.KVDescriptor
+}
+
+func (d *instanceDescriptors) register(scheduler kvs.KVScheduler, descriptors ...*kvs.KVDescriptor) error {
+	if err := scheduler.RegisterKVDescriptor(descriptors...); err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.descriptors = append(d.descriptors, descriptors...)
+	return nil
+}
+
+// lostValuesSelector selects values lost after restart of the VPP instance,
+// i.e. values of the descriptors registered for the instance.
+func (d *instanceDescriptors) lostValuesSelector() kvs.KeySelector {
+	d.mu.Lock()
+	descriptors := append([]*kvs.KVDescriptor(nil), d.descriptors...)
+	d.mu.Unlock()
+
+	return func(key string) bool {
+		for _, descriptor := range descriptors {
+			if descriptor.KeySelector != nil && descriptor.KeySelector(key) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,114 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+func TestConnRecovery(t *testing.T) {
+	RegisterTestingT(t)
+
+	start := time.Now()
+	r := &connRecovery{instance: "vpp2"}
+
+	// initial connect
+	event := r.connected(&vppcalls.SessionInfo{PID: 100, Uptime: 10}, start)
+	Expect(event).To(BeNil())
+
+	// transient disconnect
+	r.lost(start.Add(time.Second))
+	r.lost(start.Add(2 * time.Second))
+	event = r.connected(&vppcalls.SessionInfo{PID: 100, Uptime: 13.5}, start.Add(3*time.Second))
+	Expect(event).ToNot(BeNil())
+	Expect(event.Instance).To(Equal("vpp2"))
+	Expect(event.Restarted).To(BeFalse())
+	Expect(event.Disconnected).To(Equal(start.Add(time.Second)))
+	Expect(event.Reconnected).To(Equal(start.Add(3 * time.Second)))
+
+	// restart with the same PID (i.e. VPP in container)
+	r.lost(start.Add(10 * time.Second))
+	event = r.connected(&vppcalls.SessionInfo{PID: 100, Uptime: 1}, start.Add(12*time.Second))
+	Expect(event).ToNot(BeNil())
+	Expect(event.Restarted).To(BeTrue())
+	Expect(event.PreviousPID).To(BeEquivalentTo(100))
+	Expect(event.PID).To(BeEquivalentTo(100))
+	Expect(event.Disconnected).To(Equal(start.Add(10 * time.Second)))
+
+	// restart with different PID detected without noticing the loss of connection
+	event = r.connected(&vppcalls.SessionInfo{PID: 200, Uptime: 60}, start.Add(20*time.Second))
+	Expect(event).ToNot(BeNil())
+	Expect(event.Restarted).To(BeTrue())
+	Expect(event.PreviousPID).To(BeEquivalentTo(100))
+	Expect(event.PID).To(BeEquivalentTo(200))
+	Expect(event.Disconnected).To(Equal(start.Add(20 * time.Second)))
+
+	// connected again without disconnect or restart
+	event = r.connected(&vppcalls.SessionInfo{PID: 200, Uptime: 70}, start.Add(30*time.Second))
+	Expect(event).To(BeNil())
+}
+
+func TestLostValuesSelector(t *testing.T) {
+	RegisterTestingT(t)
+
+	prefixSelector := func(prefix string) kvs.KeySelector {
+		return func(key string) bool {
+			return strings.HasPrefix(key, prefix)
+		}
+	}
+	ifDescriptor := &kvs.KVDescriptor{
+		Name:        "vpp-interface",
+		NBKeyPrefix: "config/vpp/v2/interfaces/",
+		KeySelector: prefixSelector("config/vpp/v2/interfaces/"),
+	}
+	addrDescriptor := &kvs.KVDescriptor{
+		Name:        "vpp-unnumbered-interface",
+		KeySelector: prefixSelector("vpp/interface/"),
+	}
+	puntDescriptor := &kvs.KVDescriptor{
+		Name:        "vpp-punt-to-host",
+		NBKeyPrefix: "config/vpp/v2/tohost/",
+		KeySelector: prefixSelector("config/vpp/v2/tohost/"),
+	}
+
+	// values of descriptors registered for the default instance only
+	descriptors := &instanceDescriptors{descriptors: []*kvs.KVDescriptor{ifDescriptor, addrDescriptor}}
+	lost := descriptors.lostValuesSelector()
+	Expect(lost("config/vpp/v2/interfaces/memif1")).To(BeTrue())
+	Expect(lost("vpp/interface/memif1/address/static/10.0.0.1/24")).To(BeTrue())
+	Expect(lost("config/vpp/v2/tohost/l3/IPV4/UDP/9000")).To(BeFalse())
+	Expect(lost("config/linux/interfaces/v2/interface/veth1")).To(BeFalse())
+	Expect(lost("instance/vpp2/config/vpp/v2/interfaces/memif1")).To(BeFalse())
+
+	// descriptors registered later are included in new selectors
+	descriptors.descriptors = append(descriptors.descriptors, puntDescriptor)
+	Expect(lost("config/vpp/v2/tohost/l3/IPV4/UDP/9000")).To(BeFalse())
+	Expect(descriptors.lostValuesSelector()("config/vpp/v2/tohost/l3/IPV4/UDP/9000")).To(BeTrue())
+
+	// values of descriptors registered for named instance
+	instIfDescriptor, err := kvs.InstanceDescriptor("vpp2", ifDescriptor)
+	Expect(err).ToNot(HaveOccurred())
+	lost = (&instanceDescriptors{descriptors: []*kvs.KVDescriptor{instIfDescriptor}}).lostValuesSelector()
+	Expect(lost("instance/vpp2/config/vpp/v2/interfaces/memif1")).To(BeTrue())
+	Expect(lost("instance/vpp3/config/vpp/v2/interfaces/memif1")).To(BeFalse())
+	Expect(lost("config/vpp/v2/interfaces/memif1")).To(BeFalse())
+}
@@ -23,6 +23,9 @@ import (
 
 	"github.com/unrolled/render"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
+
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
 )
 
 // registerHandlers registers all supported REST APIs.
@@ -31,9 +34,14 @@ func (p *Plugin) registerHandlers(http rest.HTTPHandlers) {
 		p.Log.Debug("No http handler provided, skipping registration of REST handlers")
 		return
 	}
-	http.RegisterHTTPHandler("/govppmux/stats", p.statsHandler, "GET")
-	http.RegisterHTTPHandler(rpc.DefaultRPCPath, p.proxyHandler, "CONNECT")
-	http.RegisterHTTPHandler("/vpp/command", p.cliCommandHandler, "POST")
+	register := func(path string, handler func(*render.Render) http.HandlerFunc, method string, access auth.Access) {
+		op := auth.Operation{Name: method + " " + path, Access: access}
+		http.RegisterHTTPHandler(path, auth.WrapHTTPHandler(p.Auth, op, handler), method)
+	}
+	register("/govppmux/stats", p.statsHandler, "GET", auth.ReadAccess)
+	register("/govppmux/trace", p.traceHandler, "GET", auth.ReadAccess)
+	register(rpc.DefaultRPCPath, p.proxyHandler, "CONNECT", auth.AdminAccess)
+	register("/vpp/command", p.cliCommandHandler, "POST", auth.AdminAccess)
 }
 
 func (p *Plugin) statsHandler(formatter *render.Render) http.HandlerFunc {
@@ -44,6 +52,27 @@ func (p *Plugin) statsHandler(formatter *render.Render) http.HandlerFunc {
 	}
 }
 
+// traceHandler returns traced binary API calls selected by URL parameters
+// (see apitrace.Filter) as JSON lines.
+func (p *Plugin) traceHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if p.apiTracer == nil {
+			_ = formatter.JSON(w, http.StatusNotFound, "API trace is not enabled")
+			return
+		}
+		filter, err := apitrace.ParseFilter(req.URL.Query())
+		if err != nil {
+			_ = formatter.JSON(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", apitrace.JSONLinesContentType)
+		w.WriteHeader(http.StatusOK)
+		if err := apitrace.WriteJSONLines(w, p.APITrace(filter)); err != nil {
+			p.Log.Warnf("trace handler errored: %v", err)
+		}
+	}
+}
+
 func (p *Plugin) proxyHandler(_ *render.Render) http.HandlerFunc {
 	if !p.config.ProxyEnabled {
 		return func(w http.ResponseWriter, req *http.Request) {
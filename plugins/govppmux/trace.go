@@ -0,0 +1,186 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/apitrace"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+// apiTracer records binary API requests and replies tagged with the scheduler
+// operation which sent the request.
+type apiTracer struct {
+	log    logging.Logger
+	buffer *apitrace.Buffer
+	export *apitrace.Exporter
+
+	mu sync.Mutex
+	op *kvs.ExecutingOperation // operation being executed by a descriptor
+}
+
+func newAPITracer(cfg APITraceConfig, scheduler kvs.KVScheduler, log logging.Logger) (*apiTracer, error) {
+	t := &apiTracer{
+		log:    log,
+		buffer: apitrace.NewBuffer(cfg.BufferSize),
+	}
+	if cfg.ExportFile != "" {
+		export, err := apitrace.NewExporter(cfg.ExportFile, cfg.ExportMaxSize, 0, func(err error) {
+			log.Warnf("exporting API trace record failed: %v", err)
+		})
+		if err != nil {
+			return nil, errors.Errorf("opening API trace export file failed: %v", err)
+		}
+		t.export = export
+		t.buffer.SetExport(export)
+	}
+	if scheduler != nil {
+		scheduler.RegisterOperationTracer(t)
+	}
+	return t, nil
+}
+
+// StartOperation tags requests sent until the operation has finished with the operation.
+// Descriptor operations are executed one at a time by the scheduler.
+func (t *apiTracer) StartOperation(op kvs.ExecutingOperation) (done func()) {
+	t.mu.Lock()
+	t.op = &op
+	t.mu.Unlock()
+
+	return func() {
+		t.mu.Lock()
+		t.op = nil
+		t.mu.Unlock()
+	}
+}
+
+func (t *apiTracer) close() error {
+	if t.export == nil {
+		return nil
+	}
+	err := t.export.Close()
+	if dropped := t.export.Dropped(); dropped > 0 {
+		t.log.Warnf("%d API trace records were not exported (export queue was full)", dropped)
+	}
+	return err
+}
+
+// traceCtx is a request being traced.
+type traceCtx struct {
+	tracer   *apiTracer
+	instance string
+	op       *kvs.ExecutingOperation
+	multi    bool
+	start    time.Time
+	replies  int
+}
+
+// start starts tracing of the request. Nil is returned if tracing is disabled.
+// The request is tagged with the scheduler operation being executed (if any).
+func (t *apiTracer) start(instance string, multi bool) *traceCtx {
+	if t == nil {
+		return nil
+	}
+	tc := &traceCtx{
+		tracer:   t,
+		instance: instance,
+		multi:    multi,
+		start:    time.Now(),
+	}
+	t.mu.Lock()
+	tc.op = t.op
+	t.mu.Unlock()
+	return tc
+}
+
+// replyReceived counts replies of multi-request.
+func (tc *traceCtx) replyReceived() {
+	if tc != nil {
+		tc.replies++
+	}
+}
+
+// done records the request with its (last) reply.
+func (tc *traceCtx) done(request, reply govppapi.Message, err error) {
+	if tc == nil {
+		return
+	}
+	record := apitrace.Record{
+		Instance:  tc.instance,
+		Timestamp: tc.start,
+		Message:   request.GetMessageName(),
+		CRC:       request.GetCrcString(),
+		Request:   marshalMessage(request),
+		Replies:   tc.replies,
+		Latency:   time.Since(tc.start),
+	}
+	if reply != nil {
+		record.Reply = reply.GetMessageName()
+		record.Retval = retval(reply)
+		if !tc.multi {
+			record.ReplyData = marshalMessage(reply)
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	if tc.op != nil {
+		if tc.op.TxnSeqNum != nil {
+			txnSeqNum := *tc.op.TxnSeqNum
+			record.TxnSeqNum = &txnSeqNum
+		}
+		record.Descriptor = tc.op.Descriptor
+		record.Operation = tc.op.Operation
+		record.Key = tc.op.Key
+	}
+	tc.tracer.buffer.Add(record)
+}
+
+func marshalMessage(msg govppapi.Message) json.RawMessage {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// retval returns value of Retval field of the reply message (zero if there is none).
+func retval(reply govppapi.Message) int32 {
+	v := reflect.Indirect(reflect.ValueOf(reply))
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	if f := v.FieldByName("Retval"); f.IsValid() && f.Kind() == reflect.Int32 {
+		return int32(f.Int())
+	}
+	return 0
+}
+
+// APITrace returns traced binary API calls selected by the filter.
+// Nil is returned if tracing is disabled.
+func (p *Plugin) APITrace(filter apitrace.Filter) []apitrace.Record {
+	if p.apiTracer == nil {
+		return nil
+	}
+	return p.apiTracer.buffer.Query(filter)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// InstanceDescriptorName returns name of the descriptor instantiated for the given
+// dataplane instance (the name is returned unchanged for the default instance).
+func InstanceDescriptorName(instance, descriptor string) string {
+	if instance == "" {
+		return descriptor
+	}
+	return instance + "/" + descriptor
+}
+
+// InstanceDescriptor returns a copy of the descriptor handling values of the given
+// dataplane (i.e. VPP) instance, so that the same descriptor implementation (bound
+// to the connection of the instance) can be registered once per instance.
+// Keys of the values handled by the returned descriptor are scoped by the instance
+// (see models.InstanceKey), while the callbacks of the original descriptor receive
+// and return keys without the instance scope. Keys of dependencies and derived values
+// returned by the callbacks are scoped by the same instance, unless they are already
+// scoped (see models.ScopeInstanceKey) - descriptors may thus depend on values of other
+// instances, including the default one (i.e. memif interfaces connecting two VPP
+// instances), and the scheduler orders such operations as any other dependencies.
+// The descriptor is returned unchanged for the default instance (empty name), an error
+// is returned if its NB key prefix is already scoped by instance.
+func InstanceDescriptor(instance string, d *KVDescriptor) (*KVDescriptor, error) {
+	if instance == "" {
+		return d, nil
+	}
+	scoped := *d
+	scoped.Name = InstanceDescriptorName(instance, d.Name)
+
+	scopeRefKey := func(key string) string {
+		return models.ScopeInstanceKey(instance, key)
+	}
+	unscopeKey := func(key string) string {
+		_, unscoped := models.ParseInstanceKey(key)
+		return unscoped
+	}
+	scopeSelector := func(selector KeySelector) KeySelector {
+		return func(key string) bool {
+			inst, unscoped := models.ParseInstanceKey(key)
+			return inst == instance && selector(unscoped)
+		}
+	}
+
+	if d.NBKeyPrefix != "" {
+		prefix, err := models.InstanceKey(instance, d.NBKeyPrefix)
+		if err != nil {
+			return nil, errors.Errorf("descriptor %s: %v", d.Name, err)
+		}
+		scoped.NBKeyPrefix = prefix
+	}
+	if d.KeySelector != nil {
+		scoped.KeySelector = scopeSelector(d.KeySelector)
+	}
+	if d.KeyLabel != nil {
+		scoped.KeyLabel = func(key string) string {
+			return d.KeyLabel(unscopeKey(key))
+		}
+	}
+	if d.ValueComparator != nil {
+		scoped.ValueComparator = func(key string, oldValue, newValue proto.Message) bool {
+			return d.ValueComparator(unscopeKey(key), oldValue, newValue)
+		}
+	}
+	if d.Validate != nil {
+		scoped.Validate = func(key string, value proto.Message) error {
+			return d.Validate(unscopeKey(key), value)
+		}
+	}
+	if d.Create != nil {
+		scoped.Create = func(key string, value proto.Message) (Metadata, error) {
+			return d.Create(unscopeKey(key), value)
+		}
+	}
+	if d.Delete != nil {
+		scoped.Delete = func(key string, value proto.Message, metadata Metadata) error {
+			return d.Delete(unscopeKey(key), value, metadata)
+		}
+	}
+	if d.Update != nil {
+		scoped.Update = func(key string, oldValue, newValue proto.Message, oldMetadata Metadata) (Metadata, error) {
+			return d.Update(unscopeKey(key), oldValue, newValue, oldMetadata)
+		}
+	}
+	if d.UpdateWithRecreate != nil {
+		scoped.UpdateWithRecreate = func(key string, oldValue, newValue proto.Message, metadata Metadata) bool {
+			return d.UpdateWithRecreate(unscopeKey(key), oldValue, newValue, metadata)
+		}
+	}
+	if d.Retrieve != nil {
+		scoped.Retrieve = func(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
+			unscoped := make([]KVWithMetadata, 0, len(correlate))
+			for _, kv := range correlate {
+				kv.Key = unscopeKey(kv.Key)
+				unscoped = append(unscoped, kv)
+			}
+			retrieved, err := d.Retrieve(unscoped)
+			for i := range retrieved {
+				key, scopeErr := models.InstanceKey(instance, retrieved[i].Key)
+				if scopeErr != nil {
+					return nil, errors.Errorf("descriptor %s retrieved value of other instance: %v",
+						d.Name, scopeErr)
+				}
+				retrieved[i].Key = key
+			}
+			return retrieved, err
+		}
+	}
+	if d.DerivedValues != nil {
+		scoped.DerivedValues = func(key string, value proto.Message) []KeyValuePair {
+			derived := d.DerivedValues(unscopeKey(key), value)
+			for i := range derived {
+				derived[i].Key = scopeRefKey(derived[i].Key)
+			}
+			return derived
+		}
+	}
+	if d.Dependencies != nil {
+		scoped.Dependencies = func(key string, value proto.Message) []Dependency {
+			deps := d.Dependencies(unscopeKey(key), value)
+			for i := range deps {
+				if deps[i].Key != "" {
+					deps[i].Key = scopeRefKey(deps[i].Key)
+				}
+				if len(deps[i].AnyOf.KeyPrefixes) > 0 {
+					prefixes := make([]string, 0, len(deps[i].AnyOf.KeyPrefixes))
+					for _, prefix := range deps[i].AnyOf.KeyPrefixes {
+						prefixes = append(prefixes, scopeRefKey(prefix))
+					}
+					deps[i].AnyOf.KeyPrefixes = prefixes
+				}
+				if deps[i].AnyOf.KeySelector != nil {
+					deps[i].AnyOf.KeySelector = scopeSelector(deps[i].AnyOf.KeySelector)
+				}
+			}
+			return deps
+		}
+	}
+	if len(d.RetrieveDependencies) > 0 {
+		scoped.RetrieveDependencies = make([]string, 0, len(d.RetrieveDependencies))
+		for _, dep := range d.RetrieveDependencies {
+			scoped.RetrieveDependencies = append(scoped.RetrieveDependencies,
+				InstanceDescriptorName(instance, dep))
+		}
+	}
+	return &scoped, nil
+}
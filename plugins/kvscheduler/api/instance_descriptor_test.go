@@ -0,0 +1,118 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+func TestInstanceDescriptor(t *testing.T) {
+	g := NewWithT(t)
+
+	const prefix = "config/vpp/v2/interfaces/"
+	var created []string
+	descriptor := &api.KVDescriptor{
+		Name:        "vpp-interface",
+		NBKeyPrefix: prefix,
+		KeySelector: func(key string) bool {
+			return strings.HasPrefix(key, prefix)
+		},
+		Create: func(key string, value proto.Message) (api.Metadata, error) {
+			created = append(created, key)
+			return nil, nil
+		},
+		Dependencies: func(key string, value proto.Message) []api.Dependency {
+			return []api.Dependency{
+				{Label: "local", Key: prefix + "loop1"},
+				{Label: "remote", Key: "instance/vpp2/" + prefix + "memif1"},
+				{Label: "default", Key: "instance/default/" + prefix + "memif2"},
+				{Label: "any", AnyOf: api.AnyOfDependency{KeyPrefixes: []string{prefix}}},
+			}
+		},
+		RetrieveDependencies: []string{"vpp-bond"},
+	}
+
+	g.Expect(api.InstanceDescriptor("", descriptor)).To(BeIdenticalTo(descriptor))
+
+	scoped, err := api.InstanceDescriptor("vpp1", descriptor)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(scoped.Name).To(Equal("vpp1/vpp-interface"))
+	g.Expect(scoped.NBKeyPrefix).To(Equal("instance/vpp1/" + prefix))
+	g.Expect(scoped.RetrieveDependencies).To(Equal([]string{"vpp1/vpp-bond"}))
+
+	g.Expect(scoped.KeySelector("instance/vpp1/" + prefix + "memif1")).To(BeTrue())
+	g.Expect(scoped.KeySelector("instance/vpp2/" + prefix + "memif1")).To(BeFalse())
+	g.Expect(scoped.KeySelector(prefix + "memif1")).To(BeFalse())
+
+	_, err = scoped.Create("instance/vpp1/"+prefix+"memif1", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created).To(Equal([]string{prefix + "memif1"}))
+
+	deps := scoped.Dependencies("instance/vpp1/"+prefix+"memif1", nil)
+	g.Expect(deps).To(HaveLen(4))
+	g.Expect(deps[0].Key).To(Equal("instance/vpp1/" + prefix + "loop1"))
+	g.Expect(deps[1].Key).To(Equal("instance/vpp2/" + prefix + "memif1"))
+	g.Expect(deps[2].Key).To(Equal(prefix + "memif2"))
+	g.Expect(deps[3].AnyOf.KeyPrefixes).To(Equal([]string{"instance/vpp1/" + prefix}))
+
+	// the original descriptor is not modified
+	g.Expect(descriptor.Name).To(Equal("vpp-interface"))
+	g.Expect(descriptor.NBKeyPrefix).To(Equal(prefix))
+
+	// NB key prefix scoped by other instance
+	_, err = api.InstanceDescriptor("vpp1", &api.KVDescriptor{
+		Name:        "vpp2-interface",
+		NBKeyPrefix: "instance/vpp2/" + prefix,
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestInstanceDescriptorRetrieve(t *testing.T) {
+	g := NewWithT(t)
+
+	const prefix = "config/vpp/v2/interfaces/"
+	var retrieved []api.KVWithMetadata
+	var correlated []string
+	descriptor := &api.KVDescriptor{
+		Name:        "vpp-interface",
+		NBKeyPrefix: prefix,
+		Retrieve: func(correlate []api.KVWithMetadata) ([]api.KVWithMetadata, error) {
+			for _, kv := range correlate {
+				correlated = append(correlated, kv.Key)
+			}
+			return retrieved, nil
+		},
+	}
+	scoped, err := api.InstanceDescriptor("vpp1", descriptor)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	retrieved = []api.KVWithMetadata{{Key: prefix + "memif1"}}
+	values, err := scoped.Retrieve([]api.KVWithMetadata{{Key: "instance/vpp1/" + prefix + "memif1"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(correlated).To(Equal([]string{prefix + "memif1"}))
+	g.Expect(values).To(HaveLen(1))
+	g.Expect(values[0].Key).To(Equal("instance/vpp1/" + prefix + "memif1"))
+
+	// retrieved value of other instance is not re-scoped
+	retrieved = []api.KVWithMetadata{{Key: "instance/vpp2/" + prefix + "memif1"}}
+	_, err = scoped.Retrieve(nil)
+	g.Expect(err).To(HaveOccurred())
+}
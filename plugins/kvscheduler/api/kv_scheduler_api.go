@@ -196,6 +196,8 @@ type KVScheduler interface {
 
 	// RegisterKVDescriptor registers descriptor(s) for a set of selected
 	// keys. It should be called in the Init phase of agent plugins.
+	// Descriptors registered at run-time (e.g. remote descriptors) are applied
+	// to values received before the registration only after the next resync.
 	// Every key-value pair must have at most one descriptor associated with it
 	// (none for derived values expressing properties).
 	RegisterKVDescriptor(descriptor ...*KVDescriptor) error
@@ -251,6 +253,12 @@ type KVScheduler interface {
 	// by the sequence number.
 	GetRecordedTransaction(SeqNum uint64) (txn *RecordedTxn)
 
+	// RegisterOperationTracer registers tracer notified about every operation
+	// executed by a descriptor, so that SB calls (i.e. VPP binary API requests)
+	// made meanwhile can be correlated with the transaction and the value.
+	// Operations are not tracked at all unless a tracer is registered.
+	RegisterOperationTracer(tracer OperationTracer)
+
 	// ValidateSemantically validates given proto messages according to semantic validation(KVDescriptor.Validate)
 	// from registered KVDescriptors. If all locally known messages are valid, nil is returned. If some locally known
 	// messages are invalid, kvscheduler.MessageValidationErrors is returned. In any other case, error is returned.
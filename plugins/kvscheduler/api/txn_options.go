@@ -46,6 +46,18 @@ const (
 	// txnSimulationCtxKey is a key under which option enabling txn simulation
 	// is stored into the context.
 	txnSimulationCtxKey
+
+	// txnOriginCtxKey is a key under which transaction origin is stored
+	// into the context.
+	txnOriginCtxKey
+
+	// lostValuesCtxKey is a key under which *lost-values* txn option is stored
+	// into the context.
+	lostValuesCtxKey
+
+	// resyncDescriptorsCtxKey is a key under which *resync-descriptors* txn option
+	// is stored into the context.
+	resyncDescriptorsCtxKey
 )
 
 // modifiable default parameters for the *retry* txn option
@@ -302,3 +314,72 @@ func IsWithSimulation(ctx context.Context) bool {
 	_, withSimulation := ctx.Value(txnSimulationCtxKey).(*txnSimulationOpt)
 	return withSimulation
 }
+
+/* Txn Origin */
+
+// WithOrigin prepares context for transaction that will have its originator
+// (data source, remote peer, caller identity, request ID) recorded.
+// By default, transaction origin is not recorded.
+func WithOrigin(ctx context.Context, origin TxnOrigin) context.Context {
+	return context.WithValue(ctx, txnOriginCtxKey, &origin)
+}
+
+// IsWithOrigin returns transaction origin if the transaction context
+// is configured to include it.
+func IsWithOrigin(ctx context.Context) (origin *TxnOrigin, withOrigin bool) {
+	origin, withOrigin = ctx.Value(txnOriginCtxKey).(*TxnOrigin)
+	return origin, withOrigin
+}
+
+/* Lost Values */
+
+// lostValuesOpt represents the *lost-values* transaction option.
+type lostValuesOpt struct {
+	selector KeySelector
+}
+
+// WithLostValues prepares context for downstream resync informing the scheduler
+// that values with keys matching the selector were lost in SB (e.g. the data plane
+// has restarted). The in-memory metadata of lost values are not used to correlate
+// retrieved values (NB values are used instead, like during the startup resync),
+// the values that were not retrieved again are replayed in the order given
+// by dependencies, and descriptors without any lost value are not refreshed at all.
+// The option is ignored for other types of transactions.
+func WithLostValues(ctx context.Context, selector KeySelector) context.Context {
+	return context.WithValue(ctx, lostValuesCtxKey, &lostValuesOpt{selector: selector})
+}
+
+// IsWithLostValues returns selector of lost values if the transaction context
+// is configured with the lost-values option.
+func IsWithLostValues(ctx context.Context) (selector KeySelector, withLostValues bool) {
+	lostArgs, withLostValues := ctx.Value(lostValuesCtxKey).(*lostValuesOpt)
+	if !withLostValues {
+		return nil, false
+	}
+	return lostArgs.selector, true
+}
+
+/* Resync Descriptors */
+
+// resyncDescriptorsOpt represents the *resync-descriptors* transaction option.
+type resyncDescriptorsOpt struct {
+	descriptors []string
+}
+
+// WithResyncDescriptors prepares context for downstream resync limited to values
+// of the given descriptors (e.g. descriptor registered at run-time). Only these
+// descriptors are refreshed and only their values are re-applied, values of other
+// descriptors are left untouched. The option is ignored for other types of transactions.
+func WithResyncDescriptors(ctx context.Context, descriptors ...string) context.Context {
+	return context.WithValue(ctx, resyncDescriptorsCtxKey, &resyncDescriptorsOpt{descriptors: descriptors})
+}
+
+// IsWithResyncDescriptors returns names of descriptors to resync if the transaction
+// context is configured with the resync-descriptors option.
+func IsWithResyncDescriptors(ctx context.Context) (descriptors []string, withResyncDescriptors bool) {
+	opt, withResyncDescriptors := ctx.Value(resyncDescriptorsCtxKey).(*resyncDescriptorsOpt)
+	if !withResyncDescriptors {
+		return nil, false
+	}
+	return opt.descriptors, true
+}
@@ -111,6 +111,57 @@ func ResyncTypeToString(t ResyncType) string {
 	return t.String()
 }
 
+// TxnOrigin identifies originator of NB transaction.
+type TxnOrigin struct {
+	// DataSource is the orchestrator data source (i.e. "grpc" or "datasync").
+	DataSource string `json:",omitempty"`
+	// Peer is address of the remote client prefixed with the protocol
+	// (i.e. "grpc://10.0.0.1:51234" or "http://10.0.0.1:51236").
+	Peer string `json:",omitempty"`
+	// Identity is authenticated identity of the remote client.
+	Identity string `json:",omitempty"`
+	// RequestID is ID of the request that triggered the transaction.
+	RequestID string `json:",omitempty"`
+}
+
+// String returns a one-line human-readable representation of the txn origin.
+func (o *TxnOrigin) String() string {
+	var parts []string
+	if o.DataSource != "" {
+		parts = append(parts, "data-source="+o.DataSource)
+	}
+	if o.Peer != "" {
+		parts = append(parts, "peer="+o.Peer)
+	}
+	if o.Identity != "" {
+		parts = append(parts, "identity="+o.Identity)
+	}
+	if o.RequestID != "" {
+		parts = append(parts, "request-id="+o.RequestID)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ExecutingOperation describes operation being executed by a descriptor.
+type ExecutingOperation struct {
+	// TxnSeqNum is sequence number of the transaction executing the operation
+	// (nil for Retrieve executed outside of transaction, e.g. to dump SB view).
+	TxnSeqNum *uint64
+	// Descriptor is name of the descriptor executing the operation.
+	Descriptor string
+	// Operation is one of: Create, Update, Delete, Retrieve.
+	Operation string
+	// Key is key of the value (empty for Retrieve).
+	Key string
+}
+
+// OperationTracer is notified about operations executed by descriptors.
+type OperationTracer interface {
+	// StartOperation is called before a descriptor starts executing the operation.
+	// The returned function is called once the operation has finished.
+	StartOperation(op ExecutingOperation) (done func())
+}
+
 // RecordedTxn is used to record executed transaction.
 type RecordedTxn struct {
 	PreRecord      bool `json:",omitempty"` // not yet fully recorded, only args + plan + pre-processing errors
@@ -125,6 +176,7 @@ type RecordedTxn struct {
 	TxnType      TxnType
 	ResyncType   ResyncType       `json:",omitempty"`
 	Description  string           `json:",omitempty"`
+	Origin       *TxnOrigin       `json:",omitempty"`
 	RetryForTxn  uint64           `json:",omitempty"`
 	RetryAttempt int              `json:",omitempty"`
 	Values       []RecordedKVPair `json:",omitempty"`
@@ -217,6 +269,9 @@ func (txn *RecordedTxn) StringWithOpts(resultOnly, verbose bool, indent int) str
 				}
 			}
 		}
+		if txn.Origin != nil {
+			str += indent2 + fmt.Sprintf("- origin: %s\n", txn.Origin)
+		}
 		if txn.ResyncType == DownstreamResync {
 			goto printOps
 		}
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+// auditRecord is a single entry of the audit log, written as one line of JSON
+// for every executed NB transaction.
+type auditRecord struct {
+	Time        time.Time
+	SeqNum      uint64
+	ResyncType  string         `json:",omitempty"`
+	Description string         `json:",omitempty"`
+	Origin      *kvs.TxnOrigin `json:",omitempty"`
+	Changes     []auditChange  `json:",omitempty"`
+	Errors      []auditError   `json:",omitempty"`
+}
+
+// auditChange is a value changed by NB transaction. The value itself is not
+// recorded, as it may carry secrets (e.g. WireGuard, IPsec or IKEv2 keys),
+// only its type and digest is (both are empty for removed values).
+type auditChange struct {
+	Key       string
+	ValueType string `json:",omitempty"`
+	Digest    string `json:",omitempty"`
+}
+
+// auditError is a failed operation of NB transaction.
+type auditError struct {
+	Key       string
+	Operation string
+	Error     string
+}
+
+// auditLog is append-only file with audit records of NB transactions.
+type auditLog struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+func openAuditLog(path string) (*auditLog, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLog{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// write appends audit record of the given NB transaction.
+func (l *auditLog) write(txn *kvs.RecordedTxn) error {
+	record := auditRecord{
+		Time:        txn.Stop,
+		SeqNum:      txn.SeqNum,
+		Description: txn.Description,
+		Origin:      txn.Origin,
+	}
+	if txn.ResyncType != kvs.NotResync {
+		record.ResyncType = kvs.ResyncTypeToString(txn.ResyncType)
+	}
+	for _, kv := range txn.Values {
+		if kv.Origin == kvs.FromSB {
+			continue
+		}
+		change := auditChange{Key: kv.Key}
+		if kv.Value != nil && kv.Value.Message != nil {
+			digest, err := valueDigest(kv.Value.Message)
+			if err != nil {
+				return err
+			}
+			change.ValueType = kv.Value.ProtoMsgName
+			change.Digest = digest
+		}
+		record.Changes = append(record.Changes, change)
+	}
+	for _, op := range txn.Executed {
+		if op.NewErrMsg != "" {
+			record.Errors = append(record.Errors, auditError{
+				Key:       op.Key,
+				Operation: op.Operation.String(),
+				Error:     op.NewErrMsg,
+			})
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.enc.Encode(&record)
+}
+
+// valueDigest returns hex-encoded SHA-256 digest of the deterministic binary
+// encoding of the given value.
+func valueDigest(value proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (l *auditLog) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
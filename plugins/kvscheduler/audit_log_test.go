@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+func TestAuditLog(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler with audit log
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+	scheduler.auditLog, err = openAuditLog(auditFile)
+	Expect(err).To(BeNil())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run transaction with origin
+	origin := TxnOrigin{
+		DataSource: "grpc",
+		Peer:       "grpc://10.0.0.1:51234",
+		Identity:   "portal (token)",
+		RequestID:  "abcd",
+	}
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("secret-item"))
+	seqNum, err := schedulerTxn.Commit(WithOrigin(testCtx, origin))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// run transaction without origin
+	schedulerTxn = scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, nil)
+	_, err = schedulerTxn.Commit(testCtx)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// check transaction history
+	txn := scheduler.GetRecordedTransaction(seqNum)
+	Expect(txn).ToNot(BeNil())
+	Expect(txn.Origin).To(Equal(&origin))
+	Expect(txn.StringWithOpts(false, false, 0)).To(ContainSubstring("- origin: data-source=grpc, " +
+		"peer=grpc://10.0.0.1:51234, identity=portal (token), request-id=abcd"))
+
+	// check audit log
+	Expect(scheduler.Close()).To(Succeed())
+	data, err := ioutil.ReadFile(auditFile)
+	Expect(err).To(BeNil())
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	Expect(lines).To(HaveLen(2))
+	Expect(lines[0]).ToNot(ContainSubstring("secret-item"))
+
+	var record auditRecord
+	Expect(json.Unmarshal([]byte(lines[0]), &record)).To(Succeed())
+	Expect(record.SeqNum).To(Equal(seqNum))
+	Expect(record.Time).ToNot(Equal(time.Time{}))
+	Expect(record.Origin).To(Equal(&origin))
+	Expect(record.Changes).To(HaveLen(1))
+	Expect(record.Changes[0].Key).To(Equal(prefixA + baseValue1))
+	Expect(record.Changes[0].ValueType).To(Equal(string(proto.MessageName(test.NewArrayValue()))))
+	expDigest, err := valueDigest(test.NewArrayValue("secret-item"))
+	Expect(err).To(BeNil())
+	Expect(record.Changes[0].Digest).To(Equal(expDigest))
+	Expect(record.Errors).To(BeEmpty())
+
+	record = auditRecord{}
+	Expect(json.Unmarshal([]byte(lines[1]), &record)).To(Succeed())
+	Expect(record.SeqNum).To(Equal(seqNum + 1))
+	Expect(record.Origin).To(BeNil())
+	Expect(record.Changes).To(HaveLen(1))
+	Expect(record.Changes[0].ValueType).To(BeEmpty())
+	Expect(record.Changes[0].Digest).To(BeEmpty())
+}
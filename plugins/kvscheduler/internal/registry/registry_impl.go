@@ -16,8 +16,8 @@ package registry
 
 import (
 	"container/list"
-
 	"fmt"
+	"sync"
 
 	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -30,7 +30,11 @@ const (
 )
 
 // registry is an implementation of Registry for descriptors.
+// Descriptors can be registered at run-time, therefore all methods are
+// guarded by mutex (lookups by key also update the cache).
 type registry struct {
+	mu sync.Mutex
+
 	descriptors      map[string]*KVDescriptor // descriptor name -> descriptor
 	descriptorList   []*KVDescriptor          // ordered by retrieve dependencies
 	upToDateDescList bool                     // true if descriptorList is in sync with descriptors
@@ -55,12 +59,22 @@ func NewRegistry() Registry {
 
 // RegisterDescriptor add new descriptor into the registry.
 func (reg *registry) RegisterDescriptor(descriptor *KVDescriptor) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
 	reg.descriptors[descriptor.Name] = descriptor
 	reg.upToDateDescList = false
+
+	// keys cached before the registration could be selected by the new descriptor
+	reg.keyToCacheEntry = make(map[string]*list.Element)
+	reg.keyCache.Init()
 }
 
 // GetAllDescriptors returns all registered descriptors.
 func (reg *registry) GetAllDescriptors() (descriptors []*KVDescriptor) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
 	if reg.upToDateDescList {
 		return reg.descriptorList
 	}
@@ -86,6 +100,9 @@ func (reg *registry) GetAllDescriptors() (descriptors []*KVDescriptor) {
 
 // GetDescriptor returns descriptor with the given name.
 func (reg *registry) GetDescriptor(name string) *KVDescriptor {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
 	descriptor, has := reg.descriptors[name]
 	if !has {
 		return nil
@@ -95,6 +112,9 @@ func (reg *registry) GetDescriptor(name string) *KVDescriptor {
 
 // GetDescriptorForKey returns descriptor handling the given key.
 func (reg *registry) GetDescriptorForKey(key string) *KVDescriptor {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
 	elem, cached := reg.keyToCacheEntry[key]
 	if cached {
 		// get descriptor from the cache
@@ -178,3 +178,29 @@ func TestRegistry(t *testing.T) {
 	Expect(descriptor).ToNot(BeNil())
 	Expect(descriptor.Name).To(BeEquivalentTo(descriptor4Name))
 }
+
+func TestRegistryLateRegistration(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor1 := NewMockDescriptor(
+		&KVDescriptor{
+			Name:        descriptor1Name,
+			KeySelector: prefixSelector(prefixA),
+		}, nil, 0)
+	descriptor2 := NewMockDescriptor(
+		&KVDescriptor{
+			Name:        descriptor2Name,
+			KeySelector: prefixSelector(prefixB),
+		}, nil, 0)
+
+	registry := NewRegistry()
+	registry.RegisterDescriptor(descriptor1)
+
+	// key not selected by any descriptor yet
+	Expect(registry.GetDescriptorForKey(prefixB + randomSuffix)).To(BeNil())
+
+	// descriptor registered later must be found for the same key
+	registry.RegisterDescriptor(descriptor2)
+	Expect(registry.GetDescriptorForKey(prefixB + randomSuffix)).To(Equal(descriptor2))
+	Expect(registry.GetDescriptorForKey(prefixA + randomSuffix)).To(Equal(descriptor1))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+// mockOperationTracer records started operations.
+type mockOperationTracer struct {
+	ops     []ExecutingOperation
+	running int
+}
+
+func (t *mockOperationTracer) StartOperation(op ExecutingOperation) (done func()) {
+	Expect(t.running).To(BeZero(), "operations are expected to be executed one at a time")
+	t.ops = append(t.ops, op)
+	t.running++
+	return func() {
+		t.running--
+	}
+}
+
+func TestOperationTracer(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	Expect(scheduler.Init()).To(Succeed())
+
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	tracer := &mockOperationTracer{}
+	scheduler.RegisterOperationTracer(tracer)
+
+	// operations executed by transaction are attributed to it
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("item1"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(tracer.ops).To(HaveLen(2))
+	Expect(tracer.ops[0].Operation).To(Equal("Retrieve"))
+	Expect(tracer.ops[0].Descriptor).To(Equal(descriptor1Name))
+	Expect(tracer.ops[0].TxnSeqNum).ToNot(BeNil())
+	Expect(*tracer.ops[0].TxnSeqNum).To(Equal(seqNum))
+	Expect(tracer.ops[1].Operation).To(Equal("Create"))
+	Expect(tracer.ops[1].Key).To(Equal(prefixA + baseValue1))
+	Expect(tracer.ops[1].TxnSeqNum).ToNot(BeNil())
+	Expect(*tracer.ops[1].TxnSeqNum).To(Equal(seqNum))
+
+	// Retrieve executed to dump SB view is not attributed to any transaction
+	tracer.ops = nil
+	_, err = scheduler.DumpValuesByDescriptor(descriptor1Name, SBView)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(tracer.ops).To(HaveLen(1))
+	Expect(tracer.ops[0].Operation).To(Equal("Retrieve"))
+	Expect(tracer.ops[0].TxnSeqNum).To(BeNil())
+	Expect(tracer.running).To(BeZero())
+
+	Expect(scheduler.Close()).To(Succeed())
+}
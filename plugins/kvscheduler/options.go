@@ -18,6 +18,8 @@ import (
 	"go.ligato.io/cn-infra/v2/config"
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
+
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 )
 
 // DefaultPlugin is a default instance of Plugin.
@@ -29,6 +31,7 @@ func NewPlugin(opts ...Option) *Scheduler {
 
 	p.PluginName = "kvscheduler"
 	p.HTTPHandlers = &rest.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
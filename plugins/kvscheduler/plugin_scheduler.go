@@ -29,6 +29,7 @@ import (
 	"go.ligato.io/cn-infra/v2/infra"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/registry"
@@ -97,7 +98,9 @@ type Scheduler struct {
 	registry registry.Registry
 
 	// a list of key prefixed covered by registered descriptors
-	keyPrefixes []string
+	// (descriptors can be registered at run-time)
+	keyPrefixesLock sync.RWMutex
+	keyPrefixes     []string
 
 	// TXN processing
 	txnLock      sync.Mutex // can be used to pause transaction processing; always lock before the graph!
@@ -105,6 +108,9 @@ type Scheduler struct {
 	txnSeqNumber uint64
 	resyncCount  uint
 
+	// tracer of operations executed by descriptors (protected by txnLock)
+	opTracer kvs.OperationTracer
+
 	// value status
 	updatedStates    utils.KeySet // base values with updated status
 	valStateWatchers []valStateWatcher
@@ -114,6 +120,9 @@ type Scheduler struct {
 	txnHistory  []*kvs.RecordedTxn // ordered from the oldest to the latest
 	startTime   time.Time
 
+	// audit log of NB transactions (nil if disabled)
+	auditLog *auditLog
+
 	// debugging
 	verifyMode   bool
 	logGraphWalk bool
@@ -123,6 +132,7 @@ type Scheduler struct {
 type Deps struct {
 	infra.PluginDeps
 	HTTPHandlers rest.HTTPHandlers
+	Auth         auth.API
 }
 
 // Config holds the KVScheduler configuration.
@@ -132,6 +142,7 @@ type Config struct {
 	PermanentlyRecordedInitPeriod uint32 `json:"permanently-recorded-init-period"` // in minutes
 	EnableTxnSimulation           bool   `json:"enable-txn-simulation"`
 	PrintTxnSummary               bool   `json:"print-txn-summary"`
+	AuditLogFile                  string `json:"audit-log-file"` // append-only log of NB transactions
 }
 
 // SchedulerTxn implements transaction for the KV scheduler.
@@ -166,6 +177,13 @@ func (s *Scheduler) Init() error {
 	}
 	s.Log.Debugf("KVScheduler configuration: %+v", *s.config)
 
+	// open audit log of NB transactions
+	if s.config.AuditLogFile != "" {
+		if s.auditLog, err = openAuditLog(s.config.AuditLogFile); err != nil {
+			return errors.Errorf("failed to open audit log file: %v", err)
+		}
+	}
+
 	// prepare context for all go routines
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 	// initialize graph for in-memory storage of key-value pairs
@@ -221,6 +239,9 @@ func (s *Scheduler) loadConfig(config *Config) error {
 func (s *Scheduler) Close() error {
 	s.cancel()
 	s.wg.Wait()
+	if s.auditLog != nil {
+		return s.auditLog.close()
+	}
 	return nil
 }
 
@@ -229,6 +250,10 @@ func (s *Scheduler) Close() error {
 // Every key-value pair must have at most one descriptor associated with it
 // (none for derived values expressing properties).
 func (s *Scheduler) RegisterKVDescriptor(descriptors ...*kvs.KVDescriptor) error {
+	// descriptors can be registered also at run-time
+	s.txnLock.Lock()
+	defer s.txnLock.Unlock()
+
 	for _, d := range descriptors {
 		err := s.registerKVDescriptor(d)
 		if err != nil {
@@ -248,7 +273,9 @@ func (s *Scheduler) registerKVDescriptor(descriptor *kvs.KVDescriptor) error {
 
 	s.registry.RegisterDescriptor(descriptor)
 	if descriptor.NBKeyPrefix != "" {
+		s.keyPrefixesLock.Lock()
 		s.keyPrefixes = append(s.keyPrefixes, descriptor.NBKeyPrefix)
+		s.keyPrefixesLock.Unlock()
 	}
 
 	if descriptor.WithMetadata {
@@ -268,7 +295,10 @@ func (s *Scheduler) registerKVDescriptor(descriptor *kvs.KVDescriptor) error {
 // GetRegisteredNBKeyPrefixes returns a list of key prefixes from NB with values
 // described by registered descriptors and therefore managed by the scheduler.
 func (s *Scheduler) GetRegisteredNBKeyPrefixes() []string {
-	return s.keyPrefixes
+	s.keyPrefixesLock.RLock()
+	defer s.keyPrefixesLock.RUnlock()
+
+	return append([]string(nil), s.keyPrefixes...)
 }
 
 // StartNBTransaction starts a new transaction from NB to SB plane.
@@ -394,8 +424,10 @@ func (s *Scheduler) DumpValuesByDescriptor(descriptor string, view kvs.View) (va
 		return
 	}
 
-	// retrieve the state directly from SB via descriptor
+	// retrieve the state directly from SB via descriptor (outside of transaction)
+	done := s.executingOperation(nil, descriptor, "Retrieve", "")
 	values, err = kvDescriptor.Retrieve(inMemNodes)
+	done()
 	return
 }
 
@@ -461,6 +493,13 @@ func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err erro
 	txnData.nb.retryArgs, txnData.nb.retryEnabled = kvs.IsWithRetry(ctx)
 	txnData.nb.revertOnFailure = kvs.IsWithRevert(ctx)
 	txnData.nb.description, _ = kvs.IsWithDescription(ctx)
+	txnData.nb.origin, _ = kvs.IsWithOrigin(ctx)
+	if txnData.nb.resyncType == kvs.DownstreamResync {
+		txnData.nb.lostValues, _ = kvs.IsWithLostValues(ctx)
+		if descriptors, scoped := kvs.IsWithResyncDescriptors(ctx); scoped {
+			txnData.nb.resyncScope = utils.NewMapBasedKeySet(descriptors...)
+		}
+	}
 	txnData.nb.withSimulation = txn.scheduler.config.EnableTxnSimulation || kvs.IsWithSimulation(ctx)
 
 	// validate transaction options
@@ -39,11 +39,13 @@ const (
 type resyncData struct {
 	first  bool // true if startup-resync
 	values []kvForTxn
+	lost   kvs.KeySelector // selects values lost in SB (nil if nothing was lost)
+	scope  utils.KeySet    // descriptors to refresh (nil for all)
 }
 
 // refreshGraph updates all/some values in the graph to their *real* state
 // using the Retrieve methods from descriptors.
-func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
+func (s *Scheduler) refreshGraph(graphW graph.RWAccess, txnSeqNum uint64,
 	keys utils.KeySet, resyncData *resyncData, verbose bool,
 ) {
 	if s.logGraphWalk {
@@ -77,11 +79,20 @@ func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
 				}
 			}
 		}
+		if resyncData != nil && resyncData.scope != nil && !resyncData.scope.Has(descriptor.Name) {
+			skip = true
+		}
 		if skip {
 			// nothing to refresh in the key space of this descriptor
 			s.skipRefresh(descrNodes, nil, refreshedKeys)
 			continue
 		}
+		lost := resyncData != nil && resyncData.lost != nil
+		if lost && !hasLostValues(descriptor, descrNodes, resyncData.lost) {
+			// values of this descriptor were not affected by the loss of SB state
+			s.skipRefresh(descrNodes, nil, refreshedKeys)
+			continue
+		}
 
 		// get key-value pairs for correlation
 		var correlateCap int
@@ -104,9 +115,26 @@ func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
 				}
 			}
 		} else {
+			if lost {
+				// metadata of lost values are no longer valid, use data from NB
+				// for their correlation
+				for _, kv := range resyncData.values {
+					if descriptor.KeySelector(kv.key) && resyncData.lost(kv.key) {
+						correlate = append(correlate,
+							kvs.KVWithMetadata{
+								Key:    kv.key,
+								Value:  kv.value,
+								Origin: kv.origin,
+							})
+					}
+				}
+			}
 			// for refresh of failed values or run-time resync, use in-memory
 			// kv-pairs for correlation
 			for _, node := range descrNodes {
+				if lost && resyncData.lost(node.GetKey()) {
+					continue
+				}
 				if isNodeAvailable(node) {
 					correlate = append(correlate, nodeToKVPairWithMetadata(node))
 				}
@@ -114,7 +142,9 @@ func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
 		}
 
 		// execute Retrieve operation
+		done := s.executingOperation(&txnSeqNum, descriptor.Name, "Retrieve", "")
 		retrieved, ableToRetrieve, err := handler.retrieve(correlate)
+		done()
 
 		// mark un-retrievable as refreshed
 		if !ableToRetrieve || err != nil {
@@ -399,6 +429,19 @@ func (s *Scheduler) skipRefresh(nodes []graph.Node, except utils.KeySet, refresh
 	}
 }
 
+// hasLostValues returns true if some value of the descriptor was lost in SB.
+func hasLostValues(descriptor *kvs.KVDescriptor, nodes []graph.Node, lost kvs.KeySelector) bool {
+	if descriptor.NBKeyPrefix != "" && lost(descriptor.NBKeyPrefix) {
+		return true
+	}
+	for _, node := range nodes {
+		if lost(node.GetKey()) {
+			return true
+		}
+	}
+	return false
+}
+
 func dumpGraph(g graph.RWAccess) string {
 	keys := g.GetKeys()
 
@@ -29,6 +29,7 @@ import (
 
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -118,6 +119,12 @@ const (
 	// statusURL is URL used to print the state of values under the given
 	// descriptor / key-prefix or all of them.
 	statusURL = urlPrefix + "status"
+
+	// graphURL is URL used to visualize the graph.
+	graphURL = urlPrefix + "graph"
+
+	// statsURL is URL used to obtain scheduler statistics.
+	statsURL = urlPrefix + "stats"
 )
 
 // errorString wraps string representation of an error that, unlike the original
@@ -149,21 +156,119 @@ func recordKVsWithMetadata(in []kvs.KVWithMetadata) (out []kvs.RecordedKVWithMet
 	return out
 }
 
+// RESTOperation describes single REST API operation served by the KVScheduler.
+type RESTOperation struct {
+	Path   string
+	Method string
+	// Summary is a short description of the operation.
+	Summary string
+	// Params are supported URL query parameters.
+	Params []RESTParam
+	// Response is sample value of the JSON response (nil if the response
+	// is not described by go type).
+	Response interface{}
+
+	access  auth.Access
+	handler func(s *Scheduler, formatter *render.Render) http.HandlerFunc
+}
+
+// RESTParam describes URL query parameter of the KVScheduler REST API operation.
+type RESTParam struct {
+	Name        string
+	Description string
+}
+
+// restOperations is a table of all REST API operations supported by the KVScheduler.
+var restOperations = []RESTOperation{
+	{
+		Path: txnHistoryURL, Method: "GET", Summary: "Transaction history", Response: kvs.RecordedTxns(nil),
+		Params: []RESTParam{
+			{sinceArg, "start of the time window (unix timestamp)"},
+			{untilArg, "end of the time window (unix timestamp)"},
+			{seqNumArg, "sequence number of single transaction to display"},
+			{formatArg, "output format (" + formatJSON + " or " + formatText + ")"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).txnHistoryGetHandler,
+	},
+	{
+		Path: keyTimelineURL, Method: "GET", Summary: "Timeline of value changes for a given key",
+		Params: []RESTParam{
+			{keyArg, "key of the value"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).keyTimelineGetHandler,
+	},
+	{
+		Path: graphSnapshotURL, Method: "GET", Summary: "Graph snapshot from a given point in time",
+		Params: []RESTParam{
+			{timeArg, "point in time (nanoseconds since the start of the epoch)"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).graphSnapshotGetHandler,
+	},
+	{
+		Path: flagStatsURL, Method: "GET", Summary: "Flag statistics",
+		Params: []RESTParam{
+			{flagArg, "name of the flag"},
+			{prefixArg, "key prefix to filter keys"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).flagStatsGetHandler,
+	},
+	{
+		Path: downstreamResyncURL, Method: "POST", Summary: "Trigger downstream resync", Response: (*kvs.RecordedTxn)(nil),
+		Params: []RESTParam{
+			{retryArg, "retry failed operations"},
+			{verboseArg, "print refreshed graph"},
+		},
+		access: auth.AdminAccess, handler: (*Scheduler).downstreamResyncPostHandler,
+	},
+	{
+		Path: dumpURL, Method: "GET", Summary: "Dump of key-value pairs", Response: []kvs.RecordedKVWithMetadata(nil),
+		Params: []RESTParam{
+			{descriptorArg, "name of the descriptor to dump values for"},
+			{keyPrefixArg, "key prefix to dump values for"},
+			{viewArg, "point of view (SB, NB or cached)"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).dumpGetHandler,
+	},
+	{
+		Path: statusURL, Method: "GET", Summary: "Status of values", Response: []*kvscheduler.BaseValueStatus(nil),
+		Params: []RESTParam{
+			{descriptorArg, "name of the descriptor to print value states for"},
+			{keyArg, "key of single value to print the state for"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).statusGetHandler,
+	},
+	{
+		Path: graphURL, Method: "GET", Summary: "Graph visualization (HTML page)",
+		Params: []RESTParam{
+			{txnArg, "display graph as it was after the given transaction has finalized"},
+		},
+		access: auth.ReadAccess, handler: (*Scheduler).graphHandler,
+	},
+	{
+		Path: statsURL, Method: "GET", Summary: "Scheduler statistics",
+		access: auth.ReadAccess, handler: (*Scheduler).statsHandler,
+	},
+}
+
+// RESTOperations returns description of all REST API operations served by the KVScheduler.
+func RESTOperations() []RESTOperation {
+	return append([]RESTOperation(nil), restOperations...)
+}
+
 // registerHandlers registers all supported REST APIs.
-func (s *Scheduler) registerHandlers(http rest.HTTPHandlers) {
-	if http == nil {
+func (s *Scheduler) registerHandlers(handlers rest.HTTPHandlers) {
+	if handlers == nil {
 		s.Log.Debug("No http handler provided, skipping registration of KVScheduler REST handlers")
 		return
 	}
-	http.RegisterHTTPHandler(txnHistoryURL, s.txnHistoryGetHandler, "GET")
-	http.RegisterHTTPHandler(keyTimelineURL, s.keyTimelineGetHandler, "GET")
-	http.RegisterHTTPHandler(graphSnapshotURL, s.graphSnapshotGetHandler, "GET")
-	http.RegisterHTTPHandler(flagStatsURL, s.flagStatsGetHandler, "GET")
-	http.RegisterHTTPHandler(downstreamResyncURL, s.downstreamResyncPostHandler, "POST")
-	http.RegisterHTTPHandler(dumpURL, s.dumpGetHandler, "GET")
-	http.RegisterHTTPHandler(statusURL, s.statusGetHandler, "GET")
-	http.RegisterHTTPHandler(urlPrefix+"graph", s.graphHandler, "GET")
-	http.RegisterHTTPHandler(urlPrefix+"stats", s.statsHandler, "GET")
+	for _, restOp := range restOperations {
+		handler := restOp.handler
+		op := auth.Operation{Name: restOp.Method + " " + restOp.Path, Access: restOp.access}
+		provider := func(formatter *render.Render) http.HandlerFunc {
+			return handler(s, formatter)
+		}
+		handlers.RegisterHTTPHandler(restOp.Path, auth.WrapHTTPHandler(s.Auth, op, provider), restOp.Method)
+	}
 }
 
 // txnHistoryGetHandler is the GET handler for "txn-history" API.
@@ -1185,6 +1185,198 @@ func TestResyncNotRemovingSBValues(t *testing.T) {
 	Expect(err).To(BeNil())
 }
 
+func TestResyncWithLostValues(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	// -> descriptor1:
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		WithMetadata:  true,
+	}, mockSB, 0)
+	// -> descriptor2:
+	descriptor2 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor2Name,
+		NBKeyPrefix:   prefixB,
+		KeySelector:   prefixSelector(prefixB),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		WithMetadata:  true,
+	}, mockSB, 0)
+
+	// register both descriptors with the scheduler
+	scheduler.RegisterKVDescriptor(descriptor1)
+	scheduler.RegisterKVDescriptor(descriptor2)
+
+	// run resync transaction against empty SB
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("item1"))
+	schedulerTxn.SetValue(prefixB+baseValue2, test.NewArrayValue("item1"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(seqNum).To(BeEquivalentTo(0))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(4))
+	mockSB.PopHistoryOfOps()
+
+	// simulate loss of values from the key space of descriptor1 (i.e. restart of data plane)
+	mockSB.SetValue(prefixA+baseValue1, nil, nil, FromNB, false)
+	mockSB.SetValue(prefixA+baseValue1+"/item1", nil, nil, FromNB, true)
+
+	// run downstream resync with lost values
+	startTime := time.Now()
+	schedulerTxn = scheduler.StartNBTransaction()
+	ctx := WithLostValues(WithResync(testCtx, DownstreamResync, true), prefixSelector(prefixA))
+	seqNum, err = schedulerTxn.Commit(WithDescription(ctx, "replay"))
+	Expect(seqNum).To(BeEquivalentTo(1))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	value := mockSB.GetValue(prefixA + baseValue1)
+	Expect(value).ToNot(BeNil())
+	Expect(proto.Equal(value.Value, test.NewArrayValue("item1"))).To(BeTrue())
+	value = mockSB.GetValue(prefixA + baseValue1 + "/item1")
+	Expect(value).ToNot(BeNil())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(4))
+
+	// check operations executed in SB (descriptor2 is not refreshed, values
+	// of descriptor1 are correlated with NB values without stale metadata)
+	opHistory := mockSB.PopHistoryOfOps()
+	Expect(opHistory).To(HaveLen(3))
+	operation := opHistory[0]
+	Expect(operation.OpType).To(Equal(test.MockRetrieve))
+	Expect(operation.Descriptor).To(BeEquivalentTo(descriptor1Name))
+	checkValues(operation.CorrelateRetrieve, []KVWithMetadata{
+		{
+			Key:      prefixA + baseValue1,
+			Value:    test.NewArrayValue("item1"),
+			Metadata: nil,
+			Origin:   FromNB,
+		},
+	})
+	operation = opHistory[1]
+	Expect(operation.OpType).To(Equal(test.MockCreate))
+	Expect(operation.Key).To(BeEquivalentTo(prefixA + baseValue1))
+	Expect(operation.Err).To(BeNil())
+	operation = opHistory[2]
+	Expect(operation.OpType).To(Equal(test.MockCreate))
+	Expect(operation.Key).To(BeEquivalentTo(prefixA + baseValue1 + "/item1"))
+	Expect(operation.Err).To(BeNil())
+
+	// check value states
+	status := scheduler.GetValueStatus(prefixA + baseValue1)
+	Expect(status).ToNot(BeNil())
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_CONFIGURED))
+	Expect(status.GetValue().GetLastOperation()).To(Equal(TxnOperation_CREATE))
+
+	// replay of lost values is recorded
+	txnHistory := scheduler.GetTransactionHistory(startTime, time.Now())
+	Expect(txnHistory).To(HaveLen(1))
+	txn := txnHistory[0]
+	Expect(txn.SeqNum).To(BeEquivalentTo(1))
+	Expect(txn.ResyncType).To(BeEquivalentTo(DownstreamResync))
+	Expect(txn.Description).To(Equal("replay"))
+	Expect(txn.Executed).To(HaveLen(2))
+
+	// close scheduler
+	err = scheduler.Close()
+	Expect(err).To(BeNil())
+}
+
+func TestResyncWithDescriptorsScope(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	// -> descriptor1:
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		WithMetadata:  true,
+	}, mockSB, 0)
+	// -> descriptor2 (registered later):
+	descriptor2 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor2Name,
+		NBKeyPrefix:   prefixB,
+		KeySelector:   prefixSelector(prefixB),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run resync transaction against empty SB, value of descriptor2 is not implemented yet
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("item1"))
+	schedulerTxn.SetValue(prefixB+baseValue2, test.NewArrayValue("item1"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(seqNum).To(BeEquivalentTo(0))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(1))
+	status := scheduler.GetValueStatus(prefixB + baseValue2)
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_UNIMPLEMENTED))
+	mockSB.PopHistoryOfOps()
+
+	// value of descriptor1 lost in SB is not restored by resync of descriptor2
+	mockSB.SetValue(prefixA+baseValue1, nil, nil, FromNB, false)
+
+	// register descriptor2 at run-time and resync only its values
+	scheduler.RegisterKVDescriptor(descriptor2)
+	Expect(scheduler.GetRegisteredNBKeyPrefixes()).To(ConsistOf(prefixA, prefixB))
+	schedulerTxn = scheduler.StartNBTransaction()
+	ctx := WithResyncDescriptors(WithResync(testCtx, DownstreamResync, true), descriptor2Name)
+	seqNum, err = schedulerTxn.Commit(ctx)
+	Expect(seqNum).To(BeEquivalentTo(1))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// check the state of SB
+	Expect(mockSB.GetValue(prefixA + baseValue1)).To(BeNil())
+	value := mockSB.GetValue(prefixB + baseValue2)
+	Expect(value).ToNot(BeNil())
+	Expect(proto.Equal(value.Value, test.NewArrayValue("item1"))).To(BeTrue())
+
+	// only descriptor2 was refreshed
+	opHistory := mockSB.PopHistoryOfOps()
+	Expect(opHistory).To(HaveLen(2))
+	operation := opHistory[0]
+	Expect(operation.OpType).To(Equal(test.MockRetrieve))
+	Expect(operation.Descriptor).To(BeEquivalentTo(descriptor2Name))
+	operation = opHistory[1]
+	Expect(operation.OpType).To(Equal(test.MockCreate))
+	Expect(operation.Key).To(BeEquivalentTo(prefixB + baseValue2))
+	Expect(operation.Err).To(BeNil())
+
+	// check value states
+	status = scheduler.GetValueStatus(prefixA + baseValue1)
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_CONFIGURED))
+	status = scheduler.GetValueStatus(prefixB + baseValue2)
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_CONFIGURED))
+
+	// close scheduler
+	err = scheduler.Close()
+	Expect(err).To(BeNil())
+}
+
 func TestResyncWithMultipleDescriptors(t *testing.T) {
 	RegisterTestingT(t)
 
@@ -101,7 +101,7 @@ func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess,
 				// refresh failed value and trigger reverting
 				// (not dry-run)
 				failedKey := utils.NewSingletonKeySet(kv.key)
-				s.refreshGraph(graphW, failedKey, nil, true)
+				s.refreshGraph(graphW, txn.seqNum, failedKey, nil, true)
 				revert = true
 				break
 			}
@@ -357,7 +357,9 @@ func (s *Scheduler) applyDelete(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 	handler := newDescriptorHandler(descriptor)
 	if !args.dryRun && descriptor != nil {
 		if args.kv.origin != kvs.FromSB {
+			done := s.executingOperation(&args.txn.seqNum, descriptor.Name, "Delete", node.GetKey())
 			err = handler.delete(node.GetKey(), node.GetValue(), node.GetMetadata())
+			done()
 		}
 		if err != nil {
 			retriableErr = handler.isRetriableFailure(err)
@@ -447,7 +449,9 @@ func (s *Scheduler) applyCreate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 		var metadata interface{}
 
 		if args.kv.origin != kvs.FromSB {
+			done := s.executingOperation(&args.txn.seqNum, descriptor.Name, "Create", node.GetKey())
 			metadata, err = handler.create(node.GetKey(), node.GetValue())
+			done()
 		} else {
 			// already created in SB
 			metadata = args.kv.metadata
@@ -606,7 +610,9 @@ func (s *Scheduler) applyUpdate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 
 		// call Update handler
 		if args.kv.origin != kvs.FromSB {
+			done := s.executingOperation(&args.txn.seqNum, descriptor.Name, "Update", node.GetKey())
 			newMetadata, err = handler.update(node.GetKey(), prevValue, node.GetValue(), node.GetMetadata())
+			done()
 		} else {
 			// already modified in SB
 			newMetadata = args.kv.metadata
@@ -949,3 +955,26 @@ func (s *Scheduler) validDerivedKV(graphR graph.ReadAccess, kv kvForTxn, txnSeqN
 	}
 	return true
 }
+
+// RegisterOperationTracer registers tracer notified about operations executed by descriptors.
+func (s *Scheduler) RegisterOperationTracer(tracer kvs.OperationTracer) {
+	s.txnLock.Lock()
+	defer s.txnLock.Unlock()
+	s.opTracer = tracer
+}
+
+// executingOperation notifies the registered tracer (if any) about the operation
+// being executed by a descriptor. The returned function should be called once
+// the operation has finished. Nil txnSeqNum denotes operation executed outside
+// of transaction. Must be called with txnLock held.
+func (s *Scheduler) executingOperation(txnSeqNum *uint64, descriptor, operation, key string) (done func()) {
+	if s.opTracer == nil {
+		return func() {}
+	}
+	return s.opTracer.StartOperation(kvs.ExecutingOperation{
+		TxnSeqNum:  txnSeqNum,
+		Descriptor: descriptor,
+		Operation:  operation,
+		Key:        key,
+	})
+}
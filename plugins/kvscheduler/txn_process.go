@@ -63,6 +63,9 @@ type nbTxn struct {
 	revertOnFailure bool
 	withSimulation  bool
 	description     string
+	origin          *kvs.TxnOrigin
+	lostValues      kvs.KeySelector // defined for downstream resync after loss of SB values
+	resyncScope     utils.KeySet    // descriptors to resync (nil for all)
 	resultChan      chan txnResult
 }
 
@@ -183,7 +186,8 @@ func (s *Scheduler) preProcessTransaction(txn *transaction) (skipExec, skipSimul
 	case kvs.NBTransaction:
 		skipExec = s.preProcessNBTransaction(txn)
 		skipSimulation = skipExec || !txn.nb.withSimulation
-		record = txn.nb.resyncType != kvs.DownstreamResync
+		record = txn.nb.resyncType != kvs.DownstreamResync || txn.nb.lostValues != nil ||
+			txn.nb.resyncScope != nil
 	case kvs.RetryFailedOps:
 		skipExec = s.preProcessRetryTxn(txn)
 		skipSimulation = skipExec
@@ -219,6 +223,9 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 		// for downstream resync it is assumed that scheduler is in-sync with NB
 		currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 		for _, node := range currentNodes {
+			if !s.inResyncScope(txn, node.GetKey()) {
+				continue
+			}
 			lastUpdate := getNodeLastUpdate(node)
 			txn.values = append(txn.values,
 				kvForTxn{
@@ -239,16 +246,18 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// unless this is only UpstreamResync, refresh the graph with the current
 	// state of SB
 	if txn.nb.resyncType != kvs.UpstreamResync {
-		s.refreshGraph(graphW, nil, &resyncData{
+		s.refreshGraph(graphW, txn.seqNum, nil, &resyncData{
 			first:  s.resyncCount == 1,
 			values: txn.values,
+			lost:   txn.nb.lostValues,
+			scope:  txn.nb.resyncScope,
 		}, txn.nb.verboseRefresh)
 	}
 
 	// collect deletes for obsolete values
 	currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 	for _, node := range currentNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || !s.inResyncScope(txn, node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
@@ -262,7 +271,7 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// update (record) SB values
 	sbNodes := graphW.GetNodes(nil, sbBaseValsSelectors()...)
 	for _, node := range sbNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || !s.inResyncScope(txn, node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
@@ -277,6 +286,16 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	return
 }
 
+// inResyncScope returns true if value with the given key belongs to descriptor
+// selected for resync.
+func (s *Scheduler) inResyncScope(txn *transaction, key string) bool {
+	if txn.nb.resyncScope == nil {
+		return true
+	}
+	descriptor := s.registry.GetDescriptorForKey(key)
+	return descriptor != nil && txn.nb.resyncScope.Has(descriptor.Name)
+}
+
 // preProcessRetryTxn filters out obsolete retry operations.
 func (s *Scheduler) preProcessRetryTxn(txn *transaction) (skip bool) {
 	graphR := s.graph.Read()
@@ -349,7 +368,7 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 		// changes brought by refresh triggered solely for the verification are
 		// not saved into the graph
 		graphW := s.graph.Write(afterErrRefresh, false)
-		s.refreshGraph(graphW, toRefresh, nil, afterErrRefresh)
+		s.refreshGraph(graphW, txn.seqNum, toRefresh, nil, afterErrRefresh)
 		s.scheduleRetries(txn, graphW, toRetry)
 
 		// if enabled, verify transaction effects
@@ -124,6 +124,7 @@ func (s *Scheduler) preRecordTransaction(txn *transaction, planned kvs.RecordedT
 	if txn.txnType == kvs.NBTransaction {
 		record.ResyncType = txn.nb.resyncType
 		record.Description = txn.nb.description
+		record.Origin = txn.nb.origin
 	}
 	if txn.txnType == kvs.RetryFailedOps {
 		record.RetryForTxn = txn.retry.txnSeqNum
@@ -191,6 +192,13 @@ func (s *Scheduler) recordTransaction(txn *transaction, txnRecord *kvs.RecordedT
 		fmt.Println(buf.String())
 	}
 
+	// append NB transaction into the audit log
+	if s.auditLog != nil && txn.txnType == kvs.NBTransaction {
+		if err := s.auditLog.write(txnRecord); err != nil {
+			s.Log.Errorf("failed to write transaction #%d into the audit log: %v", txnRecord.SeqNum, err)
+		}
+	}
+
 	// add transaction record into the history
 	if s.config.RecordTransactionHistory {
 		s.historyLock.Lock()
@@ -0,0 +1,233 @@
+// Code generated by adapter-generator. DO NOT EDIT.
+
+package adapter
+
+import (
+	"google.golang.org/protobuf/proto"
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+)
+
+////////// type-safe key-value pair with metadata //////////
+
+type SysctlKVWithMetadata struct {
+	Key      string
+	Value    *linux_sysctl.Sysctl
+	Metadata *linux_sysctl.SysctlMetadata
+	Origin   ValueOrigin
+}
+
+////////// type-safe Descriptor structure //////////
+
+type SysctlDescriptor struct {
+	Name                 string
+	KeySelector          KeySelector
+	ValueTypeName        string
+	KeyLabel             func(key string) string
+	ValueComparator      func(key string, oldValue, newValue *linux_sysctl.Sysctl) bool
+	NBKeyPrefix          string
+	WithMetadata         bool
+	MetadataMapFactory   MetadataMapFactory
+	Validate             func(key string, value *linux_sysctl.Sysctl) error
+	Create               func(key string, value *linux_sysctl.Sysctl) (metadata *linux_sysctl.SysctlMetadata, err error)
+	Delete               func(key string, value *linux_sysctl.Sysctl, metadata *linux_sysctl.SysctlMetadata) error
+	Update               func(key string, oldValue, newValue *linux_sysctl.Sysctl, oldMetadata *linux_sysctl.SysctlMetadata) (newMetadata *linux_sysctl.SysctlMetadata, err error)
+	UpdateWithRecreate   func(key string, oldValue, newValue *linux_sysctl.Sysctl, metadata *linux_sysctl.SysctlMetadata) bool
+	Retrieve             func(correlate []SysctlKVWithMetadata) ([]SysctlKVWithMetadata, error)
+	IsRetriableFailure   func(err error) bool
+	DerivedValues        func(key string, value *linux_sysctl.Sysctl) []KeyValuePair
+	Dependencies         func(key string, value *linux_sysctl.Sysctl) []Dependency
+	RetrieveDependencies []string /* descriptor name */
+}
+
+////////// Descriptor adapter //////////
+
+type SysctlDescriptorAdapter struct {
+	descriptor *SysctlDescriptor
+}
+
+func NewSysctlDescriptor(typedDescriptor *SysctlDescriptor) *KVDescriptor {
+	adapter := &SysctlDescriptorAdapter{descriptor: typedDescriptor}
+	descriptor := &KVDescriptor{
+		Name:                 typedDescriptor.Name,
+		KeySelector:          typedDescriptor.KeySelector,
+		ValueTypeName:        typedDescriptor.ValueTypeName,
+		KeyLabel:             typedDescriptor.KeyLabel,
+		NBKeyPrefix:          typedDescriptor.NBKeyPrefix,
+		WithMetadata:         typedDescriptor.WithMetadata,
+		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
+		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
+		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+	}
+	if typedDescriptor.ValueComparator != nil {
+		descriptor.ValueComparator = adapter.ValueComparator
+	}
+	if typedDescriptor.Validate != nil {
+		descriptor.Validate = adapter.Validate
+	}
+	if typedDescriptor.Create != nil {
+		descriptor.Create = adapter.Create
+	}
+	if typedDescriptor.Delete != nil {
+		descriptor.Delete = adapter.Delete
+	}
+	if typedDescriptor.Update != nil {
+		descriptor.Update = adapter.Update
+	}
+	if typedDescriptor.UpdateWithRecreate != nil {
+		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
+	}
+	if typedDescriptor.Retrieve != nil {
+		descriptor.Retrieve = adapter.Retrieve
+	}
+	if typedDescriptor.Dependencies != nil {
+		descriptor.Dependencies = adapter.Dependencies
+	}
+	if typedDescriptor.DerivedValues != nil {
+		descriptor.DerivedValues = adapter.DerivedValues
+	}
+	return descriptor
+}
+
+func (da *SysctlDescriptorAdapter) ValueComparator(key string, oldValue, newValue proto.Message) bool {
+	typedOldValue, err1 := castSysctlValue(key, oldValue)
+	typedNewValue, err2 := castSysctlValue(key, newValue)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return da.descriptor.ValueComparator(key, typedOldValue, typedNewValue)
+}
+
+func (da *SysctlDescriptorAdapter) Validate(key string, value proto.Message) (err error) {
+	typedValue, err := castSysctlValue(key, value)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Validate(key, typedValue)
+}
+
+func (da *SysctlDescriptorAdapter) Create(key string, value proto.Message) (metadata Metadata, err error) {
+	typedValue, err := castSysctlValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Create(key, typedValue)
+}
+
+func (da *SysctlDescriptorAdapter) Update(key string, oldValue, newValue proto.Message, oldMetadata Metadata) (newMetadata Metadata, err error) {
+	oldTypedValue, err := castSysctlValue(key, oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newTypedValue, err := castSysctlValue(key, newValue)
+	if err != nil {
+		return nil, err
+	}
+	typedOldMetadata, err := castSysctlMetadata(key, oldMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Update(key, oldTypedValue, newTypedValue, typedOldMetadata)
+}
+
+func (da *SysctlDescriptorAdapter) Delete(key string, value proto.Message, metadata Metadata) error {
+	typedValue, err := castSysctlValue(key, value)
+	if err != nil {
+		return err
+	}
+	typedMetadata, err := castSysctlMetadata(key, metadata)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Delete(key, typedValue, typedMetadata)
+}
+
+func (da *SysctlDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValue proto.Message, metadata Metadata) bool {
+	oldTypedValue, err := castSysctlValue(key, oldValue)
+	if err != nil {
+		return true
+	}
+	newTypedValue, err := castSysctlValue(key, newValue)
+	if err != nil {
+		return true
+	}
+	typedMetadata, err := castSysctlMetadata(key, metadata)
+	if err != nil {
+		return true
+	}
+	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
+}
+
+func (da *SysctlDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
+	var correlateWithType []SysctlKVWithMetadata
+	for _, kvpair := range correlate {
+		typedValue, err := castSysctlValue(kvpair.Key, kvpair.Value)
+		if err != nil {
+			continue
+		}
+		typedMetadata, err := castSysctlMetadata(kvpair.Key, kvpair.Metadata)
+		if err != nil {
+			continue
+		}
+		correlateWithType = append(correlateWithType,
+			SysctlKVWithMetadata{
+				Key:      kvpair.Key,
+				Value:    typedValue,
+				Metadata: typedMetadata,
+				Origin:   kvpair.Origin,
+			})
+	}
+
+	typedValues, err := da.descriptor.Retrieve(correlateWithType)
+	if err != nil {
+		return nil, err
+	}
+	var values []KVWithMetadata
+	for _, typedKVWithMetadata := range typedValues {
+		kvWithMetadata := KVWithMetadata{
+			Key:      typedKVWithMetadata.Key,
+			Metadata: typedKVWithMetadata.Metadata,
+			Origin:   typedKVWithMetadata.Origin,
+		}
+		kvWithMetadata.Value = typedKVWithMetadata.Value
+		values = append(values, kvWithMetadata)
+	}
+	return values, err
+}
+
+func (da *SysctlDescriptorAdapter) DerivedValues(key string, value proto.Message) []KeyValuePair {
+	typedValue, err := castSysctlValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.DerivedValues(key, typedValue)
+}
+
+func (da *SysctlDescriptorAdapter) Dependencies(key string, value proto.Message) []Dependency {
+	typedValue, err := castSysctlValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.Dependencies(key, typedValue)
+}
+
+////////// Helper methods //////////
+
+func castSysctlValue(key string, value proto.Message) (*linux_sysctl.Sysctl, error) {
+	typedValue, ok := value.(*linux_sysctl.Sysctl)
+	if !ok {
+		return nil, ErrInvalidValueType(key, value)
+	}
+	return typedValue, nil
+}
+
+func castSysctlMetadata(key string, metadata Metadata) (*linux_sysctl.SysctlMetadata, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+	typedMetadata, ok := metadata.(*linux_sysctl.SysctlMetadata)
+	if !ok {
+		return nil, ErrInvalidMetadataType(key)
+	}
+	return typedMetadata, nil
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+)
+
+const (
+	// SysctlDescriptorName is the name of the descriptor for Linux kernel parameters.
+	SysctlDescriptorName = "linux-sysctl"
+
+	// dependency labels
+	sysctlInterfaceDep = "interface-exists"
+	microserviceDep    = "microservice-available"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrSysctlWithoutPath is returned when kernel parameter is defined without path.
+	ErrSysctlWithoutPath = errors.New("Linux sysctl defined without path")
+
+	// ErrSysctlWithInvalidPath is returned when kernel parameter path contains
+	// empty or invalid elements.
+	ErrSysctlWithInvalidPath = errors.New("Linux sysctl defined with invalid path")
+
+	// ErrSysctlWithoutValue is returned when kernel parameter is defined without value.
+	ErrSysctlWithoutValue = errors.New("Linux sysctl defined without value")
+
+	// ErrInterfaceSysctlWithNamespace is returned when per-interface kernel parameter
+	// is defined with namespace.
+	ErrInterfaceSysctlWithNamespace = errors.New("Linux per-interface sysctl is always set in the namespace of the interface")
+)
+
+// SysctlDescriptor teaches KVScheduler how to set Linux kernel parameters.
+type SysctlDescriptor struct {
+	log           logging.Logger
+	sysctlHandler linuxcalls.SysctlAPI
+	ifPlugin      ifplugin.API
+	nsPlugin      nsplugin.API
+}
+
+// NewSysctlDescriptor creates a new instance of the Sysctl descriptor.
+func NewSysctlDescriptor(ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	sysctlHandler linuxcalls.SysctlAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &SysctlDescriptor{
+		sysctlHandler: sysctlHandler,
+		ifPlugin:      ifPlugin,
+		nsPlugin:      nsPlugin,
+		log:           log.NewLogger("sysctl-descriptor"),
+	}
+
+	typedDescr := &adapter.SysctlDescriptor{
+		Name:                 SysctlDescriptorName,
+		NBKeyPrefix:          linux_sysctl.ModelSysctl.KeyPrefix(),
+		ValueTypeName:        linux_sysctl.ModelSysctl.ProtoName(),
+		KeySelector:          linux_sysctl.ModelSysctl.IsKeyValid,
+		KeyLabel:             linux_sysctl.ModelSysctl.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentSysctls,
+		WithMetadata:         true,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewSysctlDescriptor(typedDescr)
+}
+
+// EquivalentSysctls compares values of two kernel parameter settings, ignoring
+// differences in the whitespace separating multiple values (the rest of the setting
+// is encoded in the key).
+func (d *SysctlDescriptor) EquivalentSysctls(key string, oldSysctl, newSysctl *linux_sysctl.Sysctl) bool {
+	return normalizeValue(oldSysctl.Value) == normalizeValue(newSysctl.Value)
+}
+
+// Validate validates kernel parameter setting.
+func (d *SysctlDescriptor) Validate(key string, sysctl *linux_sysctl.Sysctl) error {
+	if sysctl.Path == "" {
+		return kvs.NewInvalidValueError(ErrSysctlWithoutPath, "path")
+	}
+	elems := strings.Split(sysctl.Path, ".")
+	for _, elem := range elems {
+		if elem == "" || elem == ".." || strings.ContainsRune(elem, '/') {
+			return kvs.NewInvalidValueError(ErrSysctlWithInvalidPath, "path")
+		}
+	}
+	if normalizeValue(sysctl.Value) == "" {
+		return kvs.NewInvalidValueError(ErrSysctlWithoutValue, "value")
+	}
+	if sysctl.Interface != "" {
+		if sysctl.Namespace != nil && sysctl.Namespace.Type != linux_namespace.NetNamespace_UNDEFINED {
+			return kvs.NewInvalidValueError(ErrInterfaceSysctlWithNamespace, "namespace", "interface")
+		}
+		if len(elems) < 2 {
+			return kvs.NewInvalidValueError(ErrSysctlWithInvalidPath, "path", "interface")
+		}
+	}
+	return nil
+}
+
+// Create sets the kernel parameter and records its original value in the metadata.
+func (d *SysctlDescriptor) Create(key string, sysctl *linux_sysctl.Sysctl) (metadata *linux_sysctl.SysctlMetadata, err error) {
+	err = d.inNamespace(sysctl, func(path []string) error {
+		origValue, err := d.sysctlHandler.GetValue(path...)
+		if err != nil {
+			return err
+		}
+		metadata = &linux_sysctl.SysctlMetadata{OriginalValue: origValue}
+		return d.sysctlHandler.SetValue(sysctl.Value, path...)
+	})
+	if err != nil {
+		err = errors.Errorf("failed to set linux sysctl %s: %v", key, err)
+		d.log.Error(err)
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// Delete restores the original value of the kernel parameter.
+func (d *SysctlDescriptor) Delete(key string, sysctl *linux_sysctl.Sysctl, metadata *linux_sysctl.SysctlMetadata) error {
+	if metadata == nil {
+		// setting was retrieved after the agent restart, the original value is not known
+		d.log.Warnf("original value of linux sysctl %s is not known, leaving the current value", key)
+		return nil
+	}
+	err := d.inNamespace(sysctl, func(path []string) error {
+		return d.sysctlHandler.SetValue(metadata.OriginalValue, path...)
+	})
+	if err != nil {
+		err = errors.Errorf("failed to restore linux sysctl %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update changes the value of the kernel parameter, the original value is preserved.
+func (d *SysctlDescriptor) Update(key string, oldSysctl, newSysctl *linux_sysctl.Sysctl,
+	oldMetadata *linux_sysctl.SysctlMetadata) (newMetadata *linux_sysctl.SysctlMetadata, err error) {
+
+	err = d.inNamespace(newSysctl, func(path []string) error {
+		return d.sysctlHandler.SetValue(newSysctl.Value, path...)
+	})
+	if err != nil {
+		err = errors.Errorf("failed to update linux sysctl %s: %v", key, err)
+		d.log.Error(err)
+		return nil, err
+	}
+	return oldMetadata, nil
+}
+
+// Dependencies lists dependencies for a kernel parameter setting.
+func (d *SysctlDescriptor) Dependencies(key string, sysctl *linux_sysctl.Sysctl) (deps []kvs.Dependency) {
+	// the associated interface must exist
+	if sysctl.Interface != "" {
+		return []kvs.Dependency{{
+			Label: sysctlInterfaceDep,
+			Key:   ifmodel.InterfaceKey(sysctl.Interface),
+		}}
+	}
+
+	// microservice must be available
+	if sysctl.Namespace != nil && sysctl.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: microserviceDep,
+			Key:   linux_namespace.MicroserviceKey(sysctl.Namespace.Reference),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns current values of kernel parameters configured by the agent.
+func (d *SysctlDescriptor) Retrieve(correlate []adapter.SysctlKVWithMetadata) ([]adapter.SysctlKVWithMetadata, error) {
+	var values []adapter.SysctlKVWithMetadata
+
+	for _, kv := range correlate {
+		var value string
+		err := d.inNamespace(kv.Value, func(path []string) (err error) {
+			value, err = d.sysctlHandler.GetValue(path...)
+			return err
+		})
+		if err != nil {
+			d.log.Warnf("failed to retrieve linux sysctl %s: %v", kv.Key, err)
+			continue
+		}
+		sysctl := proto.Clone(kv.Value).(*linux_sysctl.Sysctl)
+		sysctl.Value = value
+		values = append(values, adapter.SysctlKVWithMetadata{
+			Key:      kv.Key,
+			Value:    sysctl,
+			Metadata: kv.Metadata,
+			Origin:   kvs.FromNB,
+		})
+	}
+
+	return values, nil
+}
+
+// inNamespace switches to the namespace of the kernel parameter and runs the given
+// action with the resolved parameter path.
+func (d *SysctlDescriptor) inNamespace(sysctl *linux_sysctl.Sysctl, action func(path []string) error) error {
+	namespace := sysctl.Namespace
+	path := strings.Split(sysctl.Path, ".")
+
+	if sysctl.Interface != "" {
+		ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(sysctl.Interface)
+		if !found || ifMeta == nil {
+			return errors.Errorf("failed to obtain metadata for interface %s", sysctl.Interface)
+		}
+		namespace = ifMeta.Namespace
+		last := len(path) - 1
+		path = append(path[:last:last], ifMeta.HostIfName, path[last])
+	}
+
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, namespace)
+	if err != nil {
+		return errors.Errorf("failed to switch namespace: %v", err)
+	}
+	defer revertNs()
+
+	return action(path)
+}
+
+// normalizeValue separates multiple values of the kernel parameter with a single space.
+func normalizeValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
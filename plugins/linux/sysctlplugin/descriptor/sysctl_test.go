@@ -0,0 +1,288 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+)
+
+var testIfNamespace = &linux_namespace.NetNamespace{
+	Type:      linux_namespace.NetNamespace_FD,
+	Reference: "/proc/1/ns/net",
+}
+
+// mockSysctlHandler simulates kernel parameters, the values are recorded
+// together with the namespace they were accessed in.
+type mockSysctlHandler struct {
+	nsPlugin *mockNsPlugin
+	values   map[string]string
+}
+
+func (h *mockSysctlHandler) paramKey(path []string) string {
+	return h.nsPlugin.current.GetReference() + ":" + strings.Join(path, "/")
+}
+
+func (h *mockSysctlHandler) GetValue(path ...string) (string, error) {
+	value, ok := h.values[h.paramKey(path)]
+	if !ok {
+		return "", errors.Errorf("kernel parameter %s does not exist", strings.Join(path, "."))
+	}
+	return value, nil
+}
+
+func (h *mockSysctlHandler) SetValue(value string, path ...string) error {
+	if _, ok := h.values[h.paramKey(path)]; !ok {
+		return errors.Errorf("kernel parameter %s does not exist", strings.Join(path, "."))
+	}
+	h.values[h.paramKey(path)] = value
+	return nil
+}
+
+// mockIfPlugin provides index of Linux interfaces.
+type mockIfPlugin struct {
+	index ifaceidx.LinuxIfMetadataIndexRW
+}
+
+func newMockIfPlugin() *mockIfPlugin {
+	index := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-log"), "test-ifindex")
+	index.Put("myif", &ifaceidx.LinuxIfMetadata{
+		LinuxIfIndex: 5,
+		HostIfName:   "eth0",
+		Namespace:    testIfNamespace,
+	})
+	return &mockIfPlugin{index: index}
+}
+
+func (p *mockIfPlugin) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return p.index
+}
+
+func (p *mockIfPlugin) SetNotifyService(notify func(notification *linux.Notification)) {}
+
+// mockNsPlugin tracks the namespace switched into.
+type mockNsPlugin struct {
+	nsplugin.API
+	current *linux_namespace.NetNamespace
+}
+
+func (p *mockNsPlugin) SwitchToNamespace(ctx nslinuxcalls.NamespaceMgmtCtx, ns *linux_namespace.NetNamespace) (revert func(), err error) {
+	orig := p.current
+	p.current = ns
+	return func() { p.current = orig }, nil
+}
+
+func newTestSysctlDescriptor() (*SysctlDescriptor, *mockSysctlHandler) {
+	nsPlugin := &mockNsPlugin{}
+	handler := &mockSysctlHandler{
+		nsPlugin: nsPlugin,
+		values: map[string]string{
+			":net/ipv4/ip_forward":                        "0",
+			":net/ipv4/conf/eth0/rp_filter":               "2",
+			"/proc/1/ns/net:net/ipv4/conf/eth0/rp_filter": "1",
+		},
+	}
+	d := &SysctlDescriptor{
+		log:           logrus.NewLogger("test-log"),
+		sysctlHandler: handler,
+		ifPlugin:      newMockIfPlugin(),
+		nsPlugin:      nsPlugin,
+	}
+	return d, handler
+}
+
+func TestSysctlValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &SysctlDescriptor{}
+	tests := []struct {
+		name   string
+		sysctl *linux_sysctl.Sysctl
+		err    error
+	}{
+		{
+			name:   "valid",
+			sysctl: &linux_sysctl.Sysctl{Path: "net.ipv4.ip_forward", Value: "1"},
+		},
+		{
+			name:   "valid per-interface",
+			sysctl: &linux_sysctl.Sysctl{Interface: "myif", Path: "net.ipv4.conf.rp_filter", Value: "1"},
+		},
+		{
+			name: "valid per-interface with undefined namespace",
+			sysctl: &linux_sysctl.Sysctl{Interface: "myif", Path: "net.ipv4.conf.rp_filter", Value: "1",
+				Namespace: &linux_namespace.NetNamespace{}},
+		},
+		{
+			name:   "missing path",
+			sysctl: &linux_sysctl.Sysctl{Value: "1"},
+			err:    ErrSysctlWithoutPath,
+		},
+		{
+			name:   "empty path element",
+			sysctl: &linux_sysctl.Sysctl{Path: "net..ip_forward", Value: "1"},
+			err:    ErrSysctlWithInvalidPath,
+		},
+		{
+			name:   "path traversal",
+			sysctl: &linux_sysctl.Sysctl{Path: "../../etc/passwd", Value: "1"},
+			err:    ErrSysctlWithInvalidPath,
+		},
+		{
+			name:   "slash in path element",
+			sysctl: &linux_sysctl.Sysctl{Path: "net/ipv4.ip_forward", Value: "1"},
+			err:    ErrSysctlWithInvalidPath,
+		},
+		{
+			name:   "missing value",
+			sysctl: &linux_sysctl.Sysctl{Path: "net.ipv4.ip_forward", Value: " \t"},
+			err:    ErrSysctlWithoutValue,
+		},
+		{
+			name: "per-interface with namespace",
+			sysctl: &linux_sysctl.Sysctl{Interface: "myif", Path: "net.ipv4.conf.rp_filter", Value: "1",
+				Namespace: testIfNamespace},
+			err: ErrInterfaceSysctlWithNamespace,
+		},
+		{
+			name:   "per-interface with too short path",
+			sysctl: &linux_sysctl.Sysctl{Interface: "myif", Path: "rp_filter", Value: "1"},
+			err:    ErrSysctlWithInvalidPath,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.sysctl)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.err))
+		})
+	}
+}
+
+func TestSysctlCreateDelete(t *testing.T) {
+	RegisterTestingT(t)
+
+	d, handler := newTestSysctlDescriptor()
+	sysctl := &linux_sysctl.Sysctl{Path: "net.ipv4.ip_forward", Value: "1"}
+	key := linux_sysctl.SysctlKey(nil, sysctl.Path)
+
+	// the original value is recorded
+	metadata, err := d.Create(key, sysctl)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&linux_sysctl.SysctlMetadata{OriginalValue: "0"}))
+	Expect(handler.values).To(HaveKeyWithValue(":net/ipv4/ip_forward", "1"))
+
+	// the original value is preserved by update
+	newSysctl := &linux_sysctl.Sysctl{Path: "net.ipv4.ip_forward", Value: "2"}
+	metadata, err = d.Update(key, sysctl, newSysctl, metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&linux_sysctl.SysctlMetadata{OriginalValue: "0"}))
+	Expect(handler.values).To(HaveKeyWithValue(":net/ipv4/ip_forward", "2"))
+
+	// the original value is restored
+	Expect(d.Delete(key, newSysctl, metadata)).To(Succeed())
+	Expect(handler.values).To(HaveKeyWithValue(":net/ipv4/ip_forward", "0"))
+
+	// the original value is not known - the current value is left
+	handler.values[":net/ipv4/ip_forward"] = "1"
+	Expect(d.Delete(key, sysctl, nil)).To(Succeed())
+	Expect(handler.values).To(HaveKeyWithValue(":net/ipv4/ip_forward", "1"))
+
+	// non-existent parameter
+	_, err = d.Create(key, &linux_sysctl.Sysctl{Path: "net.ipv4.unknown", Value: "1"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestInterfaceSysctl(t *testing.T) {
+	RegisterTestingT(t)
+
+	d, handler := newTestSysctlDescriptor()
+	sysctl := &linux_sysctl.Sysctl{Interface: "myif", Path: "net.ipv4.conf.rp_filter", Value: "0"}
+	key := linux_sysctl.InterfaceSysctlKey(sysctl.Interface, sysctl.Path)
+
+	// host name of the interface is inserted before the last path element
+	// and the parameter is set in the namespace of the interface
+	metadata, err := d.Create(key, sysctl)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&linux_sysctl.SysctlMetadata{OriginalValue: "1"}))
+	Expect(handler.values).To(HaveKeyWithValue("/proc/1/ns/net:net/ipv4/conf/eth0/rp_filter", "0"))
+	Expect(handler.values).To(HaveKeyWithValue(":net/ipv4/conf/eth0/rp_filter", "2"))
+	Expect(d.nsPlugin.(*mockNsPlugin).current).To(BeNil())
+
+	// the path of the value is not modified
+	Expect(sysctl.Path).To(Equal("net.ipv4.conf.rp_filter"))
+
+	Expect(d.Delete(key, sysctl, metadata)).To(Succeed())
+	Expect(handler.values).To(HaveKeyWithValue("/proc/1/ns/net:net/ipv4/conf/eth0/rp_filter", "1"))
+
+	// unknown interface
+	_, err = d.Create(key, &linux_sysctl.Sysctl{Interface: "unknown", Path: "net.ipv4.conf.rp_filter", Value: "0"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestSysctlRetrieve(t *testing.T) {
+	RegisterTestingT(t)
+
+	d, _ := newTestSysctlDescriptor()
+	ifSysctl := &linux_sysctl.Sysctl{Interface: "myif", Path: "net.ipv4.conf.rp_filter", Value: "0"}
+	correlate := []adapter.SysctlKVWithMetadata{
+		{
+			Key:      linux_sysctl.SysctlKey(nil, "net.ipv4.ip_forward"),
+			Value:    &linux_sysctl.Sysctl{Path: "net.ipv4.ip_forward", Value: "1"},
+			Metadata: &linux_sysctl.SysctlMetadata{OriginalValue: "0"},
+		},
+		{
+			Key:   linux_sysctl.InterfaceSysctlKey(ifSysctl.Interface, ifSysctl.Path),
+			Value: ifSysctl,
+		},
+		{
+			// cannot be read - skipped
+			Key:   linux_sysctl.SysctlKey(nil, "net.ipv4.unknown"),
+			Value: &linux_sysctl.Sysctl{Path: "net.ipv4.unknown", Value: "1"},
+		},
+	}
+
+	values, err := d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(values).To(HaveLen(2))
+	Expect(values[0].Key).To(Equal(correlate[0].Key))
+	Expect(values[0].Value.Value).To(Equal("0"))
+	Expect(values[0].Metadata).To(Equal(correlate[0].Metadata))
+	Expect(values[0].Origin).To(Equal(kvs.FromNB))
+	Expect(values[1].Key).To(Equal(correlate[1].Key))
+	Expect(values[1].Value.Interface).To(Equal("myif"))
+	Expect(values[1].Value.Value).To(Equal("1"))
+
+	// correlated values are not modified
+	Expect(correlate[0].Value.Value).To(Equal("1"))
+	Expect(ifSysctl.Value).To(Equal("0"))
+}
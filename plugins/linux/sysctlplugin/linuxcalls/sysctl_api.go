@@ -0,0 +1,39 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+// SysctlAPI interface covers methods needed to read and write kernel parameters
+// exposed under /proc/sys. Network-related parameters are evaluated
+// in the network namespace of the calling thread.
+type SysctlAPI interface {
+	// GetValue returns the value of the kernel parameter with the given path
+	// (path elements are given separately, e.g. "net", "ipv4", "ip_forward").
+	GetValue(path ...string) (value string, err error)
+
+	// SetValue writes the value of the kernel parameter with the given path.
+	SetValue(value string, path ...string) error
+}
+
+// SysctlHandler is accessing kernel parameters via the proc filesystem.
+type SysctlHandler struct {
+	procSysDir string
+}
+
+// NewSysctlHandler creates new instance of sysctl handler.
+func NewSysctlHandler() *SysctlHandler {
+	return &SysctlHandler{
+		procSysDir: procSysDir,
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const procSysDir = "/proc/sys"
+
+// GetValue returns the value of the kernel parameter with the given path.
+func (h *SysctlHandler) GetValue(path ...string) (value string, err error) {
+	data, err := os.ReadFile(h.paramFile(path))
+	if err != nil {
+		return "", errors.Errorf("failed to read kernel parameter %s: %v", strings.Join(path, "."), err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetValue writes the value of the kernel parameter with the given path.
+func (h *SysctlHandler) SetValue(value string, path ...string) error {
+	if err := os.WriteFile(h.paramFile(path), []byte(value), 0644); err != nil {
+		return errors.Errorf("failed to write kernel parameter %s: %v", strings.Join(path, "."), err)
+	}
+	return nil
+}
+
+// paramFile returns file representing the kernel parameter in the proc filesystem.
+func (h *SysctlHandler) paramFile(path []string) string {
+	return filepath.Join(append([]string{h.procSysDir}, path...)...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetSetValue(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	Expect(os.MkdirAll(filepath.Join(dir, "net", "ipv4"), 0755)).To(Succeed())
+	paramFile := filepath.Join(dir, "net", "ipv4", "ip_forward")
+	Expect(os.WriteFile(paramFile, []byte("0\n"), 0644)).To(Succeed())
+	h := &SysctlHandler{procSysDir: dir}
+
+	value, err := h.GetValue("net", "ipv4", "ip_forward")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value).To(Equal("0"))
+
+	Expect(h.SetValue("1", "net", "ipv4", "ip_forward")).To(Succeed())
+	data, err := os.ReadFile(paramFile)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(Equal("1"))
+
+	value, err = h.GetValue("net", "ipv4", "ip_forward")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(value).To(Equal("1"))
+}
+
+func TestGetValueMissing(t *testing.T) {
+	RegisterTestingT(t)
+
+	h := &SysctlHandler{procSysDir: t.TempDir()}
+	_, err := h.GetValue("net", "ipv4", "ip_forward")
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("net.ipv4.ip_forward"))
+}
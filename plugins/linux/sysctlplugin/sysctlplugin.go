@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Sysctl --value-type *linux_sysctl.Sysctl --meta-type *linux_sysctl.SysctlMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl" --output-dir "descriptor"
+
+package sysctlplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/linuxcalls"
+)
+
+// SysctlPlugin sets Linux kernel parameters (sysctl) in network namespaces
+// and for interfaces managed by the agent.
+type SysctlPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	sysctlHandler linuxcalls.SysctlAPI
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+}
+
+// Config holds the sysctlplugin configuration.
+type Config struct {
+	Disabled bool `json:"disabled"`
+}
+
+// Init initializes and registers descriptor for Linux kernel parameters.
+func (p *SysctlPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux sysctl plugin config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling Linux sysctl plugin")
+		return nil
+	}
+
+	// init handlers
+	p.sysctlHandler = linuxcalls.NewSysctlHandler()
+
+	// init & register descriptor
+	sysctlDescriptor := descriptor.NewSysctlDescriptor(p.IfPlugin, p.NsPlugin, p.sysctlHandler, p.Log)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(sysctlDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close does nothing here.
+func (p *SysctlPlugin) Close() error {
+	return nil
+}
+
+// retrieveConfig loads SysctlPlugin configuration file.
+func (p *SysctlPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux SysctlPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, err
+}
@@ -0,0 +1,233 @@
+// Code generated by adapter-generator. DO NOT EDIT.
+
+package adapter
+
+import (
+	"google.golang.org/protobuf/proto"
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+////////// type-safe key-value pair with metadata //////////
+
+type ClassKVWithMetadata struct {
+	Key      string
+	Value    *linux_tc.Class
+	Metadata interface{}
+	Origin   ValueOrigin
+}
+
+////////// type-safe Descriptor structure //////////
+
+type ClassDescriptor struct {
+	Name                 string
+	KeySelector          KeySelector
+	ValueTypeName        string
+	KeyLabel             func(key string) string
+	ValueComparator      func(key string, oldValue, newValue *linux_tc.Class) bool
+	NBKeyPrefix          string
+	WithMetadata         bool
+	MetadataMapFactory   MetadataMapFactory
+	Validate             func(key string, value *linux_tc.Class) error
+	Create               func(key string, value *linux_tc.Class) (metadata interface{}, err error)
+	Delete               func(key string, value *linux_tc.Class, metadata interface{}) error
+	Update               func(key string, oldValue, newValue *linux_tc.Class, oldMetadata interface{}) (newMetadata interface{}, err error)
+	UpdateWithRecreate   func(key string, oldValue, newValue *linux_tc.Class, metadata interface{}) bool
+	Retrieve             func(correlate []ClassKVWithMetadata) ([]ClassKVWithMetadata, error)
+	IsRetriableFailure   func(err error) bool
+	DerivedValues        func(key string, value *linux_tc.Class) []KeyValuePair
+	Dependencies         func(key string, value *linux_tc.Class) []Dependency
+	RetrieveDependencies []string /* descriptor name */
+}
+
+////////// Descriptor adapter //////////
+
+type ClassDescriptorAdapter struct {
+	descriptor *ClassDescriptor
+}
+
+func NewClassDescriptor(typedDescriptor *ClassDescriptor) *KVDescriptor {
+	adapter := &ClassDescriptorAdapter{descriptor: typedDescriptor}
+	descriptor := &KVDescriptor{
+		Name:                 typedDescriptor.Name,
+		KeySelector:          typedDescriptor.KeySelector,
+		ValueTypeName:        typedDescriptor.ValueTypeName,
+		KeyLabel:             typedDescriptor.KeyLabel,
+		NBKeyPrefix:          typedDescriptor.NBKeyPrefix,
+		WithMetadata:         typedDescriptor.WithMetadata,
+		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
+		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
+		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+	}
+	if typedDescriptor.ValueComparator != nil {
+		descriptor.ValueComparator = adapter.ValueComparator
+	}
+	if typedDescriptor.Validate != nil {
+		descriptor.Validate = adapter.Validate
+	}
+	if typedDescriptor.Create != nil {
+		descriptor.Create = adapter.Create
+	}
+	if typedDescriptor.Delete != nil {
+		descriptor.Delete = adapter.Delete
+	}
+	if typedDescriptor.Update != nil {
+		descriptor.Update = adapter.Update
+	}
+	if typedDescriptor.UpdateWithRecreate != nil {
+		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
+	}
+	if typedDescriptor.Retrieve != nil {
+		descriptor.Retrieve = adapter.Retrieve
+	}
+	if typedDescriptor.Dependencies != nil {
+		descriptor.Dependencies = adapter.Dependencies
+	}
+	if typedDescriptor.DerivedValues != nil {
+		descriptor.DerivedValues = adapter.DerivedValues
+	}
+	return descriptor
+}
+
+func (da *ClassDescriptorAdapter) ValueComparator(key string, oldValue, newValue proto.Message) bool {
+	typedOldValue, err1 := castClassValue(key, oldValue)
+	typedNewValue, err2 := castClassValue(key, newValue)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return da.descriptor.ValueComparator(key, typedOldValue, typedNewValue)
+}
+
+func (da *ClassDescriptorAdapter) Validate(key string, value proto.Message) (err error) {
+	typedValue, err := castClassValue(key, value)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Validate(key, typedValue)
+}
+
+func (da *ClassDescriptorAdapter) Create(key string, value proto.Message) (metadata Metadata, err error) {
+	typedValue, err := castClassValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Create(key, typedValue)
+}
+
+func (da *ClassDescriptorAdapter) Update(key string, oldValue, newValue proto.Message, oldMetadata Metadata) (newMetadata Metadata, err error) {
+	oldTypedValue, err := castClassValue(key, oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newTypedValue, err := castClassValue(key, newValue)
+	if err != nil {
+		return nil, err
+	}
+	typedOldMetadata, err := castClassMetadata(key, oldMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Update(key, oldTypedValue, newTypedValue, typedOldMetadata)
+}
+
+func (da *ClassDescriptorAdapter) Delete(key string, value proto.Message, metadata Metadata) error {
+	typedValue, err := castClassValue(key, value)
+	if err != nil {
+		return err
+	}
+	typedMetadata, err := castClassMetadata(key, metadata)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Delete(key, typedValue, typedMetadata)
+}
+
+func (da *ClassDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValue proto.Message, metadata Metadata) bool {
+	oldTypedValue, err := castClassValue(key, oldValue)
+	if err != nil {
+		return true
+	}
+	newTypedValue, err := castClassValue(key, newValue)
+	if err != nil {
+		return true
+	}
+	typedMetadata, err := castClassMetadata(key, metadata)
+	if err != nil {
+		return true
+	}
+	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
+}
+
+func (da *ClassDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
+	var correlateWithType []ClassKVWithMetadata
+	for _, kvpair := range correlate {
+		typedValue, err := castClassValue(kvpair.Key, kvpair.Value)
+		if err != nil {
+			continue
+		}
+		typedMetadata, err := castClassMetadata(kvpair.Key, kvpair.Metadata)
+		if err != nil {
+			continue
+		}
+		correlateWithType = append(correlateWithType,
+			ClassKVWithMetadata{
+				Key:      kvpair.Key,
+				Value:    typedValue,
+				Metadata: typedMetadata,
+				Origin:   kvpair.Origin,
+			})
+	}
+
+	typedValues, err := da.descriptor.Retrieve(correlateWithType)
+	if err != nil {
+		return nil, err
+	}
+	var values []KVWithMetadata
+	for _, typedKVWithMetadata := range typedValues {
+		kvWithMetadata := KVWithMetadata{
+			Key:      typedKVWithMetadata.Key,
+			Metadata: typedKVWithMetadata.Metadata,
+			Origin:   typedKVWithMetadata.Origin,
+		}
+		kvWithMetadata.Value = typedKVWithMetadata.Value
+		values = append(values, kvWithMetadata)
+	}
+	return values, err
+}
+
+func (da *ClassDescriptorAdapter) DerivedValues(key string, value proto.Message) []KeyValuePair {
+	typedValue, err := castClassValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.DerivedValues(key, typedValue)
+}
+
+func (da *ClassDescriptorAdapter) Dependencies(key string, value proto.Message) []Dependency {
+	typedValue, err := castClassValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.Dependencies(key, typedValue)
+}
+
+////////// Helper methods //////////
+
+func castClassValue(key string, value proto.Message) (*linux_tc.Class, error) {
+	typedValue, ok := value.(*linux_tc.Class)
+	if !ok {
+		return nil, ErrInvalidValueType(key, value)
+	}
+	return typedValue, nil
+}
+
+func castClassMetadata(key string, metadata Metadata) (interface{}, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+	typedMetadata, ok := metadata.(interface{})
+	if !ok {
+		return nil, ErrInvalidMetadataType(key)
+	}
+	return typedMetadata, nil
+}
@@ -0,0 +1,233 @@
+// Code generated by adapter-generator. DO NOT EDIT.
+
+package adapter
+
+import (
+	"google.golang.org/protobuf/proto"
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+////////// type-safe key-value pair with metadata //////////
+
+type FilterKVWithMetadata struct {
+	Key      string
+	Value    *linux_tc.Filter
+	Metadata interface{}
+	Origin   ValueOrigin
+}
+
+////////// type-safe Descriptor structure //////////
+
+type FilterDescriptor struct {
+	Name                 string
+	KeySelector          KeySelector
+	ValueTypeName        string
+	KeyLabel             func(key string) string
+	ValueComparator      func(key string, oldValue, newValue *linux_tc.Filter) bool
+	NBKeyPrefix          string
+	WithMetadata         bool
+	MetadataMapFactory   MetadataMapFactory
+	Validate             func(key string, value *linux_tc.Filter) error
+	Create               func(key string, value *linux_tc.Filter) (metadata interface{}, err error)
+	Delete               func(key string, value *linux_tc.Filter, metadata interface{}) error
+	Update               func(key string, oldValue, newValue *linux_tc.Filter, oldMetadata interface{}) (newMetadata interface{}, err error)
+	UpdateWithRecreate   func(key string, oldValue, newValue *linux_tc.Filter, metadata interface{}) bool
+	Retrieve             func(correlate []FilterKVWithMetadata) ([]FilterKVWithMetadata, error)
+	IsRetriableFailure   func(err error) bool
+	DerivedValues        func(key string, value *linux_tc.Filter) []KeyValuePair
+	Dependencies         func(key string, value *linux_tc.Filter) []Dependency
+	RetrieveDependencies []string /* descriptor name */
+}
+
+////////// Descriptor adapter //////////
+
+type FilterDescriptorAdapter struct {
+	descriptor *FilterDescriptor
+}
+
+func NewFilterDescriptor(typedDescriptor *FilterDescriptor) *KVDescriptor {
+	adapter := &FilterDescriptorAdapter{descriptor: typedDescriptor}
+	descriptor := &KVDescriptor{
+		Name:                 typedDescriptor.Name,
+		KeySelector:          typedDescriptor.KeySelector,
+		ValueTypeName:        typedDescriptor.ValueTypeName,
+		KeyLabel:             typedDescriptor.KeyLabel,
+		NBKeyPrefix:          typedDescriptor.NBKeyPrefix,
+		WithMetadata:         typedDescriptor.WithMetadata,
+		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
+		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
+		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+	}
+	if typedDescriptor.ValueComparator != nil {
+		descriptor.ValueComparator = adapter.ValueComparator
+	}
+	if typedDescriptor.Validate != nil {
+		descriptor.Validate = adapter.Validate
+	}
+	if typedDescriptor.Create != nil {
+		descriptor.Create = adapter.Create
+	}
+	if typedDescriptor.Delete != nil {
+		descriptor.Delete = adapter.Delete
+	}
+	if typedDescriptor.Update != nil {
+		descriptor.Update = adapter.Update
+	}
+	if typedDescriptor.UpdateWithRecreate != nil {
+		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
+	}
+	if typedDescriptor.Retrieve != nil {
+		descriptor.Retrieve = adapter.Retrieve
+	}
+	if typedDescriptor.Dependencies != nil {
+		descriptor.Dependencies = adapter.Dependencies
+	}
+	if typedDescriptor.DerivedValues != nil {
+		descriptor.DerivedValues = adapter.DerivedValues
+	}
+	return descriptor
+}
+
+func (da *FilterDescriptorAdapter) ValueComparator(key string, oldValue, newValue proto.Message) bool {
+	typedOldValue, err1 := castFilterValue(key, oldValue)
+	typedNewValue, err2 := castFilterValue(key, newValue)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return da.descriptor.ValueComparator(key, typedOldValue, typedNewValue)
+}
+
+func (da *FilterDescriptorAdapter) Validate(key string, value proto.Message) (err error) {
+	typedValue, err := castFilterValue(key, value)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Validate(key, typedValue)
+}
+
+func (da *FilterDescriptorAdapter) Create(key string, value proto.Message) (metadata Metadata, err error) {
+	typedValue, err := castFilterValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Create(key, typedValue)
+}
+
+func (da *FilterDescriptorAdapter) Update(key string, oldValue, newValue proto.Message, oldMetadata Metadata) (newMetadata Metadata, err error) {
+	oldTypedValue, err := castFilterValue(key, oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newTypedValue, err := castFilterValue(key, newValue)
+	if err != nil {
+		return nil, err
+	}
+	typedOldMetadata, err := castFilterMetadata(key, oldMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return da.descriptor.Update(key, oldTypedValue, newTypedValue, typedOldMetadata)
+}
+
+func (da *FilterDescriptorAdapter) Delete(key string, value proto.Message, metadata Metadata) error {
+	typedValue, err := castFilterValue(key, value)
+	if err != nil {
+		return err
+	}
+	typedMetadata, err := castFilterMetadata(key, metadata)
+	if err != nil {
+		return err
+	}
+	return da.descriptor.Delete(key, typedValue, typedMetadata)
+}
+
+func (da *FilterDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValue proto.Message, metadata Metadata) bool {
+	oldTypedValue, err := castFilterValue(key, oldValue)
+	if err != nil {
+		return true
+	}
+	newTypedValue, err := castFilterValue(key, newValue)
+	if err != nil {
+		return true
+	}
+	typedMetadata, err := castFilterMetadata(key, metadata)
+	if err != nil {
+		return true
+	}
+	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
+}
+
+func (da *FilterDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
+	var correlateWithType []FilterKVWithMetadata
+	for _, kvpair := range correlate {
+		typedValue, err := castFilterValue(kvpair.Key, kvpair.Value)
+		if err != nil {
+			continue
+		}
+		typedMetadata, err := castFilterMetadata(kvpair.Key, kvpair.Metadata)
+		if err != nil {
+			continue
+		}
+		correlateWithType = append(correlateWithType,
+			FilterKVWithMetadata{
+				Key:      kvpair.Key,
+				Value:    typedValue,
+				Metadata: typedMetadata,
+				Origin:   kvpair.Origin,
+			})
+	}
+
+	typedValues, err := da.descriptor.Retrieve(correlateWithType)
+	if err != nil {
+		return nil, err
+	}
+	var values []KVWithMetadata
+	for _, typedKVWithMetadata := range typedValues {
+		kvWithMetadata := KVWithMetadata{
+			Key:      typedKVWithMetadata.Key,
+			Metadata: typedKVWithMetadata.Metadata,
+			Origin:   typedKVWithMetadata.Origin,
+		}
+		kvWithMetadata.Value = typedKVWithMetadata.Value
+		values = append(values, kvWithMetadata)
+	}
+	return values, err
+}
+
+func (da *FilterDescriptorAdapter) DerivedValues(key string, value proto.Message) []KeyValuePair {
+	typedValue, err := castFilterValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.DerivedValues(key, typedValue)
+}
+
+func (da *FilterDescriptorAdapter) Dependencies(key string, value proto.Message) []Dependency {
+	typedValue, err := castFilterValue(key, value)
+	if err != nil {
+		return nil
+	}
+	return da.descriptor.Dependencies(key, typedValue)
+}
+
+////////// Helper methods //////////
+
+func castFilterValue(key string, value proto.Message) (*linux_tc.Filter, error) {
+	typedValue, ok := value.(*linux_tc.Filter)
+	if !ok {
+		return nil, ErrInvalidValueType(key, value)
+	}
+	return typedValue, nil
+}
+
+func castFilterMetadata(key string, metadata Metadata) (interface{}, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+	typedMetadata, ok := metadata.(interface{})
+	if !ok {
+		return nil, ErrInvalidMetadataType(key)
+	}
+	return typedMetadata, nil
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	tclinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// ClassDescriptorName is the name of the descriptor for Linux traffic classes.
+	ClassDescriptorName = "linux-tc-class"
+
+	// dependency labels
+	classQdiscDep       = "qdisc-exists"
+	classParentClassDep = "parent-class-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassWithoutQdisc is returned when traffic class configuration is missing
+	// qdisc reference.
+	ErrClassWithoutQdisc = errors.New("Linux traffic class defined without qdisc reference")
+
+	// ErrClassWithInvalidID is returned when traffic class ID is zero or out of range.
+	ErrClassWithInvalidID = errors.New("Linux traffic class defined with invalid class ID")
+
+	// ErrClassWithInvalidParent is returned when traffic class refers to itself
+	// as the parent or the parent ID is out of range.
+	ErrClassWithInvalidParent = errors.New("Linux traffic class defined with invalid parent class")
+
+	// ErrClassWithoutType is returned when traffic class is missing class type.
+	ErrClassWithoutType = errors.New("Linux traffic class defined without class type")
+
+	// ErrHtbClassWithoutRate is returned when HTB class is missing rate.
+	ErrHtbClassWithoutRate = errors.New("Linux HTB class defined without rate")
+)
+
+// ClassDescriptor teaches KVScheduler how to configure Linux traffic classes.
+type ClassDescriptor struct {
+	log       logging.Logger
+	tcHandler tclinuxcalls.NetlinkAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewClassDescriptor creates a new instance of the Class descriptor.
+func NewClassDescriptor(ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	tcHandler tclinuxcalls.NetlinkAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("class-descriptor"),
+	}
+
+	typedDescr := &adapter.ClassDescriptor{
+		Name:                 ClassDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelClass.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelClass.ProtoName(),
+		KeySelector:          linux_tc.ModelClass.IsKeyValid,
+		KeyLabel:             linux_tc.ModelClass.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentClasses,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{QdiscDescriptorName},
+	}
+	return adapter.NewClassDescriptor(typedDescr)
+}
+
+// EquivalentClasses compares two traffic classes. Zero-valued optional attributes
+// are replaced by kernel defaults and thus considered equal to any value, bursts
+// (stored by the kernel as transmission time) are compared approximately.
+func (d *ClassDescriptor) EquivalentClasses(key string, oldClass, newClass *linux_tc.Class) bool {
+	if oldClass.ParentClass != newClass.ParentClass {
+		return false
+	}
+	oldHtb, newHtb := oldClass.GetHtb(), newClass.GetHtb()
+	if oldHtb == nil || newHtb == nil {
+		return oldHtb == newHtb
+	}
+	return oldHtb.Rate == newHtb.Rate &&
+		htbCeil(oldHtb) == htbCeil(newHtb) &&
+		oldHtb.Prio == newHtb.Prio &&
+		(oldHtb.Burst == 0 || newHtb.Burst == 0 || equalApprox(oldHtb.Burst, newHtb.Burst)) &&
+		(oldHtb.Cburst == 0 || newHtb.Cburst == 0 || equalApprox(oldHtb.Cburst, newHtb.Cburst)) &&
+		equalOptional(oldHtb.Quantum, newHtb.Quantum)
+}
+
+// Validate validates traffic class configuration.
+func (d *ClassDescriptor) Validate(key string, class *linux_tc.Class) error {
+	if class.Interface == "" {
+		return kvs.NewInvalidValueError(ErrTcWithoutInterface, "interface")
+	}
+	if class.Qdisc == 0 || class.Qdisc >= linux_tc.IngressHandle {
+		return kvs.NewInvalidValueError(ErrClassWithoutQdisc, "qdisc")
+	}
+	if class.ClassId == 0 || class.ClassId > 0xffff {
+		return kvs.NewInvalidValueError(ErrClassWithInvalidID, "class_id")
+	}
+	if class.ParentClass == class.ClassId || class.ParentClass > 0xffff {
+		return kvs.NewInvalidValueError(ErrClassWithInvalidParent, "parent_class")
+	}
+	htb := class.GetHtb()
+	if htb == nil {
+		return kvs.NewInvalidValueError(ErrClassWithoutType, "class_type")
+	}
+	if htb.Rate == 0 {
+		return kvs.NewInvalidValueError(ErrHtbClassWithoutRate, "htb.rate")
+	}
+	return nil
+}
+
+// Create adds new traffic class.
+func (d *ClassDescriptor) Create(key string, class *linux_tc.Class) (metadata interface{}, err error) {
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, class.Interface, func(linkIdx int) error {
+		return d.tcHandler.AddClass(tclinuxcalls.ClassToNetlink(class, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to add linux traffic class %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// Delete removes traffic class.
+func (d *ClassDescriptor) Delete(key string, class *linux_tc.Class, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, class.Interface, func(linkIdx int) error {
+		return d.tcHandler.DelClass(tclinuxcalls.ClassToNetlink(class, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to delete linux traffic class %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update changes parameters of the traffic class.
+func (d *ClassDescriptor) Update(key string, oldClass, newClass *linux_tc.Class, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, newClass.Interface, func(linkIdx int) error {
+		return d.tcHandler.ReplaceClass(tclinuxcalls.ClassToNetlink(newClass, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to modify linux traffic class %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// UpdateWithRecreate returns true if the parent class has changed.
+func (d *ClassDescriptor) UpdateWithRecreate(key string, oldClass, newClass *linux_tc.Class, metadata interface{}) bool {
+	return oldClass.ParentClass != newClass.ParentClass
+}
+
+// Dependencies lists dependencies for a Linux traffic class.
+func (d *ClassDescriptor) Dependencies(key string, class *linux_tc.Class) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: classQdiscDep,
+		Key:   linux_tc.QdiscKey(class.Interface, class.Qdisc),
+	})
+	if class.ParentClass != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: classParentClassDep,
+			Key:   linux_tc.ClassKey(class.Interface, class.Qdisc, class.ParentClass),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns all traffic classes (of supported types) of interfaces managed by this agent.
+func (d *ClassDescriptor) Retrieve(correlate []adapter.ClassKVWithMetadata) ([]adapter.ClassKVWithMetadata, error) {
+	var values []adapter.ClassKVWithMetadata
+
+	classDetails, err := d.tcHandler.DumpClasses()
+	if err != nil {
+		return nil, errors.Errorf("failed to retrieve linux traffic classes: %v", err)
+	}
+
+	for _, classDetail := range classDetails {
+		class := classDetail.Class
+		values = append(values, adapter.ClassKVWithMetadata{
+			Key:    linux_tc.ClassKey(class.Interface, class.Qdisc, class.ClassId),
+			Value:  class,
+			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
+		})
+	}
+
+	return values, nil
+}
+
+// htbCeil returns ceil rate of the HTB class (which defaults to the class rate).
+func htbCeil(htb *linux_tc.Class_HtbParams) uint64 {
+	if htb.Ceil == 0 {
+		return htb.Rate
+	}
+	return htb.Ceil
+}
@@ -0,0 +1,488 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	tclinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+// mockTcHandler records netlink objects added to the kernel and returns
+// prepared details when dumped.
+type mockTcHandler struct {
+	tclinuxcalls.NetlinkAPI
+
+	qdiscs  []netlink.Qdisc
+	classes []netlink.Class
+	filters []netlink.Filter
+	deleted []interface{}
+
+	qdiscDetails  []*tclinuxcalls.QdiscDetails
+	classDetails  []*tclinuxcalls.ClassDetails
+	filterDetails []*tclinuxcalls.FilterDetails
+	dumpErr       error
+}
+
+func (h *mockTcHandler) AddQdisc(qdisc netlink.Qdisc) error {
+	h.qdiscs = append(h.qdiscs, qdisc)
+	return nil
+}
+
+func (h *mockTcHandler) DelQdisc(qdisc netlink.Qdisc) error {
+	h.deleted = append(h.deleted, qdisc)
+	return nil
+}
+
+func (h *mockTcHandler) AddClass(class netlink.Class) error {
+	h.classes = append(h.classes, class)
+	return nil
+}
+
+func (h *mockTcHandler) AddFilter(filter netlink.Filter) error {
+	h.filters = append(h.filters, filter)
+	return nil
+}
+
+func (h *mockTcHandler) DumpQdiscs() ([]*tclinuxcalls.QdiscDetails, error) {
+	return h.qdiscDetails, h.dumpErr
+}
+
+func (h *mockTcHandler) DumpClasses() ([]*tclinuxcalls.ClassDetails, error) {
+	return h.classDetails, h.dumpErr
+}
+
+func (h *mockTcHandler) DumpFilters() ([]*tclinuxcalls.FilterDetails, error) {
+	return h.filterDetails, h.dumpErr
+}
+
+// mockIfPlugin provides index of Linux interfaces.
+type mockIfPlugin struct {
+	index ifaceidx.LinuxIfMetadataIndexRW
+}
+
+func newMockIfPlugin() *mockIfPlugin {
+	index := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-log"), "test-ifindex")
+	index.Put("eth0", &ifaceidx.LinuxIfMetadata{
+		LinuxIfIndex: 5,
+		Namespace:    &linux_namespace.NetNamespace{Type: linux_namespace.NetNamespace_FD, Reference: "/proc/1/ns/net"},
+	})
+	return &mockIfPlugin{index: index}
+}
+
+func (p *mockIfPlugin) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return p.index
+}
+
+func (p *mockIfPlugin) SetNotifyService(notify func(notification *linux.Notification)) {}
+
+// mockNsPlugin records namespaces switched into.
+type mockNsPlugin struct {
+	nsplugin.API
+	switched []*linux_namespace.NetNamespace
+}
+
+func (p *mockNsPlugin) SwitchToNamespace(ctx nslinuxcalls.NamespaceMgmtCtx, ns *linux_namespace.NetNamespace) (revert func(), err error) {
+	p.switched = append(p.switched, ns)
+	return func() {}, nil
+}
+
+func TestQdiscValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &QdiscDescriptor{}
+	fqCodel := &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelParams{}}
+	tests := []struct {
+		name  string
+		qdisc *linux_tc.Qdisc
+		err   error
+	}{
+		{
+			name:  "valid root qdisc",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 1, QdiscType: fqCodel},
+		},
+		{
+			name: "valid child qdisc",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 2, Parent: linux_tc.Qdisc_CLASS,
+				ParentQdisc: 1, ParentClass: 10, QdiscType: fqCodel},
+		},
+		{
+			name:  "valid ingress qdisc",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: linux_tc.IngressHandle, Parent: linux_tc.Qdisc_INGRESS},
+		},
+		{
+			name:  "missing interface",
+			qdisc: &linux_tc.Qdisc{Handle: 1, QdiscType: fqCodel},
+			err:   ErrTcWithoutInterface,
+		},
+		{
+			name:  "zero handle",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", QdiscType: fqCodel},
+			err:   ErrQdiscWithInvalidHandle,
+		},
+		{
+			name:  "ingress qdisc with invalid handle",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 1, Parent: linux_tc.Qdisc_INGRESS},
+			err:   ErrIngressQdiscWithInvalidHandle,
+		},
+		{
+			name: "ingress qdisc with type",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: linux_tc.IngressHandle,
+				Parent: linux_tc.Qdisc_INGRESS, QdiscType: fqCodel},
+			err: ErrIngressQdiscWithType,
+		},
+		{
+			name:  "missing type",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 1},
+			err:   ErrQdiscWithoutType,
+		},
+		{
+			name: "parent class of itself",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 1, Parent: linux_tc.Qdisc_CLASS,
+				ParentQdisc: 1, ParentClass: 10, QdiscType: fqCodel},
+			err: ErrQdiscWithInvalidParent,
+		},
+		{
+			name: "TBF without burst",
+			qdisc: &linux_tc.Qdisc{Interface: "eth0", Handle: 1, QdiscType: &linux_tc.Qdisc_Tbf{
+				Tbf: &linux_tc.Qdisc_TbfParams{Rate: 125000, Limit: 30000}}},
+			err: ErrTbfQdiscWithoutRate,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.qdisc)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.err))
+		})
+	}
+}
+
+func TestClassValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &ClassDescriptor{}
+	htb := &linux_tc.Class_Htb{Htb: &linux_tc.Class_HtbParams{Rate: 125000}}
+	tests := []struct {
+		name  string
+		class *linux_tc.Class
+		err   error
+	}{
+		{
+			name:  "valid class",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: 1, ClassId: 20, ParentClass: 10, ClassType: htb},
+		},
+		{
+			name:  "missing qdisc",
+			class: &linux_tc.Class{Interface: "eth0", ClassId: 20, ClassType: htb},
+			err:   ErrClassWithoutQdisc,
+		},
+		{
+			name:  "class of ingress qdisc",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: linux_tc.IngressHandle, ClassId: 20, ClassType: htb},
+			err:   ErrClassWithoutQdisc,
+		},
+		{
+			name:  "invalid class ID",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: 1, ClassId: 0x10000, ClassType: htb},
+			err:   ErrClassWithInvalidID,
+		},
+		{
+			name:  "parent class of itself",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: 1, ClassId: 20, ParentClass: 20, ClassType: htb},
+			err:   ErrClassWithInvalidParent,
+		},
+		{
+			name:  "missing type",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: 1, ClassId: 20},
+			err:   ErrClassWithoutType,
+		},
+		{
+			name: "HTB class without rate",
+			class: &linux_tc.Class{Interface: "eth0", Qdisc: 1, ClassId: 20,
+				ClassType: &linux_tc.Class_Htb{Htb: &linux_tc.Class_HtbParams{}}},
+			err: ErrHtbClassWithoutRate,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.class)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.err))
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &FilterDescriptor{}
+	u32 := &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Match{}}
+	tests := []struct {
+		name   string
+		filter *linux_tc.Filter
+		err    error
+	}{
+		{
+			name:   "valid U32 filter",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: 1, Priority: 1, ClassId: 20, FilterType: u32},
+		},
+		{
+			name: "valid flower filter",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: linux_tc.IngressHandle, Priority: 1,
+				Protocol: linux_tc.Filter_IPV6, Action: linux_tc.Filter_DROP,
+				FilterType: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerMatch{SrcNetwork: "fd00::/64"}}},
+		},
+		{
+			name:   "missing priority",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: 1, FilterType: u32},
+			err:    ErrFilterWithInvalidPriority,
+		},
+		{
+			name:   "missing type",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: 1, Priority: 1},
+			err:    ErrFilterWithoutType,
+		},
+		{
+			name: "flower filter with class",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: 1, Priority: 1, ClassId: 20,
+				FilterType: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerMatch{}}},
+			err: ErrFlowerFilterWithClass,
+		},
+		{
+			name: "flower filter with network of other protocol",
+			filter: &linux_tc.Filter{Interface: "eth0", Qdisc: 1, Priority: 1, Protocol: linux_tc.Filter_IPV6,
+				FilterType: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerMatch{DstNetwork: "10.0.0.0/8"}}},
+			err: ErrFlowerFilterWithInvalidNetwork,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.filter)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.err))
+		})
+	}
+}
+
+func TestQdiscCreateRetrieve(t *testing.T) {
+	RegisterTestingT(t)
+
+	tcHandler := &mockTcHandler{}
+	nsPlugin := &mockNsPlugin{}
+	d := &QdiscDescriptor{
+		log:       logrus.NewLogger("test-log"),
+		tcHandler: tcHandler,
+		ifPlugin:  newMockIfPlugin(),
+		nsPlugin:  nsPlugin,
+	}
+	qdisc := &linux_tc.Qdisc{
+		Interface: "eth0",
+		Handle:    1,
+		QdiscType: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbParams{DefaultClass: 10}},
+	}
+	key := linux_tc.QdiscKey("eth0", 1)
+
+	// created in the namespace of the interface
+	_, err := d.Create(key, qdisc)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(nsPlugin.switched).To(HaveLen(1))
+	Expect(nsPlugin.switched[0].GetReference()).To(Equal("/proc/1/ns/net"))
+	Expect(tcHandler.qdiscs).To(HaveLen(1))
+	htb, isHtb := tcHandler.qdiscs[0].(*netlink.Htb)
+	Expect(isHtb).To(BeTrue())
+	Expect(htb.LinkIndex).To(Equal(5))
+	Expect(htb.Handle).To(Equal(netlink.MakeHandle(1, 0)))
+	Expect(htb.Parent).To(Equal(uint32(netlink.HANDLE_ROOT)))
+	Expect(htb.Defcls).To(BeEquivalentTo(10))
+
+	// retrieved with kernel defaults filled in
+	tcHandler.qdiscDetails = []*tclinuxcalls.QdiscDetails{{
+		Qdisc: &linux_tc.Qdisc{
+			Interface: "eth0",
+			Handle:    1,
+			QdiscType: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbParams{DefaultClass: 10, Rate2Quantum: 10}},
+		},
+		Meta: &tclinuxcalls.TcMeta{InterfaceIndex: 5, Kind: "htb"},
+	}}
+	retrieved, err := d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Key).To(Equal(key))
+	Expect(retrieved[0].Origin).To(Equal(kvs.UnknownOrigin))
+	Expect(d.EquivalentQdiscs(key, qdisc, retrieved[0].Value)).To(BeTrue())
+
+	// interface not known
+	_, err = d.Create(linux_tc.QdiscKey("eth1", 1), &linux_tc.Qdisc{Interface: "eth1", Handle: 1})
+	Expect(err).To(HaveOccurred())
+	Expect(tcHandler.qdiscs).To(HaveLen(1))
+
+	// removed in the namespace of the interface
+	Expect(d.Delete(key, qdisc, nil)).To(Succeed())
+	Expect(tcHandler.deleted).To(HaveLen(1))
+
+	tcHandler.dumpErr = errors.New("netlink error")
+	_, err = d.Retrieve(nil)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestQdiscEquivalence(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &QdiscDescriptor{}
+	tbf := func(burst uint32) *linux_tc.Qdisc {
+		return &linux_tc.Qdisc{Interface: "eth0", Handle: 1, QdiscType: &linux_tc.Qdisc_Tbf{
+			Tbf: &linux_tc.Qdisc_TbfParams{Rate: 125000, Burst: burst, Limit: 30000}}}
+	}
+	netem := func(loss float32) *linux_tc.Qdisc {
+		return &linux_tc.Qdisc{Interface: "eth0", Handle: 2, QdiscType: &linux_tc.Qdisc_Netem{
+			Netem: &linux_tc.Qdisc_NetemParams{LatencyUs: 10000, Loss: loss}}}
+	}
+	fqCodel := func(limit uint32) *linux_tc.Qdisc {
+		return &linux_tc.Qdisc{Interface: "eth0", Handle: 3, QdiscType: &linux_tc.Qdisc_FqCodel{
+			FqCodel: &linux_tc.Qdisc_FqCodelParams{Limit: limit}}}
+	}
+
+	// burst converted by the kernel
+	Expect(d.EquivalentQdiscs("", tbf(10000), tbf(10037))).To(BeTrue())
+	Expect(d.EquivalentQdiscs("", tbf(10000), tbf(12000))).To(BeFalse())
+	// percentage stored as a fraction of 2^32
+	Expect(d.EquivalentQdiscs("", netem(1.5), netem(1.50001))).To(BeTrue())
+	Expect(d.EquivalentQdiscs("", netem(1.5), netem(1.6))).To(BeFalse())
+	// zero replaced by kernel default
+	Expect(d.EquivalentQdiscs("", fqCodel(0), fqCodel(10240))).To(BeTrue())
+	Expect(d.EquivalentQdiscs("", fqCodel(1024), fqCodel(10240))).To(BeFalse())
+	// different type
+	Expect(d.EquivalentQdiscs("", fqCodel(0), tbf(10000))).To(BeFalse())
+	Expect(d.UpdateWithRecreate("", fqCodel(0), tbf(10000), nil)).To(BeTrue())
+	Expect(d.UpdateWithRecreate("", fqCodel(0), fqCodel(1024), nil)).To(BeFalse())
+}
+
+func TestDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	qdiscDeps := (&QdiscDescriptor{}).Dependencies("", &linux_tc.Qdisc{
+		Interface: "eth0", Handle: 2, Parent: linux_tc.Qdisc_CLASS, ParentQdisc: 1, ParentClass: 10,
+	})
+	Expect(qdiscDeps).To(Equal([]kvs.Dependency{
+		{Label: qdiscInterfaceDep, Key: ifmodel.InterfaceKey("eth0")},
+		{Label: qdiscParentClassDep, Key: linux_tc.ClassKey("eth0", 1, 10)},
+	}))
+
+	classDeps := (&ClassDescriptor{}).Dependencies("", &linux_tc.Class{
+		Interface: "eth0", Qdisc: 1, ClassId: 20,
+	})
+	Expect(classDeps).To(Equal([]kvs.Dependency{
+		{Label: classQdiscDep, Key: linux_tc.QdiscKey("eth0", 1)},
+	}))
+
+	filterDeps := (&FilterDescriptor{}).Dependencies("", &linux_tc.Filter{
+		Interface: "eth0", Qdisc: 1, Priority: 1, ClassId: 20,
+	})
+	Expect(filterDeps).To(Equal([]kvs.Dependency{
+		{Label: filterQdiscDep, Key: linux_tc.QdiscKey("eth0", 1)},
+		{Label: filterClassDep, Key: linux_tc.ClassKey("eth0", 1, 20)},
+	}))
+}
+
+func TestClassAndFilterCreateRetrieve(t *testing.T) {
+	RegisterTestingT(t)
+
+	tcHandler := &mockTcHandler{}
+	classDescr := &ClassDescriptor{
+		log:       logrus.NewLogger("test-log"),
+		tcHandler: tcHandler,
+		ifPlugin:  newMockIfPlugin(),
+		nsPlugin:  &mockNsPlugin{},
+	}
+	filterDescr := &FilterDescriptor{
+		log:       logrus.NewLogger("test-log"),
+		tcHandler: tcHandler,
+		ifPlugin:  newMockIfPlugin(),
+		nsPlugin:  &mockNsPlugin{},
+	}
+
+	class := &linux_tc.Class{
+		Interface: "eth0",
+		Qdisc:     1,
+		ClassId:   20,
+		ClassType: &linux_tc.Class_Htb{Htb: &linux_tc.Class_HtbParams{Rate: 125000}},
+	}
+	_, err := classDescr.Create(linux_tc.ClassKey("eth0", 1, 20), class)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(tcHandler.classes).To(HaveLen(1))
+	Expect(tcHandler.classes[0].Attrs().Handle).To(Equal(netlink.MakeHandle(1, 20)))
+
+	filter := &linux_tc.Filter{
+		Interface:  "eth0",
+		Qdisc:      1,
+		Priority:   1,
+		ClassId:    20,
+		FilterType: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Match{}},
+	}
+	_, err = filterDescr.Create(linux_tc.FilterKey("eth0", 1, 1), filter)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(tcHandler.filters).To(HaveLen(1))
+	Expect(tcHandler.filters[0].Attrs().LinkIndex).To(Equal(5))
+
+	// class retrieved with ceil, burst and quantum computed by the kernel
+	tcHandler.classDetails = []*tclinuxcalls.ClassDetails{{
+		Class: &linux_tc.Class{
+			Interface: "eth0",
+			Qdisc:     1,
+			ClassId:   20,
+			ClassType: &linux_tc.Class_Htb{Htb: &linux_tc.Class_HtbParams{
+				Rate: 125000, Ceil: 125000, Burst: 1600, Cburst: 1600, Quantum: 12500,
+			}},
+		},
+	}}
+	classes, err := classDescr.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(classes).To(HaveLen(1))
+	Expect(classes[0].Key).To(Equal(linux_tc.ClassKey("eth0", 1, 20)))
+	Expect(classDescr.EquivalentClasses(classes[0].Key, class, classes[0].Value)).To(BeTrue())
+
+	tcHandler.filterDetails = []*tclinuxcalls.FilterDetails{{Filter: filter}}
+	filters, err := filterDescr.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(filters).To(HaveLen(1))
+	Expect(filters[0].Key).To(Equal(linux_tc.FilterKey("eth0", 1, 1)))
+	Expect(filterDescr.EquivalentFilters(filters[0].Key, filter, filters[0].Value)).To(BeTrue())
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	tclinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// FilterDescriptorName is the name of the descriptor for Linux tc filters.
+	FilterDescriptorName = "linux-tc-filter"
+
+	// dependency labels
+	filterQdiscDep = "qdisc-exists"
+	filterClassDep = "class-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrFilterWithoutQdisc is returned when tc filter configuration is missing
+	// qdisc reference.
+	ErrFilterWithoutQdisc = errors.New("Linux tc filter defined without qdisc reference")
+
+	// ErrFilterWithInvalidPriority is returned when tc filter priority is zero or out of range.
+	ErrFilterWithInvalidPriority = errors.New("Linux tc filter defined with invalid priority")
+
+	// ErrFilterWithInvalidClass is returned when tc filter class ID is out of range.
+	ErrFilterWithInvalidClass = errors.New("Linux tc filter defined with invalid class ID")
+
+	// ErrFilterWithoutType is returned when tc filter is missing filter type.
+	ErrFilterWithoutType = errors.New("Linux tc filter defined without filter type")
+
+	// ErrFlowerFilterWithClass is returned when flower filter is defined with class ID.
+	ErrFlowerFilterWithClass = errors.New("Linux flower filter cannot classify packets into a class")
+
+	// ErrFlowerFilterWithInvalidNetwork is returned when flower filter contains
+	// IP network that cannot be parsed or does not match the filter protocol.
+	ErrFlowerFilterWithInvalidNetwork = errors.New("Linux flower filter defined with invalid IP network")
+)
+
+// FilterDescriptor teaches KVScheduler how to configure Linux tc filters.
+type FilterDescriptor struct {
+	log       logging.Logger
+	tcHandler tclinuxcalls.NetlinkAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewFilterDescriptor creates a new instance of the Filter descriptor.
+func NewFilterDescriptor(ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	tcHandler tclinuxcalls.NetlinkAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &FilterDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("filter-descriptor"),
+	}
+
+	typedDescr := &adapter.FilterDescriptor{
+		Name:                 FilterDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelFilter.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelFilter.ProtoName(),
+		KeySelector:          linux_tc.ModelFilter.IsKeyValid,
+		KeyLabel:             linux_tc.ModelFilter.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentFilters,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{QdiscDescriptorName, ClassDescriptorName},
+	}
+	return adapter.NewFilterDescriptor(typedDescr)
+}
+
+// EquivalentFilters compares two tc filters. U32 values are compared after
+// applying the mask, IP networks are compared in the canonical form.
+func (d *FilterDescriptor) EquivalentFilters(key string, oldFilter, newFilter *linux_tc.Filter) bool {
+	return proto.Equal(normalizeFilter(oldFilter), normalizeFilter(newFilter))
+}
+
+// Validate validates tc filter configuration.
+func (d *FilterDescriptor) Validate(key string, filter *linux_tc.Filter) error {
+	if filter.Interface == "" {
+		return kvs.NewInvalidValueError(ErrTcWithoutInterface, "interface")
+	}
+	if filter.Qdisc == 0 || filter.Qdisc > linux_tc.IngressHandle {
+		return kvs.NewInvalidValueError(ErrFilterWithoutQdisc, "qdisc")
+	}
+	if filter.Priority == 0 || filter.Priority > 0xffff {
+		return kvs.NewInvalidValueError(ErrFilterWithInvalidPriority, "priority")
+	}
+	if filter.ClassId > 0xffff {
+		return kvs.NewInvalidValueError(ErrFilterWithInvalidClass, "class_id")
+	}
+	switch filterType := filter.FilterType.(type) {
+	case *linux_tc.Filter_U32:
+	case *linux_tc.Filter_Flower:
+		if filter.ClassId != 0 {
+			return kvs.NewInvalidValueError(ErrFlowerFilterWithClass, "class_id")
+		}
+		for field, network := range map[string]string{
+			"flower.src_network": filterType.Flower.SrcNetwork,
+			"flower.dst_network": filterType.Flower.DstNetwork,
+		} {
+			if network == "" {
+				continue
+			}
+			ip, _, err := net.ParseCIDR(network)
+			if err != nil {
+				return kvs.NewInvalidValueError(ErrFlowerFilterWithInvalidNetwork, field)
+			}
+			isIPv4 := ip.To4() != nil
+			if (isIPv4 && filter.Protocol != linux_tc.Filter_IPV4) ||
+				(!isIPv4 && filter.Protocol != linux_tc.Filter_IPV6) {
+				return kvs.NewInvalidValueError(ErrFlowerFilterWithInvalidNetwork, field, "protocol")
+			}
+		}
+	default:
+		return kvs.NewInvalidValueError(ErrFilterWithoutType, "filter_type")
+	}
+	return nil
+}
+
+// Create adds new tc filter.
+func (d *FilterDescriptor) Create(key string, filter *linux_tc.Filter) (metadata interface{}, err error) {
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, filter.Interface, func(linkIdx int) error {
+		nlFilter, err := tclinuxcalls.FilterToNetlink(filter, linkIdx)
+		if err != nil {
+			return err
+		}
+		return d.tcHandler.AddFilter(nlFilter)
+	})
+	if err != nil {
+		err = errors.Errorf("failed to add linux tc filter %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// Delete removes tc filter.
+func (d *FilterDescriptor) Delete(key string, filter *linux_tc.Filter, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, filter.Interface, func(linkIdx int) error {
+		nlFilter, err := tclinuxcalls.FilterToNetlink(filter, linkIdx)
+		if err != nil {
+			return err
+		}
+		return d.tcHandler.DelFilter(nlFilter)
+	})
+	if err != nil {
+		err = errors.Errorf("failed to delete linux tc filter %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return err
+}
+
+// UpdateWithRecreate always returns true - filter handles are assigned
+// by the kernel, therefore filters are modified by re-creation.
+func (d *FilterDescriptor) UpdateWithRecreate(key string, oldFilter, newFilter *linux_tc.Filter, metadata interface{}) bool {
+	return true
+}
+
+// Dependencies lists dependencies for a Linux tc filter.
+func (d *FilterDescriptor) Dependencies(key string, filter *linux_tc.Filter) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: filterQdiscDep,
+		Key:   linux_tc.QdiscKey(filter.Interface, filter.Qdisc),
+	})
+	if filter.ClassId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: filterClassDep,
+			Key:   linux_tc.ClassKey(filter.Interface, filter.Qdisc, filter.ClassId),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns all tc filters (of supported types) of interfaces managed by this agent.
+func (d *FilterDescriptor) Retrieve(correlate []adapter.FilterKVWithMetadata) ([]adapter.FilterKVWithMetadata, error) {
+	var values []adapter.FilterKVWithMetadata
+
+	filterDetails, err := d.tcHandler.DumpFilters()
+	if err != nil {
+		return nil, errors.Errorf("failed to retrieve linux tc filters: %v", err)
+	}
+
+	for _, filterDetail := range filterDetails {
+		filter := filterDetail.Filter
+		values = append(values, adapter.FilterKVWithMetadata{
+			Key:    linux_tc.FilterKey(filter.Interface, filter.Qdisc, filter.Priority),
+			Value:  filter,
+			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
+		})
+	}
+
+	return values, nil
+}
+
+// normalizeFilter returns copy of the filter with u32 values masked and IP networks
+// in the canonical form.
+func normalizeFilter(filter *linux_tc.Filter) *linux_tc.Filter {
+	filter = proto.Clone(filter).(*linux_tc.Filter)
+	switch filterType := filter.FilterType.(type) {
+	case *linux_tc.Filter_U32:
+		for _, key := range filterType.U32.Keys {
+			key.Value &= key.Mask
+		}
+	case *linux_tc.Filter_Flower:
+		filterType.Flower.SrcNetwork = canonicalNetwork(filterType.Flower.SrcNetwork)
+		filterType.Flower.DstNetwork = canonicalNetwork(filterType.Flower.DstNetwork)
+	}
+	return filter
+}
+
+// canonicalNetwork returns IP network in the canonical CIDR form.
+func canonicalNetwork(network string) string {
+	if _, ipNet, err := net.ParseCIDR(network); err == nil {
+		return ipNet.String()
+	}
+	return network
+}
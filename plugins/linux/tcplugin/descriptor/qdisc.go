@@ -0,0 +1,323 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	tclinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// QdiscDescriptorName is the name of the descriptor for Linux qdiscs.
+	QdiscDescriptorName = "linux-tc-qdisc"
+
+	// dependency labels
+	qdiscInterfaceDep   = "interface-exists"
+	qdiscParentClassDep = "parent-class-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrTcWithoutInterface is returned when traffic control configuration is missing
+	// interface reference.
+	ErrTcWithoutInterface = errors.New("Linux traffic control defined without interface reference")
+
+	// ErrQdiscWithInvalidHandle is returned when qdisc handle is zero or out of range.
+	ErrQdiscWithInvalidHandle = errors.New("Linux qdisc defined with invalid handle")
+
+	// ErrIngressQdiscWithInvalidHandle is returned when ingress qdisc handle is not 0xffff.
+	ErrIngressQdiscWithInvalidHandle = errors.New("Linux ingress qdisc must use handle 0xffff")
+
+	// ErrIngressQdiscWithType is returned when ingress qdisc has qdisc type defined.
+	ErrIngressQdiscWithType = errors.New("Linux ingress qdisc cannot have qdisc type defined")
+
+	// ErrQdiscWithoutType is returned when egress qdisc is missing qdisc type.
+	ErrQdiscWithoutType = errors.New("Linux qdisc defined without qdisc type")
+
+	// ErrQdiscWithInvalidParent is returned when qdisc parent class is not valid.
+	ErrQdiscWithInvalidParent = errors.New("Linux qdisc defined with invalid parent class")
+
+	// ErrTbfQdiscWithoutRate is returned when TBF qdisc is missing rate, burst or limit.
+	ErrTbfQdiscWithoutRate = errors.New("Linux TBF qdisc defined without rate, burst or limit")
+)
+
+// QdiscDescriptor teaches KVScheduler how to configure Linux qdiscs.
+type QdiscDescriptor struct {
+	log       logging.Logger
+	tcHandler tclinuxcalls.NetlinkAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewQdiscDescriptor creates a new instance of the Qdisc descriptor.
+func NewQdiscDescriptor(ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	tcHandler tclinuxcalls.NetlinkAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &QdiscDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("qdisc-descriptor"),
+	}
+
+	typedDescr := &adapter.QdiscDescriptor{
+		Name:                 QdiscDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelQdisc.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelQdisc.ProtoName(),
+		KeySelector:          linux_tc.ModelQdisc.IsKeyValid,
+		KeyLabel:             linux_tc.ModelQdisc.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentQdiscs,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewQdiscDescriptor(typedDescr)
+}
+
+// EquivalentQdiscs compares two qdiscs. Zero-valued optional attributes are
+// replaced by kernel defaults and thus considered equal to any value, attributes
+// which the kernel stores in a lossy representation (e.g. burst converted
+// to ticks, percentages stored as fractions of 2^32) are compared approximately.
+func (d *QdiscDescriptor) EquivalentQdiscs(key string, oldQdisc, newQdisc *linux_tc.Qdisc) bool {
+	if oldQdisc.Parent != newQdisc.Parent || qdiscKind(oldQdisc) != qdiscKind(newQdisc) {
+		return false
+	}
+	if newQdisc.Parent == linux_tc.Qdisc_CLASS &&
+		(oldQdisc.ParentQdisc != newQdisc.ParentQdisc || oldQdisc.ParentClass != newQdisc.ParentClass) {
+		return false
+	}
+
+	switch newType := newQdisc.QdiscType.(type) {
+	case *linux_tc.Qdisc_Htb:
+		oldHtb, newHtb := oldQdisc.GetHtb(), newType.Htb
+		return oldHtb.DefaultClass == newHtb.DefaultClass &&
+			equalOptional(oldHtb.Rate2Quantum, newHtb.Rate2Quantum)
+	case *linux_tc.Qdisc_FqCodel:
+		oldFqCodel, newFqCodel := oldQdisc.GetFqCodel(), newType.FqCodel
+		return oldFqCodel.Ecn == newFqCodel.Ecn &&
+			equalOptional(oldFqCodel.Limit, newFqCodel.Limit) &&
+			equalOptional(oldFqCodel.Flows, newFqCodel.Flows) &&
+			equalOptional(oldFqCodel.IntervalUs, newFqCodel.IntervalUs) &&
+			equalOptional(oldFqCodel.Quantum, newFqCodel.Quantum)
+	case *linux_tc.Qdisc_Tbf:
+		oldTbf, newTbf := oldQdisc.GetTbf(), newType.Tbf
+		return oldTbf.Rate == newTbf.Rate &&
+			oldTbf.PeakRate == newTbf.PeakRate &&
+			oldTbf.Limit == newTbf.Limit &&
+			oldTbf.MinBurst == newTbf.MinBurst &&
+			equalApprox(oldTbf.Burst, newTbf.Burst)
+	case *linux_tc.Qdisc_Netem:
+		oldNetem, newNetem := oldQdisc.GetNetem(), newType.Netem
+		return equalApprox(oldNetem.LatencyUs, newNetem.LatencyUs) &&
+			equalApprox(oldNetem.JitterUs, newNetem.JitterUs) &&
+			equalPercentage(oldNetem.Loss, newNetem.Loss) &&
+			equalPercentage(oldNetem.Duplicate, newNetem.Duplicate) &&
+			equalPercentage(oldNetem.Reorder, newNetem.Reorder) &&
+			equalPercentage(oldNetem.Corrupt, newNetem.Corrupt) &&
+			equalOptional(oldNetem.Limit, newNetem.Limit) &&
+			equalOptional(oldNetem.Gap, newNetem.Gap)
+	}
+	return true
+}
+
+// Validate validates qdisc configuration.
+func (d *QdiscDescriptor) Validate(key string, qdisc *linux_tc.Qdisc) error {
+	if qdisc.Interface == "" {
+		return kvs.NewInvalidValueError(ErrTcWithoutInterface, "interface")
+	}
+	if qdisc.Handle == 0 || qdisc.Handle > linux_tc.IngressHandle {
+		return kvs.NewInvalidValueError(ErrQdiscWithInvalidHandle, "handle")
+	}
+	if qdisc.Parent == linux_tc.Qdisc_INGRESS {
+		if qdisc.Handle != linux_tc.IngressHandle {
+			return kvs.NewInvalidValueError(ErrIngressQdiscWithInvalidHandle, "handle")
+		}
+		if qdisc.QdiscType != nil {
+			return kvs.NewInvalidValueError(ErrIngressQdiscWithType, "qdisc_type")
+		}
+		return nil
+	}
+	if qdisc.QdiscType == nil {
+		return kvs.NewInvalidValueError(ErrQdiscWithoutType, "qdisc_type")
+	}
+	if qdisc.Parent == linux_tc.Qdisc_CLASS {
+		if qdisc.ParentQdisc == 0 || qdisc.ParentQdisc == qdisc.Handle || qdisc.ParentClass == 0 {
+			return kvs.NewInvalidValueError(ErrQdiscWithInvalidParent, "parent_qdisc", "parent_class")
+		}
+	}
+	if tbf := qdisc.GetTbf(); tbf != nil && (tbf.Rate == 0 || tbf.Burst == 0 || tbf.Limit == 0) {
+		return kvs.NewInvalidValueError(ErrTbfQdiscWithoutRate, "tbf.rate", "tbf.burst", "tbf.limit")
+	}
+	return nil
+}
+
+// Create adds new qdisc.
+func (d *QdiscDescriptor) Create(key string, qdisc *linux_tc.Qdisc) (metadata interface{}, err error) {
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, qdisc.Interface, func(linkIdx int) error {
+		return d.tcHandler.AddQdisc(tclinuxcalls.QdiscToNetlink(qdisc, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to add linux qdisc %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// Delete removes qdisc.
+func (d *QdiscDescriptor) Delete(key string, qdisc *linux_tc.Qdisc, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, qdisc.Interface, func(linkIdx int) error {
+		return d.tcHandler.DelQdisc(tclinuxcalls.QdiscToNetlink(qdisc, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to delete linux qdisc %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update changes parameters of the qdisc.
+func (d *QdiscDescriptor) Update(key string, oldQdisc, newQdisc *linux_tc.Qdisc, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, newQdisc.Interface, func(linkIdx int) error {
+		return d.tcHandler.ReplaceQdisc(tclinuxcalls.QdiscToNetlink(newQdisc, linkIdx))
+	})
+	if err != nil {
+		err = errors.Errorf("failed to modify linux qdisc %s: %v", key, err)
+		d.log.Error(err)
+	}
+	return nil, err
+}
+
+// UpdateWithRecreate returns true if the qdisc type or the parent has changed.
+// The kernel does not allow to change these attributes in-place.
+func (d *QdiscDescriptor) UpdateWithRecreate(key string, oldQdisc, newQdisc *linux_tc.Qdisc, metadata interface{}) bool {
+	return qdiscKind(oldQdisc) != qdiscKind(newQdisc) ||
+		oldQdisc.Parent != newQdisc.Parent ||
+		oldQdisc.ParentQdisc != newQdisc.ParentQdisc ||
+		oldQdisc.ParentClass != newQdisc.ParentClass
+}
+
+// Dependencies lists dependencies for a Linux qdisc.
+func (d *QdiscDescriptor) Dependencies(key string, qdisc *linux_tc.Qdisc) (deps []kvs.Dependency) {
+	if qdisc.Interface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: qdiscInterfaceDep,
+			Key:   ifmodel.InterfaceKey(qdisc.Interface),
+		})
+	}
+	if qdisc.Parent == linux_tc.Qdisc_CLASS {
+		deps = append(deps, kvs.Dependency{
+			Label: qdiscParentClassDep,
+			Key:   linux_tc.ClassKey(qdisc.Interface, qdisc.ParentQdisc, qdisc.ParentClass),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns all qdiscs (of supported types) attached to interfaces managed by this agent.
+func (d *QdiscDescriptor) Retrieve(correlate []adapter.QdiscKVWithMetadata) ([]adapter.QdiscKVWithMetadata, error) {
+	var values []adapter.QdiscKVWithMetadata
+
+	qdiscDetails, err := d.tcHandler.DumpQdiscs()
+	if err != nil {
+		return nil, errors.Errorf("failed to retrieve linux qdiscs: %v", err)
+	}
+
+	for _, qdiscDetail := range qdiscDetails {
+		values = append(values, adapter.QdiscKVWithMetadata{
+			Key:    linux_tc.QdiscKey(qdiscDetail.Qdisc.Interface, qdiscDetail.Qdisc.Handle),
+			Value:  qdiscDetail.Qdisc,
+			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
+		})
+	}
+
+	return values, nil
+}
+
+// qdiscKind returns kernel name of the qdisc type.
+func qdiscKind(qdisc *linux_tc.Qdisc) string {
+	switch qdisc.QdiscType.(type) {
+	case *linux_tc.Qdisc_Htb:
+		return "htb"
+	case *linux_tc.Qdisc_FqCodel:
+		return "fq_codel"
+	case *linux_tc.Qdisc_Tbf:
+		return "tbf"
+	case *linux_tc.Qdisc_Netem:
+		return "netem"
+	}
+	if qdisc.Parent == linux_tc.Qdisc_INGRESS {
+		return "ingress"
+	}
+	return ""
+}
+
+// equalOptional compares two values of an optional attribute, where zero
+// stands for the kernel default.
+func equalOptional(a, b uint32) bool {
+	return a == 0 || b == 0 || a == b
+}
+
+// equalApprox compares values of attributes converted by the kernel into
+// a different unit, allowing for a rounding error of one percent.
+func equalApprox(a, b uint32) bool {
+	diff, max := a-b, a
+	if b > a {
+		diff, max = b-a, b
+	}
+	return diff <= 1 || float64(diff) <= float64(max)/100
+}
+
+// equalPercentage compares percentages with the precision of two decimal places.
+func equalPercentage(a, b float32) bool {
+	return math.Round(float64(a)*100) == math.Round(float64(b)*100)
+}
+
+// inInterfaceNamespace calls <action> with the Linux index of the given interface
+// from within the namespace of the interface.
+func inInterfaceNamespace(ifPlugin ifplugin.API, nsPlugin nsplugin.API, ifName string,
+	action func(linkIdx int) error) error {
+
+	ifMeta, found := ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		return errors.Errorf("failed to obtain metadata for interface %s", ifName)
+	}
+
+	// move to the namespace of the associated interface
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revertNs, err := nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	if err != nil {
+		return errors.Errorf("failed to switch namespace: %v", err)
+	}
+	defer revertNs()
+
+	return action(ifMeta.LinuxIfIndex)
+}
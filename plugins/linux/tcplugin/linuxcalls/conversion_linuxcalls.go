@@ -0,0 +1,389 @@
+// +build !windows,!darwin
+
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"math"
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+// QdiscToNetlink converts proto-modeled qdisc into its netlink representation.
+func QdiscToNetlink(qdisc *linux_tc.Qdisc, linkIndex int) netlink.Qdisc {
+	attrs := netlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    netlink.MakeHandle(uint16(qdisc.Handle), 0),
+	}
+	switch qdisc.Parent {
+	case linux_tc.Qdisc_ROOT:
+		attrs.Parent = netlink.HANDLE_ROOT
+	case linux_tc.Qdisc_INGRESS:
+		attrs.Handle = netlink.MakeHandle(linux_tc.IngressHandle, 0)
+		attrs.Parent = netlink.HANDLE_INGRESS
+		return &netlink.Ingress{QdiscAttrs: attrs}
+	case linux_tc.Qdisc_CLASS:
+		attrs.Parent = netlink.MakeHandle(uint16(qdisc.ParentQdisc), uint16(qdisc.ParentClass))
+	}
+
+	switch qdiscType := qdisc.QdiscType.(type) {
+	case *linux_tc.Qdisc_Htb:
+		htb := netlink.NewHtb(attrs)
+		htb.Defcls = qdiscType.Htb.DefaultClass
+		if qdiscType.Htb.Rate2Quantum != 0 {
+			htb.Rate2Quantum = qdiscType.Htb.Rate2Quantum
+		}
+		return htb
+	case *linux_tc.Qdisc_FqCodel:
+		fqCodel := netlink.NewFqCodel(attrs)
+		fqCodel.Limit = qdiscType.FqCodel.Limit
+		fqCodel.Flows = qdiscType.FqCodel.Flows
+		fqCodel.Interval = qdiscType.FqCodel.IntervalUs
+		fqCodel.Quantum = qdiscType.FqCodel.Quantum
+		fqCodel.ECN = 0
+		if qdiscType.FqCodel.Ecn {
+			fqCodel.ECN = 1
+		}
+		return fqCodel
+	case *linux_tc.Qdisc_Tbf:
+		tbf := qdiscType.Tbf
+		return &netlink.Tbf{
+			QdiscAttrs: attrs,
+			Rate:       tbf.Rate,
+			Limit:      tbf.Limit,
+			Buffer:     netlink.Xmittime(tbf.Rate, tbf.Burst),
+			Peakrate:   tbf.PeakRate,
+			Minburst:   tbf.MinBurst,
+		}
+	case *linux_tc.Qdisc_Netem:
+		netem := qdiscType.Netem
+		return netlink.NewNetem(attrs, netlink.NetemQdiscAttrs{
+			Latency:     netem.LatencyUs,
+			Jitter:      netem.JitterUs,
+			Loss:        netem.Loss,
+			Duplicate:   netem.Duplicate,
+			ReorderProb: netem.Reorder,
+			CorruptProb: netem.Corrupt,
+			Limit:       netem.Limit,
+			Gap:         netem.Gap,
+		})
+	}
+	return &netlink.GenericQdisc{QdiscAttrs: attrs}
+}
+
+// ClassToNetlink converts proto-modeled traffic class into its netlink representation.
+func ClassToNetlink(class *linux_tc.Class, linkIndex int) netlink.Class {
+	attrs := netlink.ClassAttrs{
+		LinkIndex: linkIndex,
+		Handle:    netlink.MakeHandle(uint16(class.Qdisc), uint16(class.ClassId)),
+		Parent:    netlink.MakeHandle(uint16(class.Qdisc), uint16(class.ParentClass)),
+	}
+	if htb := class.GetHtb(); htb != nil {
+		// netlink expects rates in bits per second
+		return netlink.NewHtbClass(attrs, netlink.HtbClassAttrs{
+			Rate:    htb.Rate * 8,
+			Ceil:    htb.Ceil * 8,
+			Buffer:  htb.Burst,
+			Cbuffer: htb.Cburst,
+			Prio:    htb.Prio,
+			Quantum: htb.Quantum,
+		})
+	}
+	return &netlink.GenericClass{ClassAttrs: attrs}
+}
+
+// FilterToNetlink converts proto-modeled tc filter into its netlink representation.
+func FilterToNetlink(filter *linux_tc.Filter, linkIndex int) (netlink.Filter, error) {
+	attrs := netlink.FilterAttrs{
+		LinkIndex: linkIndex,
+		Parent:    netlink.MakeHandle(uint16(filter.Qdisc), 0),
+		Priority:  uint16(filter.Priority),
+		Protocol:  protocolToNetlink(filter.Protocol),
+	}
+	var actions []netlink.Action
+	switch filter.Action {
+	case linux_tc.Filter_DROP:
+		actions = append(actions, gact(netlink.TC_ACT_SHOT))
+	case linux_tc.Filter_PASS:
+		actions = append(actions, gact(netlink.TC_ACT_OK))
+	}
+
+	switch filterType := filter.FilterType.(type) {
+	case *linux_tc.Filter_U32:
+		sel := &netlink.TcU32Sel{
+			Flags: netlink.TC_U32_TERMINAL,
+		}
+		for _, key := range filterType.U32.Keys {
+			sel.Keys = append(sel.Keys, netlink.TcU32Key{
+				Val:  key.Value & key.Mask,
+				Mask: key.Mask,
+				Off:  key.Offset,
+			})
+		}
+		if len(sel.Keys) == 0 {
+			// match all
+			sel.Keys = append(sel.Keys, netlink.TcU32Key{})
+		}
+		u32 := &netlink.U32{
+			FilterAttrs: attrs,
+			Sel:         sel,
+			Actions:     actions,
+		}
+		if filter.ClassId != 0 {
+			u32.ClassId = netlink.MakeHandle(uint16(filter.Qdisc), uint16(filter.ClassId))
+		}
+		return u32, nil
+	case *linux_tc.Filter_Flower:
+		flower := &netlink.Flower{
+			FilterAttrs: attrs,
+			EthType:     attrs.Protocol,
+			Actions:     actions,
+		}
+		if network := filterType.Flower.SrcNetwork; network != "" {
+			_, ipNet, err := net.ParseCIDR(network)
+			if err != nil {
+				return nil, errors.Errorf("invalid source network %q: %v", network, err)
+			}
+			flower.SrcIP, flower.SrcIPMask = ipNet.IP, ipNet.Mask
+		}
+		if network := filterType.Flower.DstNetwork; network != "" {
+			_, ipNet, err := net.ParseCIDR(network)
+			if err != nil {
+				return nil, errors.Errorf("invalid destination network %q: %v", network, err)
+			}
+			flower.DestIP, flower.DestIPMask = ipNet.IP, ipNet.Mask
+		}
+		return flower, nil
+	}
+	return nil, errors.New("filter type is not defined")
+}
+
+// qdiscFromNetlink converts netlink qdisc into its proto-modeled representation.
+// Returns nil for qdiscs not supported by the model (including kernel default
+// qdiscs, which have zero handle).
+func qdiscFromNetlink(ifName string, nlQdisc netlink.Qdisc) *linux_tc.Qdisc {
+	attrs := nlQdisc.Attrs()
+	handle, _ := netlink.MajorMinor(attrs.Handle)
+	if handle == 0 {
+		return nil
+	}
+	qdisc := &linux_tc.Qdisc{
+		Interface: ifName,
+		Handle:    uint32(handle),
+	}
+	switch attrs.Parent {
+	case netlink.HANDLE_ROOT:
+		qdisc.Parent = linux_tc.Qdisc_ROOT
+	case netlink.HANDLE_INGRESS:
+		qdisc.Parent = linux_tc.Qdisc_INGRESS
+	default:
+		parentQdisc, parentClass := netlink.MajorMinor(attrs.Parent)
+		qdisc.Parent = linux_tc.Qdisc_CLASS
+		qdisc.ParentQdisc = uint32(parentQdisc)
+		qdisc.ParentClass = uint32(parentClass)
+	}
+
+	switch nlQdisc := nlQdisc.(type) {
+	case *netlink.Ingress:
+	case *netlink.Htb:
+		qdisc.QdiscType = &linux_tc.Qdisc_Htb{
+			Htb: &linux_tc.Qdisc_HtbParams{
+				DefaultClass: nlQdisc.Defcls,
+				Rate2Quantum: nlQdisc.Rate2Quantum,
+			},
+		}
+	case *netlink.FqCodel:
+		qdisc.QdiscType = &linux_tc.Qdisc_FqCodel{
+			FqCodel: &linux_tc.Qdisc_FqCodelParams{
+				Limit:      nlQdisc.Limit,
+				Flows:      nlQdisc.Flows,
+				IntervalUs: nlQdisc.Interval,
+				Quantum:    nlQdisc.Quantum,
+				Ecn:        nlQdisc.ECN != 0,
+			},
+		}
+	case *netlink.Tbf:
+		qdisc.QdiscType = &linux_tc.Qdisc_Tbf{
+			Tbf: &linux_tc.Qdisc_TbfParams{
+				Rate:     nlQdisc.Rate,
+				Burst:    netlink.Xmitsize(nlQdisc.Rate, nlQdisc.Buffer),
+				Limit:    nlQdisc.Limit,
+				PeakRate: nlQdisc.Peakrate,
+				MinBurst: nlQdisc.Minburst,
+			},
+		}
+	case *netlink.Netem:
+		qdisc.QdiscType = &linux_tc.Qdisc_Netem{
+			Netem: &linux_tc.Qdisc_NetemParams{
+				LatencyUs: ticksToUsec(nlQdisc.Latency),
+				JitterUs:  ticksToUsec(nlQdisc.Jitter),
+				Loss:      u32ToPercentage(nlQdisc.Loss),
+				Duplicate: u32ToPercentage(nlQdisc.Duplicate),
+				Reorder:   u32ToPercentage(nlQdisc.ReorderProb),
+				Corrupt:   u32ToPercentage(nlQdisc.CorruptProb),
+				Limit:     nlQdisc.Limit,
+				Gap:       nlQdisc.Gap,
+			},
+		}
+	default:
+		return nil
+	}
+	return qdisc
+}
+
+// classFromNetlink converts netlink traffic class into its proto-modeled representation.
+// Returns nil for classes not supported by the model.
+func classFromNetlink(ifName string, nlClass netlink.Class) *linux_tc.Class {
+	htb, isHtb := nlClass.(*netlink.HtbClass)
+	if !isHtb {
+		return nil
+	}
+	qdisc, classID := netlink.MajorMinor(htb.Handle)
+	_, parentClass := netlink.MajorMinor(htb.Parent)
+	return &linux_tc.Class{
+		Interface:   ifName,
+		Qdisc:       uint32(qdisc),
+		ClassId:     uint32(classID),
+		ParentClass: uint32(parentClass),
+		ClassType: &linux_tc.Class_Htb{
+			Htb: &linux_tc.Class_HtbParams{
+				Rate:    htb.Rate,
+				Ceil:    htb.Ceil,
+				Burst:   netlink.Xmitsize(htb.Rate, htb.Buffer),
+				Cburst:  netlink.Xmitsize(htb.Ceil, htb.Cbuffer),
+				Prio:    htb.Prio,
+				Quantum: htb.Quantum,
+			},
+		},
+	}
+}
+
+// filterFromNetlink converts netlink tc filter into its proto-modeled representation.
+// Returns nil for filters not supported by the model.
+func filterFromNetlink(ifName string, nlFilter netlink.Filter) *linux_tc.Filter {
+	attrs := nlFilter.Attrs()
+	qdisc, _ := netlink.MajorMinor(attrs.Parent)
+	filter := &linux_tc.Filter{
+		Interface: ifName,
+		Qdisc:     uint32(qdisc),
+		Priority:  uint32(attrs.Priority),
+		Protocol:  protocolFromNetlink(attrs.Protocol),
+	}
+
+	var actions []netlink.Action
+	switch nlFilter := nlFilter.(type) {
+	case *netlink.U32:
+		if nlFilter.Sel == nil {
+			// hash table created implicitly by the kernel
+			return nil
+		}
+		_, filter.ClassId = majorMinor32(nlFilter.ClassId)
+		u32 := &linux_tc.Filter_U32Match{}
+		for _, key := range nlFilter.Sel.Keys {
+			if key.Mask == 0 && key.Val == 0 && key.Off == 0 {
+				// match-all key
+				continue
+			}
+			u32.Keys = append(u32.Keys, &linux_tc.Filter_U32Match_Key{
+				Value:  key.Val,
+				Mask:   key.Mask,
+				Offset: key.Off,
+			})
+		}
+		filter.FilterType = &linux_tc.Filter_U32{U32: u32}
+		actions = nlFilter.Actions
+	case *netlink.Flower:
+		flower := &linux_tc.Filter_FlowerMatch{}
+		if nlFilter.SrcIP != nil {
+			flower.SrcNetwork = (&net.IPNet{IP: nlFilter.SrcIP, Mask: nlFilter.SrcIPMask}).String()
+		}
+		if nlFilter.DestIP != nil {
+			flower.DstNetwork = (&net.IPNet{IP: nlFilter.DestIP, Mask: nlFilter.DestIPMask}).String()
+		}
+		filter.FilterType = &linux_tc.Filter_Flower{Flower: flower}
+		actions = nlFilter.Actions
+	default:
+		return nil
+	}
+
+	for _, action := range actions {
+		if _, isGact := action.(*netlink.GenericAction); !isGact {
+			continue
+		}
+		switch action.Attrs().Action {
+		case netlink.TC_ACT_SHOT:
+			filter.Action = linux_tc.Filter_DROP
+		case netlink.TC_ACT_OK:
+			filter.Action = linux_tc.Filter_PASS
+		}
+	}
+	return filter
+}
+
+// gact returns generic tc action with the given verdict.
+func gact(verdict netlink.TcAct) *netlink.GenericAction {
+	return &netlink.GenericAction{
+		ActionAttrs: netlink.ActionAttrs{
+			Action: verdict,
+		},
+	}
+}
+
+func protocolToNetlink(protocol linux_tc.Filter_Protocol) uint16 {
+	switch protocol {
+	case linux_tc.Filter_IPV4:
+		return unix.ETH_P_IP
+	case linux_tc.Filter_IPV6:
+		return unix.ETH_P_IPV6
+	case linux_tc.Filter_ARP:
+		return unix.ETH_P_ARP
+	case linux_tc.Filter_VLAN:
+		return unix.ETH_P_8021Q
+	}
+	return unix.ETH_P_ALL
+}
+
+func protocolFromNetlink(protocol uint16) linux_tc.Filter_Protocol {
+	switch protocol {
+	case unix.ETH_P_IP:
+		return linux_tc.Filter_IPV4
+	case unix.ETH_P_IPV6:
+		return linux_tc.Filter_IPV6
+	case unix.ETH_P_ARP:
+		return linux_tc.Filter_ARP
+	case unix.ETH_P_8021Q:
+		return linux_tc.Filter_VLAN
+	}
+	return linux_tc.Filter_ALL
+}
+
+func majorMinor32(handle uint32) (uint32, uint32) {
+	major, minor := netlink.MajorMinor(handle)
+	return uint32(major), uint32(minor)
+}
+
+func ticksToUsec(ticks uint32) uint32 {
+	return uint32(math.Round(float64(ticks) / netlink.TickInUsec()))
+}
+
+func u32ToPercentage(value uint32) float32 {
+	return float32(math.Round(float64(value)/math.MaxUint32*10000) / 100)
+}
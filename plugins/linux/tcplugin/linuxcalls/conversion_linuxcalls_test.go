@@ -0,0 +1,276 @@
+// +build !windows,!darwin
+
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/proto"
+
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+func TestQdiscConversion(t *testing.T) {
+	RegisterTestingT(t)
+
+	tests := []struct {
+		name  string
+		qdisc *linux_tc.Qdisc
+	}{
+		{
+			name: "root HTB",
+			qdisc: &linux_tc.Qdisc{
+				Interface: "eth0",
+				Handle:    1,
+				Parent:    linux_tc.Qdisc_ROOT,
+				QdiscType: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbParams{
+					DefaultClass: 10,
+					Rate2Quantum: 5,
+				}},
+			},
+		},
+		{
+			name: "FQ-CoDel attached to class",
+			qdisc: &linux_tc.Qdisc{
+				Interface:   "eth0",
+				Handle:      20,
+				Parent:      linux_tc.Qdisc_CLASS,
+				ParentQdisc: 1,
+				ParentClass: 10,
+				QdiscType: &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelParams{
+					Limit:      1024,
+					Flows:      256,
+					IntervalUs: 100000,
+					Quantum:    1514,
+					Ecn:        true,
+				}},
+			},
+		},
+		{
+			name: "ingress",
+			qdisc: &linux_tc.Qdisc{
+				Interface: "eth0",
+				Handle:    linux_tc.IngressHandle,
+				Parent:    linux_tc.Qdisc_INGRESS,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nlQdisc := QdiscToNetlink(test.qdisc, 5)
+			Expect(nlQdisc.Attrs().LinkIndex).To(Equal(5))
+			qdisc := qdiscFromNetlink("eth0", nlQdisc)
+			Expect(proto.Equal(qdisc, test.qdisc)).To(BeTrue(), "converted qdisc: %v", qdisc)
+		})
+	}
+}
+
+func TestQdiscConversionLossy(t *testing.T) {
+	RegisterTestingT(t)
+
+	tbf := &linux_tc.Qdisc{
+		Interface: "eth0",
+		Handle:    1,
+		Parent:    linux_tc.Qdisc_ROOT,
+		QdiscType: &linux_tc.Qdisc_Tbf{Tbf: &linux_tc.Qdisc_TbfParams{
+			Rate:  125000,
+			Burst: 10000,
+			Limit: 30000,
+		}},
+	}
+	nlTbf, isTbf := QdiscToNetlink(tbf, 5).(*netlink.Tbf)
+	Expect(isTbf).To(BeTrue())
+	Expect(nlTbf.Rate).To(BeEquivalentTo(125000))
+	qdisc := qdiscFromNetlink("eth0", nlTbf)
+	Expect(qdisc.GetTbf().GetRate()).To(BeEquivalentTo(125000))
+	Expect(qdisc.GetTbf().GetLimit()).To(BeEquivalentTo(30000))
+	Expect(qdisc.GetTbf().GetBurst()).To(BeNumerically("~", 10000, 100))
+
+	netem := &linux_tc.Qdisc{
+		Interface: "eth0",
+		Handle:    2,
+		Parent:    linux_tc.Qdisc_ROOT,
+		QdiscType: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemParams{
+			LatencyUs: 10000,
+			JitterUs:  1000,
+			Loss:      1.5,
+			Duplicate: 0.25,
+			Limit:     2000,
+		}},
+	}
+	nlNetem, isNetem := QdiscToNetlink(netem, 5).(*netlink.Netem)
+	Expect(isNetem).To(BeTrue())
+	qdisc = qdiscFromNetlink("eth0", nlNetem)
+	Expect(qdisc.GetNetem().GetLatencyUs()).To(BeNumerically("~", 10000, 100))
+	Expect(qdisc.GetNetem().GetJitterUs()).To(BeNumerically("~", 1000, 10))
+	Expect(qdisc.GetNetem().GetLoss()).To(BeEquivalentTo(1.5))
+	Expect(qdisc.GetNetem().GetDuplicate()).To(BeEquivalentTo(0.25))
+	Expect(qdisc.GetNetem().GetLimit()).To(BeEquivalentTo(2000))
+}
+
+func TestQdiscFromNetlinkUnsupported(t *testing.T) {
+	RegisterTestingT(t)
+
+	// kernel default qdisc
+	Expect(qdiscFromNetlink("eth0", &netlink.GenericQdisc{
+		QdiscAttrs: netlink.QdiscAttrs{Parent: netlink.HANDLE_ROOT},
+		QdiscType:  "noqueue",
+	})).To(BeNil())
+
+	// qdisc type not supported by the model
+	Expect(qdiscFromNetlink("eth0", &netlink.Prio{
+		QdiscAttrs: netlink.QdiscAttrs{
+			Handle: netlink.MakeHandle(1, 0),
+			Parent: netlink.HANDLE_ROOT,
+		},
+	})).To(BeNil())
+}
+
+func TestClassConversion(t *testing.T) {
+	RegisterTestingT(t)
+
+	class := &linux_tc.Class{
+		Interface:   "eth0",
+		Qdisc:       1,
+		ClassId:     20,
+		ParentClass: 10,
+		ClassType: &linux_tc.Class_Htb{Htb: &linux_tc.Class_HtbParams{
+			Rate:    125000,
+			Ceil:    250000,
+			Burst:   16000,
+			Cburst:  32000,
+			Prio:    2,
+			Quantum: 1514,
+		}},
+	}
+	nlClass, isHtb := ClassToNetlink(class, 5).(*netlink.HtbClass)
+	Expect(isHtb).To(BeTrue())
+	Expect(nlClass.LinkIndex).To(Equal(5))
+	Expect(nlClass.Handle).To(Equal(netlink.MakeHandle(1, 20)))
+	Expect(nlClass.Parent).To(Equal(netlink.MakeHandle(1, 10)))
+
+	converted := classFromNetlink("eth0", nlClass)
+	Expect(converted.GetInterface()).To(Equal("eth0"))
+	Expect(converted.GetQdisc()).To(BeEquivalentTo(1))
+	Expect(converted.GetClassId()).To(BeEquivalentTo(20))
+	Expect(converted.GetParentClass()).To(BeEquivalentTo(10))
+	htb := converted.GetHtb()
+	Expect(htb.GetRate()).To(BeEquivalentTo(125000))
+	Expect(htb.GetCeil()).To(BeEquivalentTo(250000))
+	Expect(htb.GetBurst()).To(BeNumerically("~", 16000, 160))
+	Expect(htb.GetCburst()).To(BeNumerically("~", 32000, 320))
+	Expect(htb.GetPrio()).To(BeEquivalentTo(2))
+	Expect(htb.GetQuantum()).To(BeEquivalentTo(1514))
+
+	// class types not supported by the model
+	Expect(classFromNetlink("eth0", &netlink.GenericClass{ClassType: "hfsc"})).To(BeNil())
+}
+
+func TestFilterConversion(t *testing.T) {
+	RegisterTestingT(t)
+
+	tests := []struct {
+		name   string
+		filter *linux_tc.Filter
+	}{
+		{
+			name: "U32 classifying into class",
+			filter: &linux_tc.Filter{
+				Interface: "eth0",
+				Qdisc:     1,
+				Priority:  10,
+				Protocol:  linux_tc.Filter_IPV4,
+				ClassId:   20,
+				FilterType: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Match{
+					Keys: []*linux_tc.Filter_U32Match_Key{
+						{Value: 0x0a000000, Mask: 0xff000000, Offset: 16},
+					},
+				}},
+			},
+		},
+		{
+			name: "U32 matching all packets",
+			filter: &linux_tc.Filter{
+				Interface:  "eth0",
+				Qdisc:      linux_tc.IngressHandle,
+				Priority:   1,
+				Protocol:   linux_tc.Filter_ALL,
+				Action:     linux_tc.Filter_PASS,
+				FilterType: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Match{}},
+			},
+		},
+		{
+			name: "flower dropping packets",
+			filter: &linux_tc.Filter{
+				Interface: "eth0",
+				Qdisc:     linux_tc.IngressHandle,
+				Priority:  2,
+				Protocol:  linux_tc.Filter_IPV4,
+				Action:    linux_tc.Filter_DROP,
+				FilterType: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerMatch{
+					SrcNetwork: "10.0.0.0/24",
+					DstNetwork: "192.168.1.1/32",
+				}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nlFilter, err := FilterToNetlink(test.filter, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nlFilter.Attrs().LinkIndex).To(Equal(5))
+			filter := filterFromNetlink("eth0", nlFilter)
+			Expect(proto.Equal(filter, test.filter)).To(BeTrue(), "converted filter: %v", filter)
+		})
+	}
+}
+
+func TestFilterConversionErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := FilterToNetlink(&linux_tc.Filter{Interface: "eth0", Qdisc: 1, Priority: 1}, 5)
+	Expect(err).To(HaveOccurred())
+
+	_, err = FilterToNetlink(&linux_tc.Filter{
+		Interface: "eth0",
+		Qdisc:     1,
+		Priority:  1,
+		FilterType: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerMatch{
+			SrcNetwork: "10.0.0.0",
+		}},
+	}, 5)
+	Expect(err).To(HaveOccurred())
+
+	// hash table created implicitly by the kernel for U32 filters
+	Expect(filterFromNetlink("eth0", &netlink.U32{})).To(BeNil())
+}
+
+func TestProtocolConversion(t *testing.T) {
+	RegisterTestingT(t)
+
+	for protocol := range linux_tc.Filter_Protocol_name {
+		protocol := linux_tc.Filter_Protocol(protocol)
+		Expect(protocolFromNetlink(protocolToNetlink(protocol))).To(Equal(protocol))
+	}
+	Expect(protocolToNetlink(linux_tc.Filter_ALL)).To(BeEquivalentTo(unix.ETH_P_ALL))
+	Expect(protocolToNetlink(linux_tc.Filter_IPV6)).To(BeEquivalentTo(unix.ETH_P_IPV6))
+}
@@ -0,0 +1,180 @@
+// +build !windows,!darwin
+
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+)
+
+// GetQdiscs reads all qdiscs attached to the interface with the given index.
+func (h *NetLinkHandler) GetQdiscs(interfaceIdx int) ([]netlink.Qdisc, error) {
+	link, err := netlink.LinkByIndex(interfaceIdx)
+	if err != nil {
+		return nil, err
+	}
+	return netlink.QdiscList(link)
+}
+
+// GetClasses reads all traffic classes of the qdisc with the given handle.
+func (h *NetLinkHandler) GetClasses(interfaceIdx int, qdisc uint32) ([]netlink.Class, error) {
+	link, err := netlink.LinkByIndex(interfaceIdx)
+	if err != nil {
+		return nil, err
+	}
+	return netlink.ClassList(link, netlink.MakeHandle(uint16(qdisc), 0))
+}
+
+// GetFilters reads all filters attached to the qdisc with the given handle.
+func (h *NetLinkHandler) GetFilters(interfaceIdx int, qdisc uint32) ([]netlink.Filter, error) {
+	link, err := netlink.LinkByIndex(interfaceIdx)
+	if err != nil {
+		return nil, err
+	}
+	return netlink.FilterList(link, netlink.MakeHandle(uint16(qdisc), 0))
+}
+
+// DumpQdiscs reads qdiscs of all interfaces managed by the agent and returns
+// them as details with proto-modeled data and additional metadata.
+func (h *NetLinkHandler) DumpQdiscs() (qdiscDetails []*QdiscDetails, err error) {
+	err = h.walkInterfaces(func(ifName string, ifIdx int) error {
+		nlQdiscs, err := h.GetQdiscs(ifIdx)
+		if err != nil {
+			return errors.Errorf("failed to list qdiscs of interface %s: %v", ifName, err)
+		}
+		for _, nlQdisc := range nlQdiscs {
+			if qdisc := qdiscFromNetlink(ifName, nlQdisc); qdisc != nil {
+				qdiscDetails = append(qdiscDetails, &QdiscDetails{
+					Qdisc: qdisc,
+					Meta: &TcMeta{
+						InterfaceIndex: uint32(ifIdx),
+						Kind:           nlQdisc.Type(),
+					},
+				})
+			}
+		}
+		return nil
+	})
+	return qdiscDetails, err
+}
+
+// DumpClasses reads traffic classes of all interfaces managed by the agent and
+// returns them as details with proto-modeled data and additional metadata.
+func (h *NetLinkHandler) DumpClasses() (classDetails []*ClassDetails, err error) {
+	err = h.walkQdiscs(func(ifName string, ifIdx int, qdisc uint32) error {
+		nlClasses, err := h.GetClasses(ifIdx, qdisc)
+		if err != nil {
+			return errors.Errorf("failed to list classes of qdisc %d (interface %s): %v", qdisc, ifName, err)
+		}
+		for _, nlClass := range nlClasses {
+			if class := classFromNetlink(ifName, nlClass); class != nil {
+				classDetails = append(classDetails, &ClassDetails{
+					Class: class,
+					Meta: &TcMeta{
+						InterfaceIndex: uint32(ifIdx),
+						Kind:           nlClass.Type(),
+					},
+				})
+			}
+		}
+		return nil
+	})
+	return classDetails, err
+}
+
+// DumpFilters reads tc filters of all interfaces managed by the agent and
+// returns them as details with proto-modeled data and additional metadata.
+func (h *NetLinkHandler) DumpFilters() (filterDetails []*FilterDetails, err error) {
+	err = h.walkQdiscs(func(ifName string, ifIdx int, qdisc uint32) error {
+		nlFilters, err := h.GetFilters(ifIdx, qdisc)
+		if err != nil {
+			return errors.Errorf("failed to list filters of qdisc %d (interface %s): %v", qdisc, ifName, err)
+		}
+		for _, nlFilter := range nlFilters {
+			if filter := filterFromNetlink(ifName, nlFilter); filter != nil {
+				filterDetails = append(filterDetails, &FilterDetails{
+					Filter: filter,
+					Meta: &TcMeta{
+						InterfaceIndex: uint32(ifIdx),
+						Kind:           nlFilter.Type(),
+					},
+				})
+			}
+		}
+		return nil
+	})
+	return filterDetails, err
+}
+
+// walkQdiscs calls <visit> for every non-default qdisc of every interface
+// managed by the agent, from within the namespace of the interface.
+func (h *NetLinkHandler) walkQdiscs(visit func(ifName string, ifIdx int, qdisc uint32) error) error {
+	return h.walkInterfaces(func(ifName string, ifIdx int) error {
+		nlQdiscs, err := h.GetQdiscs(ifIdx)
+		if err != nil {
+			return errors.Errorf("failed to list qdiscs of interface %s: %v", ifName, err)
+		}
+		for _, nlQdisc := range nlQdiscs {
+			handle, _ := netlink.MajorMinor(nlQdisc.Attrs().Handle)
+			if handle == 0 {
+				// default qdisc assigned by the kernel
+				continue
+			}
+			if err := visit(ifName, ifIdx, uint32(handle)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// walkInterfaces calls <visit> for every interface managed by the agent,
+// from within the namespace of the interface.
+func (h *NetLinkHandler) walkInterfaces(visit func(ifName string, ifIdx int) error) error {
+	nsCtx := linuxcalls.NewNamespaceMgmtCtx()
+
+	for _, ifName := range h.ifIndexes.ListAllInterfaces() {
+		// get interface metadata
+		ifMeta, found := h.ifIndexes.LookupByName(ifName)
+		if !found || ifMeta == nil {
+			err := errors.Errorf("failed to obtain metadata for interface %s", ifName)
+			h.log.Error(err)
+			return err
+		}
+
+		// switch to the namespace of the interface
+		revertNs, err := h.nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+		if err != nil {
+			// namespace and all the traffic control it had contained no longer exist
+			h.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": ifMeta.Namespace,
+			}).Warn("Failed to retrieve traffic control from the namespace")
+			continue
+		}
+		err = visit(ifName, ifMeta.LinuxIfIndex)
+		revertNs()
+		if err != nil {
+			h.log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
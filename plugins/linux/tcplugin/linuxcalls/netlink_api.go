@@ -0,0 +1,130 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"github.com/vishvananda/netlink"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+// QdiscDetails is an object combining linux qdisc data based on proto
+// model with additional metadata
+type QdiscDetails struct {
+	Qdisc *linux_tc.Qdisc `json:"linux_qdisc"`
+	Meta  *TcMeta         `json:"linux_qdisc_meta"`
+}
+
+// ClassDetails is an object combining linux traffic class data based on proto
+// model with additional metadata
+type ClassDetails struct {
+	Class *linux_tc.Class `json:"linux_class"`
+	Meta  *TcMeta         `json:"linux_class_meta"`
+}
+
+// FilterDetails is an object combining linux tc filter data based on proto
+// model with additional metadata
+type FilterDetails struct {
+	Filter *linux_tc.Filter `json:"linux_filter"`
+	Meta   *TcMeta          `json:"linux_filter_meta"`
+}
+
+// TcMeta represents linux traffic control object metadata
+type TcMeta struct {
+	InterfaceIndex uint32 `json:"interface_index"`
+	Kind           string `json:"kind"`
+}
+
+// NetlinkAPI interface covers all methods inside linux calls package needed
+// to manage linux qdiscs, classes and filters.
+type NetlinkAPI interface {
+	NetlinkAPIWrite
+	NetlinkAPIRead
+}
+
+// NetlinkAPIWrite interface covers write methods inside linux calls package
+// needed to manage linux qdiscs, classes and filters.
+type NetlinkAPIWrite interface {
+	/* Qdisc */
+	// AddQdisc adds new qdisc.
+	AddQdisc(qdisc netlink.Qdisc) error
+	// ReplaceQdisc changes parameters of an existing qdisc.
+	ReplaceQdisc(qdisc netlink.Qdisc) error
+	// DelQdisc removes qdisc (together with all its classes and filters).
+	DelQdisc(qdisc netlink.Qdisc) error
+
+	/* Class */
+	// AddClass adds new traffic class.
+	AddClass(class netlink.Class) error
+	// ReplaceClass changes parameters of an existing traffic class.
+	ReplaceClass(class netlink.Class) error
+	// DelClass removes traffic class.
+	DelClass(class netlink.Class) error
+
+	/* Filter */
+	// AddFilter adds new tc filter.
+	AddFilter(filter netlink.Filter) error
+	// ReplaceFilter changes an existing tc filter.
+	ReplaceFilter(filter netlink.Filter) error
+	// DelFilter removes tc filter.
+	DelFilter(filter netlink.Filter) error
+}
+
+// NetlinkAPIRead interface covers read methods inside linux calls package
+// needed to manage linux qdiscs, classes and filters.
+type NetlinkAPIRead interface {
+	// GetQdiscs reads all qdiscs attached to the interface with the given index.
+	GetQdiscs(interfaceIdx int) ([]netlink.Qdisc, error)
+
+	// GetClasses reads all traffic classes of the qdisc with the given handle.
+	GetClasses(interfaceIdx int, qdisc uint32) ([]netlink.Class, error)
+
+	// GetFilters reads all filters attached to the qdisc with the given handle.
+	GetFilters(interfaceIdx int, qdisc uint32) ([]netlink.Filter, error)
+
+	// DumpQdiscs reads qdiscs of all interfaces managed by the agent and returns
+	// them as details with proto-modeled data and additional metadata.
+	// Kernel default qdiscs and qdisc types not supported by the model are skipped.
+	DumpQdiscs() ([]*QdiscDetails, error)
+
+	// DumpClasses reads traffic classes of all interfaces managed by the agent and
+	// returns them as details with proto-modeled data and additional metadata.
+	DumpClasses() ([]*ClassDetails, error)
+
+	// DumpFilters reads tc filters of all interfaces managed by the agent and
+	// returns them as details with proto-modeled data and additional metadata.
+	DumpFilters() ([]*FilterDetails, error)
+}
+
+// NetLinkHandler is accessor for Netlink methods.
+type NetLinkHandler struct {
+	nsPlugin  nsplugin.API
+	ifIndexes ifaceidx.LinuxIfMetadataIndex
+
+	log logging.Logger
+}
+
+// NewNetLinkHandler creates new instance of Netlink handler.
+func NewNetLinkHandler(nsPlugin nsplugin.API, ifIndexes ifaceidx.LinuxIfMetadataIndex,
+	log logging.Logger) *NetLinkHandler {
+	return &NetLinkHandler{
+		nsPlugin:  nsPlugin,
+		ifIndexes: ifIndexes,
+		log:       log,
+	}
+}
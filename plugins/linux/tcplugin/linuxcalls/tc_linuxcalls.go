@@ -0,0 +1,66 @@
+// +build !windows,!darwin
+
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+// AddQdisc adds new qdisc.
+func (h *NetLinkHandler) AddQdisc(qdisc netlink.Qdisc) error {
+	return netlink.QdiscAdd(qdisc)
+}
+
+// ReplaceQdisc changes parameters of an existing qdisc.
+func (h *NetLinkHandler) ReplaceQdisc(qdisc netlink.Qdisc) error {
+	return netlink.QdiscReplace(qdisc)
+}
+
+// DelQdisc removes qdisc (together with all its classes and filters).
+func (h *NetLinkHandler) DelQdisc(qdisc netlink.Qdisc) error {
+	return netlink.QdiscDel(qdisc)
+}
+
+// AddClass adds new traffic class.
+func (h *NetLinkHandler) AddClass(class netlink.Class) error {
+	return netlink.ClassAdd(class)
+}
+
+// ReplaceClass changes parameters of an existing traffic class.
+func (h *NetLinkHandler) ReplaceClass(class netlink.Class) error {
+	return netlink.ClassReplace(class)
+}
+
+// DelClass removes traffic class.
+func (h *NetLinkHandler) DelClass(class netlink.Class) error {
+	return netlink.ClassDel(class)
+}
+
+// AddFilter adds new tc filter.
+func (h *NetLinkHandler) AddFilter(filter netlink.Filter) error {
+	return netlink.FilterAdd(filter)
+}
+
+// ReplaceFilter changes an existing tc filter.
+func (h *NetLinkHandler) ReplaceFilter(filter netlink.Filter) error {
+	return netlink.FilterReplace(filter)
+}
+
+// DelFilter removes tc filter.
+func (h *NetLinkHandler) DelFilter(filter netlink.Filter) error {
+	return netlink.FilterDel(filter)
+}
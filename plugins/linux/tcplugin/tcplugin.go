@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Qdisc --value-type *linux_tc.Qdisc --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name Class --value-type *linux_tc.Class --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name Filter --value-type *linux_tc.Filter --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc" --output-dir "descriptor"
+
+package tcplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+)
+
+// TCPlugin configures Linux traffic control (qdiscs, classes and filters)
+// of interfaces managed by the agent using Netlink API.
+type TCPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	tcHandler linuxcalls.NetlinkAPI
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+}
+
+// Config holds the tcplugin configuration.
+type Config struct {
+	Disabled bool `json:"disabled"`
+}
+
+// Init initializes and registers descriptors for Linux qdiscs, classes and filters.
+func (p *TCPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux TC plugin config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling Linux TC plugin")
+		return nil
+	}
+
+	// init handlers
+	p.tcHandler = linuxcalls.NewNetLinkHandler(p.NsPlugin, p.IfPlugin.GetInterfaceIndex(), p.Log)
+
+	// init & register descriptors
+	qdiscDescriptor := descriptor.NewQdiscDescriptor(p.IfPlugin, p.NsPlugin, p.tcHandler, p.Log)
+	classDescriptor := descriptor.NewClassDescriptor(p.IfPlugin, p.NsPlugin, p.tcHandler, p.Log)
+	filterDescriptor := descriptor.NewFilterDescriptor(p.IfPlugin, p.NsPlugin, p.tcHandler, p.Log)
+
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(qdiscDescriptor, classDescriptor, filterDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close does nothing here.
+func (p *TCPlugin) Close() error {
+	return nil
+}
+
+// retrieveConfig loads TCPlugin configuration file.
+func (p *TCPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux TCPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, err
+}
@@ -0,0 +1,40 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/remotedescriptor"
+)
+
+// Config file representation for orchestrator plugin
+type Config struct {
+	// Connections to remote descriptors registered by external processes
+	RemoteDescriptors remotedescriptor.ClientConfig `json:"remote-descriptors"`
+}
+
+// loadConfig returns orchestrator plugin file configuration (empty config
+// if the file does not exist)
+func (p *Plugin) loadConfig() (*Config, error) {
+	cfg := &Config{}
+
+	found, err := p.Cfg.LoadValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		p.Log.Debug("Orchestrator config not found")
+	}
+	return cfg, nil
+}
@@ -15,11 +15,20 @@
 // Package contextdecorator handles insertions and extractions of orchestrator related data from context.
 package contextdecorator
 
-import "context"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
 
 type dataSrcKeyT string
 
-var dataSrcKey = dataSrcKeyT("dataSrc")
+var (
+	dataSrcKey   = dataSrcKeyT("dataSrc")
+	peerKey      = dataSrcKeyT("peer")
+	identityKey  = dataSrcKeyT("identity")
+	requestIDKey = dataSrcKeyT("requestID")
+)
 
 func DataSrcContext(ctx context.Context, dataSrc string) context.Context {
 	return context.WithValue(ctx, dataSrcKey, dataSrc)
@@ -29,3 +38,44 @@ func DataSrcFromContext(ctx context.Context) (dataSrc string, ok bool) {
 	dataSrc, ok = ctx.Value(dataSrcKey).(string)
 	return
 }
+
+// PeerContext returns context carrying address of the remote client prefixed
+// with the protocol (i.e. "grpc://10.0.0.1:51234").
+func PeerContext(ctx context.Context, peer string) context.Context {
+	return context.WithValue(ctx, peerKey, peer)
+}
+
+// PeerFromContext returns address of the remote client carried by the context.
+func PeerFromContext(ctx context.Context) (peer string, ok bool) {
+	peer, ok = ctx.Value(peerKey).(string)
+	return
+}
+
+// IdentityContext returns context carrying authenticated identity of the remote client.
+func IdentityContext(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityKey, identity)
+}
+
+// IdentityFromContext returns authenticated identity of the remote client carried by the context.
+func IdentityFromContext(ctx context.Context) (identity string, ok bool) {
+	identity, ok = ctx.Value(identityKey).(string)
+	return
+}
+
+// RequestIDContext returns context carrying ID of the request.
+func RequestIDContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns ID of the request carried by the context.
+func RequestIDFromContext(ctx context.Context) (requestID string, ok bool) {
+	requestID, ok = ctx.Value(requestIDKey).(string)
+	return
+}
+
+// NewRequestID generates random ID for request that does not have one assigned by the client.
+func NewRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
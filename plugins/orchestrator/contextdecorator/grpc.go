@@ -0,0 +1,75 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contextdecorator
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// DataSrcMetadataKey is gRPC metadata key used by clients to select data source.
+	DataSrcMetadataKey = "datasrc"
+	// RequestIDMetadataKey is gRPC metadata key (and HTTP header) carrying request ID.
+	RequestIDMetadataKey = "x-request-id"
+	// IdempotencyKeyMetadataKey is gRPC metadata key used by clients to identify
+	// request and its re-submissions, which are then not applied repeatedly.
+	IdempotencyKeyMetadataKey = "x-idempotency-key"
+	// SimulationMetadataKey is gRPC metadata key used by clients to request simulation
+	// of the transaction before its execution (value "true").
+	SimulationMetadataKey = "x-simulation"
+
+	defaultGRPCDataSrc = "grpc"
+)
+
+// GRPCContext decorates context of incoming gRPC call with the data source
+// (from metadata, "grpc" by default), address of the remote peer and request ID
+// (from metadata, generated if not set).
+func GRPCContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if dataSrc := md.Get(DataSrcMetadataKey); len(dataSrc) == 1 {
+		ctx = DataSrcContext(ctx, dataSrc[0])
+	} else {
+		ctx = DataSrcContext(ctx, defaultGRPCDataSrc)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ctx = PeerContext(ctx, "grpc://"+p.Addr.String())
+	}
+	requestID := NewRequestID()
+	if ids := md.Get(RequestIDMetadataKey); len(ids) > 0 && ids[0] != "" {
+		requestID = ids[0]
+	}
+	return RequestIDContext(ctx, requestID)
+}
+
+// IdempotencyKey returns idempotency key assigned by the client in metadata
+// of incoming gRPC call.
+func IdempotencyKey(ctx context.Context) (key string, ok bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(IdempotencyKeyMetadataKey); len(keys) > 0 && keys[0] != "" {
+		return keys[0], true
+	}
+	return "", false
+}
+
+// SimulationRequested returns true if the client requested simulation of the transaction
+// in metadata of incoming gRPC call.
+func SimulationRequested(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	sim := md.Get(SimulationMetadataKey)
+	return len(sim) == 1 && sim[0] == "true"
+}
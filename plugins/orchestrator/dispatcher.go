@@ -35,30 +35,54 @@ import (
 type KeyVal struct {
 	Key string
 	Val proto.Message
+	// Labels are user-defined labels of the value (optional).
+	Labels Labels
+	// Precondition (optional) is evaluated with the current value of the key
+	// (nil if not set) atomically with the push, i.e. no other change of the key
+	// can be pushed in between. If the precondition does not hold, nothing is
+	// pushed and PreconditionFailedError is returned.
+	Precondition func(current proto.Message) bool
+}
+
+// PreconditionFailedError is returned by PushData if precondition of some
+// key-value pair does not hold.
+type PreconditionFailedError struct {
+	Key string
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("precondition of key %q failed", e.Key)
 }
 
 // KVPairs represents key-value pairs.
 type KVPairs map[string]proto.Message
 
+// Labels are user-defined labels attached to value.
+type Labels map[string]string
+
 type Status = kvscheduler.ValueStatus
 
 type Result struct {
 	Key    string
 	Status *Status
+	// Op is the change of the value requested by NB (create, update or delete).
+	Op kvscheduler.TxnOperation
 }
 
 type Dispatcher interface {
 	ListData() KVPairs
+	ListLabels() map[string]Labels
 	PushData(context.Context, []KeyVal) ([]Result, error)
 	GetStatus(key string) (*Status, error)
 	ListState() (KVPairs, error)
 }
 
 type dispatcher struct {
-	log logging.Logger
-	kvs kvs.KVScheduler
-	mu  sync.Mutex
-	db  KVStore
+	log    logging.Logger
+	kvs    kvs.KVScheduler
+	mu     sync.Mutex
+	db     KVStore
+	labels map[string]Labels
 }
 
 // ListData retrieves actual data.
@@ -69,6 +93,39 @@ func (p *dispatcher) ListData() KVPairs {
 	return p.db.ListAll()
 }
 
+// ListLabels retrieves labels of actual data.
+func (p *dispatcher) ListLabels() map[string]Labels {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	labels := make(map[string]Labels, len(p.labels))
+	for key, l := range p.labels {
+		labels[key] = l
+	}
+	return labels
+}
+
+// setLabels sets labels of the value stored under key (nil labels are removed).
+func (p *dispatcher) setLabels(key string, labels Labels) {
+	if len(labels) == 0 {
+		delete(p.labels, key)
+		return
+	}
+	if p.labels == nil {
+		p.labels = make(map[string]Labels)
+	}
+	p.labels[key] = labels
+}
+
+// getData returns actual data stored under given key (nil if not found).
+func (p *dispatcher) getData(key string) proto.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	val, _ := p.db.Get(key)
+	return val
+}
+
 func (p *dispatcher) GetStatus(key string) (*Status, error) {
 	s := p.kvs.GetValueStatus(key)
 	status := s.GetValue()
@@ -86,8 +143,9 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 	uniq := make(map[string]proto.Message)
 	for _, kv := range kvPairs {
 		if kv.Val != nil {
-			// check if given key matches the key generated from value
-			if k := models.Key(kv.Val); k != kv.Key {
+			// check if given key (without instance scope) matches the key generated from value
+			_, key := models.ParseInstanceKey(kv.Key)
+			if k := models.Key(kv.Val); k != key {
 				return nil, errors.Errorf("given key %q does not match with key generated from value: %q (value: %#v)", kv.Key, k, kv.Val)
 			}
 		}
@@ -101,6 +159,17 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	// check preconditions against the current data
+	for _, kv := range kvPairs {
+		if kv.Precondition == nil {
+			continue
+		}
+		current, _ := p.db.Get(kv.Key)
+		if !kv.Precondition(current) {
+			return nil, &PreconditionFailedError{Key: kv.Key}
+		}
+	}
+
 	pr := trace.StartRegion(ctx, "prepare kv data")
 
 	dataSrc, ok := contextdecorator.DataSrcFromContext(ctx)
@@ -110,10 +179,32 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 
 	p.log.Debugf("Push data with %d KV pairs (source: %s)", len(kvPairs), dataSrc)
 
+	// record originator of the change with the transaction
+	origin := kvs.TxnOrigin{DataSource: dataSrc}
+	origin.Peer, _ = contextdecorator.PeerFromContext(ctx)
+	origin.Identity, _ = contextdecorator.IdentityFromContext(ctx)
+	origin.RequestID, _ = contextdecorator.RequestIDFromContext(ctx)
+	ctx = kvs.WithOrigin(ctx, origin)
+
+	// operations requested for the values
+	ops := make(map[string]kvscheduler.TxnOperation, len(kvPairs))
+	for _, kv := range kvPairs {
+		if kv.Val == nil {
+			ops[kv.Key] = kvscheduler.TxnOperation_DELETE
+		} else if _, exists := p.db.Get(kv.Key); exists {
+			ops[kv.Key] = kvscheduler.TxnOperation_UPDATE
+		} else {
+			ops[kv.Key] = kvscheduler.TxnOperation_CREATE
+		}
+	}
+
 	txn := p.kvs.StartNBTransaction()
 
 	if typ, _ := kvs.IsResync(ctx); typ == kvs.FullResync {
 		trace.Log(ctx, "resyncType", typ.String())
+		for key := range p.db.List(dataSrc) {
+			p.setLabels(key, nil)
+		}
 		p.db.Reset(dataSrc)
 		for _, kv := range kvPairs {
 			if kv.Val == nil {
@@ -122,6 +213,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 			}
 			p.log.Debugf(" - PUT: %q ", kv.Key)
 			p.db.Update(dataSrc, kv.Key, kv.Val)
+			p.setLabels(kv.Key, kv.Labels)
 		}
 		allPairs := p.db.ListAll()
 		p.log.Debugf("will resync %d pairs", len(allPairs))
@@ -134,10 +226,12 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 				p.log.Debugf(" - DELETE: %q", kv.Key)
 				txn.SetValue(kv.Key, nil)
 				p.db.Delete(dataSrc, kv.Key)
+				p.setLabels(kv.Key, nil)
 			} else {
 				p.log.Debugf(" - UPDATE: %q ", kv.Key)
 				txn.SetValue(kv.Key, kv.Val)
 				p.db.Update(dataSrc, kv.Key, kv.Val)
+				p.setLabels(kv.Key, kv.Labels)
 			}
 		}
 	}
@@ -159,6 +253,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal) (results []
 		results = append(results, Result{
 			Key:    key,
 			Status: s.GetValue(),
+			Op:     ops[key],
 		})
 	}
 	if err != nil {
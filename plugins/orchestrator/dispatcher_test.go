@@ -0,0 +1,60 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestPushDataPreconditionFailed(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &dispatcher{
+		log: logrus.NewLogger("test-log"),
+		db:  newMemStore(),
+	}
+	iface := &interfaces.Interface{Name: "if1", Mtu: 1500}
+	d.db.Update("grpc", interfaces.InterfaceKey("if1"), iface)
+
+	var checked proto.Message
+	_, err := d.PushData(context.Background(), []KeyVal{
+		{
+			Key: interfaces.InterfaceKey("if2"),
+			Val: &interfaces.Interface{Name: "if2"},
+		},
+		{
+			Key: interfaces.InterfaceKey("if1"),
+			Val: &interfaces.Interface{Name: "if1", Mtu: 9000},
+			Precondition: func(current proto.Message) bool {
+				checked = current
+				return false
+			},
+		},
+	})
+	Expect(err).To(Equal(&PreconditionFailedError{Key: interfaces.InterfaceKey("if1")}))
+	Expect(proto.Equal(checked, iface)).To(BeTrue())
+
+	// nothing was pushed
+	data := d.ListData()
+	Expect(data).To(HaveLen(1))
+	Expect(proto.Equal(data[interfaces.InterfaceKey("if1")], iface)).To(BeTrue())
+}
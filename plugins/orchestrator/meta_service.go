@@ -16,12 +16,10 @@ package orchestrator
 
 import (
 	"fmt"
-	"strings"
 
 	"go.ligato.io/cn-infra/v2/logging"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
@@ -30,9 +28,11 @@ import (
 	"google.golang.org/protobuf/types/descriptorpb"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 type genericService struct {
@@ -41,9 +41,15 @@ type genericService struct {
 
 	log      logging.Logger
 	dispatch Dispatcher
+	subs     Subscriber
+	auth     auth.API
+	requests *requestCache
 }
 
 func (s *genericService) KnownModels(ctx context.Context, req *generic.KnownModelsRequest) (*generic.KnownModelsResponse, error) {
+	if err := s.authorizeRead(ctx, "MetaService.KnownModels"); err != nil {
+		return nil, err
+	}
 	var infos []*generic.ModelDetail
 	for _, model := range models.RegisteredModels() {
 		if req.Class == "" || model.Spec().Class == req.Class {
@@ -57,6 +63,9 @@ func (s *genericService) KnownModels(ctx context.Context, req *generic.KnownMode
 }
 
 func (s *genericService) ProtoFileDescriptor(ctx context.Context, req *generic.ProtoFileDescriptorRequest) (*generic.ProtoFileDescriptorResponse, error) {
+	if err := s.authorizeRead(ctx, "MetaService.ProtoFileDescriptor"); err != nil {
+		return nil, err
+	}
 	for _, model := range models.RegisteredModels() {
 		if req.FullProtoFileName == model.ProtoFile() {
 			fileDesc := model.NewInstance().ProtoReflect().Descriptor().ParentFile()
@@ -81,7 +90,7 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 	}
 	s.log.Debug("------------------------------")
 
-	var ops = make(map[string]generic.UpdateResult_Operation)
+	var ids = make(map[string]*generic.Item_ID)
 	var kvPairs []KeyVal
 
 	for _, update := range req.Updates {
@@ -104,108 +113,141 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 			if err != nil {
 				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
-			ops[key] = generic.UpdateResult_UPDATE
 		} else if item.Id != nil {
 			model, err := models.GetModelForItem(item)
 			if err != nil {
 				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 			key = model.KeyPrefix() + item.Id.Name
-			ops[key] = generic.UpdateResult_DELETE
 		} else {
 			return nil, status.Error(codes.InvalidArgument, "ProtoItem has no key or val defined.")
 		}
+		ids[key] = item.Id
 		kvPairs = append(kvPairs, KeyVal{
-			Key: key,
-			Val: val,
+			Key:    key,
+			Val:    val,
+			Labels: update.Labels,
 		})
 	}
 
-	md, hasMeta := metadata.FromIncomingContext(ctx)
-	if hasMeta && len(md["datasrc"]) == 1 {
-		ctx = contextdecorator.DataSrcContext(ctx, md["datasrc"][0])
-	} else {
-		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
+	keys := make([]string, 0, len(kvPairs))
+	for _, kv := range kvPairs {
+		keys = append(keys, kv.Key)
 	}
+	ctx, err := auth.AuthorizeGRPC(s.auth, ctx, auth.Operation{
+		Name:       "GenericManager.SetConfig",
+		Access:     auth.WriteAccess,
+		Keys:       keys,
+		FullResync: req.OverwriteAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = contextdecorator.GRPCContext(ctx)
 	if req.OverwriteAll {
 		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 	}
+	if contextdecorator.SimulationRequested(ctx) {
+		ctx = kvs.WithSimulation(ctx)
+	}
 	ctx = kvs.WithRetryDefault(ctx)
+
+	push := func() (*generic.SetConfigResponse, error) {
+		return s.pushData(ctx, kvPairs, ids)
+	}
+	// request re-submitted by the client gets reply of the original request
+	if idempotencyKey, ok := contextdecorator.IdempotencyKey(ctx); ok && s.requests != nil {
+		identity, _ := contextdecorator.IdentityFromContext(ctx)
+		key, err := requestCacheKey(identity, idempotencyKey, req)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return s.requests.do(ctx, key, push)
+	}
+	return push()
+}
+
+// pushData pushes the changes to dispatcher and returns results for all changed items.
+// Results of failed transaction are returned also as details of the error status.
+func (s *genericService) pushData(ctx context.Context, kvPairs []KeyVal,
+	ids map[string]*generic.Item_ID) (*generic.SetConfigResponse, error) {
+
 	results, err := s.dispatch.PushData(ctx, kvPairs)
-	if err != nil {
+	if err != nil && results == nil {
 		st := status.New(codes.FailedPrecondition, err.Error())
 		return nil, st.Err()
 	}
 
 	updateResults := []*generic.UpdateResult{}
 	for _, res := range results {
-		var msg string
-		if details := res.Status.GetDetails(); len(details) > 0 {
-			msg = strings.Join(res.Status.GetDetails(), ", ")
-		} else {
-			msg = res.Status.GetError()
-		}
 		updateResults = append(updateResults, &generic.UpdateResult{
-			Key: res.Key,
-			Status: &generic.ItemStatus{
-				Status:  res.Status.State.String(),
-				Message: msg,
-			},
-			// Op: res.Status.LastOperation.String(),
+			Id:     ids[res.Key],
+			Key:    res.Key,
+			Op:     updateOperation(res.Op),
+			Status: itemStatus(res.Status),
 		})
 	}
+	resp := &generic.SetConfigResponse{Results: updateResults}
 
-	/*
-		// commit the transaction
-		if err := txn.Commit(); err != nil {
-			st := status.New(codes.FailedPrecondition, err.Error())
-			return nil, st.Err()
-			// TODO: use the WithDetails to return extra info to clients.
-			//ds, err := st.WithDetails(&rpc.DebugInfo{Detail: "Local transaction failed!"})
-			//if err != nil {
-			//	return nil, st.Err()
-			//}
-			//return nil, ds.Err()
+	if err != nil {
+		st := status.New(codes.FailedPrecondition, err.Error())
+		if ds, derr := st.WithDetails(resp); derr == nil {
+			st = ds
+		} else {
+			s.log.Warnf("attaching results to error status failed: %v", derr)
 		}
-	*/
+		return resp, st.Err()
+	}
+	return resp, nil
+}
 
-	return &generic.SetConfigResponse{Results: updateResults}, nil
+// updateOperation converts operation requested for the value to operation of update result.
+func updateOperation(op kvscheduler.TxnOperation) generic.UpdateResult_Operation {
+	switch op {
+	case kvscheduler.TxnOperation_CREATE:
+		return generic.UpdateResult_CREATE
+	case kvscheduler.TxnOperation_UPDATE:
+		return generic.UpdateResult_UPDATE
+	case kvscheduler.TxnOperation_DELETE:
+		return generic.UpdateResult_DELETE
+	}
+	return generic.UpdateResult_UNSPECIFIED
 }
 
-func (s *genericService) GetConfig(context.Context, *generic.GetConfigRequest) (*generic.GetConfigResponse, error) {
+func (s *genericService) GetConfig(ctx context.Context, _ *generic.GetConfigRequest) (*generic.GetConfigResponse, error) {
+	if err := s.authorizeRead(ctx, "GenericManager.GetConfig"); err != nil {
+		return nil, err
+	}
 	var items []*generic.ConfigItem
 
+	labels := s.dispatch.ListLabels()
 	for key, data := range s.dispatch.ListData() {
 		item, err := models.MarshalItem(data)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-		var itemStatus *generic.ItemStatus
+		var status *generic.ItemStatus
 		st, err := s.dispatch.GetStatus(key)
 		if err != nil {
 			s.log.Warnf("GetStatus failed: %v", err)
 		} else {
-			var msg string
-			if details := st.GetDetails(); len(details) > 0 {
-				msg = strings.Join(st.GetDetails(), ", ")
-			} else {
-				msg = st.GetError()
-			}
-			itemStatus = &generic.ItemStatus{
-				Status:  st.GetState().String(),
-				Message: msg,
-			}
+			status = itemStatus(st)
 		}
 		items = append(items, &generic.ConfigItem{
 			Item:   item,
-			Status: itemStatus,
+			Status: status,
+			Labels: labels[key],
 		})
 	}
 
 	return &generic.GetConfigResponse{Items: items}, nil
 }
 
-func (s *genericService) DumpState(context.Context, *generic.DumpStateRequest) (*generic.DumpStateResponse, error) {
+func (s *genericService) DumpState(ctx context.Context, _ *generic.DumpStateRequest) (*generic.DumpStateResponse, error) {
+	if err := s.authorizeRead(ctx, "GenericManager.DumpState"); err != nil {
+		return nil, err
+	}
 	pairs, err := s.dispatch.ListState()
 	if err != nil {
 		return nil, err
@@ -237,7 +279,30 @@ func (s *genericService) DumpState(context.Context, *generic.DumpStateRequest) (
 }
 
 func (s *genericService) Subscribe(req *generic.SubscribeRequest, server generic.ManagerService_SubscribeServer) error {
-	return status.Error(codes.Unimplemented, "Not implemented yet")
+	if err := s.authorizeRead(server.Context(), "GenericManager.Subscribe"); err != nil {
+		return err
+	}
+	if s.subs == nil {
+		return status.Error(codes.Unimplemented, "subscriptions are not available")
+	}
+	if err := validateSubscriptions(req.GetSubscriptions()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.log.Debugf("=> GenericMgr.Subscribe: %d subscriptions", len(req.GetSubscriptions()))
+	err := s.subs.Subscribe(server.Context(), req.GetSubscriptions(), server.Send)
+	if err == context.Canceled {
+		return nil
+	}
+	if err == ErrSlowSubscriber {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+// authorizeRead authorizes caller of gRPC call to read configuration or state.
+func (s *genericService) authorizeRead(ctx context.Context, name string) error {
+	_, err := auth.AuthorizeGRPC(s.auth, ctx, auth.Operation{Name: name, Access: auth.ReadAccess})
+	return err
 }
 
 // toImportSet performs convenient format conversion to descriptor.FileDescriptorSet
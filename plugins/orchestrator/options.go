@@ -19,6 +19,7 @@ import (
 	"go.ligato.io/cn-infra/v2/datasync/kvdbsync/local"
 	"go.ligato.io/cn-infra/v2/rpc/grpc"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
 )
 
@@ -31,6 +32,7 @@ func NewPlugin(opts ...Option) *Plugin {
 
 	p.PluginName = "orchestrator"
 	p.GRPC = &grpc.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 	p.Watcher = local.DefaultRegistry
 	p.reflection = true
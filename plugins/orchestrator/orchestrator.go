@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
@@ -48,7 +49,9 @@ type Plugin struct {
 	Deps
 
 	*dispatcher
-	manager *genericService
+	*subscriptionRegistry
+	manager        *genericService
+	remoteRegistry *remoteRegistryService
 
 	reflection bool
 
@@ -66,6 +69,7 @@ type Deps struct {
 	infra.PluginDeps
 
 	GRPC            grpc.Server
+	Auth            auth.API
 	KVScheduler     kvs.KVScheduler
 	Watcher         datasync.KeyValProtoWatcher
 	StatusPublisher datasync.KeyProtoValWriter
@@ -81,16 +85,32 @@ func (p *Plugin) Init() (err error) {
 		kvs: p.KVScheduler,
 	}
 
+	p.subscriptionRegistry = newSubscriptionRegistry(p.dispatcher)
+
 	// register grpc service
 	p.manager = &genericService{
 		log:      p.log,
 		dispatch: p.dispatcher,
+		subs:     p.subscriptionRegistry,
+		auth:     p.Auth,
+		requests: newRequestCache(requestCacheSize),
+	}
+	cfg, err := p.loadConfig()
+	if err != nil {
+		return err
+	}
+	dialOpts, err := cfg.RemoteDescriptors.DialOptions()
+	if err != nil {
+		return err
 	}
+	p.remoteRegistry = newRemoteRegistryService(
+		logging.DefaultRegistry.NewLogger("remote-registry"), p.KVScheduler, p.Auth, dialOpts)
 
 	if grpcServer := p.GRPC.GetServer(); grpcServer != nil {
 		p.Log.Debugf("registering generic manager and meta service")
 		generic.RegisterManagerServiceServer(grpcServer, p.manager)
 		generic.RegisterMetaServiceServer(grpcServer, p.manager)
+		kvscheduler.RegisterRemoteRegistryServiceServer(grpcServer, p.remoteRegistry)
 
 		// register grpc services for reflection
 		if p.reflection {
@@ -106,6 +126,8 @@ func (p *Plugin) Init() (err error) {
 		p.debugf("- model: %+v", *model.Spec())
 	}
 
+	// key prefixes of additional VPP instances (instance/<name>/) are registered
+	// by descriptors instantiated for the instances
 	var prefixes []string
 	if nbPrefixes := p.kvs.GetRegisteredNBKeyPrefixes(); len(nbPrefixes) > 0 {
 		p.log.Infof("Watching %d key prefixes from KVScheduler", len(nbPrefixes))
@@ -116,6 +138,7 @@ func (p *Plugin) Init() (err error) {
 	} else {
 		p.log.Warnf("No key prefixes found in KVScheduler (ensure that all KVDescriptors are registered before this)")
 	}
+	prefixes = append(prefixes, otherVersionPrefixes(prefixes)...)
 
 	// initialize datasync channels
 	p.resyncChan = make(chan datasync.ResyncEvent)
@@ -149,6 +172,7 @@ func (p *Plugin) AfterInit() (err error) {
 func (p *Plugin) Close() (err error) {
 	close(p.quit)
 	p.wg.Wait()
+	p.remoteRegistry.close()
 	return nil
 }
 
@@ -196,6 +220,11 @@ func (p *Plugin) watchEvents() {
 						continue
 					}
 				}
+				kv.Key, kv.Val, err = toCurrentVersion(kv.Key, kv.Val)
+				if err != nil {
+					p.log.Errorf("converting value for key %q to current model version failed: %v", x.GetKey(), err)
+					continue
+				}
 				kvPairs = append(kvPairs, kv)
 			}
 
@@ -234,8 +263,13 @@ func (p *Plugin) watchEvents() {
 						p.log.Errorf("unmarshal value for key %q failed: %v", key, err)
 						continue
 					}
+					currentKey, val, err := toCurrentVersion(key, val)
+					if err != nil {
+						p.log.Errorf("converting value for key %q to current model version failed: %v", key, err)
+						continue
+					}
 					kvPairs = append(kvPairs, KeyVal{
-						Key: key,
+						Key: currentKey,
 						Val: val,
 					})
 					p.log.Debugf(" -- key: %s", x.GetKey())
@@ -289,6 +323,8 @@ func (p *Plugin) watchStatus(ch <-chan *kvscheduler.BaseValueStatus) {
 					dv.State, dv.Details, dv.Key, dv.LastOperation, dv.Error)
 			}
 
+			p.subscriptionRegistry.notify(s.Value)
+
 			if EnableStatusPublishing {
 				p.publishStatuses([]Result{
 					{Key: s.Value.Key, Status: s.Value},
@@ -325,9 +361,51 @@ func (p *Plugin) debugf(f string, a ...interface{}) {
 	}
 }
 
+// otherVersionPrefixes returns key prefixes of other versions supported by models
+// with the given (current version) key prefixes, which may be scoped by instance.
+func otherVersionPrefixes(prefixes []string) []string {
+	// instances watching the key prefix
+	watched := make(map[string][]string, len(prefixes))
+	for _, prefix := range prefixes {
+		instance, unscoped := models.ParseInstanceKey(prefix)
+		watched[unscoped] = append(watched[unscoped], instance)
+	}
+	var other []string
+	for _, model := range models.RegisteredModels() {
+		for _, instance := range watched[model.KeyPrefix()] {
+			for _, version := range model.Versions()[1:] {
+				prefix, err := models.InstanceKey(instance, models.KeyPrefixForVersion(model, version))
+				if err != nil {
+					continue // model key prefixes are never scoped
+				}
+				other = append(other, prefix)
+			}
+		}
+	}
+	return other
+}
+
+// toCurrentVersion converts key of any supported model version (optionally scoped
+// by instance) and its value into key and value of the current model version.
+func toCurrentVersion(key string, val proto.Message) (string, proto.Message, error) {
+	instance, unscoped := models.ParseInstanceKey(key)
+	currentKey, val, err := models.ToCurrentVersion(unscoped, val)
+	if err != nil {
+		return "", nil, err
+	}
+	currentKey, err = models.InstanceKey(instance, currentKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return currentKey, val, nil
+}
+
 // UnmarshalLazyValue is helper function for unmarshalling from datasync.LazyValue.
+// The key can be of any version supported by the model and it can be scoped
+// by instance, the returned value is not converted to the current model version.
 func UnmarshalLazyValue(key string, lazy datasync.LazyValue) (proto.Message, error) {
-	model, err := models.GetModelForKey(key)
+	_, key = models.ParseInstanceKey(key)
+	model, _, err := models.GetModelForVersionedKey(key)
 	if err != nil {
 		return nil, err
 	}
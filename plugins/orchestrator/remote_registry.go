@@ -0,0 +1,195 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"fmt"
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/remotedescriptor"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// remoteRegistryService registers models and descriptors of external processes.
+type remoteRegistryService struct {
+	kvscheduler.UnimplementedRemoteRegistryServiceServer
+
+	log      logging.Logger
+	kvs      kvs.KVScheduler
+	auth     auth.API
+	dialOpts []grpc.DialOption
+
+	mu          sync.Mutex
+	descriptors map[string]*remotedescriptor.RemoteDescriptor
+}
+
+func newRemoteRegistryService(log logging.Logger, scheduler kvs.KVScheduler, authAPI auth.API,
+	dialOpts []grpc.DialOption) *remoteRegistryService {
+	return &remoteRegistryService{
+		log:         log,
+		kvs:         scheduler,
+		auth:        authAPI,
+		dialOpts:    dialOpts,
+		descriptors: make(map[string]*remotedescriptor.RemoteDescriptor),
+	}
+}
+
+func (s *remoteRegistryService) RegisterDescriptor(ctx context.Context, req *kvscheduler.RegisterDescriptorRequest) (*kvscheduler.RegisterDescriptorResponse, error) {
+	// registration makes the agent connect to the given address and resync, which is
+	// allowed only to administrators
+	if _, err := auth.AuthorizeGRPC(s.auth, ctx, auth.Operation{
+		Name:   "RemoteRegistry.RegisterDescriptor",
+		Access: auth.AdminAccess,
+	}); err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "descriptor name is not defined")
+	}
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "remote descriptor address is not defined")
+	}
+	if req.GetModel().GetSpec() == nil {
+		return nil, status.Error(codes.InvalidArgument, "model spec is not defined")
+	}
+	modelName := models.ToSpec(req.GetModel().GetSpec()).ModelName()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// external process registering again (e.g. after restart) only reconnects
+	if descriptor, registered := s.descriptors[req.GetName()]; registered {
+		if descriptor.Model().Name() != modelName {
+			return nil, status.Errorf(codes.AlreadyExists, "descriptor %s is already registered for model %s",
+				req.GetName(), descriptor.Model().Name())
+		}
+		if err := descriptor.Connect(req.GetAddress()); err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		// values which could not be applied while the process was unavailable
+		// are re-applied
+		s.resync(req.GetName())
+		return &kvscheduler.RegisterDescriptorResponse{}, nil
+	}
+
+	modelInfo, err := remoteModelInfo(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	model, err := models.DefaultRegistry.Register(modelInfo, models.ToSpec(modelInfo.Spec))
+	if err != nil {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	descriptor := remotedescriptor.NewRemoteDescriptor(req.GetName(), model, s.log, s.dialOpts...)
+	if err := descriptor.Connect(req.GetAddress()); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	kvDescriptor := descriptor.KVDescriptor(req.GetWithUpdate(), req.GetWithRetrieve(), req.GetRetrieveDependencies())
+	if err := s.kvs.RegisterKVDescriptor(kvDescriptor); err != nil {
+		descriptor.Close()
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.descriptors[req.GetName()] = descriptor
+	s.log.Infof("remote descriptor %s registered for model %s", req.GetName(), model.Name())
+
+	// values of the model received before the registration are applied by resync
+	s.resync(req.GetName())
+
+	return &kvscheduler.RegisterDescriptorResponse{}, nil
+}
+
+// resync re-applies values of the remote descriptor (values of other descriptors
+// are not touched).
+func (s *remoteRegistryService) resync(descriptor string) {
+	ctx := contextdecorator.DataSrcContext(context.Background(), "remote-registry")
+	ctx = kvs.WithResync(ctx, kvs.DownstreamResync, true)
+	ctx = kvs.WithResyncDescriptors(ctx, descriptor)
+	if _, err := s.kvs.StartNBTransaction().Commit(ctx); err != nil {
+		s.log.Warnf("resync after registration of remote descriptor %s failed: %v", descriptor, err)
+	}
+}
+
+// close closes connections to all remote descriptors.
+func (s *remoteRegistryService) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, descriptor := range s.descriptors {
+		if err := descriptor.Close(); err != nil {
+			s.log.Warnf("closing remote descriptor %s failed: %v", name, err)
+		}
+	}
+}
+
+// remoteModelInfo builds model information from the registration request.
+func remoteModelInfo(req *kvscheduler.RegisterDescriptorRequest) (*models.ModelInfo, error) {
+	files, err := protodesc.NewFiles(req.GetProtoFiles())
+	if err != nil {
+		return nil, fmt.Errorf("invalid proto files: %v", err)
+	}
+	protoName := req.GetModel().GetProtoName()
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(protoName))
+	if err != nil {
+		return nil, fmt.Errorf("proto message %q not found: %v", protoName, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a proto message", protoName)
+	}
+
+	detail := &generic.ModelDetail{
+		Spec:      req.GetModel().GetSpec(),
+		ProtoName: protoName,
+		Options:   req.GetModel().GetOptions(),
+	}
+	if !hasModelOption(detail, "nameTemplate") && msgDesc.Fields().ByName("name") != nil {
+		// instances are named by the name field by default (same as local models)
+		detail.Options = append(detail.Options, &generic.ModelDetail_Option{
+			Key: "nameTemplate", Values: []string{"{{.Name}}"},
+		})
+	}
+	if !hasModelOption(detail, "protoFile") {
+		detail.Options = append(detail.Options, &generic.ModelDetail_Option{
+			Key: "protoFile", Values: []string{msgDesc.ParentFile().Path()},
+		})
+	}
+	return &models.ModelInfo{
+		ModelDetail:       detail,
+		MessageDescriptor: msgDesc,
+	}, nil
+}
+
+func hasModelOption(detail *generic.ModelDetail, key string) bool {
+	for _, opt := range detail.GetOptions() {
+		if opt.GetKey() == key && len(opt.GetValues()) > 0 {
+			return true
+		}
+	}
+	return false
+}
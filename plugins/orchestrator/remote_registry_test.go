@@ -0,0 +1,208 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// fakeScheduler records registered descriptors and committed transactions.
+type fakeScheduler struct {
+	kvs.KVScheduler
+
+	mu          sync.Mutex
+	descriptors []*kvs.KVDescriptor
+	commits     []context.Context
+}
+
+func (s *fakeScheduler) RegisterKVDescriptor(descriptors ...*kvs.KVDescriptor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.descriptors = append(s.descriptors, descriptors...)
+	return nil
+}
+
+func (s *fakeScheduler) StartNBTransaction() kvs.Txn {
+	return &fakeTxn{scheduler: s}
+}
+
+type fakeTxn struct {
+	kvs.Txn
+	scheduler *fakeScheduler
+}
+
+func (t *fakeTxn) Commit(ctx context.Context) (uint64, error) {
+	t.scheduler.mu.Lock()
+	defer t.scheduler.mu.Unlock()
+	t.scheduler.commits = append(t.scheduler.commits, ctx)
+	return uint64(len(t.scheduler.commits)), nil
+}
+
+// denyingAuth rejects all operations.
+type denyingAuth struct{}
+
+func (denyingAuth) AuthenticateHTTP(*http.Request) (*auth.Identity, error) {
+	return &auth.Identity{}, nil
+}
+
+func (denyingAuth) AuthenticateGRPC(context.Context) (*auth.Identity, error) {
+	return &auth.Identity{}, nil
+}
+
+func (denyingAuth) Authorize(*auth.Identity, auth.Operation) error {
+	return auth.ErrPermissionDenied
+}
+
+// startRemoteDescriptorServer serves RemoteDescriptorService (with all methods
+// unimplemented) over in-memory connection and returns dial options for it.
+func startRemoteDescriptorServer(t *testing.T) []grpc.DialOption {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	kvscheduler.RegisterRemoteDescriptorServiceServer(grpcServer, &kvscheduler.UnimplementedRemoteDescriptorServiceServer{})
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	return []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+	}
+}
+
+// registerRequest returns request registering descriptor for model with
+// the given type, defined by a proto message with the name field.
+func registerRequest(name, modelType string) *kvscheduler.RegisterDescriptorRequest {
+	protoFile := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("remotetest/" + modelType + ".proto"),
+		Package: proto.String("remotetest." + modelType),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Item"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			}},
+		}},
+	}
+	return &kvscheduler.RegisterDescriptorRequest{
+		Name: name,
+		Model: &generic.ModelDetail{
+			Spec: &generic.ModelSpec{
+				Module:  "remotetest",
+				Version: "v1",
+				Type:    modelType,
+			},
+			ProtoName: "remotetest." + modelType + ".Item",
+		},
+		ProtoFiles: &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{protoFile}},
+		Address:    "bufnet",
+		WithUpdate: true,
+	}
+}
+
+func TestRegisterDescriptor(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := &fakeScheduler{}
+	s := newRemoteRegistryService(logrus.NewLogger("test-log"), scheduler, nil, startRemoteDescriptorServer(t))
+	defer s.close()
+
+	_, err := s.RegisterDescriptor(context.Background(), registerRequest("remote-items", "item"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(scheduler.descriptors).To(HaveLen(1))
+	descriptor := scheduler.descriptors[0]
+	Expect(descriptor.Name).To(Equal("remote-items"))
+	Expect(descriptor.NBKeyPrefix).To(Equal("config/remotetest/v1/item/"))
+	Expect(descriptor.KeySelector("config/remotetest/v1/item/item1")).To(BeTrue())
+	Expect(descriptor.Update).ToNot(BeNil())
+	Expect(descriptor.Retrieve).To(BeNil())
+
+	// values of the registered descriptor are resynced
+	Expect(scheduler.commits).To(HaveLen(1))
+	resyncType, _ := kvs.IsResync(scheduler.commits[0])
+	Expect(resyncType).To(Equal(kvs.DownstreamResync))
+	scope, scoped := kvs.IsWithResyncDescriptors(scheduler.commits[0])
+	Expect(scoped).To(BeTrue())
+	Expect(scope).To(Equal([]string{"remote-items"}))
+
+	// registering again only reconnects and resyncs
+	_, err = s.RegisterDescriptor(context.Background(), registerRequest("remote-items", "item"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(scheduler.descriptors).To(HaveLen(1))
+	Expect(scheduler.commits).To(HaveLen(2))
+
+	// the name cannot be re-used for another model
+	_, err = s.RegisterDescriptor(context.Background(), registerRequest("remote-items", "otheritem"))
+	Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+}
+
+func TestRegisterDescriptorInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := &fakeScheduler{}
+	s := newRemoteRegistryService(logrus.NewLogger("test-log"), scheduler, nil, startRemoteDescriptorServer(t))
+	defer s.close()
+
+	req := registerRequest("", "invaliditem")
+	_, err := s.RegisterDescriptor(context.Background(), req)
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	req = registerRequest("remote-invaliditems", "invaliditem")
+	req.Address = ""
+	_, err = s.RegisterDescriptor(context.Background(), req)
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	req = registerRequest("remote-invaliditems", "invaliditem")
+	req.Model.ProtoName = "remotetest.invaliditem.Unknown"
+	_, err = s.RegisterDescriptor(context.Background(), req)
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	Expect(scheduler.descriptors).To(BeEmpty())
+	Expect(scheduler.commits).To(BeEmpty())
+}
+
+func TestRegisterDescriptorUnauthorized(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := &fakeScheduler{}
+	s := newRemoteRegistryService(logrus.NewLogger("test-log"), scheduler, denyingAuth{}, startRemoteDescriptorServer(t))
+	defer s.close()
+
+	_, err := s.RegisterDescriptor(context.Background(), registerRequest("remote-denied-items", "denieditem"))
+	Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	Expect(scheduler.descriptors).To(BeEmpty())
+}
@@ -0,0 +1,58 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remotedescriptor
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/client/tlsconfig"
+)
+
+// ClientConfig configures connections to remote descriptors. TLS options use
+// the same keys as the configuration of the agent's gRPC server, so that the
+// agent can present the same certificate to the external processes.
+type ClientConfig struct {
+	// InsecureTransport disables TLS (connections are established without
+	// any credentials).
+	InsecureTransport bool `json:"insecure-transport"`
+	// CertFile and KeyFile define certificate presented to remote descriptors
+	// (not presented if empty).
+	CertFile string `json:"cert-file"`
+	KeyFile  string `json:"key-file"`
+	// CAFiles are used to verify certificates of remote descriptors
+	// (the host's root CA set is used if empty).
+	CAFiles []string `json:"ca-files"`
+}
+
+// DialOptions returns options for connecting to remote descriptors.
+func (c *ClientConfig) DialOptions() ([]grpc.DialOption, error) {
+	if c.InsecureTransport {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+	var tlsOpts []tlsconfig.Option
+	if c.CertFile != "" || c.KeyFile != "" {
+		tlsOpts = append(tlsOpts, tlsconfig.CertKey(c.CertFile, c.KeyFile))
+	}
+	for _, caFile := range c.CAFiles {
+		tlsOpts = append(tlsOpts, tlsconfig.CA(caFile))
+	}
+	tc, err := tlsconfig.New(tlsOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid TLS configuration of remote descriptors")
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tc))}, nil
+}
@@ -0,0 +1,363 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package remotedescriptor implements KVDescriptor which applies values
+// by calling back RemoteDescriptorService of an external process.
+package remotedescriptor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// CallTimeout is the maximum duration of a single call to the remote descriptor.
+var CallTimeout = 30 * time.Second
+
+// UnavailableDepsLabel labels dependency which is never satisfied, returned for
+// values with dependencies that could not be obtained from the remote descriptor.
+// Such values remain pending until they are re-applied (e.g. by the resync
+// following re-registration of the remote descriptor).
+const UnavailableDepsLabel = "remote-dependencies-unavailable"
+
+// RemoteDescriptor forwards callbacks of KVDescriptor to RemoteDescriptorService
+// of an external process. The connection can be replaced when the external
+// process registers again (e.g. after restart).
+type RemoteDescriptor struct {
+	name     string
+	model    models.KnownModel
+	log      logging.Logger
+	dialOpts []grpc.DialOption
+
+	mu      sync.RWMutex
+	address string
+	conn    *grpc.ClientConn
+	client  kvscheduler.RemoteDescriptorServiceClient
+
+	depsMu sync.Mutex
+	deps   map[string]cachedDeps // key -> dependencies obtained for the last validated value
+}
+
+// cachedDeps are dependencies of value obtained from the remote descriptor.
+type cachedDeps struct {
+	value proto.Message
+	deps  []kvs.Dependency
+}
+
+// NewRemoteDescriptor creates a new instance of RemoteDescriptor for the given model.
+// Dial options are used to connect to the remote descriptor (see ClientConfig).
+func NewRemoteDescriptor(name string, model models.KnownModel, log logging.Logger,
+	dialOpts ...grpc.DialOption) *RemoteDescriptor {
+	return &RemoteDescriptor{
+		name:     name,
+		model:    model,
+		log:      log,
+		dialOpts: dialOpts,
+		deps:     make(map[string]cachedDeps),
+	}
+}
+
+// Name returns name of the descriptor.
+func (d *RemoteDescriptor) Name() string {
+	return d.name
+}
+
+// Model returns model handled by the descriptor.
+func (d *RemoteDescriptor) Model() models.KnownModel {
+	return d.model
+}
+
+// Connect (re)connects the descriptor to RemoteDescriptorService at the given address.
+func (d *RemoteDescriptor) Connect(address string) error {
+	conn, err := grpc.Dial(address, d.dialOpts...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to remote descriptor %s at %s", d.name, address)
+	}
+
+	d.mu.Lock()
+	oldConn := d.conn
+	d.address = address
+	d.conn = conn
+	d.client = kvscheduler.NewRemoteDescriptorServiceClient(conn)
+	d.mu.Unlock()
+
+	if oldConn != nil {
+		if err := oldConn.Close(); err != nil {
+			d.log.Warnf("closing previous connection of remote descriptor %s failed: %v", d.name, err)
+		}
+	}
+	d.log.Infof("remote descriptor %s connected to %s", d.name, address)
+	return nil
+}
+
+// Close closes connection to the remote descriptor.
+func (d *RemoteDescriptor) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.conn == nil {
+		return nil
+	}
+	err := d.conn.Close()
+	d.conn = nil
+	d.client = nil
+	return err
+}
+
+// KVDescriptor returns descriptor for the KVScheduler.
+func (d *RemoteDescriptor) KVDescriptor(withUpdate, withRetrieve bool, retrieveDeps []string) *kvs.KVDescriptor {
+	descriptor := &kvs.KVDescriptor{
+		Name:                 d.name,
+		NBKeyPrefix:          d.model.KeyPrefix(),
+		ValueTypeName:        d.model.ProtoName(),
+		KeySelector:          d.model.IsKeyValid,
+		KeyLabel:             d.model.StripKeyPrefix,
+		Validate:             d.Validate,
+		Create:               d.Create,
+		Delete:               d.Delete,
+		IsRetriableFailure:   d.IsRetriableFailure,
+		Dependencies:         d.Dependencies,
+		RetrieveDependencies: retrieveDeps,
+	}
+	if withUpdate {
+		descriptor.Update = d.Update
+	}
+	if withRetrieve {
+		descriptor.Retrieve = d.Retrieve
+	}
+	return descriptor
+}
+
+// Validate forwards validation of the value to the remote descriptor.
+// Dependencies of the valid value are obtained together with the validation,
+// so that the value is not applied with dependencies unknown.
+func (d *RemoteDescriptor) Validate(key string, value proto.Message) error {
+	item, err := toKeyValue(key, value)
+	if err != nil {
+		return kvs.NewInvalidValueError(err)
+	}
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		_, err := client.Validate(ctx, &kvscheduler.ValidateRequest{Item: item})
+		if status.Code(err) == codes.InvalidArgument {
+			return kvs.NewInvalidValueError(errors.New(status.Convert(err).Message()))
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deps, err := d.dependencies(item)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get dependencies from remote descriptor %s", d.name)
+	}
+	d.depsMu.Lock()
+	d.deps[key] = cachedDeps{value: value, deps: deps}
+	d.depsMu.Unlock()
+	return nil
+}
+
+// Create forwards creation of the value to the remote descriptor.
+func (d *RemoteDescriptor) Create(key string, value proto.Message) (metadata kvs.Metadata, err error) {
+	item, err := toKeyValue(key, value)
+	if err != nil {
+		return nil, err
+	}
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		_, err := client.Create(ctx, &kvscheduler.CreateRequest{Item: item})
+		return err
+	})
+	return nil, err
+}
+
+// Update forwards update of the value to the remote descriptor.
+func (d *RemoteDescriptor) Update(key string, oldValue, newValue proto.Message, oldMetadata kvs.Metadata) (newMetadata kvs.Metadata, err error) {
+	oldAny, err := anypb.New(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newAny, err := anypb.New(newValue)
+	if err != nil {
+		return nil, err
+	}
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		_, err := client.Update(ctx, &kvscheduler.UpdateRequest{
+			Key:      key,
+			OldValue: oldAny,
+			NewValue: newAny,
+		})
+		return err
+	})
+	return nil, err
+}
+
+// Delete forwards removal of the value to the remote descriptor.
+func (d *RemoteDescriptor) Delete(key string, value proto.Message, metadata kvs.Metadata) error {
+	item, err := toKeyValue(key, value)
+	if err != nil {
+		return err
+	}
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		_, err := client.Delete(ctx, &kvscheduler.DeleteRequest{Item: item})
+		return err
+	})
+	if err == nil {
+		d.depsMu.Lock()
+		delete(d.deps, key)
+		d.depsMu.Unlock()
+	}
+	return err
+}
+
+// Retrieve returns values retrieved by the remote descriptor.
+func (d *RemoteDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (retrieved []kvs.KVWithMetadata, err error) {
+	req := &kvscheduler.RetrieveRequest{}
+	for _, kv := range correlate {
+		item, err := toKeyValue(kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		req.Correlate = append(req.Correlate, item)
+	}
+
+	var resp *kvscheduler.RetrieveResponse
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		resp, err = client.Retrieve(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range resp.GetItems() {
+		value := d.model.NewInstance()
+		if err := item.GetValue().UnmarshalTo(value); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal value retrieved for key %s", item.GetKey())
+		}
+		retrieved = append(retrieved, kvs.KVWithMetadata{
+			Key:    item.GetKey(),
+			Value:  value,
+			Origin: kvs.UnknownOrigin,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies returns dependencies of the value reported by the remote descriptor.
+// Dependencies obtained by the validation of the value are re-used. If the remote
+// descriptor cannot be asked, the value depends on a key which is never created
+// (see UnavailableDepsLabel) instead of being treated as dependency-free.
+func (d *RemoteDescriptor) Dependencies(key string, value proto.Message) []kvs.Dependency {
+	d.depsMu.Lock()
+	cached, isCached := d.deps[key]
+	d.depsMu.Unlock()
+	if isCached && proto.Equal(cached.value, value) {
+		return cached.deps
+	}
+
+	item, err := toKeyValue(key, value)
+	if err == nil {
+		var deps []kvs.Dependency
+		if deps, err = d.dependencies(item); err == nil {
+			return deps
+		}
+	}
+	d.log.Warnf("failed to get dependencies for key %s from remote descriptor %s: %v", key, d.name, err)
+	return []kvs.Dependency{{
+		Label: UnavailableDepsLabel,
+		Key:   UnavailableDepsKey(d.name),
+	}}
+}
+
+// UnavailableDepsKey returns key of the never satisfied dependency of values
+// with dependencies unavailable from the given remote descriptor.
+func UnavailableDepsKey(descriptor string) string {
+	return "remote-descriptor/" + descriptor + "/dependencies-unavailable"
+}
+
+// dependencies asks the remote descriptor for dependencies of the item.
+func (d *RemoteDescriptor) dependencies(item *kvscheduler.KeyValue) (deps []kvs.Dependency, err error) {
+	var resp *kvscheduler.DependenciesResponse
+	err = d.call(func(ctx context.Context, client kvscheduler.RemoteDescriptorServiceClient) error {
+		resp, err = client.Dependencies(ctx, &kvscheduler.DependenciesRequest{Item: item})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dep := range resp.GetDependencies() {
+		if len(dep.GetAnyOfKeyPrefixes()) > 0 {
+			deps = append(deps, kvs.Dependency{
+				Label: dep.GetLabel(),
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: dep.GetAnyOfKeyPrefixes(),
+				},
+			})
+			continue
+		}
+		deps = append(deps, kvs.Dependency{
+			Label: dep.GetLabel(),
+			Key:   dep.GetKey(),
+		})
+	}
+	return deps, nil
+}
+
+// IsRetriableFailure returns false for errors which are not expected to go away
+// with another attempt.
+func (d *RemoteDescriptor) IsRetriableFailure(err error) bool {
+	switch status.Code(errors.Cause(err)) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.Unimplemented:
+		return false
+	}
+	return true
+}
+
+// call runs the given function with the current client and timeout.
+func (d *RemoteDescriptor) call(f func(context.Context, kvscheduler.RemoteDescriptorServiceClient) error) error {
+	d.mu.RLock()
+	client := d.client
+	d.mu.RUnlock()
+
+	if client == nil {
+		return status.Errorf(codes.Unavailable, "remote descriptor %s is not connected", d.name)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), CallTimeout)
+	defer cancel()
+	return f(ctx, client)
+}
+
+func toKeyValue(key string, value proto.Message) (*kvscheduler.KeyValue, error) {
+	item := &kvscheduler.KeyValue{Key: key}
+	if value != nil {
+		var err error
+		if item.Value, err = anypb.New(value); err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal value for key %s", key)
+		}
+	}
+	return item, nil
+}
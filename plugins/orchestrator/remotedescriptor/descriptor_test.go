@@ -0,0 +1,239 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package remotedescriptor
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// fakeRemoteDescriptor implements RemoteDescriptorService of an external process.
+type fakeRemoteDescriptor struct {
+	kvscheduler.UnimplementedRemoteDescriptorServiceServer
+
+	mu       sync.Mutex
+	invalid  bool
+	depsErr  error
+	deps     []*kvscheduler.DependenciesResponse_Dependency
+	depCalls int
+	created  []string
+	retrieve []*kvscheduler.KeyValue
+}
+
+func (f *fakeRemoteDescriptor) Validate(_ context.Context, req *kvscheduler.ValidateRequest) (*kvscheduler.ValidateResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.invalid {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid value %s", req.GetItem().GetKey())
+	}
+	return &kvscheduler.ValidateResponse{}, nil
+}
+
+func (f *fakeRemoteDescriptor) Create(_ context.Context, req *kvscheduler.CreateRequest) (*kvscheduler.CreateResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.created = append(f.created, req.GetItem().GetKey())
+	return &kvscheduler.CreateResponse{}, nil
+}
+
+func (f *fakeRemoteDescriptor) Delete(context.Context, *kvscheduler.DeleteRequest) (*kvscheduler.DeleteResponse, error) {
+	return &kvscheduler.DeleteResponse{}, nil
+}
+
+func (f *fakeRemoteDescriptor) Retrieve(context.Context, *kvscheduler.RetrieveRequest) (*kvscheduler.RetrieveResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &kvscheduler.RetrieveResponse{Items: f.retrieve}, nil
+}
+
+func (f *fakeRemoteDescriptor) Dependencies(context.Context, *kvscheduler.DependenciesRequest) (*kvscheduler.DependenciesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.depCalls++
+	if f.depsErr != nil {
+		return nil, f.depsErr
+	}
+	return &kvscheduler.DependenciesResponse{Dependencies: f.deps}, nil
+}
+
+func (f *fakeRemoteDescriptor) set(fn func(f *fakeRemoteDescriptor)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn(f)
+}
+
+// startFakeRemoteDescriptor serves the fake service over in-memory connection
+// and returns dial options for connecting to it.
+func startFakeRemoteDescriptor(t *testing.T, srv *fakeRemoteDescriptor) []grpc.DialOption {
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	kvscheduler.RegisterRemoteDescriptorServiceServer(grpcServer, srv)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	return []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+	}
+}
+
+func newTestDescriptor(t *testing.T, srv *fakeRemoteDescriptor) *RemoteDescriptor {
+	d := NewRemoteDescriptor("remote-interfaces", interfaces.ModelInterface,
+		logrus.NewLogger("test-log"), startFakeRemoteDescriptor(t, srv)...)
+	Expect(d.Connect("bufnet")).To(Succeed())
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestKVDescriptor(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := NewRemoteDescriptor("remote-interfaces", interfaces.ModelInterface, logrus.NewLogger("test-log"))
+	descriptor := d.KVDescriptor(false, true, []string{"other-descriptor"})
+	Expect(descriptor.Name).To(Equal("remote-interfaces"))
+	Expect(descriptor.NBKeyPrefix).To(Equal(interfaces.ModelInterface.KeyPrefix()))
+	Expect(descriptor.KeySelector(interfaces.InterfaceKey("if1"))).To(BeTrue())
+	Expect(descriptor.Update).To(BeNil())
+	Expect(descriptor.Retrieve).ToNot(BeNil())
+	Expect(descriptor.RetrieveDependencies).To(Equal([]string{"other-descriptor"}))
+}
+
+func TestValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	srv := &fakeRemoteDescriptor{}
+	d := newTestDescriptor(t, srv)
+	key := interfaces.InterfaceKey("if1")
+	value := &interfaces.Interface{Name: "if1"}
+
+	Expect(d.Validate(key, value)).To(Succeed())
+
+	srv.set(func(f *fakeRemoteDescriptor) { f.invalid = true })
+	err := d.Validate(key, value)
+	Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+	Expect(err.Error()).To(ContainSubstring("invalid value"))
+}
+
+func TestDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	srv := &fakeRemoteDescriptor{deps: []*kvscheduler.DependenciesResponse_Dependency{
+		{Label: "bridge-domain", Key: "config/vpp/l2/v2/bridge-domain/bd1"},
+		{Label: "any-interface", AnyOfKeyPrefixes: []string{"config/vpp/v2/interfaces/"}},
+	}}
+	d := newTestDescriptor(t, srv)
+	key := interfaces.InterfaceKey("if1")
+	value := &interfaces.Interface{Name: "if1"}
+
+	// dependencies obtained by validation are re-used
+	Expect(d.Validate(key, value)).To(Succeed())
+	deps := d.Dependencies(key, &interfaces.Interface{Name: "if1"})
+	Expect(deps).To(Equal([]kvs.Dependency{
+		{Label: "bridge-domain", Key: "config/vpp/l2/v2/bridge-domain/bd1"},
+		{Label: "any-interface", AnyOf: kvs.AnyOfDependency{KeyPrefixes: []string{"config/vpp/v2/interfaces/"}}},
+	}))
+	Expect(srv.depCalls).To(Equal(1))
+
+	// changed value is not served from the cache
+	deps = d.Dependencies(key, &interfaces.Interface{Name: "if1", Mtu: 1500})
+	Expect(deps).To(HaveLen(2))
+	Expect(srv.depCalls).To(Equal(2))
+}
+
+func TestDependenciesUnavailable(t *testing.T) {
+	RegisterTestingT(t)
+
+	srv := &fakeRemoteDescriptor{depsErr: status.Error(codes.Internal, "out of order")}
+	d := newTestDescriptor(t, srv)
+	key := interfaces.InterfaceKey("if1")
+	value := &interfaces.Interface{Name: "if1"}
+
+	// validation fails if the dependencies are not known
+	err := d.Validate(key, value)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("out of order"))
+
+	// value is not treated as dependency-free
+	Expect(d.Dependencies(key, value)).To(Equal([]kvs.Dependency{{
+		Label: UnavailableDepsLabel,
+		Key:   UnavailableDepsKey("remote-interfaces"),
+	}}))
+
+	// the same for disconnected descriptor
+	disconnected := NewRemoteDescriptor("remote-interfaces", interfaces.ModelInterface, logrus.NewLogger("test-log"))
+	Expect(disconnected.Dependencies(key, value)).To(HaveLen(1))
+	Expect(disconnected.Dependencies(key, value)[0].Label).To(Equal(UnavailableDepsLabel))
+}
+
+func TestCreateAndRetrieve(t *testing.T) {
+	RegisterTestingT(t)
+
+	retrieved, err := anypb.New(&interfaces.Interface{Name: "if2", Enabled: true})
+	Expect(err).ToNot(HaveOccurred())
+	srv := &fakeRemoteDescriptor{retrieve: []*kvscheduler.KeyValue{
+		{Key: interfaces.InterfaceKey("if2"), Value: retrieved},
+	}}
+	d := newTestDescriptor(t, srv)
+
+	_, err = d.Create(interfaces.InterfaceKey("if1"), &interfaces.Interface{Name: "if1"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(srv.created).To(Equal([]string{interfaces.InterfaceKey("if1")}))
+
+	values, err := d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(values).To(HaveLen(1))
+	Expect(values[0].Key).To(Equal(interfaces.InterfaceKey("if2")))
+	Expect(values[0].Value.(*interfaces.Interface).GetEnabled()).To(BeTrue())
+}
+
+func TestIsRetriableFailure(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := NewRemoteDescriptor("remote-interfaces", interfaces.ModelInterface, logrus.NewLogger("test-log"))
+	Expect(d.IsRetriableFailure(status.Error(codes.Unavailable, "restarting"))).To(BeTrue())
+	Expect(d.IsRetriableFailure(status.Error(codes.FailedPrecondition, "missing"))).To(BeFalse())
+}
+
+func TestClientConfig(t *testing.T) {
+	RegisterTestingT(t)
+
+	opts, err := (&ClientConfig{InsecureTransport: true}).DialOptions()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(opts).To(HaveLen(1))
+
+	// TLS is used by default
+	opts, err = (&ClientConfig{}).DialOptions()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(opts).To(HaveLen(1))
+
+	_, err = (&ClientConfig{CAFiles: []string{"/non-existent/ca.pem"}}).DialOptions()
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,107 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+// requestCacheSize is a number of the most recent replies kept by request cache.
+const requestCacheSize = 100
+
+// requestCache remembers replies to the most recent SetConfig requests identified
+// by idempotency key assigned by the client. Request re-submitted by the client (i.e.
+// after reconnect, when the reply was lost) gets the reply to the original request
+// instead of being applied again.
+type requestCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*cachedReply
+	order   []*cachedReply
+}
+
+type cachedReply struct {
+	id    string
+	done  chan struct{}
+	reply *generic.SetConfigResponse
+	err   error
+}
+
+// requestCacheKey identifies request by the client identity, idempotency key and hash
+// of the request payload. Request with the same idempotency key but different payload
+// (i.e. key reused by the client by mistake) is thus not mistaken for re-submission.
+func requestCacheKey(identity, idempotencyKey string, req *generic.SetConfigRequest) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return identity + "/" + idempotencyKey + "/" + hex.EncodeToString(hash[:]), nil
+}
+
+func newRequestCache(size int) *requestCache {
+	return &requestCache{
+		size:    size,
+		entries: make(map[string]*cachedReply),
+	}
+}
+
+// do returns reply to the request with the given ID (see requestCacheKey), calling fn only for the first
+// submission of the request. Re-submission of request which is still being processed
+// waits for its reply. Requests failed without reply (i.e. rejected before the
+// transaction was executed) are not remembered.
+func (c *requestCache) do(ctx context.Context, id string,
+	fn func() (*generic.SetConfigResponse, error)) (*generic.SetConfigResponse, error) {
+
+	c.mu.Lock()
+	if e, ok := c.entries[id]; ok {
+		c.mu.Unlock()
+		select {
+		case <-e.done:
+			return e.reply, e.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	e := &cachedReply{id: id, done: make(chan struct{})}
+	c.entries[id] = e
+	c.order = append(c.order, e)
+	if len(c.order) > c.size {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		if c.entries[oldest.id] == oldest {
+			delete(c.entries, oldest.id)
+		}
+	}
+	c.mu.Unlock()
+
+	e.reply, e.err = fn()
+	if e.reply == nil {
+		c.mu.Lock()
+		if c.entries[id] == e {
+			delete(c.entries, id)
+		}
+		c.mu.Unlock()
+	}
+	close(e.done)
+	return e.reply, e.err
+}
@@ -0,0 +1,62 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+func TestRequestCacheResubmit(t *testing.T) {
+	RegisterTestingT(t)
+
+	cache := newRequestCache(2)
+	calls := 0
+	push := func() (*generic.SetConfigResponse, error) {
+		calls++
+		return &generic.SetConfigResponse{}, nil
+	}
+	req := &generic.SetConfigRequest{OverwriteAll: true}
+
+	key, err := requestCacheKey("client1", "key1", req)
+	Expect(err).ToNot(HaveOccurred())
+	reply, err := cache.do(context.Background(), key, push)
+	Expect(err).ToNot(HaveOccurred())
+
+	// re-submitted request gets the original reply
+	key, err = requestCacheKey("client1", "key1", req)
+	Expect(err).ToNot(HaveOccurred())
+	resubmitted, err := cache.do(context.Background(), key, push)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resubmitted).To(BeIdenticalTo(reply))
+	Expect(calls).To(Equal(1))
+
+	// the same idempotency key used by another client or with different payload
+	key, err = requestCacheKey("client2", "key1", req)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = cache.do(context.Background(), key, push)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(calls).To(Equal(2))
+
+	key, err = requestCacheKey("client1", "key1", &generic.SetConfigRequest{})
+	Expect(err).ToNot(HaveOccurred())
+	_, err = cache.do(context.Background(), key, push)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(calls).To(Equal(3))
+}
@@ -24,6 +24,7 @@ import (
 type KVStore interface {
 	ListAll() KVPairs
 	List(dataSrc string) KVPairs
+	Get(key string) (proto.Message, bool)
 	Update(dataSrc, key string, val proto.Message)
 	Delete(dataSrc, key string)
 	Reset(dataSrc string)
@@ -65,6 +66,21 @@ func (s *memStore) List(dataSrc string) KVPairs {
 	return pairs
 }
 
+// Get returns value stored under given key (by any data source).
+func (s *memStore) Get(key string) (val proto.Message, found bool) {
+	var dataSrcs []string
+	for dataSrc := range s.db {
+		dataSrcs = append(dataSrcs, dataSrc)
+	}
+	sort.Strings(dataSrcs)
+	for _, dataSrc := range dataSrcs {
+		if v, ok := s.db[dataSrc][key]; ok {
+			val, found = v, true
+		}
+	}
+	return val, found
+}
+
 // Update updates value stored under key with given value.
 func (s *memStore) Update(dataSrc, key string, val proto.Message) {
 	if _, ok := s.db[dataSrc]; !ok {
@@ -0,0 +1,314 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// maxNotificationsPerResponse limits number of notifications sent to subscriber
+// in a single response.
+const maxNotificationsPerResponse = 100
+
+// maxPendingNotifications limits number of items with pending status change
+// for a single subscriber. The subscriber that falls behind more is dropped.
+const maxPendingNotifications = 10000
+
+// ErrSlowSubscriber is returned by Subscribe when the subscriber was dropped
+// for not keeping up with the status changes.
+var ErrSlowSubscriber = errors.New("subscriber dropped for not keeping up with status changes")
+
+// Subscriber allows to subscribe for status notifications of configuration items.
+type Subscriber interface {
+	// Subscribe sends status notifications of items matching the subscriptions (all items
+	// if there are no subscriptions) using the send callback, starting with the current
+	// status of the subscribed NB configuration items. It blocks until the context is done
+	// or sending fails. Status changes of a single item are coalesced while the subscriber
+	// is not ready to receive them, so that the slow subscribers receive the latest status.
+	// The subscriber with too many items pending is dropped with ErrSlowSubscriber.
+	Subscribe(ctx context.Context, subscriptions []*generic.Subscription,
+		send func(*generic.SubscribeResponse) error) error
+}
+
+// subscriber is a client subscribed for status notifications.
+type subscriber struct {
+	subscriptions []*generic.Subscription
+
+	mu         sync.Mutex
+	pending    map[string]*Status
+	order      []string
+	maxPending int
+	overflow   bool
+	signal     chan struct{}
+}
+
+// subscriptionRegistry dispatches status changes of values to subscribers.
+type subscriptionRegistry struct {
+	dispatch   *dispatcher
+	maxPending int
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newSubscriptionRegistry(dispatch *dispatcher) *subscriptionRegistry {
+	return &subscriptionRegistry{
+		dispatch:    dispatch,
+		maxPending:  maxPendingNotifications,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// validateSubscriptions checks that subscribed models are known.
+func validateSubscriptions(subscriptions []*generic.Subscription) error {
+	for _, sub := range subscriptions {
+		if sub.GetId().GetModel() == "" {
+			return fmt.Errorf("subscription %v has no model", sub)
+		}
+		if _, err := models.GetModel(sub.GetId().GetModel()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe sends status notifications to the subscriber until the context is done.
+func (r *subscriptionRegistry) Subscribe(ctx context.Context, subscriptions []*generic.Subscription,
+	send func(*generic.SubscribeResponse) error) error {
+	if err := validateSubscriptions(subscriptions); err != nil {
+		return err
+	}
+	sub := &subscriber{
+		subscriptions: subscriptions,
+		pending:       make(map[string]*Status),
+		maxPending:    r.maxPending,
+		signal:        make(chan struct{}, 1),
+	}
+
+	r.mu.Lock()
+	r.subscribers[sub] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.subscribers, sub)
+		r.mu.Unlock()
+	}()
+
+	// start with the current status of the subscribed configuration items
+	// (items changed meanwhile are sent just once with the latest status)
+	data := r.dispatch.ListData()
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if status, err := r.dispatch.GetStatus(key); err == nil {
+			sub.enqueue(status)
+		}
+	}
+
+	for {
+		select {
+		case <-sub.signal:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		for {
+			if sub.overflowed() {
+				return ErrSlowSubscriber
+			}
+			statuses := sub.dequeue(maxNotificationsPerResponse)
+			if len(statuses) == 0 {
+				break
+			}
+			resp := &generic.SubscribeResponse{}
+			for _, status := range statuses {
+				if notif := r.notification(status); notif != nil {
+					resp.Notifications = append(resp.Notifications, notif)
+				}
+			}
+			if len(resp.Notifications) == 0 {
+				continue
+			}
+			if err := send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// notify dispatches status change of a value to the subscribers.
+func (r *subscriptionRegistry) notify(status *Status) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for sub := range r.subscribers {
+		sub.enqueue(status)
+	}
+}
+
+// notification builds notification for the given value status. The item data
+// are included unless the value was removed.
+func (r *subscriptionRegistry) notification(status *Status) *generic.Notification {
+	model, err := models.GetModelForKey(status.GetKey())
+	if err != nil {
+		return nil
+	}
+	var item *generic.Item
+	if data := r.valueData(status); data != nil {
+		item, err = models.MarshalItem(data)
+		if err != nil {
+			r.dispatch.log.Warnf("marshalling item %s for notification failed: %v", status.GetKey(), err)
+		}
+	}
+	if item == nil {
+		item = &generic.Item{
+			Id: &generic.Item_ID{
+				Model: model.Name(),
+				Name:  model.StripKeyPrefix(status.GetKey()),
+			},
+		}
+	}
+	return &generic.Notification{
+		Item:   item,
+		Status: itemStatus(status),
+	}
+}
+
+// valueData returns the current data of NB configuration item or SB state value
+// for the given status.
+func (r *subscriptionRegistry) valueData(status *Status) proto.Message {
+	switch status.GetState() {
+	case kvscheduler.ValueState_NONEXISTENT, kvscheduler.ValueState_REMOVED:
+		return nil
+	case kvscheduler.ValueState_OBTAINED:
+		values, err := r.dispatch.kvs.DumpValuesByKeyPrefix(status.GetKey(), kvs.CachedView)
+		if err != nil {
+			return nil
+		}
+		for _, kv := range values {
+			if kv.Key == status.GetKey() {
+				return kv.Value
+			}
+		}
+		return nil
+	}
+	return r.dispatch.getData(status.GetKey())
+}
+
+// matches returns true if the value with the given key matches some of the subscriptions.
+func (s *subscriber) matches(key string) bool {
+	if len(s.subscriptions) == 0 {
+		return true
+	}
+	model, err := models.GetModelForKey(key)
+	if err != nil {
+		return false
+	}
+	for _, sub := range s.subscriptions {
+		id := sub.GetId()
+		if id.GetModel() != model.Name() {
+			continue
+		}
+		if id.GetName() == "" || id.GetName() == model.StripKeyPrefix(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue adds status to the pending notifications (replacing previous status of the same value).
+// Once the limit of pending notifications is exceeded, the subscriber is marked as overflowed
+// and its pending notifications are discarded.
+func (s *subscriber) enqueue(status *Status) {
+	if !s.matches(status.GetKey()) {
+		return
+	}
+	s.mu.Lock()
+	if s.overflow {
+		s.mu.Unlock()
+		return
+	}
+	if _, ok := s.pending[status.GetKey()]; !ok {
+		if len(s.order) >= s.maxPending {
+			s.overflow = true
+			s.pending = nil
+			s.order = nil
+		} else {
+			s.order = append(s.order, status.GetKey())
+		}
+	}
+	if !s.overflow {
+		s.pending[status.GetKey()] = status
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// overflowed returns true if the subscriber exceeded the limit of pending notifications.
+func (s *subscriber) overflowed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.overflow
+}
+
+// dequeue removes up to max pending statuses in the order of their first change.
+func (s *subscriber) dequeue(max int) []*Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.order)
+	if n > max {
+		n = max
+	}
+	statuses := make([]*Status, 0, n)
+	for _, key := range s.order[:n] {
+		statuses = append(statuses, s.pending[key])
+		delete(s.pending, key)
+	}
+	s.order = s.order[n:]
+	return statuses
+}
+
+// itemStatus converts value status to status of generic configuration item.
+func itemStatus(status *Status) *generic.ItemStatus {
+	var msg string
+	if details := status.GetDetails(); len(details) > 0 {
+		msg = strings.Join(status.GetDetails(), ", ")
+	} else {
+		msg = status.GetError()
+	}
+	return &generic.ItemStatus{
+		Status:  status.GetState().String(),
+		Message: msg,
+	}
+}
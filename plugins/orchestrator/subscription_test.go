@@ -0,0 +1,189 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// failedStatus returns status of the interface that failed with the given error.
+func failedStatus(name, err string) *Status {
+	return &Status{
+		Key:   interfaces.InterfaceKey(name),
+		State: kvscheduler.ValueState_FAILED,
+		Error: err,
+	}
+}
+
+// notifiedItems returns "name: message" for every notification of the response.
+func notifiedItems(resp *generic.SubscribeResponse) []string {
+	var items []string
+	for _, notif := range resp.GetNotifications() {
+		items = append(items, fmt.Sprintf("%s: %s",
+			notif.GetItem().GetId().GetName(), notif.GetStatus().GetMessage()))
+	}
+	return items
+}
+
+// testSubscription runs subscription of all items in the background. Every response
+// is passed to the returned channel and sending then blocks until the gate is opened.
+type testSubscription struct {
+	responses chan *generic.SubscribeResponse
+	gate      chan struct{}
+	errCh     chan error
+	cancel    context.CancelFunc
+}
+
+func startTestSubscription(registry *subscriptionRegistry) *testSubscription {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &testSubscription{
+		responses: make(chan *generic.SubscribeResponse),
+		gate:      make(chan struct{}),
+		errCh:     make(chan error, 1),
+		cancel:    cancel,
+	}
+	go func() {
+		s.errCh <- registry.Subscribe(ctx, nil, func(resp *generic.SubscribeResponse) error {
+			s.responses <- resp
+			<-s.gate
+			return nil
+		})
+	}()
+	Eventually(func() int {
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		return len(registry.subscribers)
+	}).Should(Equal(1))
+	return s
+}
+
+func newTestSubscriptionRegistry() *subscriptionRegistry {
+	return newSubscriptionRegistry(&dispatcher{
+		log: logrus.NewLogger("test-log"),
+		db:  newMemStore(),
+	})
+}
+
+func TestSubscriberCoalescing(t *testing.T) {
+	RegisterTestingT(t)
+
+	sub := &subscriber{
+		pending:    make(map[string]*Status),
+		maxPending: maxPendingNotifications,
+		signal:     make(chan struct{}, 1),
+	}
+	sub.enqueue(failedStatus("if1", "err-1"))
+	sub.enqueue(failedStatus("if2", "err-1"))
+	sub.enqueue(failedStatus("if1", "err-2"))
+	sub.enqueue(failedStatus("if3", "err-1"))
+	sub.enqueue(failedStatus("if1", "err-3"))
+	Expect(sub.signal).To(HaveLen(1))
+
+	// statuses are coalesced and ordered by the first change
+	statuses := sub.dequeue(2)
+	Expect(statuses).To(HaveLen(2))
+	Expect(statuses[0].GetKey()).To(Equal(interfaces.InterfaceKey("if1")))
+	Expect(statuses[0].GetError()).To(Equal("err-3"))
+	Expect(statuses[1].GetKey()).To(Equal(interfaces.InterfaceKey("if2")))
+
+	statuses = sub.dequeue(2)
+	Expect(statuses).To(HaveLen(1))
+	Expect(statuses[0].GetKey()).To(Equal(interfaces.InterfaceKey("if3")))
+	Expect(sub.dequeue(2)).To(BeEmpty())
+}
+
+func TestSubscriberFilter(t *testing.T) {
+	RegisterTestingT(t)
+
+	sub := &subscriber{
+		subscriptions: []*generic.Subscription{{
+			Id: &generic.Item_ID{Model: interfaces.ModelInterface.Name(), Name: "if2"},
+		}},
+		pending:    make(map[string]*Status),
+		maxPending: maxPendingNotifications,
+		signal:     make(chan struct{}, 1),
+	}
+	sub.enqueue(failedStatus("if1", "err-1"))
+	Expect(sub.signal).To(BeEmpty())
+	sub.enqueue(failedStatus("if2", "err-1"))
+	Expect(sub.signal).To(HaveLen(1))
+
+	statuses := sub.dequeue(maxNotificationsPerResponse)
+	Expect(statuses).To(HaveLen(1))
+	Expect(statuses[0].GetKey()).To(Equal(interfaces.InterfaceKey("if2")))
+}
+
+func TestSubscribeBackPressure(t *testing.T) {
+	RegisterTestingT(t)
+
+	registry := newTestSubscriptionRegistry()
+	registry.maxPending = 2
+	sub := startTestSubscription(registry)
+
+	// the subscriber is blocked on sending the first notification
+	registry.notify(failedStatus("if1", "err-1"))
+	Eventually(sub.responses).Should(Receive(WithTransform(notifiedItems,
+		Equal([]string{"if1: err-1"}))))
+
+	// changes made meanwhile are coalesced (without exceeding the limit)
+	for i := 2; i <= 10; i++ {
+		registry.notify(failedStatus("if1", fmt.Sprintf("err-%d", i)))
+		registry.notify(failedStatus("if2", fmt.Sprintf("err-%d", i)))
+	}
+	close(sub.gate)
+	Eventually(sub.responses).Should(Receive(WithTransform(notifiedItems,
+		Equal([]string{"if1: err-10", "if2: err-10"}))))
+	Consistently(sub.responses).ShouldNot(Receive())
+
+	sub.cancel()
+	Eventually(sub.errCh).Should(Receive(Equal(context.Canceled)))
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	Expect(registry.subscribers).To(BeEmpty())
+}
+
+func TestSubscribeDropSlowSubscriber(t *testing.T) {
+	RegisterTestingT(t)
+
+	registry := newTestSubscriptionRegistry()
+	registry.maxPending = 2
+	sub := startTestSubscription(registry)
+	defer sub.cancel()
+
+	// the subscriber is blocked on sending the first notification
+	registry.notify(failedStatus("if1", "err-1"))
+	Eventually(sub.responses).Should(Receive())
+
+	// too many items changed meanwhile
+	registry.notify(failedStatus("if1", "err-2"))
+	registry.notify(failedStatus("if2", "err-2"))
+	registry.notify(failedStatus("if3", "err-2"))
+	close(sub.gate)
+
+	Eventually(sub.errCh).Should(Receive(Equal(ErrSlowSubscriber)))
+	Expect(sub.responses).ToNot(Receive())
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	Expect(registry.subscribers).To(BeEmpty())
+}
@@ -19,6 +19,9 @@ package restapi
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -29,6 +32,7 @@ import (
 
 	yaml2 "github.com/ghodss/yaml"
 	"github.com/goccy/go-yaml"
+	"github.com/gorilla/mux"
 	"github.com/unrolled/render"
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/logging/logrus"
@@ -45,14 +49,32 @@ import (
 	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/pkg/version"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/configurator"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
-	kvscheduler "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/plugins/restapi/jsonschema/converter"
 	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
+	telemetryvppcalls "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
+	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
+	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	igmpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
+	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	lbvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	puntvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 const (
@@ -85,19 +107,32 @@ var (
 )
 
 func (p *Plugin) registerInfoHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Version, p.versionHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.JSONSchema, p.jsonSchemaHandler, GET)
+	p.registerHandler(resturl.Version, GET, (*types.Version)(nil), p.versionHandler)
+	p.registerHandler(resturl.JSONSchema, GET, nil, p.jsonSchemaHandler)
+	p.registerHandler(resturl.OpenAPI, GET, nil, p.openAPIHandler)
 }
 
 func (p *Plugin) registerNBConfigurationHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Validate, p.validationHandler, POST)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationGetHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationUpdateHandler, PUT)
+	p.registerHandler(resturl.Validate, POST, nil, p.validationHandler)
+	p.registerHandler(resturl.Configuration, GET, nil, p.configurationGetHandler)
+	p.registerHandler(resturl.Configuration, PUT, nil, p.configurationUpdateHandler)
+	for _, path := range []string{resturl.ConfigurationItem, resturl.ConfigurationSingleton} {
+		p.registerHandler(path, GET, nil, p.configItemGetHandler)
+		p.registerHandler(path, PUT, nil, p.configItemPutHandler)
+		p.registerHandler(path, PATCH, nil, p.configItemPatchHandler)
+		p.registerHandler(path, DELETE, nil, p.configItemDeleteHandler)
+	}
+}
+
+// Registers event stream handlers
+func (p *Plugin) registerStreamHandlers() {
+	p.registerHandler(resturl.StatusStream, GET, nil, p.statusStreamHandler)
+	p.registerHandler(resturl.NotificationStream, GET, nil, p.notificationStreamHandler)
 }
 
 // Registers ABF REST handler
 func (p *Plugin) registerABFHandler() {
-	p.registerHTTPHandler(resturl.ABF, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.ABF, GET, []*abfvppcalls.ABFDetails(nil), func() (interface{}, error) {
 		if p.abfHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
@@ -108,49 +143,56 @@ func (p *Plugin) registerABFHandler() {
 // Registers access list REST handlers
 func (p *Plugin) registerACLHandlers() {
 	// GET IP ACLs
-	p.registerHTTPHandler(resturl.ACLIP, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.ACLIP, GET, []*aclvppcalls.ACLDetails(nil), func() (interface{}, error) {
 		if p.aclHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.aclHandler.DumpACL()
 	})
 	// GET MACIP ACLs
-	p.registerHTTPHandler(resturl.ACLMACIP, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.ACLMACIP, GET, []*aclvppcalls.ACLDetails(nil), func() (interface{}, error) {
 		if p.aclHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.aclHandler.DumpMACIPACL()
 	})
+	// GET ACL stats
+	p.registerHTTPHandler(resturl.ACLStats, GET, []*vpp_acl.ACLStats(nil), func() (interface{}, error) {
+		if p.VPPACLPlugin == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.VPPACLPlugin.GetACLStats()
+	})
 }
 
 // Registers interface REST handlers
 func (p *Plugin) registerInterfaceHandlers() {
 	// GET all interfaces
-	p.registerHTTPHandler(resturl.Interface, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Interface, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfaces(context.TODO())
 	})
 	// GET loopback interfaces
-	p.registerHTTPHandler(resturl.Loopback, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Loopback, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_SOFTWARE_LOOPBACK)
 	})
 	// GET ethernet interfaces
-	p.registerHTTPHandler(resturl.Ethernet, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Ethernet, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_DPDK)
 	})
 	// GET memif interfaces
-	p.registerHTTPHandler(resturl.Memif, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Memif, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_MEMIF)
 	})
 	// GET tap interfaces
-	p.registerHTTPHandler(resturl.Tap, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Tap, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_TAP)
 	})
 	// GET af-packet interfaces
-	p.registerHTTPHandler(resturl.AfPacket, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.AfPacket, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_AF_PACKET)
 	})
 	// GET VxLAN interfaces
-	p.registerHTTPHandler(resturl.VxLan, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.VxLan, GET, map[uint32]*ifvppcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.ifHandler.DumpInterfacesByType(context.TODO(), interfaces.Interface_VXLAN_TUNNEL)
 	})
 }
@@ -158,53 +200,74 @@ func (p *Plugin) registerInterfaceHandlers() {
 // Registers NAT REST handlers
 func (p *Plugin) registerNATHandlers() {
 	// GET NAT global config
-	p.registerHTTPHandler(resturl.NatGlobal, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.NatGlobal, GET, (*nat.Nat44Global)(nil), func() (interface{}, error) {
 		if p.natHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.natHandler.Nat44GlobalConfigDump(false)
 	})
 	// GET DNAT config
-	p.registerHTTPHandler(resturl.NatDNat, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.NatDNat, GET, []*nat.DNat44(nil), func() (interface{}, error) {
 		if p.natHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.natHandler.DNat44Dump()
 	})
 	// GET NAT interfaces
-	p.registerHTTPHandler(resturl.NatInterfaces, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.NatInterfaces, GET, []*nat.Nat44Interface(nil), func() (interface{}, error) {
 		if p.natHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.natHandler.Nat44InterfacesDump()
 	})
 	// GET NAT address pools
-	p.registerHTTPHandler(resturl.NatAddressPools, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.NatAddressPools, GET, []*nat.Nat44AddressPool(nil), func() (interface{}, error) {
 		if p.natHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.natHandler.Nat44AddressPoolsDump()
 	})
+	// GET NAT44 users
+	p.registerHTTPHandler(resturl.NatUsers, GET, []*nat.Nat44User(nil), func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat44UsersDump()
+	})
+	// GET NAT44 sessions
+	p.registerHTTPHandler(resturl.NatSessions, GET, []*nat.Nat44Session(nil), func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat44SessionsDump()
+	})
 }
 
 // Registers L2 plugin REST handlers
 func (p *Plugin) registerL2Handlers() {
 	// GET bridge domains
-	p.registerHTTPHandler(resturl.Bd, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Bd, GET, []*l2vppcalls.BridgeDomainDetails(nil), func() (interface{}, error) {
 		if p.l2Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l2Handler.DumpBridgeDomains()
 	})
+	// GET bridge domain stats
+	p.registerHTTPHandler(resturl.BdStats, GET, []*l2vppcalls.BridgeDomainStats(nil), func() (interface{}, error) {
+		if p.l2Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.l2Handler.DumpBridgeDomainStats()
+	})
 	// GET FIB entries
-	p.registerHTTPHandler(resturl.Fib, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Fib, GET, map[string]*l2vppcalls.FibTableDetails(nil), func() (interface{}, error) {
 		if p.l2Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l2Handler.DumpL2FIBs()
 	})
 	// GET cross connects
-	p.registerHTTPHandler(resturl.Xc, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Xc, GET, map[uint32]*l2vppcalls.XConnectDetails(nil), func() (interface{}, error) {
 		if p.l2Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
@@ -215,42 +278,49 @@ func (p *Plugin) registerL2Handlers() {
 // Registers L3 plugin REST handlers
 func (p *Plugin) registerL3Handlers() {
 	// GET ARP entries
-	p.registerHTTPHandler(resturl.Arps, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Arps, GET, []*l3vppcalls.ArpDetails(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l3Handler.DumpArpEntries()
 	})
 	// GET proxy ARP interfaces
-	p.registerHTTPHandler(resturl.PArpIfs, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.PArpIfs, GET, []*l3vppcalls.ProxyArpInterfaceDetails(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l3Handler.DumpProxyArpInterfaces()
 	})
 	// GET proxy ARP ranges
-	p.registerHTTPHandler(resturl.PArpRngs, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.PArpRngs, GET, []*l3vppcalls.ProxyArpRangesDetails(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l3Handler.DumpProxyArpRanges()
 	})
 	// GET static routes
-	p.registerHTTPHandler(resturl.Routes, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Routes, GET, []*l3vppcalls.RouteDetails(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l3Handler.DumpRoutes()
 	})
+	// GET multicast routes
+	p.registerHTTPHandler(resturl.MRoutes, GET, []*l3vppcalls.MRouteDetails(nil), func() (interface{}, error) {
+		if p.l3Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.l3Handler.DumpMRoutes()
+	})
 	// GET scan ip neighbor setup
-	p.registerHTTPHandler(resturl.IPScanNeigh, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.IPScanNeigh, GET, (*l3.IPScanNeighbor)(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.l3Handler.GetIPScanNeighbor()
 	})
 	// GET vrrp entries
-	p.registerHTTPHandler(resturl.Vrrps, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.Vrrps, GET, []*l3vppcalls.VrrpDetails(nil), func() (interface{}, error) {
 		if p.l3Handler == nil {
 			return nil, ErrHandlerUnavailable
 		}
@@ -261,21 +331,21 @@ func (p *Plugin) registerL3Handlers() {
 // Registers IPSec plugin REST handlers
 func (p *Plugin) registerIPSecHandlers() {
 	// GET IPSec SPD entries
-	p.registerHTTPHandler(resturl.SPDs, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.SPDs, GET, []*ipsec.SecurityPolicyDatabase(nil), func() (interface{}, error) {
 		if p.ipSecHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.ipSecHandler.DumpIPSecSPD()
 	})
 	// GET IPSec SP entries
-	p.registerHTTPHandler(resturl.SPs, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.SPs, GET, []*ipsec.SecurityPolicy(nil), func() (interface{}, error) {
 		if p.ipSecHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
 		return p.ipSecHandler.DumpIPSecSP()
 	})
 	// GET IPSec SA entries
-	p.registerHTTPHandler(resturl.SAs, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.SAs, GET, []*ipsecvppcalls.IPSecSaDetails(nil), func() (interface{}, error) {
 		if p.ipSecHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
@@ -286,7 +356,7 @@ func (p *Plugin) registerIPSecHandlers() {
 // Registers punt plugin REST handlers
 func (p *Plugin) registerPuntHandlers() {
 	// GET punt registered socket entries
-	p.registerHTTPHandler(resturl.PuntSocket, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.PuntSocket, GET, []*puntvppcalls.PuntDetails(nil), func() (interface{}, error) {
 		if p.puntHandler == nil {
 			return nil, ErrHandlerUnavailable
 		}
@@ -294,14 +364,43 @@ func (p *Plugin) registerPuntHandlers() {
 	})
 }
 
+// Registers LB plugin REST handlers
+func (p *Plugin) registerLBHandlers() {
+	// GET load-balancer VIPs
+	p.registerHTTPHandler(resturl.LbVips, GET, []*lb.VIP(nil), func() (interface{}, error) {
+		if p.lbHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.lbHandler.DumpLbVips()
+	})
+	// GET load-balancer application servers
+	p.registerHTTPHandler(resturl.LbAppServers, GET, []*lbvppcalls.LbAppServerDetails(nil), func() (interface{}, error) {
+		if p.lbHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.lbHandler.DumpLbAppServers()
+	})
+}
+
+// Registers IGMP plugin REST handlers
+func (p *Plugin) registerIGMPHandlers() {
+	// GET IGMP groups
+	p.registerHTTPHandler(resturl.IgmpGroups, GET, []*igmpvppcalls.IgmpGroupDetails(nil), func() (interface{}, error) {
+		if p.igmpHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.igmpHandler.DumpIgmpGroups()
+	})
+}
+
 // Registers linux interface plugin REST handlers
 func (p *Plugin) registerLinuxInterfaceHandlers() {
 	// GET linux interfaces
-	p.registerHTTPHandler(resturl.LinuxInterface, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.LinuxInterface, GET, []*iflinuxcalls.InterfaceDetails(nil), func() (interface{}, error) {
 		return p.linuxIfHandler.DumpInterfaces()
 	})
 	// GET linux interface stats
-	p.registerHTTPHandler(resturl.LinuxInterfaceStats, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.LinuxInterfaceStats, GET, []*iflinuxcalls.InterfaceStatistics(nil), func() (interface{}, error) {
 		return p.linuxIfHandler.DumpInterfaceStats()
 	})
 }
@@ -309,25 +408,25 @@ func (p *Plugin) registerLinuxInterfaceHandlers() {
 // Registers linux L3 plugin REST handlers
 func (p *Plugin) registerLinuxL3Handlers() {
 	// GET linux routes
-	p.registerHTTPHandler(resturl.LinuxRoutes, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.LinuxRoutes, GET, []*l3linuxcalls.RouteDetails(nil), func() (interface{}, error) {
 		return p.linuxL3Handler.DumpRoutes()
 	})
 	// GET linux ARPs
-	p.registerHTTPHandler(resturl.LinuxArps, GET, func() (interface{}, error) {
+	p.registerHTTPHandler(resturl.LinuxArps, GET, []*l3linuxcalls.ArpDetails(nil), func() (interface{}, error) {
 		return p.linuxL3Handler.DumpARPEntries()
 	})
 }
 
 // Registers Telemetry handler
 func (p *Plugin) registerTelemetryHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Telemetry, p.telemetryHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TMemory, p.telemetryMemoryHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TRuntime, p.telemetryRuntimeHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TNodeCount, p.telemetryNodeCountHandler, GET)
+	p.registerHandler(resturl.Telemetry, GET, nil, p.telemetryHandler)
+	p.registerHandler(resturl.TMemory, GET, (*telemetryvppcalls.MemoryInfo)(nil), p.telemetryMemoryHandler)
+	p.registerHandler(resturl.TRuntime, GET, (*telemetryvppcalls.RuntimeInfo)(nil), p.telemetryRuntimeHandler)
+	p.registerHandler(resturl.TNodeCount, GET, (*telemetryvppcalls.NodeCounterInfo)(nil), p.telemetryNodeCountHandler)
 }
 
 func (p *Plugin) registerStatsHandler() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.ConfiguratorStats, p.configuratorStatsHandler, GET)
+	p.registerHandler(resturl.ConfiguratorStats, GET, (*configurator.Stats)(nil), p.configuratorStatsHandler)
 }
 
 // Registers index page
@@ -347,8 +446,81 @@ func (p *Plugin) registerIndexHandlers() {
 	p.HTTPHandlers.RegisterHTTPHandler("/", handlerFunc, GET)
 }
 
+// registerHandler registers HTTP handler and records it as REST API operation for OpenAPI document.
+// The response is sample value of handler JSON response (or nil if it is not described).
+func (p *Plugin) registerHandler(path, method string, response interface{},
+	handler func(formatter *render.Render) http.HandlerFunc) {
+	p.operations = append(p.operations, apiOperation{
+		path:     path,
+		method:   method,
+		response: response,
+		yaml:     path == resturl.Configuration || path == resturl.Validate,
+		params:   operationParams(path, method),
+	})
+	p.HTTPHandlers.RegisterHTTPHandler(path, auth.WrapHTTPHandler(p.Auth, handlerOperation(path, method), handler), method)
+}
+
+// changeContext returns context for data push triggered by the REST request decorated
+// with the originator of the change. The request ID (taken from the X-Request-ID header
+// or generated) is returned to the client in the response header.
+func changeContext(w http.ResponseWriter, req *http.Request) context.Context {
+	// Note: using "grpc" data source so that 'agentctl update --replace' can also work with this data
+	// ('agentctl update' can change data also from non-grpc data sources, but
+	// 'agentctl update --replace' (=resync) can't)
+	ctx := contextdecorator.DataSrcContext(context.Background(), "grpc")
+
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	ctx = contextdecorator.PeerContext(ctx, scheme+"://"+req.RemoteAddr)
+	if identity, ok := contextdecorator.IdentityFromContext(req.Context()); ok {
+		ctx = contextdecorator.IdentityContext(ctx, identity)
+	}
+	requestID := req.Header.Get(contextdecorator.RequestIDMetadataKey)
+	if requestID == "" {
+		requestID = contextdecorator.NewRequestID()
+	}
+	w.Header().Set(contextdecorator.RequestIDMetadataKey, requestID)
+	return contextdecorator.RequestIDContext(ctx, requestID)
+}
+
+// handlerOperation returns operation authorized before the handler is invoked.
+// Handlers modifying configuration are further authorized for the modified keys
+// by authorizeConfigWrite.
+func handlerOperation(path, method string) auth.Operation {
+	op := auth.Operation{Name: method + " " + path, Access: auth.WriteAccess, KeysAuthorizedLater: true}
+	if method == GET || path == resturl.Validate {
+		op.Access = auth.ReadAccess
+		op.KeysAuthorizedLater = false
+	}
+	return op
+}
+
+// authorizeConfigWrite checks that the caller is allowed to modify configuration
+// items with the given keys (or replace the whole configuration) and writes
+// error response if not.
+func (p *Plugin) authorizeConfigWrite(w http.ResponseWriter, req *http.Request, formatter *render.Render,
+	keys []string, fullResync bool) bool {
+	if p.Auth == nil {
+		return true
+	}
+	id, _ := auth.IdentityFromContext(req.Context())
+	err := p.Auth.Authorize(id, auth.Operation{
+		Name:       req.Method + " " + req.URL.Path,
+		Access:     auth.WriteAccess,
+		Keys:       keys,
+		FullResync: fullResync,
+	})
+	if err != nil {
+		p.logError(formatter.JSON(w, auth.HTTPStatus(err), err.Error()))
+		return false
+	}
+	return true
+}
+
 // registerHTTPHandler is common register method for all handlers
-func (p *Plugin) registerHTTPHandler(key, method string, f func() (interface{}, error)) {
+func (p *Plugin) registerHTTPHandler(key, method string, response interface{}, f func() (interface{}, error)) {
 	handlerFunc := func(formatter *render.Render) http.HandlerFunc {
 		return func(w http.ResponseWriter, req *http.Request) {
 			p.govppmux.Lock()
@@ -365,7 +537,7 @@ func (p *Plugin) registerHTTPHandler(key, method string, f func() (interface{},
 			p.logError(formatter.JSON(w, http.StatusOK, res))
 		}
 	}
-	p.HTTPHandlers.RegisterHTTPHandler(key, handlerFunc, method)
+	p.registerHandler(key, method, response, handlerFunc)
 }
 
 // jsonSchemaHandler returns JSON schema of VPP-Agent configuration.
@@ -578,7 +750,7 @@ func (p *Plugin) validationHandler(formatter *render.Render) http.HandlerFunc {
 		// run Descriptor validators on config messages
 		err = p.KVScheduler.ValidateSemantically(configMessages)
 		if err != nil {
-			if validationErrors, ok := err.(*kvscheduler.InvalidMessagesError); ok {
+			if validationErrors, ok := err.(*kvs.InvalidMessagesError); ok {
 				convertedValidationErrors := p.ConvertValidationErrorOutput(validationErrors, knownModels, config)
 				p.logError(formatter.JSON(w, http.StatusBadRequest, convertedValidationErrors))
 				return
@@ -591,7 +763,7 @@ func (p *Plugin) validationHandler(formatter *render.Render) http.HandlerFunc {
 }
 
 // ConvertValidationErrorOutput converts kvscheduler.ValidateSemantically(...) output to REST API output
-func (p *Plugin) ConvertValidationErrorOutput(validationErrors *kvscheduler.InvalidMessagesError, knownModels []*models.ModelInfo, config *dynamicpb.Message) []interface{} {
+func (p *Plugin) ConvertValidationErrorOutput(validationErrors *kvs.InvalidMessagesError, knownModels []*models.ModelInfo, config *dynamicpb.Message) []interface{} {
 	// create helper mapping
 	nameToModel := make(map[protoreflect.FullName]*models.ModelInfo)
 	for _, knownModel := range knownModels {
@@ -901,16 +1073,22 @@ func (p *Plugin) configurationUpdateHandler(formatter *render.Render) http.Handl
 			})
 		}
 
+		// check that the caller may modify all the items
+		_, replace := req.URL.Query()[URLReplaceParamName]
+		keys := make([]string, 0, len(configKVPairs))
+		for _, kv := range configKVPairs {
+			keys = append(keys, kv.Key)
+		}
+		if !p.authorizeConfigWrite(w, req, formatter, keys, replace) {
+			return
+		}
+
 		// create context for data push
-		ctx := context.Background()
+		ctx := changeContext(w, req)
 		// // FullResync
-		if _, found := req.URL.Query()[URLReplaceParamName]; found {
+		if replace {
 			ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 		}
-		// // Note: using "grpc" data source so that 'agentctl update --replace' can also work with this data
-		// // ('agentctl update' can change data also from non-grpc data sources, but
-		// // 'agentctl update --replace' (=resync) can't)
-		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
 
 		// config data pushed into VPP-Agent
 		_, err = p.Dispatcher.PushData(ctx, configKVPairs)
@@ -1021,3 +1199,552 @@ func (p *Plugin) logError(err error) {
 		p.Log.Error(err)
 	}
 }
+
+// configItem is REST API representation of single NB configuration item
+type configItem struct {
+	Model  string          `json:"model"`
+	Name   string          `json:"name,omitempty"`
+	Key    string          `json:"key"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Status json.RawMessage `json:"status,omitempty"`
+}
+
+// configItemRef identifies single NB configuration item addressed by configuration item URL
+type configItemRef struct {
+	model models.KnownModel
+	name  string
+	key   string
+}
+
+// resolveConfigItem resolves model and item name from configuration item URL. The model is looked up
+// in the model registry so that both locally and remotely known models can be handled. If the URL does
+// not refer to known configuration item, the 404 response is written and false is returned.
+func (p *Plugin) resolveConfigItem(w http.ResponseWriter, req *http.Request,
+	formatter *render.Render) (*configItemRef, bool) {
+	vars := mux.Vars(req)
+	model, err := models.DefaultRegistry.GetModel(vars["model"])
+	if err != nil || model.Spec().Class != "config" {
+		p.logError(formatter.JSON(w, http.StatusNotFound,
+			fmt.Sprintf("unknown configuration model %q", vars["model"])))
+		return nil, false
+	}
+	name := vars["name"]
+	if (name == "") != (model.NameTemplate() == "") {
+		p.logError(formatter.JSON(w, http.StatusNotFound,
+			fmt.Sprintf("invalid item name %q for configuration model %q", name, model.Name())))
+		return nil, false
+	}
+	return &configItemRef{
+		model: model,
+		name:  name,
+		key:   model.KeyPrefix() + name,
+	}, true
+}
+
+// configItemETag computes entity tag for the given configuration item value. The tag is computed
+// from deterministic binary encoding of the value, so that the same content produces the same tag
+// regardless of whether the value is generated or dynamic proto message.
+func configItemETag(value proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:16])), nil
+}
+
+// etagMatches returns true if the given entity tag is listed in the If-Match/If-None-Match header value.
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkConfigItemPreconditions evaluates If-Match and If-None-Match request headers against
+// the current entity tag of configuration item (empty if item does not exist) and returns false
+// if the request must not be applied.
+func checkConfigItemPreconditions(req *http.Request, currentETag string) bool {
+	if ifMatch := req.Header.Get("If-Match"); ifMatch != "" {
+		if currentETag == "" || !etagMatches(ifMatch, currentETag) {
+			return false
+		}
+	}
+	if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		if currentETag != "" && etagMatches(ifNoneMatch, currentETag) {
+			return false
+		}
+	}
+	return true
+}
+
+// configItemPrecondition returns precondition evaluating If-Match and If-None-Match request headers
+// against the current value of configuration item. The precondition is checked by the dispatcher
+// atomically with the push, so that changes pushed meanwhile by any other writer are detected.
+func configItemPrecondition(req *http.Request) func(current proto.Message) bool {
+	return func(current proto.Message) bool {
+		var etag string
+		if current != nil {
+			var err error
+			if etag, err = configItemETag(current); err != nil {
+				return false
+			}
+		}
+		return checkConfigItemPreconditions(req, etag)
+	}
+}
+
+// unchangedConfigItem returns precondition which holds if configuration item is still set
+// to the value with the given entity tag.
+func unchangedConfigItem(etag string) func(current proto.Message) bool {
+	return func(current proto.Message) bool {
+		if current == nil {
+			return false
+		}
+		currentETag, err := configItemETag(current)
+		return err == nil && currentETag == etag
+	}
+}
+
+// currentConfigItem returns the current value of the configuration item (nil if item is not configured)
+// together with its entity tag.
+func (p *Plugin) currentConfigItem(ref *configItemRef) (proto.Message, string, error) {
+	value, found := p.Dispatcher.ListData()[ref.key]
+	if !found || value == nil {
+		return nil, "", nil
+	}
+	etag, err := configItemETag(value)
+	if err != nil {
+		return nil, "", err
+	}
+	return value, etag, nil
+}
+
+// writeConfigItem writes configuration item with its value status as JSON response.
+func (p *Plugin) writeConfigItem(w http.ResponseWriter, formatter *render.Render, httpStatus int,
+	ref *configItemRef, value proto.Message, status *orchestrator.Status) {
+	item := configItem{
+		Model: ref.model.Name(),
+		Name:  ref.name,
+		Key:   ref.key,
+	}
+	if value != nil {
+		b, err := protojson.Marshal(value)
+		if err != nil {
+			p.internalError("can't marshal configuration item value", err, w, formatter)
+			return
+		}
+		item.Value = b
+		etag, err := configItemETag(value)
+		if err != nil {
+			p.internalError("can't compute entity tag of configuration item", err, w, formatter)
+			return
+		}
+		w.Header().Set("ETag", etag)
+	}
+	if status != nil {
+		b, err := protojson.Marshal(status)
+		if err != nil {
+			p.internalError("can't marshal configuration item status", err, w, formatter)
+			return
+		}
+		item.Status = b
+	}
+	p.logError(formatter.JSON(w, httpStatus, item))
+}
+
+// readConfigItemBody reads request body (in JSON or YAML format) and returns it converted to JSON.
+// The returned bool is false if error response was already written.
+func (p *Plugin) readConfigItemBody(w http.ResponseWriter, req *http.Request,
+	formatter *render.Render) ([]byte, bool) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		p.internalError("can't read request body", err, w, formatter)
+		return nil, false
+	}
+	bj, err := yaml2.YAMLToJSON(body)
+	if err != nil {
+		p.logError(formatter.JSON(w, http.StatusBadRequest,
+			fmt.Sprintf("can't convert request body to JSON: %v", err)))
+		return nil, false
+	}
+	return bj, true
+}
+
+// readConfigItemValue reads configuration item value (in JSON or YAML format) from request body
+// into new instance of the item model. The returned bool is false if error response was already written.
+func (p *Plugin) readConfigItemValue(w http.ResponseWriter, req *http.Request, formatter *render.Render,
+	ref *configItemRef) (proto.Message, bool) {
+	bj, ok := p.readConfigItemBody(w, req, formatter)
+	if !ok {
+		return nil, false
+	}
+	value := ref.model.NewInstance()
+	if err := protojson.Unmarshal(bj, value); err != nil {
+		p.logError(formatter.JSON(w, http.StatusBadRequest,
+			fmt.Sprintf("can't unmarshal request body into %s: %v", ref.model.ProtoName(), err)))
+		return nil, false
+	}
+	return value, true
+}
+
+// applyConfigItem validates and pushes the new value of configuration item into VPP-Agent
+// (if the precondition holds) and writes the response.
+func (p *Plugin) applyConfigItem(w http.ResponseWriter, req *http.Request, formatter *render.Render,
+	ref *configItemRef, value proto.Message, precondition func(current proto.Message) bool) {
+	// the item name is part of the value and it must match the item name from URL
+	if key, err := models.GetKey(value); err != nil || key != ref.key {
+		p.logError(formatter.JSON(w, http.StatusBadRequest,
+			fmt.Sprintf("configuration item key %q computed from request body "+
+				"does not match key %q addressed by URL", key, ref.key)))
+		return
+	}
+
+	// run Descriptor validators on the item
+	if err := p.KVScheduler.ValidateSemantically([]proto.Message{value}); err != nil {
+		if validationErrors, ok := err.(*kvs.InvalidMessagesError); ok {
+			knownModels, err := client.LocalClient.KnownModels("config")
+			if err != nil {
+				p.internalError("can't get registered models", err, w, formatter)
+				return
+			}
+			config, err := client.NewDynamicConfig(knownModels)
+			if err != nil {
+				p.internalError("can't create dynamic config", err, w, formatter)
+				return
+			}
+			convertedValidationErrors := p.ConvertValidationErrorOutput(validationErrors, knownModels, config)
+			p.logError(formatter.JSON(w, http.StatusBadRequest, convertedValidationErrors))
+			return
+		}
+		p.internalError("can't validate data", err, w, formatter)
+		return
+	}
+
+	// push the item using the same data source as the whole configuration handlers
+	result, ok := p.pushConfigItem(w, req, formatter, ref, value, precondition)
+	if !ok {
+		return
+	}
+	httpStatus := http.StatusOK
+	if result.Op == kvscheduler.TxnOperation_CREATE {
+		httpStatus = http.StatusCreated
+	}
+	p.writeConfigItem(w, formatter, httpStatus, ref, value, result.Status)
+}
+
+// pushConfigItem pushes the new value of configuration item (nil to remove the item) into VPP-Agent
+// if the precondition holds and returns the item result. If the precondition does not hold, response
+// with 412 status code is written and false is returned. If the transaction fails for the item,
+// the error response with the item status is written and false is returned. Invalid values are
+// reported with 422 status code.
+func (p *Plugin) pushConfigItem(w http.ResponseWriter, req *http.Request, formatter *render.Render,
+	ref *configItemRef, value proto.Message, precondition func(current proto.Message) bool) (*orchestrator.Result, bool) {
+	ctx := changeContext(w, req)
+	results, err := p.Dispatcher.PushData(ctx, []orchestrator.KeyVal{
+		{Key: ref.key, Val: value, Precondition: precondition},
+	})
+	var precondErr *orchestrator.PreconditionFailedError
+	if errors.As(err, &precondErr) {
+		p.logError(formatter.JSON(w, http.StatusPreconditionFailed,
+			fmt.Sprintf("configuration item %q was modified or does not exist", ref.key)))
+		return nil, false
+	}
+	result := &orchestrator.Result{Key: ref.key}
+	for _, res := range results {
+		if res.Key == ref.key {
+			*result = res
+		}
+	}
+	status := result.Status
+	if err != nil {
+		if status == nil {
+			p.internalError("can't push data into vpp-agent", err, w, formatter)
+			return nil, false
+		}
+		httpStatus := http.StatusInternalServerError
+		if status.GetState() == kvscheduler.ValueState_INVALID {
+			httpStatus = http.StatusUnprocessableEntity
+		}
+		p.Log.Warnf("configuration item %q was not applied: %v", ref.key, err)
+		p.writeConfigItem(w, formatter, httpStatus, ref, value, status)
+		return nil, false
+	}
+	return result, true
+}
+
+// mergeConfigItemPatch applies JSON merge patch (RFC 7396) onto the current value of configuration
+// item (in JSON format using proto field names) and returns the result as new instance of the item model.
+// Fields of the patch can be named by JSON or proto field names. Null removes the field, objects are
+// merged recursively (including maps) and other values, lists included, replace the current field value.
+// Setting a member of oneof removes the other members of the same oneof.
+func mergeConfigItemPatch(model models.KnownModel, current, patch []byte) (proto.Message, error) {
+	target, err := decodeJSONObject(current)
+	if err != nil {
+		return nil, err
+	}
+	patchObj, err := decodeJSONObject(patch)
+	if err != nil {
+		return nil, fmt.Errorf("merge patch must be a JSON object: %v", err)
+	}
+	value := model.NewInstance()
+	if err := mergeJSONMessage(target, patchObj, value.ProtoReflect().Descriptor()); err != nil {
+		return nil, err
+	}
+	merged, err := json.Marshal(target)
+	if err != nil {
+		return nil, err
+	}
+	if err := protojson.Unmarshal(merged, value); err != nil {
+		return nil, fmt.Errorf("can't unmarshal patched value into %s: %v", model.ProtoName(), err)
+	}
+	return value, nil
+}
+
+// decodeJSONObject decodes JSON object keeping numbers in their original form.
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	obj := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// mergeJSONMessage applies merge patch onto JSON object representing proto message.
+func mergeJSONMessage(target, patch map[string]interface{}, md protoreflect.MessageDescriptor) error {
+	fields := md.Fields()
+	for name, patchValue := range patch {
+		fd := fields.ByJSONName(name)
+		if fd == nil {
+			fd = fields.ByName(protoreflect.Name(name))
+		}
+		if fd == nil {
+			return fmt.Errorf("unknown field %q in %s", name, md.FullName())
+		}
+		key := string(fd.Name())
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			for i := 0; i < oneof.Fields().Len(); i++ {
+				if other := oneof.Fields().Get(i); other != fd {
+					delete(target, string(other.Name()))
+				}
+			}
+		}
+		if patchValue == nil {
+			delete(target, key)
+			continue
+		}
+		patchObj, isObj := patchValue.(map[string]interface{})
+		if !isObj || fd.IsList() || (!fd.IsMap() && fd.Message() == nil) {
+			target[key] = patchValue
+			continue
+		}
+		targetObj, _ := target[key].(map[string]interface{})
+		if targetObj == nil {
+			targetObj = make(map[string]interface{})
+		}
+		var err error
+		switch {
+		case fd.IsMap():
+			err = mergeJSONMap(targetObj, patchObj, fd.MapValue().Message())
+		case isWellKnownMessage(fd.Message()):
+			mergeJSONObject(targetObj, patchObj)
+		default:
+			err = mergeJSONMessage(targetObj, patchObj, fd.Message())
+		}
+		if err != nil {
+			return err
+		}
+		target[key] = targetObj
+	}
+	return nil
+}
+
+// mergeJSONMap applies merge patch onto JSON object representing proto map. Values of message type
+// (md is nil for scalar values) are merged, other values are replaced.
+func mergeJSONMap(target, patch map[string]interface{}, md protoreflect.MessageDescriptor) error {
+	if md == nil || isWellKnownMessage(md) {
+		mergeJSONObject(target, patch)
+		return nil
+	}
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(target, key)
+			continue
+		}
+		patchObj, isObj := patchValue.(map[string]interface{})
+		if !isObj {
+			target[key] = patchValue
+			continue
+		}
+		targetObj, _ := target[key].(map[string]interface{})
+		if targetObj == nil {
+			targetObj = make(map[string]interface{})
+		}
+		if err := mergeJSONMessage(targetObj, patchObj, md); err != nil {
+			return err
+		}
+		target[key] = targetObj
+	}
+	return nil
+}
+
+// mergeJSONObject applies merge patch onto JSON object without knowledge of its schema.
+func mergeJSONObject(target, patch map[string]interface{}) {
+	for name, patchValue := range patch {
+		if patchValue == nil {
+			delete(target, name)
+			continue
+		}
+		patchObj, isObj := patchValue.(map[string]interface{})
+		if !isObj {
+			target[name] = patchValue
+			continue
+		}
+		targetObj, _ := target[name].(map[string]interface{})
+		if targetObj == nil {
+			targetObj = make(map[string]interface{})
+		}
+		mergeJSONObject(targetObj, patchObj)
+		target[name] = targetObj
+	}
+}
+
+// isWellKnownMessage returns true for well-known types which have special JSON representation.
+func isWellKnownMessage(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == "google.protobuf"
+}
+
+// configItemGetHandler returns single NB configuration item together with its status.
+func (p *Plugin) configItemGetHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ref, ok := p.resolveConfigItem(w, req, formatter)
+		if !ok {
+			return
+		}
+		value, etag, err := p.currentConfigItem(ref)
+		if err != nil {
+			p.internalError("can't retrieve configuration item", err, w, formatter)
+			return
+		}
+		if value == nil {
+			p.logError(formatter.JSON(w, http.StatusNotFound,
+				fmt.Sprintf("configuration item %q not found", ref.key)))
+			return
+		}
+		if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		status, _ := p.Dispatcher.GetStatus(ref.key) // status is not available for not yet processed item
+		p.writeConfigItem(w, formatter, http.StatusOK, ref, value, status)
+	}
+}
+
+// configItemPutHandler creates or replaces single NB configuration item. The optimistic concurrency
+// is supported using If-Match (update only if item was not changed) and If-None-Match (create only)
+// headers with entity tag returned by previous GET.
+func (p *Plugin) configItemPutHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ref, ok := p.resolveConfigItem(w, req, formatter)
+		if !ok {
+			return
+		}
+		if !p.authorizeConfigWrite(w, req, formatter, []string{ref.key}, false) {
+			return
+		}
+		value, ok := p.readConfigItemValue(w, req, formatter, ref)
+		if !ok {
+			return
+		}
+		p.applyConfigItem(w, req, formatter, ref, value, configItemPrecondition(req))
+	}
+}
+
+// configItemPatchHandler updates existing single NB configuration item. The request body is JSON
+// merge patch (RFC 7396) applied onto the current item value.
+func (p *Plugin) configItemPatchHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ref, ok := p.resolveConfigItem(w, req, formatter)
+		if !ok {
+			return
+		}
+		if !p.authorizeConfigWrite(w, req, formatter, []string{ref.key}, false) {
+			return
+		}
+		patch, ok := p.readConfigItemBody(w, req, formatter)
+		if !ok {
+			return
+		}
+
+		current, etag, err := p.currentConfigItem(ref)
+		if err != nil {
+			p.internalError("can't retrieve configuration item", err, w, formatter)
+			return
+		}
+		if current == nil {
+			p.logError(formatter.JSON(w, http.StatusNotFound,
+				fmt.Sprintf("configuration item %q not found", ref.key)))
+			return
+		}
+		if !checkConfigItemPreconditions(req, etag) {
+			p.logError(formatter.JSON(w, http.StatusPreconditionFailed,
+				fmt.Sprintf("configuration item %q was modified", ref.key)))
+			return
+		}
+
+		// the current value can be generated or dynamic proto message depending on how it was pushed,
+		// the patch is therefore merged with its JSON form and unmarshalled into the model instance
+		currentJSON, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(current)
+		if err != nil {
+			p.internalError("can't marshal current configuration item", err, w, formatter)
+			return
+		}
+		value, err := mergeConfigItemPatch(ref.model, currentJSON, patch)
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusBadRequest,
+				fmt.Sprintf("can't apply merge patch to configuration item %q: %v", ref.key, err)))
+			return
+		}
+		// the patch is applied only if the item was not changed since it was read
+		p.applyConfigItem(w, req, formatter, ref, value, unchangedConfigItem(etag))
+	}
+}
+
+// configItemDeleteHandler removes single NB configuration item.
+func (p *Plugin) configItemDeleteHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ref, ok := p.resolveConfigItem(w, req, formatter)
+		if !ok {
+			return
+		}
+		if !p.authorizeConfigWrite(w, req, formatter, []string{ref.key}, false) {
+			return
+		}
+
+		current, etag, err := p.currentConfigItem(ref)
+		if err != nil {
+			p.internalError("can't retrieve configuration item", err, w, formatter)
+			return
+		}
+		if current == nil {
+			p.logError(formatter.JSON(w, http.StatusNotFound,
+				fmt.Sprintf("configuration item %q not found", ref.key)))
+			return
+		}
+		if !checkConfigItemPreconditions(req, etag) {
+			p.logError(formatter.JSON(w, http.StatusPreconditionFailed,
+				fmt.Sprintf("configuration item %q was modified", ref.key)))
+			return
+		}
+		if _, ok := p.pushConfigItem(w, req, formatter, ref, nil, configItemPrecondition(req)); !ok {
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,370 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
+	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const testItemPath = "/configuration/vpp.interfaces/if1"
+
+// mockDispatcher stores pushed configuration, values with keys listed in invalid
+// are reported as invalid.
+type mockDispatcher struct {
+	orchestrator.Dispatcher
+
+	mu      sync.Mutex
+	data    orchestrator.KVPairs
+	invalid map[string]bool
+	delay   time.Duration
+	pushes  int
+	// other simulates change pushed by another writer (e.g. gRPC client)
+	// between reading the item and the push
+	other map[string]proto.Message
+}
+
+func newMockDispatcher() *mockDispatcher {
+	return &mockDispatcher{
+		data:    make(orchestrator.KVPairs),
+		invalid: make(map[string]bool),
+	}
+}
+
+func (d *mockDispatcher) ListData() orchestrator.KVPairs {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data := make(orchestrator.KVPairs, len(d.data))
+	for key, value := range d.data {
+		data[key] = value
+	}
+	return data
+}
+
+func (d *mockDispatcher) GetStatus(key string) (*orchestrator.Status, error) {
+	return &orchestrator.Status{Key: key, State: kvscheduler.ValueState_CONFIGURED}, nil
+}
+
+func (d *mockDispatcher) PushData(ctx context.Context, kvPairs []orchestrator.KeyVal) ([]orchestrator.Result, error) {
+	// widen the window between reading the item and push
+	time.Sleep(d.delay)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for key, value := range d.other {
+		d.data[key] = value
+		delete(d.other, key)
+	}
+	for _, kv := range kvPairs {
+		if kv.Precondition != nil && !kv.Precondition(d.data[kv.Key]) {
+			return nil, &orchestrator.PreconditionFailedError{Key: kv.Key}
+		}
+	}
+	d.pushes++
+	var (
+		results []orchestrator.Result
+		err     error
+	)
+	for _, kv := range kvPairs {
+		status := &orchestrator.Status{Key: kv.Key, State: kvscheduler.ValueState_CONFIGURED}
+		op := kvscheduler.TxnOperation_UPDATE
+		if kv.Val == nil {
+			delete(d.data, kv.Key)
+			status.State = kvscheduler.ValueState_REMOVED
+			op = kvscheduler.TxnOperation_DELETE
+		} else {
+			if _, exists := d.data[kv.Key]; !exists {
+				op = kvscheduler.TxnOperation_CREATE
+			}
+			d.data[kv.Key] = kv.Val
+		}
+		if d.invalid[kv.Key] {
+			status.State = kvscheduler.ValueState_INVALID
+			status.Error = "invalid value"
+			err = errors.New("transaction failed")
+		}
+		results = append(results, orchestrator.Result{Key: kv.Key, Status: status, Op: op})
+	}
+	return results, err
+}
+
+type mockScheduler struct {
+	kvs.KVScheduler
+}
+
+func (s *mockScheduler) ValidateSemantically([]proto.Message) error {
+	return nil
+}
+
+func testConfigItemRouter() (*mux.Router, *mockDispatcher) {
+	dispatcher := newMockDispatcher()
+	p := &Plugin{
+		Deps: Deps{
+			PluginDeps:  infra.PluginDeps{Log: logging.ForPlugin("test-log")},
+			Dispatcher:  dispatcher,
+			KVScheduler: &mockScheduler{},
+		},
+	}
+	formatter := render.New()
+	router := mux.NewRouter()
+	router.HandleFunc(resturl.ConfigurationItem, p.configItemGetHandler(formatter)).Methods(GET)
+	router.HandleFunc(resturl.ConfigurationItem, p.configItemPutHandler(formatter)).Methods(PUT)
+	router.HandleFunc(resturl.ConfigurationItem, p.configItemPatchHandler(formatter)).Methods(PATCH)
+	router.HandleFunc(resturl.ConfigurationItem, p.configItemDeleteHandler(formatter)).Methods(DELETE)
+	return router, dispatcher
+}
+
+func doRequest(router http.Handler, method, path, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func ifMatch(etag string) http.Header {
+	return http.Header{"If-Match": []string{etag}}
+}
+
+// storedInterface returns the interface stored by the dispatcher.
+func storedInterface(dispatcher *mockDispatcher) *interfaces.Interface {
+	value := dispatcher.ListData()[interfaces.InterfaceKey("if1")]
+	if value == nil {
+		return nil
+	}
+	iface := &interfaces.Interface{}
+	b, err := proto.Marshal(value)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Unmarshal(b, iface)).To(Succeed())
+	return iface
+}
+
+func TestConfigItemPut(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	body := `{"name": "if1", "type": "MEMIF", "enabled": true, "memif": {"master": true, "id": 1}}`
+
+	w := doRequest(router, PUT, testItemPath, body, nil)
+	Expect(w.Code).To(Equal(http.StatusCreated))
+	etag := w.Header().Get("ETag")
+	Expect(etag).ToNot(BeEmpty())
+	Expect(storedInterface(dispatcher).GetMemif().GetId()).To(BeEquivalentTo(1))
+
+	w = doRequest(router, GET, testItemPath, "", nil)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	Expect(w.Header().Get("ETag")).To(Equal(etag))
+
+	// create only
+	w = doRequest(router, PUT, testItemPath, body, http.Header{"If-None-Match": []string{"*"}})
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+	// replace the unchanged item
+	body = `{"name": "if1", "type": "MEMIF", "memif": {"id": 2}}`
+	w = doRequest(router, PUT, testItemPath, body, ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusOK))
+	Expect(w.Header().Get("ETag")).ToNot(Equal(etag))
+	Expect(storedInterface(dispatcher).GetEnabled()).To(BeFalse())
+
+	// the item was modified since
+	w = doRequest(router, PUT, testItemPath, body, ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+	// item name does not match URL
+	w = doRequest(router, PUT, testItemPath, `{"name": "if2"}`, nil)
+	Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+	// unknown model
+	w = doRequest(router, PUT, "/configuration/vpp.unknown/if1", body, nil)
+	Expect(w.Code).To(Equal(http.StatusNotFound))
+}
+
+func TestConfigItemPutConcurrent(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	w := doRequest(router, PUT, testItemPath, `{"name": "if1", "type": "MEMIF", "mtu": 1500}`, nil)
+	Expect(w.Code).To(Equal(http.StatusCreated))
+	etag := w.Header().Get("ETag")
+	dispatcher.delay = 10 * time.Millisecond
+
+	// only one of the concurrent updates of the same version succeeds
+	const updates = 10
+	codes := make(chan int, updates)
+	var wg sync.WaitGroup
+	for i := 0; i < updates; i++ {
+		wg.Add(1)
+		go func(mtu int) {
+			defer wg.Done()
+			body := `{"name": "if1", "type": "MEMIF", "mtu": ` + strconv.Itoa(mtu) + `}`
+			codes <- doRequest(router, PUT, testItemPath, body, ifMatch(etag)).Code
+		}(1501 + i)
+	}
+	wg.Wait()
+	close(codes)
+
+	var succeeded, failed int
+	for code := range codes {
+		switch code {
+		case http.StatusOK:
+			succeeded++
+		case http.StatusPreconditionFailed:
+			failed++
+		}
+	}
+	Expect(succeeded).To(Equal(1))
+	Expect(failed).To(Equal(updates - 1))
+	Expect(dispatcher.pushes).To(Equal(2))
+}
+
+func TestConfigItemPatch(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	w := doRequest(router, PATCH, testItemPath, `{"mtu": 9000}`, nil)
+	Expect(w.Code).To(Equal(http.StatusNotFound))
+
+	body := `{"name": "if1", "type": "MEMIF", "enabled": true, "mtu": 1500,
+		"ip_addresses": ["10.0.0.1/24", "10.0.0.2/24"], "memif": {"master": true, "id": 1}}`
+	w = doRequest(router, PUT, testItemPath, body, nil)
+	Expect(w.Code).To(Equal(http.StatusCreated))
+	etag := w.Header().Get("ETag")
+
+	// nested messages are merged, lists are replaced, JSON and proto names can be used
+	w = doRequest(router, PATCH, testItemPath,
+		`{"mtu": 9000, "ipAddresses": ["10.0.1.1/24"], "memif": {"socket_filename": "/tmp/memif.sock"}}`, ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusOK))
+	iface := storedInterface(dispatcher)
+	Expect(iface.GetEnabled()).To(BeTrue())
+	Expect(iface.GetMtu()).To(BeEquivalentTo(9000))
+	Expect(iface.GetIpAddresses()).To(Equal([]string{"10.0.1.1/24"}))
+	Expect(iface.GetMemif().GetMaster()).To(BeTrue())
+	Expect(iface.GetMemif().GetId()).To(BeEquivalentTo(1))
+	Expect(iface.GetMemif().GetSocketFilename()).To(Equal("/tmp/memif.sock"))
+
+	// stale entity tag
+	w = doRequest(router, PATCH, testItemPath, `{"mtu": 1500}`, ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+	// null removes the field (YAML body)
+	w = doRequest(router, PATCH, testItemPath, "enabled: null\nmemif:\n  master: null\n", nil)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	iface = storedInterface(dispatcher)
+	Expect(iface.GetEnabled()).To(BeFalse())
+	Expect(iface.GetMemif().GetMaster()).To(BeFalse())
+	Expect(iface.GetMemif().GetId()).To(BeEquivalentTo(1))
+
+	// setting another member of oneof replaces the current one
+	w = doRequest(router, PATCH, testItemPath, `{"type": "TAP", "tap": {"version": 2}}`, nil)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	iface = storedInterface(dispatcher)
+	Expect(iface.GetType()).To(Equal(interfaces.Interface_TAP))
+	Expect(iface.GetMemif()).To(BeNil())
+	Expect(iface.GetTap().GetVersion()).To(BeEquivalentTo(2))
+
+	// invalid patches
+	for _, patch := range []string{`{"unknown": 1}`, `{"mtu": "large"}`, `["mtu"]`, `{"name": "if2"}`} {
+		w = doRequest(router, PATCH, testItemPath, patch, nil)
+		Expect(w.Code).To(Equal(http.StatusBadRequest), "patch: %s", patch)
+	}
+	Expect(storedInterface(dispatcher).GetTap().GetVersion()).To(BeEquivalentTo(2))
+}
+
+func TestConfigItemInvalid(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	dispatcher.invalid[interfaces.InterfaceKey("if1")] = true
+
+	w := doRequest(router, PUT, testItemPath, `{"name": "if1", "type": "MEMIF"}`, nil)
+	Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+	var item struct {
+		Key    string `json:"key"`
+		Status struct {
+			State string `json:"state"`
+			Error string `json:"error"`
+		} `json:"status"`
+	}
+	Expect(json.Unmarshal(w.Body.Bytes(), &item)).To(Succeed())
+	Expect(item.Key).To(Equal(interfaces.InterfaceKey("if1")))
+	Expect(item.Status.State).To(Equal("INVALID"))
+	Expect(item.Status.Error).To(Equal("invalid value"))
+}
+
+func TestConfigItemDelete(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	w := doRequest(router, PUT, testItemPath, `{"name": "if1", "type": "MEMIF"}`, nil)
+	Expect(w.Code).To(Equal(http.StatusCreated))
+	etag := w.Header().Get("ETag")
+
+	w = doRequest(router, DELETE, testItemPath, "", ifMatch(`"other"`))
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+	w = doRequest(router, DELETE, testItemPath, "", ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusNoContent))
+	Expect(storedInterface(dispatcher)).To(BeNil())
+
+	w = doRequest(router, DELETE, testItemPath, "", nil)
+	Expect(w.Code).To(Equal(http.StatusNotFound))
+}
+
+func TestConfigItemChangedByOtherWriter(t *testing.T) {
+	RegisterTestingT(t)
+
+	router, dispatcher := testConfigItemRouter()
+	w := doRequest(router, PUT, testItemPath, `{"name": "if1", "type": "MEMIF", "mtu": 1500}`, nil)
+	Expect(w.Code).To(Equal(http.StatusCreated))
+	etag := w.Header().Get("ETag")
+	other := &interfaces.Interface{Name: "if1", Type: interfaces.Interface_MEMIF, Mtu: 2000}
+
+	// the item is changed by another writer after the handler has read it
+	dispatcher.other = map[string]proto.Message{interfaces.InterfaceKey("if1"): other}
+	w = doRequest(router, PATCH, testItemPath, `{"enabled": true}`, nil)
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+	Expect(proto.Equal(storedInterface(dispatcher), other)).To(BeTrue())
+
+	dispatcher.other = map[string]proto.Message{interfaces.InterfaceKey("if1"): proto.Clone(other)}
+	w = doRequest(router, DELETE, testItemPath, "", ifMatch(etag))
+	Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+	Expect(proto.Equal(storedInterface(dispatcher), other)).To(BeTrue())
+	Expect(dispatcher.pushes).To(Equal(1))
+}
@@ -0,0 +1,631 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restapi
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unrolled/render"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/version"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// openAPIVersion is version of OpenAPI specification used for generated document. The version 3.1
+// is fully compatible with JSON Schema, so the generated schemas can use all its keywords.
+const openAPIVersion = "3.1.0"
+
+// apiObject is generic JSON object of OpenAPI document
+type apiObject = map[string]interface{}
+
+// apiOperation describes single REST API operation for OpenAPI document
+type apiOperation struct {
+	path   string
+	method string
+	// response is sample value of the JSON response used to describe the response schema
+	// (nil if the response can't be described by go type)
+	response interface{}
+	// yaml is true if the operation body (request body for PUT/POST, response for GET)
+	// is YAML-formatted all-in-one configuration as used by agentctl
+	yaml bool
+	// params are supported URL query parameters
+	params []apiParam
+	// summary and tag override the values taken from index page
+	summary string
+	tag     string
+}
+
+// apiParam describes URL query parameter of REST API operation
+type apiParam struct {
+	name        string
+	description string
+}
+
+// schedulerOperations describes REST API served by KVScheduler plugin (it is registered by the scheduler
+// itself, so it is not recorded while registering handlers of this plugin).
+func schedulerOperations() []apiOperation {
+	var operations []apiOperation
+	for _, restOp := range kvs.RESTOperations() {
+		op := apiOperation{
+			path:     restOp.Path,
+			method:   restOp.Method,
+			response: restOp.Response,
+			summary:  restOp.Summary,
+			tag:      "KV scheduler",
+		}
+		for _, param := range restOp.Params {
+			op.params = append(op.params, apiParam{name: param.Name, description: param.Description})
+		}
+		operations = append(operations, op)
+	}
+	return operations
+}
+
+// operationParams returns URL query parameters supported by handlers of this plugin.
+func operationParams(path, method string) []apiParam {
+	switch {
+	case path == resturl.Configuration && method == PUT:
+		return []apiParam{
+			{URLReplaceParamName, "replace the whole configuration instead of updating it"},
+		}
+	case path == resturl.JSONSchema:
+		return []apiParam{
+			{URLFieldNamingParamName, "field naming of exported schema (" +
+				OnlyProtoFieldNames + " or " + OnlyJSONFieldNames + ")"},
+		}
+	case path == resturl.StatusStream:
+		return []apiParam{
+			{URLStreamFromParamName, "sequence number of the first streamed value status update"},
+			{URLStreamPrefixParamName, "key prefix of streamed values (can be repeated)"},
+			{URLStreamStateParamName, "state of streamed values (can be repeated)"},
+		}
+	case path == resturl.NotificationStream:
+		return []apiParam{
+			{URLStreamFromParamName, "index of the first streamed notification"},
+			{URLStreamFilterParamName, "JSON-encoded notification used as filter (can be repeated)"},
+		}
+	}
+	return nil
+}
+
+var (
+	urlPathVarRegexp    = regexp.MustCompile(`\{(\w+):[^}]*\}`)
+	invalidSchemaRegexp = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+	operationIDRegexp   = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+	timeType      = reflect.TypeOf(time.Time{})
+	ipType        = reflect.TypeOf(net.IP{})
+	byteSliceType = reflect.TypeOf([]byte(nil))
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// openAPIHandler returns OpenAPI document describing REST API of VPP-Agent. The document is generated
+// on every request, because the remotely known models can be registered at any time.
+func (p *Plugin) openAPIHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		p.logError(formatter.JSON(w, http.StatusOK, p.buildOpenAPI(models.DefaultRegistry)))
+	}
+}
+
+// buildOpenAPI builds OpenAPI document from the registered operations and the model registry.
+func (p *Plugin) buildOpenAPI(registry models.Registry) apiObject {
+	b := &openAPIBuilder{
+		schemas: apiObject{},
+		paths:   apiObject{},
+	}
+
+	// summaries and tags of operations are taken from the index page
+	type indexRef struct {
+		group, name string
+	}
+	indexRefs := make(map[string]indexRef)
+	if p.index != nil {
+		for group, items := range p.index.ItemMap {
+			for _, item := range items {
+				indexRefs[item.Path] = indexRef{group: group, name: item.Name}
+			}
+		}
+	}
+
+	operations := append(append([]apiOperation{}, p.operations...), schedulerOperations()...)
+	for _, op := range operations {
+		if op.path == resturl.ConfigurationItem || op.path == resturl.ConfigurationSingleton {
+			continue // expanded for every known model below
+		}
+		if ref, ok := indexRefs[op.path]; ok {
+			if op.summary == "" {
+				op.summary = ref.name
+			}
+			if op.tag == "" {
+				op.tag = ref.group
+			}
+		}
+		b.addOperation(op)
+	}
+
+	knownModels := registry.RegisteredModels()
+	sort.Slice(knownModels, func(i, j int) bool {
+		return knownModels[i].Name() < knownModels[j].Name()
+	})
+	for _, model := range knownModels {
+		switch model.Spec().Class {
+		case "config":
+			b.addConfigItemOperations(model)
+		case "metrics":
+			b.addMetricsOperation(model)
+		}
+	}
+
+	return apiObject{
+		"openapi": openAPIVersion,
+		"info": apiObject{
+			"title":   "VPP-Agent REST API",
+			"version": version.Version(),
+		},
+		"paths": b.paths,
+		"components": apiObject{
+			"schemas": b.schemas,
+		},
+	}
+}
+
+// openAPIBuilder collects paths and schema components of OpenAPI document
+type openAPIBuilder struct {
+	schemas apiObject
+	paths   apiObject
+}
+
+func (b *openAPIBuilder) pathItem(path string) apiObject {
+	path = urlPathVarRegexp.ReplaceAllString(path, "{$1}")
+	item, ok := b.paths[path].(apiObject)
+	if !ok {
+		item = apiObject{}
+		b.paths[path] = item
+	}
+	return item
+}
+
+func operationID(method, path string) string {
+	return strings.ToLower(method) + strings.TrimSuffix(operationIDRegexp.ReplaceAllString(path, "_"), "_")
+}
+
+func errorResponse(description string) apiObject {
+	return apiObject{
+		"description": description,
+		"content": apiObject{
+			"application/json": apiObject{"schema": apiObject{"type": "string"}},
+		},
+	}
+}
+
+func (b *openAPIBuilder) addOperation(op apiOperation) {
+	operation := apiObject{
+		"operationId": operationID(op.method, op.path),
+		"responses": apiObject{
+			"500": errorResponse("Internal server error"),
+		},
+	}
+	if op.summary != "" {
+		operation["summary"] = op.summary
+	}
+	if op.tag != "" {
+		operation["tags"] = []string{op.tag}
+	}
+	var params []apiObject
+	for _, param := range op.params {
+		params = append(params, apiObject{
+			"name":        param.name,
+			"in":          "query",
+			"description": param.description,
+			"schema":      apiObject{"type": "string"},
+		})
+	}
+	if len(params) > 0 {
+		operation["parameters"] = params
+	}
+
+	okResponse := apiObject{"description": "Successful operation"}
+	if op.yaml && op.method == GET {
+		okResponse["content"] = apiObject{
+			YamlContentType: apiObject{"schema": apiObject{"type": "object"}},
+		}
+	} else if op.response != nil {
+		okResponse["content"] = apiObject{
+			"application/json": apiObject{"schema": b.goTypeSchema(reflect.TypeOf(op.response))},
+		}
+	}
+	operation["responses"].(apiObject)["200"] = okResponse
+
+	if op.yaml && op.method != GET {
+		operation["requestBody"] = apiObject{
+			"required": true,
+			"content": apiObject{
+				YamlContentType: apiObject{"schema": apiObject{"type": "object"}},
+			},
+		}
+		operation["responses"].(apiObject)["400"] = apiObject{
+			"description": "Invalid configuration",
+			"content": apiObject{
+				"application/json": apiObject{"schema": apiObject{"type": "array"}},
+			},
+		}
+	}
+
+	b.pathItem(op.path)[strings.ToLower(op.method)] = operation
+}
+
+// addConfigItemOperations adds operations for single configuration item of the given model
+// (see configItemGetHandler, configItemPutHandler, configItemPatchHandler and configItemDeleteHandler).
+func (b *openAPIBuilder) addConfigItemOperations(model models.KnownModel) {
+	path := "/configuration/" + model.Name()
+	var params []apiObject
+	if model.NameTemplate() != "" {
+		path += "/{name}"
+		params = append(params, apiObject{
+			"name":        "name",
+			"in":          "path",
+			"required":    true,
+			"description": "name of the configuration item",
+			"schema":      apiObject{"type": "string"},
+		})
+	}
+	tag := "Configuration items"
+	if _, isRemote := model.(*models.RemotelyKnownModel); isRemote {
+		tag = "Configuration items (remote models)"
+	}
+
+	valueSchema := b.protoMessageSchema(model.NewInstance().ProtoReflect().Descriptor())
+	itemResponse := apiObject{
+		"description": "Configuration item with its status",
+		"headers": apiObject{
+			"ETag": apiObject{
+				"description": "entity tag of the item value",
+				"schema":      apiObject{"type": "string"},
+			},
+		},
+		"content": apiObject{
+			"application/json": apiObject{"schema": apiObject{
+				"type": "object",
+				"properties": apiObject{
+					"model":  apiObject{"type": "string"},
+					"name":   apiObject{"type": "string"},
+					"key":    apiObject{"type": "string"},
+					"value":  valueSchema,
+					"status": b.protoMessageSchema((&kvscheduler.ValueStatus{}).ProtoReflect().Descriptor()),
+				},
+			}},
+		},
+	}
+	requestBody := apiObject{
+		"required": true,
+		"content": apiObject{
+			"application/json": apiObject{"schema": valueSchema},
+			YamlContentType:    apiObject{"schema": valueSchema},
+		},
+	}
+	patchBody := apiObject{
+		"description": "JSON merge patch (RFC 7396) applied onto the item value",
+		"required":    true,
+		"content": apiObject{
+			"application/merge-patch+json": apiObject{"schema": valueSchema},
+			"application/json":             apiObject{"schema": valueSchema},
+			YamlContentType:                apiObject{"schema": valueSchema},
+		},
+	}
+	conditionalHeaders := []apiObject{
+		{
+			"name":        "If-Match",
+			"in":          "header",
+			"description": "apply the request only if the item has one of the given entity tags",
+			"schema":      apiObject{"type": "string"},
+		},
+		{
+			"name":        "If-None-Match",
+			"in":          "header",
+			"description": "apply the request only if the item does not have any of the given entity tags",
+			"schema":      apiObject{"type": "string"},
+		},
+	}
+	invalidItemResponse := apiObject{
+		"description": "Configuration item with its status, rejected as invalid",
+		"content":     itemResponse["content"],
+	}
+	validationError := apiObject{
+		"description": "Invalid configuration item",
+		"content": apiObject{
+			"application/json": apiObject{"schema": apiObject{}},
+		},
+	}
+
+	item := b.pathItem(path)
+	if len(params) > 0 {
+		item["parameters"] = params
+	}
+	item["get"] = apiObject{
+		"operationId": "get_" + operationIDRegexp.ReplaceAllString(model.Name(), "_"),
+		"summary":     "Get " + model.ProtoName() + " configuration item",
+		"tags":        []string{tag},
+		"parameters":  conditionalHeaders[1:],
+		"responses": apiObject{
+			"200": itemResponse,
+			"304": apiObject{"description": "Not modified"},
+			"404": errorResponse("Unknown model or item"),
+		},
+	}
+	item["put"] = apiObject{
+		"operationId": "put_" + operationIDRegexp.ReplaceAllString(model.Name(), "_"),
+		"summary":     "Create or replace " + model.ProtoName() + " configuration item",
+		"tags":        []string{tag},
+		"parameters":  conditionalHeaders,
+		"requestBody": requestBody,
+		"responses": apiObject{
+			"200": itemResponse,
+			"201": itemResponse,
+			"400": validationError,
+			"404": errorResponse("Unknown model"),
+			"412": errorResponse("Precondition failed"),
+			"422": invalidItemResponse,
+		},
+	}
+	item["patch"] = apiObject{
+		"operationId": "patch_" + operationIDRegexp.ReplaceAllString(model.Name(), "_"),
+		"summary":     "Update fields of " + model.ProtoName() + " configuration item",
+		"tags":        []string{tag},
+		"parameters":  conditionalHeaders[:1],
+		"requestBody": patchBody,
+		"responses": apiObject{
+			"200": itemResponse,
+			"400": validationError,
+			"404": errorResponse("Unknown model or item"),
+			"412": errorResponse("Precondition failed"),
+			"422": invalidItemResponse,
+		},
+	}
+	item["delete"] = apiObject{
+		"operationId": "delete_" + operationIDRegexp.ReplaceAllString(model.Name(), "_"),
+		"summary":     "Delete " + model.ProtoName() + " configuration item",
+		"tags":        []string{tag},
+		"parameters":  conditionalHeaders[:1],
+		"responses": apiObject{
+			"204": apiObject{"description": "Item deleted"},
+			"404": errorResponse("Unknown model or item"),
+			"412": errorResponse("Precondition failed"),
+		},
+	}
+}
+
+// addMetricsOperation adds operation retrieving metrics of the given model (served by the Telemetry
+// plugin, so it is not recorded while registering handlers of this plugin).
+func (b *openAPIBuilder) addMetricsOperation(model models.KnownModel) {
+	path := strings.Replace(resturl.Metrics, "{metric}", model.Name(), 1)
+	metricsError := func(description string) apiObject {
+		return apiObject{
+			"description": description,
+			"content": apiObject{
+				"application/json": apiObject{"schema": apiObject{
+					"type":       "object",
+					"properties": apiObject{"Error": apiObject{"type": "string"}},
+				}},
+			},
+		}
+	}
+	b.pathItem(path)["get"] = apiObject{
+		"operationId": "get_metrics_" + operationIDRegexp.ReplaceAllString(model.Name(), "_"),
+		"summary":     "Get " + model.ProtoName() + " metrics",
+		"tags":        []string{"Metrics"},
+		"responses": apiObject{
+			"200": apiObject{
+				"description": "Successful operation",
+				"content": apiObject{
+					"application/json": apiObject{
+						"schema": b.protoMessageSchema(model.NewInstance().ProtoReflect().Descriptor()),
+					},
+				},
+			},
+			"404": metricsError("Unknown model"),
+			"500": metricsError("Metrics could not be retrieved"),
+		},
+	}
+}
+
+// schemaRef returns reference to schema component with given name.
+func schemaRef(name string) apiObject {
+	return apiObject{"$ref": "#/components/schemas/" + name}
+}
+
+// goTypeSchema returns schema of go type as encoded by encoding/json (used for responses
+// of dump handlers). Named struct types are added into schema components.
+func (b *openAPIBuilder) goTypeSchema(t reflect.Type) apiObject {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType:
+		return apiObject{"type": "string", "format": "date-time"}
+	case ipType:
+		return apiObject{"type": "string"}
+	case byteSliceType:
+		return apiObject{"type": "string", "contentEncoding": "base64"}
+	}
+	if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+		return apiObject{} // custom JSON encoding
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return apiObject{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return apiObject{"type": "integer"}
+	case reflect.Int64, reflect.Uint64, reflect.Uintptr:
+		return apiObject{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return apiObject{"type": "number"}
+	case reflect.String:
+		return apiObject{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return apiObject{"type": "array", "items": b.goTypeSchema(t.Elem())}
+	case reflect.Map:
+		return apiObject{"type": "object", "additionalProperties": b.goTypeSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.goStructSchema(t)
+		}
+		name := goTypeSchemaName(t)
+		if _, exists := b.schemas[name]; !exists {
+			b.schemas[name] = apiObject{} // placeholder for recursive types
+			b.schemas[name] = b.goStructSchema(t)
+		}
+		return schemaRef(name)
+	default:
+		return apiObject{}
+	}
+}
+
+func (b *openAPIBuilder) goStructSchema(t reflect.Type) apiObject {
+	properties := apiObject{}
+	b.goStructProperties(t, properties)
+	return apiObject{"type": "object", "properties": properties}
+}
+
+func (b *openAPIBuilder) goStructProperties(t reflect.Type, properties apiObject) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagParts := strings.Split(tag, ",")
+		name := tagParts[0]
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if name == "" && field.Anonymous && fieldType.Kind() == reflect.Struct {
+			b.goStructProperties(fieldType, properties) // embedded struct fields are promoted
+			continue
+		}
+		if field.PkgPath != "" {
+			continue // unexported field
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema := b.goTypeSchema(field.Type)
+		for _, opt := range tagParts[1:] {
+			if opt == "string" {
+				schema = apiObject{"type": "string"}
+			}
+		}
+		properties[name] = schema
+	}
+}
+
+// goTypeSchemaName returns schema component name for named go type. The name is derived
+// from the package path to distinguish types with the same name from different packages.
+func goTypeSchemaName(t reflect.Type) string {
+	pkgPath := strings.TrimPrefix(t.PkgPath(), "go.ligato.io/vpp-agent/v3/")
+	name := strings.ReplaceAll(pkgPath, "/", ".") + "." + t.Name()
+	return invalidSchemaRegexp.ReplaceAllString(name, "_")
+}
+
+// protoMessageSchema returns schema of proto message as encoded by protojson (used for configuration
+// items). The message schema is added into schema components named by the message full name.
+func (b *openAPIBuilder) protoMessageSchema(md protoreflect.MessageDescriptor) apiObject {
+	switch md.FullName() {
+	case "google.protobuf.Empty", "google.protobuf.Struct":
+		return apiObject{"type": "object"}
+	case "google.protobuf.Any":
+		return apiObject{"type": "object", "properties": apiObject{"@type": apiObject{"type": "string"}}}
+	case "google.protobuf.Timestamp":
+		return apiObject{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return apiObject{"type": "string"}
+	case "google.protobuf.Value":
+		return apiObject{}
+	case "google.protobuf.ListValue":
+		return apiObject{"type": "array"}
+	}
+	if md.ParentFile() != nil && md.ParentFile().Package() == "google.protobuf" &&
+		strings.HasSuffix(string(md.Name()), "Value") {
+		// wrapper types are encoded as the wrapped scalar value
+		return b.protoFieldKindSchema(md.Fields().ByName("value"))
+	}
+
+	name := invalidSchemaRegexp.ReplaceAllString(string(md.FullName()), "_")
+	if _, exists := b.schemas[name]; !exists {
+		b.schemas[name] = apiObject{} // placeholder for recursive messages
+		properties := apiObject{}
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			properties[fd.JSONName()] = b.protoFieldSchema(fd)
+		}
+		b.schemas[name] = apiObject{"type": "object", "properties": properties}
+	}
+	return schemaRef(name)
+}
+
+func (b *openAPIBuilder) protoFieldSchema(fd protoreflect.FieldDescriptor) apiObject {
+	switch {
+	case fd.IsMap():
+		return apiObject{"type": "object", "additionalProperties": b.protoFieldKindSchema(fd.MapValue())}
+	case fd.IsList():
+		return apiObject{"type": "array", "items": b.protoFieldKindSchema(fd)}
+	default:
+		return b.protoFieldKindSchema(fd)
+	}
+}
+
+func (b *openAPIBuilder) protoFieldKindSchema(fd protoreflect.FieldDescriptor) apiObject {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return apiObject{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return apiObject{"type": "integer"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return apiObject{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return apiObject{"type": "number"}
+	case protoreflect.StringKind:
+		return apiObject{"type": "string"}
+	case protoreflect.BytesKind:
+		return apiObject{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return apiObject{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.protoMessageSchema(fd.Message())
+	default:
+		return apiObject{}
+	}
+}
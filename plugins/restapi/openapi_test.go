@@ -0,0 +1,182 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	govppmux_model "go.ligato.io/vpp-agent/v3/proto/ligato/govppmux"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// testTree is recursive go type used as response of test operation.
+type testTree struct {
+	Name     string      `json:"name"`
+	Children []*testTree `json:"children,omitempty"`
+}
+
+// newTestTreeModel returns remote model of recursive proto message test.Tree.
+func newTestTreeModel(t *testing.T) *models.ModelInfo {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test/tree.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Tree"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("name"),
+					JsonName: proto.String("name"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				},
+				{
+					Name:     proto.String("children"),
+					JsonName: proto.String("children"),
+					Number:   proto.Int32(2),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".test.Tree"),
+				},
+			},
+		}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to build proto descriptor: %v", err)
+	}
+	return &models.ModelInfo{
+		ModelDetail: &generic.ModelDetail{
+			Spec:      &generic.ModelSpec{Module: "remote", Type: "tree", Version: "v1", Class: "config"},
+			ProtoName: "test.Tree",
+			Options:   []*generic.ModelDetail_Option{{Key: "nameTemplate", Values: []string{"{{.Name}}"}}},
+		},
+		MessageDescriptor: file.Messages().ByName("Tree"),
+	}
+}
+
+func TestBuildOpenAPI(t *testing.T) {
+	RegisterTestingT(t)
+
+	registry := models.NewRegistry()
+	_, err := registry.Register(&interfaces.Interface{}, models.Spec{
+		Module:  "vpp",
+		Type:    "interfaces",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = registry.Register(newTestTreeModel(t), models.Spec{})
+	Expect(err).ToNot(HaveOccurred())
+	_, err = registry.Register(&govppmux_model.Metrics{}, models.Spec{
+		Module: "govppmux",
+		Type:   "stats",
+		Class:  "metrics",
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	p := &Plugin{}
+	p.operations = []apiOperation{
+		{path: resturl.Configuration, method: GET, yaml: true},
+		{path: resturl.ConfigurationItem, method: GET},
+		{path: "/test/tree", method: GET, response: (*testTree)(nil)},
+	}
+
+	// the document (including recursive schemas) can be encoded
+	data, err := json.Marshal(p.buildOpenAPI(registry))
+	Expect(err).ToNot(HaveOccurred())
+	var doc map[string]interface{}
+	Expect(json.Unmarshal(data, &doc)).To(Succeed())
+
+	Expect(doc).To(HaveKeyWithValue("openapi", "3.1.0"))
+	Expect(doc).To(HaveKey("info"))
+	info := doc["info"].(map[string]interface{})
+	Expect(info).To(HaveKeyWithValue("title", Not(BeEmpty())))
+	Expect(info).To(HaveKey("version"))
+	Expect(doc).To(HaveKey("components"))
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	paths := doc["paths"].(map[string]interface{})
+
+	// every operation has unique ID and responses
+	operationIDs := make(map[string]bool)
+	for path, item := range paths {
+		Expect(path).To(HavePrefix("/"))
+		Expect(path).ToNot(ContainSubstring(":"), "path variable patterns must be removed")
+		for method, operation := range item.(map[string]interface{}) {
+			if method == "parameters" {
+				continue
+			}
+			Expect([]string{"get", "put", "post", "patch", "delete"}).To(ContainElement(method))
+			operation := operation.(map[string]interface{})
+			id, _ := operation["operationId"].(string)
+			Expect(id).ToNot(BeEmpty(), "%s %s", method, path)
+			Expect(operationIDs).ToNot(HaveKey(id), "%s %s", method, path)
+			operationIDs[id] = true
+			Expect(operation["responses"]).ToNot(BeEmpty(), "%s %s", method, path)
+		}
+	}
+
+	// all schema references are resolvable
+	var checkRefs func(v interface{})
+	checkRefs = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				Expect(ref).To(HavePrefix("#/components/schemas/"))
+				Expect(schemas).To(HaveKey(strings.TrimPrefix(ref, "#/components/schemas/")))
+			}
+			for _, elem := range v {
+				checkRefs(elem)
+			}
+		case []interface{}:
+			for _, elem := range v {
+				checkRefs(elem)
+			}
+		}
+	}
+	checkRefs(doc)
+
+	// config items of local and remote models
+	Expect(paths).To(HaveKey("/configuration"))
+	Expect(paths).ToNot(HaveKey("/configuration/{model}/{name}"))
+	Expect(paths).To(HaveKey("/configuration/vpp.interfaces/{name}"))
+	Expect(paths).To(HaveKey("/configuration/remote.tree/{name}"))
+	remoteGet := paths["/configuration/remote.tree/{name}"].(map[string]interface{})["get"].(map[string]interface{})
+	Expect(remoteGet["tags"]).To(ConsistOf("Configuration items (remote models)"))
+	Expect(schemas).To(HaveKey("ligato.vpp.interfaces.Interface"))
+
+	// metrics served by the Telemetry plugin
+	Expect(paths).To(HaveKey("/metrics/govppmux.stats"))
+	Expect(schemas).To(HaveKey("ligato.govppmux.Metrics"))
+
+	// recursive types refer to themselves
+	Expect(schemas).To(HaveKey("test.Tree"))
+	treeChildren := schemas["test.Tree"].(map[string]interface{})["properties"].(map[string]interface{})["children"]
+	Expect(treeChildren).To(HaveKeyWithValue("items", map[string]interface{}{"$ref": "#/components/schemas/test.Tree"}))
+	goTreeName := goTypeSchemaName(reflect.TypeOf(testTree{}))
+	Expect(schemas).To(HaveKey(goTreeName))
+	goTreeChildren := schemas[goTreeName].(map[string]interface{})["properties"].(map[string]interface{})["children"]
+	Expect(goTreeChildren).To(HaveKeyWithValue("items", map[string]interface{}{"$ref": "#/components/schemas/" + goTreeName}))
+}
@@ -17,6 +17,8 @@ package restapi
 import (
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 	"go.ligato.io/cn-infra/v2/servicelabel"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
+	"go.ligato.io/vpp-agent/v3/plugins/configurator"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
 	linuxifplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
@@ -38,6 +40,7 @@ func NewPlugin(opts ...Option) *Plugin {
 
 	p.PluginName = "restpapi"
 	p.HTTPHandlers = &rest.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 	p.VPP = &govppmux.DefaultPlugin
 	p.ServiceLabel = &servicelabel.DefaultPlugin
 	p.AddrAlloc = &netalloc.DefaultPlugin
@@ -49,6 +52,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.NsPlugin = &nsplugin.DefaultPlugin
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 	p.Dispatcher = &orchestrator.DefaultPlugin
+	p.Notifications = &configurator.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
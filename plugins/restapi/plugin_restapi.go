@@ -23,6 +23,7 @@ import (
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 	access "go.ligato.io/cn-infra/v2/rpc/rest/security/model/access-security"
 	"go.ligato.io/cn-infra/v2/servicelabel"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	vpevppcalls "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
 	kvscheduler "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -39,11 +40,13 @@ import (
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	igmpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	lbvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 	puntvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
@@ -51,9 +54,11 @@ import (
 
 // REST api methods
 const (
-	GET  = http.MethodGet
-	POST = http.MethodPost
-	PUT  = http.MethodPut
+	GET    = http.MethodGet
+	POST   = http.MethodPost
+	PUT    = http.MethodPut
+	PATCH  = http.MethodPatch
+	DELETE = http.MethodDelete
 )
 
 // Default Go routine count used to retrieve linux configuration
@@ -79,10 +84,18 @@ type Plugin struct {
 	ipSecHandler     ipsecvppcalls.IPSecVPPRead
 	puntHandler      puntvppcalls.PuntVPPRead
 	wireguardHandler wireguardvppcalls.WgVppRead
+	lbHandler        lbvppcalls.LbVppRead
+	igmpHandler      igmpvppcalls.IgmpVppRead
 	// Linux handlers
 	linuxIfHandler iflinuxcalls.NetlinkAPIRead
 	linuxL3Handler l3linuxcalls.NetlinkAPIRead
 
+	// Value status updates streamed to clients
+	statusStream *statusStream
+
+	// REST API operations registered by this plugin (used to generate OpenAPI document)
+	operations []apiOperation
+
 	govppmux sync.Mutex
 }
 
@@ -90,6 +103,7 @@ type Plugin struct {
 type Deps struct {
 	infra.PluginDeps
 	HTTPHandlers  rest.HTTPHandlers
+	Auth          auth.API
 	VPP           govppmux.API
 	ServiceLabel  servicelabel.ReaderAPI
 	AddrAlloc     netalloc.AddressAllocator
@@ -101,6 +115,7 @@ type Deps struct {
 	NsPlugin      nsplugin.API
 	Dispatcher    orchestrator.Dispatcher
 	KVScheduler   kvscheduler.KVScheduler
+	Notifications NotificationWatcher
 }
 
 // index defines map of main index page entries
@@ -177,12 +192,28 @@ func (p *Plugin) Init() (err error) {
 	if p.wireguardHandler == nil {
 		p.Log.Info("Wireguard handler is not available, it will be skipped")
 	}
+	p.lbHandler = lbvppcalls.CompatibleLbVppHandler(p.VPP, p.Log)
+	if p.lbHandler == nil {
+		p.Log.Info("LB handler is not available, it will be skipped")
+	}
+	p.igmpHandler = igmpvppcalls.CompatibleIgmpVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.igmpHandler == nil {
+		p.Log.Info("IGMP handler is not available, it will be skipped")
+	}
 
 	// Linux handlers
 	p.linuxIfHandler = iflinuxcalls.NewNetLinkHandler(p.NsPlugin, linuxIfIndexes, p.ServiceLabel.GetAgentPrefix(),
 		defaultGoRoutineCount, p.Log)
 	p.linuxL3Handler = l3linuxcalls.NewNetLinkHandler(p.NsPlugin, linuxIfIndexes, defaultGoRoutineCount, p.Log)
 
+	// Subscribe for value status updates streamed to clients
+	p.statusStream = newStatusStream()
+	p.KVScheduler.WatchValueStatus(p.statusStream.updates, nil)
+	if recovery, ok := p.VPP.(govppmux.RecoveryWatcher); ok {
+		recovery.WatchRecovery(p.statusStream.recoveries)
+	}
+	p.statusStream.run()
+
 	p.index = &index{
 		ItemMap: getIndexPageItems(),
 	}
@@ -199,6 +230,8 @@ func (p *Plugin) AfterInit() (err error) {
 	p.registerInfoHandlers()
 	// NB configuration handlers.
 	p.registerNBConfigurationHandlers()
+	// Event stream handlers.
+	p.registerStreamHandlers()
 	// VPP handlers
 	p.registerTelemetryHandlers()
 	// core
@@ -211,6 +244,8 @@ func (p *Plugin) AfterInit() (err error) {
 	p.registerACLHandlers()
 	p.registerNATHandlers()
 	p.registerPuntHandlers()
+	p.registerLBHandlers()
+	p.registerIGMPHandlers()
 	// Linux handlers
 	p.registerLinuxInterfaceHandlers()
 	p.registerLinuxL3Handlers()
@@ -222,6 +257,9 @@ func (p *Plugin) AfterInit() (err error) {
 
 // Close is used to clean up resources used by Plugin
 func (p *Plugin) Close() error {
+	if p.statusStream != nil {
+		p.statusStream.close()
+	}
 	return nil
 }
 
@@ -231,14 +269,20 @@ func getIndexPageItems() map[string][]indexItem {
 		"Info": {
 			{Name: "Version", Path: resturl.Version},
 			{Name: "JSONSchema", Path: resturl.JSONSchema},
+			{Name: "OpenAPI", Path: resturl.OpenAPI},
 		},
 		"NB configuration": {
 			{Name: "Get or Put NB configuration", Path: resturl.Configuration},
 			{Name: "Validation", Path: resturl.Validate},
 		},
+		"Event streams": {
+			{Name: "Value status", Path: resturl.StatusStream},
+			{Name: "Notifications", Path: resturl.NotificationStream},
+		},
 		"ACL plugin": {
 			{Name: "IP-type access lists", Path: resturl.ACLIP},
 			{Name: "MACIP-type access lists", Path: resturl.ACLMACIP},
+			{Name: "Access list rule hit counters", Path: resturl.ACLStats},
 		},
 		"Interface plugin": {
 			{Name: "All interfaces", Path: resturl.Interface},
@@ -251,15 +295,24 @@ func getIndexPageItems() map[string][]indexItem {
 		},
 		"L2 plugin": {
 			{Name: "Bridge domains", Path: resturl.Bd},
+			{Name: "Bridge domain stats", Path: resturl.BdStats},
 			{Name: "L2Fibs", Path: resturl.Fib},
 			{Name: "Cross connects", Path: resturl.Xc},
 		},
 		"L3 plugin": {
 			{Name: "Routes", Path: resturl.Routes},
+			{Name: "Multicast routes", Path: resturl.MRoutes},
 			{Name: "ARPs", Path: resturl.Arps},
 			{Name: "Proxy ARP interfaces", Path: resturl.PArpIfs},
 			{Name: "Proxy ARP ranges", Path: resturl.PArpRngs},
 		},
+		"LB plugin": {
+			{Name: "VIPs", Path: resturl.LbVips},
+			{Name: "Application servers", Path: resturl.LbAppServers},
+		},
+		"IGMP plugin": {
+			{Name: "Groups", Path: resturl.IgmpGroups},
+		},
 		"Telemetry": {
 			{Name: "All data", Path: resturl.Telemetry},
 			{Name: "Memory", Path: resturl.TMemory},
@@ -282,6 +335,7 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission("/", GET),
 			newPermission(resturl.Version, GET),
 			newPermission(resturl.JSONSchema, GET),
+			newPermission(resturl.OpenAPI, GET),
 		},
 	}
 	nbConfigValidationPg := &access.PermissionGroup{
@@ -296,6 +350,8 @@ func getPermissionsGroups() []*access.PermissionGroup {
 		Permissions: []*access.PermissionGroup_Permissions{
 			newPermission("/", GET),
 			newPermission(resturl.Configuration, GET),
+			newPermission(resturl.ConfigurationItem, GET),
+			newPermission(resturl.ConfigurationSingleton, GET),
 		},
 	}
 	nbConfigWritePg := &access.PermissionGroup{
@@ -303,6 +359,16 @@ func getPermissionsGroups() []*access.PermissionGroup {
 		Permissions: []*access.PermissionGroup_Permissions{
 			newPermission("/", PUT),
 			newPermission(resturl.Configuration, PUT),
+			newPermission(resturl.ConfigurationItem, PUT, PATCH, DELETE),
+			newPermission(resturl.ConfigurationSingleton, PUT, PATCH, DELETE),
+		},
+	}
+	streamPg := &access.PermissionGroup{
+		Name: "stream",
+		Permissions: []*access.PermissionGroup_Permissions{
+			newPermission("/", GET),
+			newPermission(resturl.StatusStream, GET),
+			newPermission(resturl.NotificationStream, GET),
 		},
 	}
 	tracerPg := &access.PermissionGroup{
@@ -329,6 +395,7 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.ABF, GET),
 			newPermission(resturl.ACLIP, GET),
 			newPermission(resturl.ACLMACIP, GET),
+			newPermission(resturl.ACLStats, GET),
 			newPermission(resturl.Interface, GET),
 			newPermission(resturl.Loopback, GET),
 			newPermission(resturl.Ethernet, GET),
@@ -337,17 +404,22 @@ func getPermissionsGroups() []*access.PermissionGroup {
 			newPermission(resturl.VxLan, GET),
 			newPermission(resturl.AfPacket, GET),
 			newPermission(resturl.Bd, GET),
+			newPermission(resturl.BdStats, GET),
 			newPermission(resturl.Fib, GET),
 			newPermission(resturl.Xc, GET),
 			newPermission(resturl.Arps, GET),
 			newPermission(resturl.Routes, GET),
+			newPermission(resturl.MRoutes, GET),
 			newPermission(resturl.PArpIfs, GET),
 			newPermission(resturl.PArpRngs, GET),
+			newPermission(resturl.LbVips, GET),
+			newPermission(resturl.LbAppServers, GET),
+			newPermission(resturl.IgmpGroups, GET),
 		},
 	}
 
 	return []*access.PermissionGroup{infoPg, tracerPg, telemetryPg, dumpPg,
-		nbConfigValidationPg, nbConfigReadPg, nbConfigWritePg}
+		nbConfigValidationPg, nbConfigReadPg, nbConfigWritePg, streamPg}
 }
 
 // Returns permission object with url and provided methods
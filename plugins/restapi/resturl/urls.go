@@ -22,6 +22,10 @@ const (
 	// JSONSchema is a path for retrieving JSON Schema for VPP-Agent configuration (dynamically created
 	// container of all registered configuration models).
 	JSONSchema = "/info/configuration/jsonschema"
+
+	// OpenAPI is a path for retrieving OpenAPI document describing REST API of VPP-Agent (generated from
+	// registered handlers and all known configuration models).
+	OpenAPI = "/info/openapi"
 )
 
 // Configuration
@@ -32,6 +36,24 @@ const (
 	// Validate is a path for validating NB yaml configuration for VPP-Agent (the same all-in-one dynamically
 	// created yaml configuration as used in agentctl configuration get/update)
 	Validate = "/configuration/validate"
+
+	// ConfigurationItem is a path for handling(GET,PUT,PATCH,DELETE) single NB configuration item identified
+	// by its model name (i.e. "vpp.interfaces") and item name (i.e. "loop1")
+	ConfigurationItem = "/configuration/{model}/{name:.+}"
+
+	// ConfigurationSingleton is a path for handling(GET,PUT,PATCH,DELETE) NB configuration item of model
+	// that has no name template (only one item of such model can exist)
+	ConfigurationSingleton = "/configuration/{model}"
+)
+
+// Event streams
+const (
+	// StatusStream is a path for streaming (as server-sent events) updates of value status, optionally
+	// filtered by key prefix and value state
+	StatusStream = "/stream/status"
+
+	// NotificationStream is a path for streaming (as server-sent events) VPP and Linux notifications
+	NotificationStream = "/stream/notifications"
 )
 
 // Linux Dumps
@@ -61,6 +83,8 @@ const (
 	ACLIP = "/dump/vpp/v2/acl/ip"
 	// REST ACL MACIP prefix
 	ACLMACIP = "/dump/vpp/v2/acl/macip"
+	// REST ACL stats (rule hit counters)
+	ACLStats = "/dump/vpp/v2/acl/stats"
 )
 
 // VPP Interfaces
@@ -92,12 +116,18 @@ const (
 	NatInterfaces = "/dump/vpp/v2/nat/interfaces"
 	// NatAddressPools is a REST path of NAT address pools config
 	NatAddressPools = "/dump/vpp/v2/nat/pools"
+	// NatUsers is a REST path of NAT44 users (inside addresses with active sessions)
+	NatUsers = "/dump/vpp/v2/nat/users"
+	// NatSessions is a REST path of active NAT44 sessions
+	NatSessions = "/dump/vpp/v2/nat/sessions"
 )
 
 // L2 plugin
 const (
 	// restBd is rest bridge domain path
 	Bd = "/dump/vpp/v2/bd"
+	// BdStats is rest bridge domain statistics path
+	BdStats = "/dump/vpp/v2/bd/stats"
 	// restFib is rest FIB path
 	Fib = "/dump/vpp/v2/fib"
 	// restXc is rest cross-connect path
@@ -108,6 +138,8 @@ const (
 const (
 	// Routes is rest static route path
 	Routes = "/dump/vpp/v2/routes"
+	// MRoutes is rest multicast route path
+	MRoutes = "/dump/vpp/v2/mroutes"
 	// Arps is rest ARPs path
 	Arps = "/dump/vpp/v2/arps"
 	// PArpIfs is rest proxy ARP interfaces path
@@ -135,6 +167,20 @@ const (
 	PuntSocket = "/dump/vpp/v2/punt/sockets"
 )
 
+// VPP LB plugin
+const (
+	// LbVips is rest load-balancer VIPs path
+	LbVips = "/dump/vpp/v2/lb/vips"
+	// LbAppServers is rest load-balancer application servers path
+	LbAppServers = "/dump/vpp/v2/lb/as"
+)
+
+// VPP IGMP plugin
+const (
+	// IgmpGroups is rest IGMP groups path
+	IgmpGroups = "/dump/vpp/v2/igmp/groups"
+)
+
 // VPP Wireguard plugin
 const (
 	Peers = "/dump/vpp/v2/wireguard/peers"
@@ -147,6 +193,10 @@ const (
 	TMemory    = "/vpp/telemetry/memory"
 	TRuntime   = "/vpp/telemetry/runtime"
 	TNodeCount = "/vpp/telemetry/nodecount"
+
+	// Metrics is a path for retrieving metrics of model identified by its model name
+	// (i.e. "govppmux.stats"), served by the Telemetry plugin
+	Metrics = "/metrics/{metric}"
 )
 
 // Stats
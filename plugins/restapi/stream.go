@@ -0,0 +1,441 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unrolled/render"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	configuratorpb "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+const (
+	// URLStreamFromParamName is URL parameter name of event streams selecting sequence number
+	// of the first streamed event (events still available in the buffer are replayed).
+	// The Last-Event-ID header sent by reconnecting SSE clients takes precedence.
+	URLStreamFromParamName = "from"
+	// URLStreamPrefixParamName is URL parameter name of the value status stream selecting
+	// key prefixes of streamed values (can be repeated).
+	URLStreamPrefixParamName = "prefix"
+	// URLStreamStateParamName is URL parameter name of the value status stream selecting
+	// states of streamed values (i.e. "configured", "failed"; can be repeated).
+	URLStreamStateParamName = "state"
+	// URLStreamFilterParamName is URL parameter name of the notification stream with JSON-encoded
+	// notification used as a filter (i.e. {"vppNotification":{}}; can be repeated).
+	URLStreamFilterParamName = "filter"
+
+	// EventStreamContentType is http header content type for server-sent events
+	EventStreamContentType = "text/event-stream"
+
+	lastEventIDHeader = "Last-Event-ID"
+
+	// statusStreamBufferSize is the number of the most recent value status updates
+	// kept for replay to reconnecting clients.
+	statusStreamBufferSize = 1000
+	// streamHeartbeatPeriod is a period of comments sent to idle streams to keep
+	// the connection open through proxies.
+	streamHeartbeatPeriod = 15 * time.Second
+)
+
+// NotificationWatcher allows to watch VPP and Linux notifications.
+type NotificationWatcher interface {
+	// WatchNotifications sends notifications matching the request using the send callback
+	// until the context is done or sending fails.
+	WatchNotifications(ctx context.Context, req *configuratorpb.NotifyRequest,
+		send func(*configuratorpb.NotifyResponse) error) error
+}
+
+// streamReset is data of event sent to the client of the value status stream when some
+// of the requested events are no longer available (or the client resumes the stream
+// of previous agent run). The client should re-read the full state of the values.
+type streamReset struct {
+	// Oldest is sequence number of the oldest available event (streamed next).
+	Oldest uint64 `json:"oldest"`
+}
+
+// statusEvent is a value status update or VPP recovery numbered by the status stream.
+type statusEvent struct {
+	seq      uint64
+	status   *kvscheduler.BaseValueStatus
+	recovery *govppmux.RecoveryEvent
+}
+
+// statusStream numbers value status updates received from the scheduler (and events
+// of recovery from the loss of connection to VPP) and keeps the most recent ones
+// in a ring buffer, so that the clients can resume the stream after reconnecting.
+type statusStream struct {
+	mu       sync.Mutex
+	buffer   [statusStreamBufferSize]statusEvent
+	nextSeq  uint64
+	watchers map[chan struct{}]struct{}
+
+	updates    chan *kvscheduler.BaseValueStatus
+	recoveries chan *govppmux.RecoveryEvent
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func newStatusStream() *statusStream {
+	return &statusStream{
+		nextSeq:    1,
+		watchers:   make(map[chan struct{}]struct{}),
+		updates:    make(chan *kvscheduler.BaseValueStatus, statusStreamBufferSize),
+		recoveries: make(chan *govppmux.RecoveryEvent, 10),
+	}
+}
+
+// run receives value status updates until the stream is closed.
+func (s *statusStream) run() {
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case status := <-s.updates:
+				s.push(statusEvent{status: status})
+			case recovery := <-s.recoveries:
+				s.push(statusEvent{recovery: recovery})
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *statusStream) close() {
+	if s.cancel != nil {
+		s.cancel()
+		s.wg.Wait()
+	}
+}
+
+func (s *statusStream) push(event statusEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.seq = s.nextSeq
+	s.buffer[s.nextSeq%statusStreamBufferSize] = event
+	s.nextSeq++
+	for w := range s.watchers {
+		select {
+		case w <- struct{}{}:
+		default:
+			// watcher has not yet processed previous update
+		}
+	}
+}
+
+// since returns buffered events starting with the given sequence number (zero selects
+// the oldest available event) and the sequence number of the next event. The gap is true
+// if some of the requested events are no longer available or the sequence number is from
+// the previous agent run, the events then start with the oldest available event.
+func (s *statusStream) since(from uint64) (events []statusEvent, next uint64, gap bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oldest := uint64(1)
+	if s.nextSeq > statusStreamBufferSize {
+		oldest = s.nextSeq - statusStreamBufferSize
+	}
+	if from == 0 {
+		from = oldest
+	} else if from > s.nextSeq || from < oldest {
+		from = oldest
+		gap = true
+	}
+	for seq := from; seq < s.nextSeq; seq++ {
+		events = append(events, s.buffer[seq%statusStreamBufferSize])
+	}
+	return events, s.nextSeq, gap
+}
+
+// watch returns channel signaled when new events are pushed to the stream.
+func (s *statusStream) watch() chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := make(chan struct{}, 1)
+	s.watchers[w] = struct{}{}
+	return w
+}
+
+func (s *statusStream) unwatch(w chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.watchers, w)
+}
+
+// eventWriter writes server-sent events to HTTP response.
+type eventWriter struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// newEventWriter writes headers of event stream response. It fails if the response
+// can't be flushed after every event.
+func newEventWriter(w http.ResponseWriter) (*eventWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming is not supported by HTTP server")
+	}
+	w.Header().Set("Content-Type", EventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return &eventWriter{w: w, flusher: flusher}, nil
+}
+
+// event writes event with the given ID, type and JSON-encoded data.
+func (ew *eventWriter) event(id uint64, eventType string, data proto.Message) error {
+	b, err := protojson.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return ew.write(id, eventType, b)
+}
+
+// jsonEvent writes event with the given ID, type and data encoded as JSON.
+func (ew *eventWriter) jsonEvent(id uint64, eventType string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return ew.write(id, eventType, b)
+}
+
+func (ew *eventWriter) write(id uint64, eventType string, b []byte) error {
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+
+	if _, err := fmt.Fprintf(ew.w, "id: %d\nevent: %s\ndata: %s\n\n", id, eventType, b); err != nil {
+		return err
+	}
+	ew.flusher.Flush()
+	return nil
+}
+
+// heartbeat writes comments to the stream periodically until the context is done.
+func (ew *eventWriter) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(streamHeartbeatPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ew.mu.Lock()
+			_, err := fmt.Fprint(ew.w, ": heartbeat\n\n")
+			if err == nil {
+				ew.flusher.Flush()
+			}
+			ew.mu.Unlock()
+			if err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// statusStreamHandler streams value status updates (see BaseValueStatus) as server-sent events
+// of type "status". Recovery from the loss of connection to VPP is streamed (regardless
+// of filters) as event of type "vpp-recovery" (see govppmux.RecoveryEvent). The event ID
+// is a sequence number which can be used to resume the stream. If some events requested
+// by the client are no longer available, event of type "reset" (see streamReset) is sent
+// before the oldest available event.
+func (p *Plugin) statusStreamHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		from, err := streamStart(req)
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusBadRequest, err.Error()))
+			return
+		}
+		prefixes := req.URL.Query()[URLStreamPrefixParamName]
+		states, err := parseValueStates(req.URL.Query()[URLStreamStateParamName])
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		// watch before reading the buffer to not miss any update
+		updated := p.statusStream.watch()
+		defer p.statusStream.unwatch(updated)
+
+		ew, err := newEventWriter(w)
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusNotImplemented, err.Error()))
+			return
+		}
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		go ew.heartbeat(ctx)
+
+		for {
+			events, next, gap := p.statusStream.since(from)
+			if gap {
+				oldest := next - uint64(len(events))
+				if err := ew.jsonEvent(oldest-1, "reset", streamReset{Oldest: oldest}); err != nil {
+					p.Log.Debugf("status stream closed: %v", err)
+					return
+				}
+			}
+			from = next
+			for _, ev := range events {
+				var err error
+				if ev.recovery != nil {
+					err = ew.jsonEvent(ev.seq, "vpp-recovery", ev.recovery)
+				} else if matchValueStatus(ev.status, prefixes, states) {
+					err = ew.event(ev.seq, "status", ev.status)
+				}
+				if err != nil {
+					p.Log.Debugf("status stream closed: %v", err)
+					return
+				}
+			}
+			select {
+			case <-updated:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// notificationStreamHandler streams VPP and Linux notifications as server-sent events
+// of type "notification". The event ID is an index of the next notification (as returned
+// by the Notify RPC of the configurator service) and can be used to resume the stream.
+func (p *Plugin) notificationStreamHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if p.Notifications == nil {
+			p.logError(formatter.JSON(w, http.StatusNotFound, "notifications are not available"))
+			return
+		}
+		from, err := streamStart(req)
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusBadRequest, err.Error()))
+			return
+		}
+		notifyReq := &configuratorpb.NotifyRequest{}
+		if from > 0 {
+			if from-1 > math.MaxUint32 {
+				p.logError(formatter.JSON(w, http.StatusBadRequest,
+					fmt.Sprintf("notification index %d is out of range", from)))
+				return
+			}
+			notifyReq.Idx = uint32(from - 1)
+		}
+		for _, f := range req.URL.Query()[URLStreamFilterParamName] {
+			filter := &configuratorpb.Notification{}
+			if err := protojson.Unmarshal([]byte(f), filter); err != nil {
+				p.logError(formatter.JSON(w, http.StatusBadRequest,
+					fmt.Sprintf("invalid notification filter: %v", err)))
+				return
+			}
+			notifyReq.Filters = append(notifyReq.Filters, filter)
+		}
+
+		ew, err := newEventWriter(w)
+		if err != nil {
+			p.logError(formatter.JSON(w, http.StatusNotImplemented, err.Error()))
+			return
+		}
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		go ew.heartbeat(ctx)
+
+		err = p.Notifications.WatchNotifications(ctx, notifyReq, func(n *configuratorpb.NotifyResponse) error {
+			return ew.event(uint64(n.GetNextIdx()), "notification", n.GetNotification())
+		})
+		p.Log.Debugf("notification stream closed: %v", err)
+	}
+}
+
+// streamStart returns sequence number of the first event requested by the client.
+// Zero is returned if the client did not request any (all buffered events are streamed).
+func streamStart(req *http.Request) (uint64, error) {
+	if lastID := req.Header.Get(lastEventIDHeader); lastID != "" {
+		id, err := strconv.ParseUint(lastID, 10, 64)
+		if err == nil && id == math.MaxUint64 {
+			err = strconv.ErrRange
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s header: %w", lastEventIDHeader, err)
+		}
+		return id + 1, nil
+	}
+	if fromStr := req.URL.Query().Get(URLStreamFromParamName); fromStr != "" {
+		from, err := strconv.ParseUint(fromStr, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s parameter: %w", URLStreamFromParamName, err)
+		}
+		return from, nil
+	}
+	return 0, nil
+}
+
+// parseValueStates parses value state names (case-insensitive).
+func parseValueStates(names []string) (map[kvscheduler.ValueState]struct{}, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	states := make(map[kvscheduler.ValueState]struct{})
+	for _, name := range names {
+		state, ok := kvscheduler.ValueState_value[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid value state %q", name)
+		}
+		states[kvscheduler.ValueState(state)] = struct{}{}
+	}
+	return states, nil
+}
+
+// matchValueStatus returns true if the value has one of the key prefixes and states
+// (empty selection matches all values).
+func matchValueStatus(status *kvscheduler.BaseValueStatus, prefixes []string,
+	states map[kvscheduler.ValueState]struct{}) bool {
+	value := status.GetValue()
+	if states != nil {
+		if _, ok := states[value.GetState()]; !ok {
+			return false
+		}
+	}
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value.GetKey(), prefix) {
+			return true
+		}
+	}
+	return false
+}
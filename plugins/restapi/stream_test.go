@@ -0,0 +1,234 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// newTestStatusStream returns status stream with the given number of pushed status updates
+// of values "key1", "key2", ... in state CONFIGURED.
+func newTestStatusStream(pushed int) *statusStream {
+	s := newStatusStream()
+	for i := 1; i <= pushed; i++ {
+		s.push(statusEvent{status: valueStatus(fmt.Sprintf("key%d", i), kvscheduler.ValueState_CONFIGURED)})
+	}
+	return s
+}
+
+func valueStatus(key string, state kvscheduler.ValueState) *kvscheduler.BaseValueStatus {
+	return &kvscheduler.BaseValueStatus{
+		Value: &kvscheduler.ValueStatus{Key: key, State: state},
+	}
+}
+
+func TestStatusStreamSince(t *testing.T) {
+	tests := []struct {
+		name       string
+		pushed     int
+		from       uint64
+		expFirst   uint64 // sequence number of the first returned event (0 if none)
+		expEvents  int
+		expNextSeq uint64
+		expGap     bool
+	}{
+		{name: "empty stream", pushed: 0, from: 0, expEvents: 0, expNextSeq: 1},
+		{name: "all events", pushed: 5, from: 0, expFirst: 1, expEvents: 5, expNextSeq: 6},
+		{name: "resume", pushed: 5, from: 3, expFirst: 3, expEvents: 3, expNextSeq: 6},
+		{name: "resume up to date", pushed: 5, from: 6, expEvents: 0, expNextSeq: 6},
+		{name: "resume previous agent run", pushed: 5, from: 100,
+			expFirst: 1, expEvents: 5, expNextSeq: 6, expGap: true},
+		{name: "resume previous agent run (empty stream)", pushed: 0, from: 100,
+			expEvents: 0, expNextSeq: 1, expGap: true},
+		{name: "wrapped buffer", pushed: statusStreamBufferSize + 10, from: 0,
+			expFirst: 11, expEvents: statusStreamBufferSize, expNextSeq: statusStreamBufferSize + 11},
+		{name: "resume wrapped buffer", pushed: statusStreamBufferSize + 10, from: statusStreamBufferSize + 5,
+			expFirst: statusStreamBufferSize + 5, expEvents: 6, expNextSeq: statusStreamBufferSize + 11},
+		{name: "resume oldest available", pushed: statusStreamBufferSize + 10, from: 11,
+			expFirst: 11, expEvents: statusStreamBufferSize, expNextSeq: statusStreamBufferSize + 11},
+		{name: "resume overwritten", pushed: statusStreamBufferSize + 10, from: 10,
+			expFirst: 11, expEvents: statusStreamBufferSize, expNextSeq: statusStreamBufferSize + 11, expGap: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := newTestStatusStream(test.pushed)
+
+			events, next, gap := s.since(test.from)
+			g.Expect(events).To(HaveLen(test.expEvents))
+			g.Expect(next).To(Equal(test.expNextSeq))
+			g.Expect(gap).To(Equal(test.expGap))
+			for i, ev := range events {
+				g.Expect(ev.seq).To(Equal(test.expFirst + uint64(i)))
+				g.Expect(ev.status.GetValue().GetKey()).To(Equal(fmt.Sprintf("key%d", ev.seq)))
+			}
+		})
+	}
+}
+
+func TestMatchValueStatus(t *testing.T) {
+	configured := map[kvscheduler.ValueState]struct{}{kvscheduler.ValueState_CONFIGURED: {}}
+	tests := []struct {
+		name     string
+		status   *kvscheduler.BaseValueStatus
+		prefixes []string
+		states   map[kvscheduler.ValueState]struct{}
+		expMatch bool
+	}{
+		{name: "no filter", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_FAILED),
+			expMatch: true},
+		{name: "prefix match", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_FAILED),
+			prefixes: []string{"config/vpp/v2/routes/", "config/vpp/v2/interfaces/"}, expMatch: true},
+		{name: "prefix mismatch", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_FAILED),
+			prefixes: []string{"config/vpp/v2/routes/"}, expMatch: false},
+		{name: "state match", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_CONFIGURED),
+			states: configured, expMatch: true},
+		{name: "state mismatch", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_FAILED),
+			states: configured, expMatch: false},
+		{name: "prefix and state match", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_CONFIGURED),
+			prefixes: []string{"config/vpp/"}, states: configured, expMatch: true},
+		{name: "prefix match, state mismatch", status: valueStatus("config/vpp/v2/interfaces/if1", kvscheduler.ValueState_PENDING),
+			prefixes: []string{"config/vpp/"}, states: configured, expMatch: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(matchValueStatus(test.status, test.prefixes, test.states)).To(Equal(test.expMatch))
+		})
+	}
+}
+
+func TestStreamStart(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		lastID  string
+		expFrom uint64
+		expErr  bool
+	}{
+		{name: "default", expFrom: 0},
+		{name: "from", query: "from=5", expFrom: 5},
+		{name: "from beyond 32 bits", query: "from=8589934592", expFrom: 8589934592},
+		{name: "last event ID", lastID: "7", expFrom: 8},
+		{name: "last event ID takes precedence", query: "from=5", lastID: "7", expFrom: 8},
+		{name: "invalid from", query: "from=x", expErr: true},
+		{name: "invalid last event ID", lastID: "-1", expErr: true},
+		{name: "last event ID out of range", lastID: "18446744073709551615", expErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			req := httptest.NewRequest(http.MethodGet, "/stream/status?"+test.query, nil)
+			if test.lastID != "" {
+				req.Header.Set(lastEventIDHeader, test.lastID)
+			}
+			from, err := streamStart(req)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(from).To(Equal(test.expFrom))
+		})
+	}
+}
+
+// streamEvent is server-sent event parsed from the response.
+type streamEvent struct {
+	id, event, data string
+}
+
+func parseStreamEvents(body string) []streamEvent {
+	var events []streamEvent
+	for _, block := range strings.Split(body, "\n\n") {
+		var ev streamEvent
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				ev.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				ev.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				ev.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+		if ev.event != "" {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+// streamStatus runs the status stream handler until all buffered events are streamed.
+func streamStatus(s *statusStream, query string, header http.Header) []streamEvent {
+	p := &Plugin{
+		Deps:         Deps{PluginDeps: infra.PluginDeps{Log: logging.ForPlugin("test-log")}},
+		statusStream: s,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest(http.MethodGet, "/stream/status?"+query, nil).WithContext(ctx)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	p.statusStreamHandler(render.New())(w, req)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	return parseStreamEvents(w.Body.String())
+}
+
+func TestStatusStreamResume(t *testing.T) {
+	RegisterTestingT(t)
+
+	s := newTestStatusStream(3)
+	s.push(statusEvent{status: valueStatus("key4", kvscheduler.ValueState_FAILED)})
+
+	// filtered stream
+	events := streamStatus(s, "state=failed", nil)
+	Expect(events).To(HaveLen(1))
+	Expect(events[0].id).To(Equal("4"))
+	Expect(events[0].event).To(Equal("status"))
+	Expect(events[0].data).To(ContainSubstring(`"key":"key4"`))
+
+	// resume after the last received event
+	events = streamStatus(s, "prefix=key", http.Header{lastEventIDHeader: []string{"2"}})
+	Expect(events).To(HaveLen(2))
+	Expect(events[0].id).To(Equal("3"))
+	Expect(events[1].id).To(Equal("4"))
+
+	// resume with events no longer available
+	for i := 5; i <= statusStreamBufferSize+5; i++ {
+		s.push(statusEvent{status: valueStatus(fmt.Sprintf("key%d", i), kvscheduler.ValueState_CONFIGURED)})
+	}
+	events = streamStatus(s, "from=3", nil)
+	Expect(events).To(HaveLen(statusStreamBufferSize + 1))
+	Expect(events[0].event).To(Equal("reset"))
+	Expect(events[0].id).To(Equal("5"))
+	Expect(events[0].data).To(MatchJSON(`{"oldest": 6}`))
+	Expect(events[1].id).To(Equal("6"))
+}
@@ -6,8 +6,11 @@ import (
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 	"go.ligato.io/cn-infra/v2/servicelabel"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin"
 )
 
 // DefaultPlugin is default instance of Plugin
@@ -23,7 +26,10 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.Prometheus = &prometheus.DefaultPlugin
 	p.GRPC = &grpc.DefaultPlugin
 	p.HTTPHandlers = &rest.DefaultPlugin
+	p.Auth = &auth.DefaultPlugin
 	p.IfPlugin = &ifplugin.DefaultPlugin
+	p.ACLPlugin = &aclplugin.DefaultPlugin
+	p.WgPlugin = &wireguardplugin.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
@@ -112,6 +112,29 @@ const (
 	ifCounterRxMiss    = "rx_miss"
 )
 
+// ACL metrics
+const (
+	aclMetricsNamespace = "acl"
+
+	aclCounterNameLabel = "name"
+	aclCounterRuleLabel = "rule"
+
+	aclCounterPackets = "packets"
+	aclCounterBytes   = "bytes"
+)
+
+// Wireguard metrics
+const (
+	wgMetricsNamespace = "wireguard"
+
+	wgPeerNameLabel      = "name"
+	wgPeerInterfaceLabel = "interface"
+
+	wgPeerEstablished = "peer_established"
+	wgPeerRxBytes     = "peer_rx_bytes"
+	wgPeerTxBytes     = "peer_tx_bytes"
+)
+
 type prometheusMetrics struct {
 	runtimeGaugeVecs map[string]*prometheus.GaugeVec
 	runtimeStats     map[string]*runtimeStats
@@ -127,6 +150,12 @@ type prometheusMetrics struct {
 
 	ifCounterGaugeVecs map[string]*prometheus.GaugeVec
 	ifCounterStats     map[string]*ifCounterStats
+
+	aclCounterGaugeVecs map[string]*prometheus.GaugeVec
+	aclCounterStats     map[aclRuleKey]*aclCounterStats
+
+	wgPeerGaugeVecs map[string]*prometheus.GaugeVec
+	wgPeerStats     map[string]*wgPeerStats
 }
 
 type runtimeStats struct {
@@ -159,6 +188,20 @@ type ifCounterStats struct {
 	metrics map[string]prometheus.Gauge
 }
 
+type aclRuleKey struct {
+	name string
+	rule uint32
+}
+
+type aclCounterStats struct {
+	metrics map[string]prometheus.Gauge
+}
+
+type wgPeerStats struct {
+	labels  prometheus.Labels
+	metrics map[string]prometheus.Gauge
+}
+
 func (p *Plugin) registerPrometheus() error {
 	p.Log.Debugf("registering prometheus registry path: %v", registryPath)
 
@@ -329,6 +372,65 @@ func (p *Plugin) registerPrometheus() error {
 		}
 	}
 
+	// ACL counter metrics
+	p.aclCounterGaugeVecs = make(map[string]*prometheus.GaugeVec)
+	p.aclCounterStats = make(map[aclRuleKey]*aclCounterStats)
+
+	for _, metric := range [][2]string{
+		{aclCounterPackets, "Packets matched by the rule"},
+		{aclCounterBytes, "Bytes matched by the rule"},
+	} {
+		name := metric[0]
+		p.aclCounterGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: aclMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{aclCounterNameLabel, aclCounterRuleLabel})
+
+	}
+
+	// register created vectors to prometheus
+	for name, metric := range p.aclCounterGaugeVecs {
+		if err := p.Prometheus.Register(registryPath, metric); err != nil {
+			p.Log.Errorf("failed to register %v metric: %v", name, err)
+			return err
+		}
+	}
+
+	// Wireguard peer metrics
+	p.wgPeerGaugeVecs = make(map[string]*prometheus.GaugeVec)
+	p.wgPeerStats = make(map[string]*wgPeerStats)
+
+	for _, metric := range [][2]string{
+		{wgPeerEstablished, "Handshake with the peer is established"},
+		{wgPeerRxBytes, "Bytes received from the peer"},
+		{wgPeerTxBytes, "Bytes sent to the peer"},
+	} {
+		name := metric[0]
+		p.wgPeerGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: wgMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{wgPeerNameLabel, wgPeerInterfaceLabel})
+
+	}
+
+	// register created vectors to prometheus
+	for name, metric := range p.wgPeerGaugeVecs {
+		if err := p.Prometheus.Register(registryPath, metric); err != nil {
+			p.Log.Errorf("failed to register %v metric: %v", name, err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -492,6 +594,94 @@ func (p *Plugin) updatePrometheus(ctx context.Context) {
 		}
 	}
 
+	if !p.skipped[aclMetricsNamespace] && p.ACLPlugin != nil {
+		// Update ACL counters
+		aclStats, err := p.ACLPlugin.GetACLStats()
+		if err != nil {
+			p.Log.Debugf("GetACLStats failed: %v", err)
+		} else {
+			p.tracef("ACL stats: %+v", aclStats)
+			for _, acl := range aclStats {
+				for _, rule := range acl.Rules {
+					key := aclRuleKey{name: acl.Name, rule: rule.RuleIndex}
+					stats, ok := p.aclCounterStats[key]
+					if !ok {
+						stats = &aclCounterStats{
+							metrics: map[string]prometheus.Gauge{},
+						}
+						p.aclCounterStats[key] = stats
+
+						// add gauges with corresponding labels into vectors
+						for k, vec := range p.aclCounterGaugeVecs {
+							stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
+								aclCounterNameLabel: acl.Name,
+								aclCounterRuleLabel: fmt.Sprint(rule.RuleIndex),
+							})
+							if err != nil {
+								p.Log.Error(err)
+							}
+						}
+					}
+
+					stats.metrics[aclCounterPackets].Set(float64(rule.Packets))
+					stats.metrics[aclCounterBytes].Set(float64(rule.Bytes))
+				}
+			}
+		}
+	}
+
+	if !p.skipped[wgMetricsNamespace] && p.WgPlugin != nil {
+		// Update wireguard peers
+		peerStates, err := p.WgPlugin.GetPeerStates()
+		if err != nil {
+			p.Log.Debugf("GetPeerStates failed: %v", err)
+		} else {
+			p.tracef("wireguard peer states: %+v", peerStates)
+			current := make(map[string]bool)
+			for _, peer := range peerStates {
+				current[peer.Name] = true
+				stats, ok := p.wgPeerStats[peer.Name]
+				if !ok {
+					stats = &wgPeerStats{
+						labels: prometheus.Labels{
+							wgPeerNameLabel:      peer.Name,
+							wgPeerInterfaceLabel: peer.WgIfName,
+						},
+						metrics: map[string]prometheus.Gauge{},
+					}
+					p.wgPeerStats[peer.Name] = stats
+
+					// add gauges with corresponding labels into vectors
+					for k, vec := range p.wgPeerGaugeVecs {
+						stats.metrics[k], err = vec.GetMetricWith(stats.labels)
+						if err != nil {
+							p.Log.Error(err)
+						}
+					}
+				}
+
+				established := 0.0
+				if peer.Established {
+					established = 1
+				}
+				stats.metrics[wgPeerEstablished].Set(established)
+				stats.metrics[wgPeerRxBytes].Set(float64(peer.RxBytes))
+				stats.metrics[wgPeerTxBytes].Set(float64(peer.TxBytes))
+			}
+
+			// remove gauges of removed peers
+			for name, stats := range p.wgPeerStats {
+				if current[name] {
+					continue
+				}
+				for _, vec := range p.wgPeerGaugeVecs {
+					vec.Delete(stats.labels)
+				}
+				delete(p.wgPeerStats, name)
+			}
+		}
+	}
+
 	if !p.skipped[ifMetricsNamespace] {
 		// Update interface counters
 		ifStats, err := p.handler.GetInterfaceStats(ctx)
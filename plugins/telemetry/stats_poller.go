@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
@@ -21,13 +22,20 @@ import (
 type statsPollerServer struct {
 	configurator.UnimplementedStatsPollerServiceServer
 
-	handler vppcalls.TelemetryVppAPI
-	ifIndex ifaceidx.IfaceMetadataIndex
+	handler      vppcalls.TelemetryVppAPI
+	ifIndex      ifaceidx.IfaceMetadataIndex
+	aclStats     ACLStatsProvider
+	wgPeerStates WgPeerStateProvider
+	auth         auth.API
 
 	log logging.Logger
 }
 
 func (s *statsPollerServer) PollStats(req *configurator.PollStatsRequest, svr configurator.StatsPollerService_PollStatsServer) error {
+	op := auth.Operation{Name: "StatsPollerService.PollStats", Access: auth.ReadAccess}
+	if _, err := auth.AuthorizeGRPC(s.auth, svr.Context(), op); err != nil {
+		return err
+	}
 	if req.GetPeriodSec() == 0 && req.GetNumPolls() > 1 {
 		return status.Error(codes.InvalidArgument, "period must be > 0 if number of polls is > 1")
 	}
@@ -141,6 +149,66 @@ func (s *statsPollerServer) streamVppStats(ctx context.Context, ch chan *vpp.Sta
 			return ctx.Err()
 		}
 	}
+
+	if err := s.streamACLStats(ctx, ch); err != nil {
+		return err
+	}
+	return s.streamWgPeerStates(ctx, ch)
+}
+
+func (s *statsPollerServer) streamACLStats(ctx context.Context, ch chan *vpp.Stats) error {
+	if s.aclStats == nil {
+		return nil
+	}
+	aclStats, err := s.aclStats.GetACLStats()
+	if err != nil {
+		// ACL stats are optional (VPP ACL plugin may be disabled)
+		s.log.Debugf("ACL stats not available: %v", err)
+		return nil
+	}
+
+	s.log.Debugf("streaming %d ACL stats", len(aclStats))
+
+	for _, acl := range aclStats {
+		vppStats := &vpp.Stats{
+			Acl: acl,
+		}
+
+		select {
+		case ch <- vppStats:
+			// stats sent
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (s *statsPollerServer) streamWgPeerStates(ctx context.Context, ch chan *vpp.Stats) error {
+	if s.wgPeerStates == nil {
+		return nil
+	}
+	peerStates, err := s.wgPeerStates.GetPeerStates()
+	if err != nil {
+		// wireguard state is optional (VPP wireguard plugin may be disabled)
+		s.log.Debugf("wireguard peer states not available: %v", err)
+		return nil
+	}
+
+	s.log.Debugf("streaming %d wireguard peer states", len(peerStates))
+
+	for _, peer := range peerStates {
+		vppStats := &vpp.Stats{
+			WireguardPeer: peer,
+		}
+
+		select {
+		case ch <- vppStats:
+			// stats sent
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return nil
 }
 
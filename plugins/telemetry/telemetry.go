@@ -34,14 +34,19 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/metrics"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/auth"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls/vpp2210"
 )
 
 var debug = os.Getenv("DEBUG_TELEMETRY") != ""
@@ -71,6 +76,16 @@ type InterfaceIndexProvider interface {
 	GetInterfaceIndex() ifaceidx.IfaceMetadataIndex
 }
 
+type ACLStatsProvider interface {
+	// GetACLStats returns hit counters of rules of all configured ACLs.
+	GetACLStats() ([]*vpp_acl.ACLStats, error)
+}
+
+type WgPeerStateProvider interface {
+	// GetPeerStates returns the runtime state of all configured wireguard peers.
+	GetPeerStates() ([]*vpp_wg.PeerState, error)
+}
+
 // Deps represents dependencies of Telemetry Plugin
 type Deps struct {
 	infra.PluginDeps
@@ -79,7 +94,10 @@ type Deps struct {
 	Prometheus   prom.API
 	GRPC         grpc.Server
 	HTTPHandlers rest.HTTPHandlers
+	Auth         auth.API
 	IfPlugin     InterfaceIndexProvider
+	ACLPlugin    ACLStatsProvider
+	WgPlugin     WgPeerStateProvider
 }
 
 // Init initializes Telemetry Plugin
@@ -132,12 +150,14 @@ func (p *Plugin) Init() error {
 
 	// Setup stats poller
 	p.statsPollerServer.log = p.Log.NewLogger("stats-poller")
+	p.statsPollerServer.auth = p.Auth
 	if err := p.setupStatsPoller(); err != nil {
 		return errors.WithMessage(err, "setting up stats poller failed")
 	}
 
 	if p.HTTPHandlers != nil {
-		p.HTTPHandlers.RegisterHTTPHandler("/metrics/{metric}", metricsHandler, "GET")
+		op := auth.Operation{Name: "GET " + resturl.Metrics, Access: auth.ReadAccess}
+		p.HTTPHandlers.RegisterHTTPHandler(resturl.Metrics, auth.WrapHTTPHandler(p.Auth, op, metricsHandler), "GET")
 	}
 
 	return nil
@@ -163,6 +183,8 @@ func (p *Plugin) setupStatsPoller() error {
 		p.statsPollerServer.handler = h
 	}
 	p.statsPollerServer.ifIndex = p.IfPlugin.GetInterfaceIndex()
+	p.statsPollerServer.aclStats = p.ACLPlugin
+	p.statsPollerServer.wgPeerStates = p.WgPlugin
 
 	if p.GRPC != nil && p.GRPC.GetServer() != nil {
 		configurator.RegisterStatsPollerServiceServer(p.GRPC.GetServer(), &p.statsPollerServer)
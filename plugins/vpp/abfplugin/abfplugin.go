@@ -32,6 +32,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls/vpp2210"
 )
 
 // ABFPlugin is a plugin that manages ACL-based forwarding.
@@ -71,7 +72,7 @@ func (p *ABFPlugin) Init() error {
 
 	// init & register descriptor
 	abfDescriptor := descriptor.NewABFDescriptor(p.abfHandler, p.ACLPlugin.GetACLIndex(), p.Log)
-	if err := p.Deps.Scheduler.RegisterKVDescriptor(abfDescriptor); err != nil {
+	if err := p.VPP.RegisterKVDescriptor(p.Deps.Scheduler, abfDescriptor); err != nil {
 		return err
 	}
 
@@ -85,7 +86,7 @@ func (p *ABFPlugin) Init() error {
 
 	// init & register derived value descriptor
 	abfInterfaceDescriptor := descriptor.NewABFToInterfaceDescriptor(p.abfIndex, p.abfHandler, p.IfPlugin, p.Log)
-	if err := p.Deps.Scheduler.RegisterKVDescriptor(abfInterfaceDescriptor); err != nil {
+	if err := p.VPP.RegisterKVDescriptor(p.Deps.Scheduler, abfInterfaceDescriptor); err != nil {
 		return err
 	}
 
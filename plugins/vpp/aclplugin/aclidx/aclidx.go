@@ -32,6 +32,9 @@ type ACLMetadataIndex interface {
 	// LookupName looks up previously stored item identified by name in mapping.
 	LookupByIndex(idx uint32) (name string, metadata *ACLMetadata, exists bool)
 
+	// ListAllNames returns names of all ACLs in the mapping.
+	ListAllNames() (names []string)
+
 	// WatchAcls
 	WatchAcls(subscriber string, channel chan<- ACLMetadataDto)
 }
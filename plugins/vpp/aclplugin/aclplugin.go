@@ -17,6 +17,8 @@
 package aclplugin
 
 import (
+	"sort"
+
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
@@ -28,10 +30,12 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls/vpp2210"
 )
 
 // ACLPlugin is a plugin that manages ACLs.
@@ -68,10 +72,14 @@ func (p *ACLPlugin) Init() (err error) {
 		return errors.New("aclHandler is not available")
 	}
 
+	// enable collection of ACL rule hit counters (disabled again by VPP restart)
+	p.enableACLStats()
+	p.VPP.OnReconnect(p.enableACLStats)
+
 	// init & register descriptors
 	p.aclDescriptor = descriptor.NewACLDescriptor(p.aclHandler, p.IfPlugin, p.Log)
 	aclDescriptor := adapter.NewACLDescriptor(p.aclDescriptor.GetDescriptor())
-	err = p.Scheduler.RegisterKVDescriptor(aclDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.Scheduler, aclDescriptor)
 	if err != nil {
 		return err
 	}
@@ -86,7 +94,7 @@ func (p *ACLPlugin) Init() (err error) {
 
 	p.aclInterfaceDescriptor = descriptor.NewACLToInterfaceDescriptor(p.aclIndex, p.aclHandler, p.Log)
 	aclInterfaceDescriptor := p.aclInterfaceDescriptor.GetDescriptor()
-	err = p.Scheduler.RegisterKVDescriptor(aclInterfaceDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.Scheduler, aclInterfaceDescriptor)
 	if err != nil {
 		return err
 	}
@@ -94,6 +102,13 @@ func (p *ACLPlugin) Init() (err error) {
 	return nil
 }
 
+// enableACLStats enables collection of ACL rule hit counters in VPP.
+func (p *ACLPlugin) enableACLStats() {
+	if err := p.aclHandler.EnableACLStats(true); err != nil {
+		p.Log.Warnf("ACL stats collection could not be enabled: %v", err)
+	}
+}
+
 // AfterInit registers plugin with StatusCheck.
 func (p *ACLPlugin) AfterInit() error {
 	if p.StatusCheck != nil {
@@ -107,3 +122,32 @@ func (p *ACLPlugin) AfterInit() error {
 func (p *ACLPlugin) GetACLIndex() aclidx.ACLMetadataIndex {
 	return p.aclIndex
 }
+
+// GetACLStats returns hit counters of rules of all configured ACLs (L3/L4)
+// read from the VPP stats segment.
+func (p *ACLPlugin) GetACLStats() ([]*vpp_acl.ACLStats, error) {
+	if p.aclIndex == nil {
+		return nil, errors.New("VPP plugin ACL is not available")
+	}
+	aclCounters, err := vppcalls.DumpACLStats(p.VPP.Stats())
+	if err != nil {
+		return nil, err
+	}
+
+	names := p.aclIndex.ListAllNames()
+	sort.Strings(names)
+
+	var aclStats []*vpp_acl.ACLStats
+	for _, name := range names {
+		meta, exists := p.aclIndex.LookupByName(name)
+		if !exists || meta.L2 {
+			// MACIP ACLs have no hit counters
+			continue
+		}
+		aclStats = append(aclStats, &vpp_acl.ACLStats{
+			Name:  name,
+			Rules: aclCounters[meta.Index],
+		})
+	}
+	return aclStats, nil
+}
@@ -16,6 +16,7 @@ package aclplugin
 
 import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/aclidx"
+	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 )
 
 // API defines methods exposed by VPP-ACLPlugin.
@@ -23,4 +24,8 @@ type API interface {
 	// GetInterfaceIndex gives read-only access to map with metadata of all configured
 	// VPP access lists.
 	GetACLIndex() aclidx.ACLMetadataIndex
+
+	// GetACLStats returns hit counters of rules of all configured ACLs (L3/L4)
+	// read from the VPP stats segment.
+	GetACLStats() ([]*vpp_acl.ACLStats, error)
 }
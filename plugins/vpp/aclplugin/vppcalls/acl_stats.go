@@ -0,0 +1,78 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	"errors"
+	"fmt"
+
+	"go.fd.io/govpp/adapter"
+	govppapi "go.fd.io/govpp/api"
+
+	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+)
+
+// aclStatsPattern matches stats segment entries with ACL rule hit counters,
+// which are stored as combined counters under "/acl/<acl-index>/matches".
+const aclStatsPattern = `^/acl/[0-9]+/matches$`
+
+// ErrStatsUnavailable is returned when VPP stats segment cannot be accessed.
+var ErrStatsUnavailable = errors.New("VPP stats API is not available")
+
+// StatsDumper is implemented by stats providers with raw access to the VPP stats segment.
+type StatsDumper interface {
+	// DumpStats returns stats segment entries matching the given patterns.
+	DumpStats(patterns ...string) ([]adapter.StatEntry, error)
+}
+
+// DumpACLStats reads hit counters of ACL (L3/L4) rules from the VPP stats segment.
+// Counters summed over all VPP threads are returned per ACL index, rules are
+// ordered by their index in the ACL.
+func DumpACLStats(stats govppapi.StatsProvider) (map[uint32][]*acl.ACLStats_RuleStats, error) {
+	dumper, ok := stats.(StatsDumper)
+	if !ok {
+		return nil, ErrStatsUnavailable
+	}
+	entries, err := dumper.DumpStats(aclStatsPattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump ACL stats: %v", err)
+	}
+
+	aclStats := make(map[uint32][]*acl.ACLStats_RuleStats)
+	for _, entry := range entries {
+		var aclIdx uint32
+		if _, err := fmt.Sscanf(string(entry.Name), "/acl/%d/matches", &aclIdx); err != nil {
+			continue
+		}
+		counters, ok := entry.Data.(adapter.CombinedCounterStat)
+		if !ok {
+			continue
+		}
+		var rules []*acl.ACLStats_RuleStats
+		for _, thread := range counters {
+			for ruleIdx, counter := range thread {
+				if ruleIdx >= len(rules) {
+					rules = append(rules, &acl.ACLStats_RuleStats{
+						RuleIndex: uint32(ruleIdx),
+					})
+				}
+				rules[ruleIdx].Packets += counter.Packets()
+				rules[ruleIdx].Bytes += counter.Bytes()
+			}
+		}
+		aclStats[aclIdx] = rules
+	}
+	return aclStats, nil
+}
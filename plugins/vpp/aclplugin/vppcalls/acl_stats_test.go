@@ -0,0 +1,72 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.fd.io/govpp/adapter"
+	govppapi "go.fd.io/govpp/api"
+
+	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+)
+
+type mockStats struct {
+	govppapi.StatsProvider
+	entries []adapter.StatEntry
+}
+
+func (m *mockStats) DumpStats(patterns ...string) ([]adapter.StatEntry, error) {
+	return m.entries, nil
+}
+
+func TestDumpACLStats(t *testing.T) {
+	RegisterTestingT(t)
+
+	stats := &mockStats{
+		entries: []adapter.StatEntry{
+			{
+				StatIdentifier: adapter.StatIdentifier{Name: []byte("/acl/0/matches")},
+				Type:           adapter.CombinedCounterVector,
+				Data: adapter.CombinedCounterStat{
+					{{1, 100}, {2, 200}},
+					{{3, 300}},
+				},
+			},
+			{
+				StatIdentifier: adapter.StatIdentifier{Name: []byte("/acl/2/matches")},
+				Type:           adapter.CombinedCounterVector,
+				Data:           adapter.CombinedCounterStat{{}},
+			},
+		},
+	}
+
+	aclStats, err := DumpACLStats(stats)
+	Expect(err).To(BeNil())
+	Expect(aclStats).To(HaveLen(2))
+	Expect(aclStats[0]).To(Equal([]*acl.ACLStats_RuleStats{
+		{RuleIndex: 0, Packets: 4, Bytes: 400},
+		{RuleIndex: 1, Packets: 2, Bytes: 200},
+	}))
+	Expect(aclStats[2]).To(BeEmpty())
+}
+
+func TestDumpACLStatsUnavailable(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := DumpACLStats(nil)
+	Expect(err).To(Equal(ErrStatsUnavailable))
+}
@@ -90,6 +90,9 @@ type ACLVppAPI interface {
 	AddMACIPACLToInterface(aclIndex uint32, ifName string) error
 	// DeleteMACIPACLFromInterface deletes MACIP ACL (L2) from single interface.
 	DeleteMACIPACLFromInterface(aclIndex uint32, ifName string) error
+	// EnableACLStats enables or disables collection of ACL (L3/L4) rule hit counters
+	// in the VPP stats segment.
+	EnableACLStats(enable bool) error
 }
 
 // ACLVppRead provides read methods for ACL plugin
@@ -159,6 +159,20 @@ func (h *ACLVppHandler) DeleteMACIPACL(aclIndex uint32) error {
 	return nil
 }
 
+// EnableACLStats implements ACL handler.
+func (h *ACLVppHandler) EnableACLStats(enable bool) error {
+	req := &vpp_acl.ACLStatsIntfCountersEnable{
+		Enable: enable,
+	}
+	reply := &vpp_acl.ACLStatsIntfCountersEnableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("failed to set ACL stats collection to %t: %v", enable, err)
+	}
+
+	return nil
+}
+
 // Method transforms provided set of IP proto ACL rules to binapi ACL rules.
 func transformACLIpRules(rules []*acl.ACL_Rule) (aclIPRules []acl_types.ACLRule, err error) {
 	for _, rule := range rules {
@@ -491,3 +491,20 @@ func TestModifyMACIPAcl(t *testing.T) {
 	err = ctx.aclHandler.ModifyMACIPACL(0, aclIPrules, "test_modify4")
 	Expect(err).To(Not(BeNil()))
 }
+
+// Test enabling of ACL stats collection
+func TestEnableACLStats(t *testing.T) {
+	ctx := setupACLTest(t)
+	defer ctx.teardownACLTest()
+
+	ctx.MockVpp.MockReply(&vpp_acl.ACLStatsIntfCountersEnableReply{})
+	err := ctx.aclHandler.EnableACLStats(true)
+	Expect(err).To(BeNil())
+	msg, ok := ctx.MockChannel.Msg.(*vpp_acl.ACLStatsIntfCountersEnable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_acl.ACLStatsIntfCountersEnableReply{Retval: -1})
+	err = ctx.aclHandler.EnableACLStats(false)
+	Expect(err).To(Not(BeNil()))
+}
@@ -0,0 +1,110 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapi_test
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	govppapi "go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210"
+
+	// generated, but not included in binapi.Versions
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vrrp"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/vrrp"
+)
+
+// TestMessageCRCs checks the generated binapi of every supported VPP version
+// against the CRC snapshot recorded by vpp-version-gen from the VPP API files.
+func TestMessageCRCs(t *testing.T) {
+	if len(binapi.Versions) == 0 {
+		t.Fatal("no binapi versions loaded")
+	}
+	binapiPath := reflect.TypeOf(binapi.VersionMsgs{}).PkgPath()
+	registered := govppapi.GetRegisteredMessages()
+
+	for version, msgs := range binapi.Versions {
+		versionDir := "vpp" + strings.Replace(string(version), ".", "", 1)
+		snapshot := filepath.Join("testdata", versionDir+".crc")
+		crcs, err := loadCRCSnapshot(snapshot)
+		if err != nil {
+			t.Errorf("version %v: loading CRC snapshot failed: %v", version, err)
+			continue
+		}
+
+		// messages used by the agent
+		for _, msg := range msgs.AllMessages() {
+			checkMessageCRC(t, version, crcs, msg)
+		}
+
+		// all generated messages
+		generated := registered[path.Join(binapiPath, versionDir)]
+		if len(generated) == 0 {
+			t.Errorf("version %v: no generated messages registered", version)
+			continue
+		}
+		for _, msg := range generated {
+			checkMessageCRC(t, version, crcs, msg)
+		}
+		for name, crc := range crcs {
+			if _, ok := generated[name+"_"+crc]; !ok {
+				t.Errorf("version %v: message %s_%s of VPP API is missing in binapi", version, name, crc)
+			}
+		}
+	}
+}
+
+func checkMessageCRC(t *testing.T, version binapi.Version, crcs map[string]string, msg govppapi.Message) {
+	t.Helper()
+	crc, ok := crcs[msg.GetMessageName()]
+	if !ok {
+		t.Errorf("version %v: message %s is not defined by VPP API", version, msg.GetMessageName())
+		return
+	}
+	if crc != msg.GetCrcString() {
+		t.Errorf("version %v: message %s has CRC %s, VPP API defines %s",
+			version, msg.GetMessageName(), msg.GetCrcString(), crc)
+	}
+}
+
+// loadCRCSnapshot returns CRCs of the messages by their names.
+func loadCRCSnapshot(file string) (map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	crcs := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.LastIndex(line, "_"); i > 0 {
+			crcs[line[:i]] = line[i+1:]
+		}
+	}
+	return crcs, scanner.Err()
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.fd.io/govpp/binapigen"
+)
+
+// inputFileRe matches go:generate lines of the version registration file.
+var inputFileRe = regexp.MustCompile(`^//go:generate binapigen --input-file=\$VPP_API_DIR/(.+\.api\.json)$`)
+
+// generateBinapi generates binary API of the new version for the API files
+// used by the template version.
+func generateBinapi(root, from, to, apiDir string) error {
+	fromFile := filepath.Join(root, binapiDir, from, from+".go")
+	apiFiles, err := listAPIFiles(fromFile)
+	if err != nil {
+		return err
+	}
+	if len(apiFiles) == 0 {
+		return fmt.Errorf("no API files listed in %s", fromFile)
+	}
+
+	var filesToGenerate []string
+	for _, apiFile := range apiFiles {
+		filesToGenerate = append(filesToGenerate, filepath.Join(apiDir, apiFile))
+	}
+	outputDir := filepath.Join(root, binapiDir, to)
+	opts := binapigen.Options{
+		OutputDir:        outputDir,
+		ImportPrefix:     path.Join(modulePath, binapiDir, to),
+		NoVersionInfo:    true,
+		NoSourcePathInfo: true,
+	}
+	binapigen.Run(apiDir, filesToGenerate, opts, func(gen *binapigen.Generator) error {
+		for _, file := range gen.Files {
+			if !file.Generate {
+				continue
+			}
+			binapigen.GenerateAPI(gen, file)
+			binapigen.GenerateRPC(gen, file)
+		}
+		return nil
+	})
+
+	return writeVersionFile(fromFile, filepath.Join(outputDir, to+".go"), from, to)
+}
+
+// listAPIFiles returns API files (relative to API directory) generated
+// for the version.
+func listAPIFiles(versionFile string) ([]string, error) {
+	f, err := os.Open(versionFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var apiFiles []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if m := inputFileRe.FindStringSubmatch(scanner.Text()); m != nil {
+			apiFiles = append(apiFiles, m[1])
+		}
+	}
+	sort.Strings(apiFiles)
+	return apiFiles, scanner.Err()
+}
+
+// writeVersionFile writes the file registering messages of the new version
+// into binapi.Versions, derived from the file of the template version.
+func writeVersionFile(fromFile, toFile, from, to string) error {
+	content, err := ioutil.ReadFile(fromFile)
+	if err != nil {
+		return err
+	}
+	s := strings.ReplaceAll(string(content), from, to)
+	s = strings.Replace(s,
+		fmt.Sprintf("const Version = %q", versionString(from)),
+		fmt.Sprintf("const Version = %q", versionString(to)), 1)
+	return ioutil.WriteFile(toFile, []byte(s), 0644)
+}
+
+// versionString returns VPP version for the version package name (vpp2202 -> 22.02).
+func versionString(pkg string) string {
+	v := strings.TrimPrefix(pkg, "vpp")
+	return v[:2] + "." + v[2:]
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// registerMessageRe matches registration of a message in the generated binapi.
+var registerMessageRe = regexp.MustCompile(`api\.RegisterMessage\(\(\*(\w+)\)\(nil\), "(\w+_[0-9a-f]{8})"\)`)
+
+// message is a message of the generated binary API.
+type message struct {
+	// name of the message including the CRC (e.g. control_ping_51077d14)
+	nameCrc string
+}
+
+// loadMessages returns messages of the binary API version by their Go type
+// qualified with the package name (e.g. memclnt.ControlPing).
+func loadMessages(root, version string) (map[string]message, error) {
+	msgs := make(map[string]message)
+	dir := filepath.Join(root, binapiDir, version)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".ba.go") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		pkg := filepath.Base(filepath.Dir(path))
+		for _, m := range registerMessageRe.FindAllStringSubmatch(string(content), -1) {
+			msgs[pkg+"."+m[1]] = message{nameCrc: m[2]}
+		}
+		return nil
+	})
+	return msgs, err
+}
+
+// writeCRCSnapshot records names and CRCs of all messages defined by the VPP
+// API files of the version. The snapshot is taken from the VPP API JSON files
+// (not from the generated binapi), so that the unit tests can detect binapi
+// that does not match the VPP release.
+func writeCRCSnapshot(root, version, apiDir string) error {
+	apiFiles, err := listAPIFiles(filepath.Join(root, binapiDir, version, version+".go"))
+	if err != nil {
+		return err
+	}
+	if len(apiFiles) == 0 {
+		return fmt.Errorf("no API files listed for %s", version)
+	}
+
+	var lines []string
+	for _, apiFile := range apiFiles {
+		file, err := vppapi.ParseFile(filepath.Join(apiDir, apiFile))
+		if err != nil {
+			return err
+		}
+		for _, msg := range file.Messages {
+			lines = append(lines, msg.Name+"_"+strings.TrimPrefix(msg.CRC, "0x"))
+		}
+	}
+	sort.Strings(lines)
+
+	dir := filepath.Join(root, binapiDir, "testdata")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	content := strings.Join(lines, "\n") + "\n"
+	return ioutil.WriteFile(filepath.Join(dir, version+".crc"), []byte(content), 0644)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// scaffoldHandlers copies every vppcalls handler package of the template version
+// into the package for the new version, registers the new packages in the plugins
+// and returns list of changed messages used by the handlers.
+func scaffoldHandlers(root, from, to string) (changed []string, err error) {
+	fromMsgs, err := loadMessages(root, from)
+	if err != nil {
+		return nil, err
+	}
+	toMsgs, err := loadMessages(root, to)
+	if err != nil {
+		return nil, err
+	}
+
+	handlerDirs, err := findHandlerDirs(root, from)
+	if err != nil {
+		return nil, err
+	}
+	for _, fromDir := range handlerDirs {
+		toDir := filepath.Join(filepath.Dir(fromDir), to)
+		if _, err := os.Stat(toDir); err == nil {
+			fmt.Printf("skipping %s (already exists)\n", toDir)
+			continue
+		}
+		if err := copyHandlerDir(fromDir, toDir, from, to); err != nil {
+			return nil, err
+		}
+		if err := registerHandlerPkg(root, fromDir, toDir); err != nil {
+			return nil, err
+		}
+		used, err := usedMessages(toDir, to)
+		if err != nil {
+			return nil, err
+		}
+		rel, _ := filepath.Rel(root, toDir)
+		for _, msg := range used {
+			toMsg, ok := toMsgs[msg]
+			if !ok {
+				if _, wasMsg := fromMsgs[msg]; wasMsg {
+					changed = append(changed, fmt.Sprintf("%s: %s was removed", rel, msg))
+				}
+				continue
+			}
+			if fromMsg, ok := fromMsgs[msg]; ok && fromMsg.nameCrc != toMsg.nameCrc {
+				changed = append(changed, fmt.Sprintf("%s: %s changed (%s -> %s)",
+					rel, msg, fromMsg.nameCrc, toMsg.nameCrc))
+			}
+		}
+	}
+	return changed, nil
+}
+
+// findHandlerDirs returns all vppcalls/<version> directories under plugins.
+func findHandlerDirs(root, version string) (dirs []string, err error) {
+	err = filepath.Walk(filepath.Join(root, "plugins"), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == version && filepath.Base(filepath.Dir(path)) == "vppcalls" {
+			dirs = append(dirs, path)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	sort.Strings(dirs)
+	return dirs, err
+}
+
+// copyHandlerDir copies the Go files of the handler package replacing
+// references to the template version.
+func copyHandlerDir(fromDir, toDir, from, to string) error {
+	files, err := ioutil.ReadDir(fromDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(toDir, 0755); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".go") {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(fromDir, file.Name()))
+		if err != nil {
+			return err
+		}
+		s := strings.ReplaceAll(string(content), from, to)
+		if err := ioutil.WriteFile(filepath.Join(toDir, file.Name()), []byte(s), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerHandlerPkg adds blank import of the new handler package next to each
+// blank import of the template handler package.
+func registerHandlerPkg(root, fromDir, toDir string) error {
+	fromRel, err := filepath.Rel(root, fromDir)
+	if err != nil {
+		return err
+	}
+	toRel, err := filepath.Rel(root, toDir)
+	if err != nil {
+		return err
+	}
+	fromImport := fmt.Sprintf("_ %q", path.Join(modulePath, filepath.ToSlash(fromRel)))
+	toImport := fmt.Sprintf("_ %q", path.Join(modulePath, filepath.ToSlash(toRel)))
+
+	return filepath.Walk(filepath.Join(root, "plugins"), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		s := string(content)
+		if !strings.Contains(s, fromImport) || strings.Contains(s, toImport) {
+			return nil
+		}
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			if strings.TrimSpace(line) == fromImport {
+				indent := line[:strings.Index(line, "_")]
+				lines = append(lines[:i+1], append([]string{indent + toImport}, lines[i+1:]...)...)
+				break
+			}
+		}
+		return ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode())
+	})
+}
+
+// usedMessages returns binapi types (qualified with the package name) referenced
+// by the non-test files of the handler package.
+func usedMessages(dir, version string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	binapiPrefix := path.Join(modulePath, binapiDir, version) + "/"
+
+	used := make(map[string]struct{})
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			// binapi package names by the names used in the file
+			imported := make(map[string]string)
+			for _, imp := range file.Imports {
+				importPath, _ := strconv.Unquote(imp.Path.Value)
+				if !strings.HasPrefix(importPath, binapiPrefix) {
+					continue
+				}
+				binapiPkg := path.Base(importPath)
+				name := binapiPkg
+				if imp.Name != nil {
+					name = imp.Name.Name
+				}
+				imported[name] = binapiPkg
+			}
+			ast.Inspect(file, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				if x, ok := sel.X.(*ast.Ident); ok {
+					if binapiPkg, ok := imported[x.Name]; ok {
+						used[binapiPkg+"."+sel.Sel.Name] = struct{}{}
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	var msgs []string
+	for msg := range used {
+		msgs = append(msgs, msg)
+	}
+	sort.Strings(msgs)
+	return msgs, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// vpp-version-gen adds support for a new VPP release using an already supported
+// release as a template.
+//
+// It performs the following steps:
+//  1. generates the curated set of binary API packages (the same API files as
+//     used by the template version) from the VPP API JSON directory
+//     into plugins/vpp/binapi/vpp<to>,
+//  2. records CRCs of all messages defined by the VPP API JSON files of the new
+//     version into plugins/vpp/binapi/testdata/vpp<to>.crc, the unit tests check
+//     the generated binapi against it,
+//  3. scaffolds vppcalls handlers (every vppcalls/vpp<from> package) for the new
+//     version and registers them in the plugins,
+//  4. reports messages used by the scaffolded handlers whose CRC has changed
+//     between the two versions, those handlers need to be reviewed manually.
+//
+// Usage (from the repository root):
+//
+//	vpp-version-gen --from 2202 --to 2210 --api-dir /usr/share/vpp/api
+//
+// The binary API generation and the CRC snapshot are skipped if --api-dir is not
+// set (binapi for the new version must already exist).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+const (
+	// modulePath is the import path of the repository module.
+	modulePath = "go.ligato.io/vpp-agent/v3"
+	// binapiDir is the directory (relative to repository root) with the binary API.
+	binapiDir = "plugins/vpp/binapi"
+)
+
+var (
+	fromFlag       = flag.String("from", "", "Supported VPP version used as the template (e.g. 2202).")
+	toFlag         = flag.String("to", "", "New VPP version (e.g. 2210).")
+	apiDirFlag     = flag.String("api-dir", "", "Directory with VPP API JSON files of the new version.")
+	rootFlag       = flag.String("root", ".", "Root directory of the repository.")
+	noHandlersFlag = flag.Bool("no-handlers", false, "Do not scaffold vppcalls handlers.")
+)
+
+var versionRe = regexp.MustCompile(`^[0-9]{4}$`)
+
+func main() {
+	flag.Parse()
+
+	if !versionRe.MatchString(*fromFlag) || !versionRe.MatchString(*toFlag) {
+		fmt.Fprintln(os.Stderr, "ERROR: from and to versions must be specified in format YYMM")
+		os.Exit(1)
+	}
+	from, to := "vpp"+*fromFlag, "vpp"+*toFlag
+	root := *rootFlag
+
+	if *apiDirFlag != "" {
+		if err := generateBinapi(root, from, to, *apiDirFlag); err != nil {
+			fmt.Fprintln(os.Stderr, "ERROR: generating binapi failed:", err)
+			os.Exit(2)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, binapiDir, to)); err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: binapi for %s not found (use --api-dir to generate it): %v\n", to, err)
+		os.Exit(2)
+	}
+
+	if *apiDirFlag != "" {
+		if err := writeCRCSnapshot(root, to, *apiDirFlag); err != nil {
+			fmt.Fprintln(os.Stderr, "ERROR: writing CRC snapshot failed:", err)
+			os.Exit(3)
+		}
+	} else {
+		fmt.Fprintln(os.Stderr, "WARNING: CRC snapshot not updated (--api-dir not set)")
+	}
+
+	if *noHandlersFlag {
+		return
+	}
+	changed, err := scaffoldHandlers(root, from, to)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "ERROR: scaffolding handlers failed:", err)
+		os.Exit(4)
+	}
+	for _, c := range changed {
+		fmt.Println(c)
+	}
+	if len(changed) > 0 {
+		fmt.Printf("%d messages used by the handlers have changed, review the handlers\n", len(changed))
+	}
+}
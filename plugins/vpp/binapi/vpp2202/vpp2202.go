@@ -22,11 +22,15 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/arp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/flowprobe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gtpu"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/igmp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ikev2"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip6_nd"
@@ -36,10 +40,12 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l3xc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lb"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memif"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ed"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ei"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/punt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rdma"
@@ -66,6 +72,7 @@ func init() {
 			af_packet.AllMessages,
 			arp.AllMessages,
 			bond.AllMessages,
+			classify.AllMessages,
 			gre.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
@@ -90,14 +97,19 @@ func init() {
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			cnat.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
 			gtpu.AllMessages,
+			igmp.AllMessages,
+			ikev2.AllMessages,
 			l3xc.AllMessages,
+			lb.AllMessages,
 			memif.AllMessages,
 			nat44_ed.AllMessages,
 			nat44_ei.AllMessages,
+			nat64.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
 			vmxnet3.AllMessages,
@@ -111,6 +123,7 @@ func init() {
 //go:generate binapigen --input-file=$VPP_API_DIR/core/af_packet.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/arp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/bond.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/classify.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/gre.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/interface.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/ip.api.json
@@ -133,14 +146,19 @@ func init() {
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/abf.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/acl.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/cnat.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dhcp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dns.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/flowprobe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/gtpu.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/igmp.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/ikev2.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/l3xc.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/lb.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/memif.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat44_ed.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat44_ei.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat64.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/rdma.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/stn.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/vmxnet3.api.json
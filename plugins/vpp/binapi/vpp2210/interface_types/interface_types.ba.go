@@ -0,0 +1,305 @@
+// Code generated by GoVPP's binapi-generator. DO NOT EDIT.
+
+// Package interface_types contains generated bindings for API file interface_types.api.
+//
+// Contents:
+//   1 alias
+//   7 enums
+//
+package interface_types
+
+import (
+	"strconv"
+
+	api "go.fd.io/govpp/api"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the GoVPP api package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// GoVPP api package needs to be updated.
+const _ = api.GoVppAPIPackageIsVersion2
+
+const (
+	APIFile    = "interface_types"
+	APIVersion = "1.0.0"
+	VersionCrc = 0x7f2ba79a
+)
+
+// Direction defines enum 'direction'.
+type Direction uint8
+
+const (
+	RX Direction = 0
+	TX Direction = 1
+)
+
+var (
+	Direction_name = map[uint8]string{
+		0: "RX",
+		1: "TX",
+	}
+	Direction_value = map[string]uint8{
+		"RX": 0,
+		"TX": 1,
+	}
+)
+
+func (x Direction) String() string {
+	s, ok := Direction_name[uint8(x)]
+	if ok {
+		return s
+	}
+	return "Direction(" + strconv.Itoa(int(x)) + ")"
+}
+
+// IfStatusFlags defines enum 'if_status_flags'.
+type IfStatusFlags uint32
+
+const (
+	IF_STATUS_API_FLAG_ADMIN_UP IfStatusFlags = 1
+	IF_STATUS_API_FLAG_LINK_UP  IfStatusFlags = 2
+)
+
+var (
+	IfStatusFlags_name = map[uint32]string{
+		1: "IF_STATUS_API_FLAG_ADMIN_UP",
+		2: "IF_STATUS_API_FLAG_LINK_UP",
+	}
+	IfStatusFlags_value = map[string]uint32{
+		"IF_STATUS_API_FLAG_ADMIN_UP": 1,
+		"IF_STATUS_API_FLAG_LINK_UP":  2,
+	}
+)
+
+func (x IfStatusFlags) String() string {
+	s, ok := IfStatusFlags_name[uint32(x)]
+	if ok {
+		return s
+	}
+	str := func(n uint32) string {
+		s, ok := IfStatusFlags_name[uint32(n)]
+		if ok {
+			return s
+		}
+		return "IfStatusFlags(" + strconv.Itoa(int(n)) + ")"
+	}
+	for i := uint32(0); i <= 32; i++ {
+		val := uint32(x)
+		if val&(1<<i) != 0 {
+			if s != "" {
+				s += "|"
+			}
+			s += str(1 << i)
+		}
+	}
+	if s == "" {
+		return str(uint32(x))
+	}
+	return s
+}
+
+// IfType defines enum 'if_type'.
+type IfType uint32
+
+const (
+	IF_API_TYPE_HARDWARE IfType = 0
+	IF_API_TYPE_SUB      IfType = 1
+	IF_API_TYPE_P2P      IfType = 2
+	IF_API_TYPE_PIPE     IfType = 3
+)
+
+var (
+	IfType_name = map[uint32]string{
+		0: "IF_API_TYPE_HARDWARE",
+		1: "IF_API_TYPE_SUB",
+		2: "IF_API_TYPE_P2P",
+		3: "IF_API_TYPE_PIPE",
+	}
+	IfType_value = map[string]uint32{
+		"IF_API_TYPE_HARDWARE": 0,
+		"IF_API_TYPE_SUB":      1,
+		"IF_API_TYPE_P2P":      2,
+		"IF_API_TYPE_PIPE":     3,
+	}
+)
+
+func (x IfType) String() string {
+	s, ok := IfType_name[uint32(x)]
+	if ok {
+		return s
+	}
+	return "IfType(" + strconv.Itoa(int(x)) + ")"
+}
+
+// LinkDuplex defines enum 'link_duplex'.
+type LinkDuplex uint32
+
+const (
+	LINK_DUPLEX_API_UNKNOWN LinkDuplex = 0
+	LINK_DUPLEX_API_HALF    LinkDuplex = 1
+	LINK_DUPLEX_API_FULL    LinkDuplex = 2
+)
+
+var (
+	LinkDuplex_name = map[uint32]string{
+		0: "LINK_DUPLEX_API_UNKNOWN",
+		1: "LINK_DUPLEX_API_HALF",
+		2: "LINK_DUPLEX_API_FULL",
+	}
+	LinkDuplex_value = map[string]uint32{
+		"LINK_DUPLEX_API_UNKNOWN": 0,
+		"LINK_DUPLEX_API_HALF":    1,
+		"LINK_DUPLEX_API_FULL":    2,
+	}
+)
+
+func (x LinkDuplex) String() string {
+	s, ok := LinkDuplex_name[uint32(x)]
+	if ok {
+		return s
+	}
+	return "LinkDuplex(" + strconv.Itoa(int(x)) + ")"
+}
+
+// MtuProto defines enum 'mtu_proto'.
+type MtuProto uint32
+
+const (
+	MTU_PROTO_API_L3   MtuProto = 0
+	MTU_PROTO_API_IP4  MtuProto = 1
+	MTU_PROTO_API_IP6  MtuProto = 2
+	MTU_PROTO_API_MPLS MtuProto = 3
+)
+
+var (
+	MtuProto_name = map[uint32]string{
+		0: "MTU_PROTO_API_L3",
+		1: "MTU_PROTO_API_IP4",
+		2: "MTU_PROTO_API_IP6",
+		3: "MTU_PROTO_API_MPLS",
+	}
+	MtuProto_value = map[string]uint32{
+		"MTU_PROTO_API_L3":   0,
+		"MTU_PROTO_API_IP4":  1,
+		"MTU_PROTO_API_IP6":  2,
+		"MTU_PROTO_API_MPLS": 3,
+	}
+)
+
+func (x MtuProto) String() string {
+	s, ok := MtuProto_name[uint32(x)]
+	if ok {
+		return s
+	}
+	return "MtuProto(" + strconv.Itoa(int(x)) + ")"
+}
+
+// RxMode defines enum 'rx_mode'.
+type RxMode uint32
+
+const (
+	RX_MODE_API_UNKNOWN   RxMode = 0
+	RX_MODE_API_POLLING   RxMode = 1
+	RX_MODE_API_INTERRUPT RxMode = 2
+	RX_MODE_API_ADAPTIVE  RxMode = 3
+	RX_MODE_API_DEFAULT   RxMode = 4
+)
+
+var (
+	RxMode_name = map[uint32]string{
+		0: "RX_MODE_API_UNKNOWN",
+		1: "RX_MODE_API_POLLING",
+		2: "RX_MODE_API_INTERRUPT",
+		3: "RX_MODE_API_ADAPTIVE",
+		4: "RX_MODE_API_DEFAULT",
+	}
+	RxMode_value = map[string]uint32{
+		"RX_MODE_API_UNKNOWN":   0,
+		"RX_MODE_API_POLLING":   1,
+		"RX_MODE_API_INTERRUPT": 2,
+		"RX_MODE_API_ADAPTIVE":  3,
+		"RX_MODE_API_DEFAULT":   4,
+	}
+)
+
+func (x RxMode) String() string {
+	s, ok := RxMode_name[uint32(x)]
+	if ok {
+		return s
+	}
+	return "RxMode(" + strconv.Itoa(int(x)) + ")"
+}
+
+// SubIfFlags defines enum 'sub_if_flags'.
+type SubIfFlags uint32
+
+const (
+	SUB_IF_API_FLAG_NO_TAGS           SubIfFlags = 1
+	SUB_IF_API_FLAG_ONE_TAG           SubIfFlags = 2
+	SUB_IF_API_FLAG_TWO_TAGS          SubIfFlags = 4
+	SUB_IF_API_FLAG_DOT1AD            SubIfFlags = 8
+	SUB_IF_API_FLAG_EXACT_MATCH       SubIfFlags = 16
+	SUB_IF_API_FLAG_DEFAULT           SubIfFlags = 32
+	SUB_IF_API_FLAG_OUTER_VLAN_ID_ANY SubIfFlags = 64
+	SUB_IF_API_FLAG_INNER_VLAN_ID_ANY SubIfFlags = 128
+	SUB_IF_API_FLAG_MASK_VNET         SubIfFlags = 254
+	SUB_IF_API_FLAG_DOT1AH            SubIfFlags = 256
+)
+
+var (
+	SubIfFlags_name = map[uint32]string{
+		1:   "SUB_IF_API_FLAG_NO_TAGS",
+		2:   "SUB_IF_API_FLAG_ONE_TAG",
+		4:   "SUB_IF_API_FLAG_TWO_TAGS",
+		8:   "SUB_IF_API_FLAG_DOT1AD",
+		16:  "SUB_IF_API_FLAG_EXACT_MATCH",
+		32:  "SUB_IF_API_FLAG_DEFAULT",
+		64:  "SUB_IF_API_FLAG_OUTER_VLAN_ID_ANY",
+		128: "SUB_IF_API_FLAG_INNER_VLAN_ID_ANY",
+		254: "SUB_IF_API_FLAG_MASK_VNET",
+		256: "SUB_IF_API_FLAG_DOT1AH",
+	}
+	SubIfFlags_value = map[string]uint32{
+		"SUB_IF_API_FLAG_NO_TAGS":           1,
+		"SUB_IF_API_FLAG_ONE_TAG":           2,
+		"SUB_IF_API_FLAG_TWO_TAGS":          4,
+		"SUB_IF_API_FLAG_DOT1AD":            8,
+		"SUB_IF_API_FLAG_EXACT_MATCH":       16,
+		"SUB_IF_API_FLAG_DEFAULT":           32,
+		"SUB_IF_API_FLAG_OUTER_VLAN_ID_ANY": 64,
+		"SUB_IF_API_FLAG_INNER_VLAN_ID_ANY": 128,
+		"SUB_IF_API_FLAG_MASK_VNET":         254,
+		"SUB_IF_API_FLAG_DOT1AH":            256,
+	}
+)
+
+func (x SubIfFlags) String() string {
+	s, ok := SubIfFlags_name[uint32(x)]
+	if ok {
+		return s
+	}
+	str := func(n uint32) string {
+		s, ok := SubIfFlags_name[uint32(n)]
+		if ok {
+			return s
+		}
+		return "SubIfFlags(" + strconv.Itoa(int(n)) + ")"
+	}
+	for i := uint32(0); i <= 32; i++ {
+		val := uint32(x)
+		if val&(1<<i) != 0 {
+			if s != "" {
+				s += "|"
+			}
+			s += str(1 << i)
+		}
+	}
+	if s == "" {
+		return str(uint32(x))
+	}
+	return s
+}
+
+// InterfaceIndex defines alias 'interface_index'.
+type InterfaceIndex uint32
@@ -0,0 +1,31 @@
+// Code generated by GoVPP's binapi-generator. DO NOT EDIT.
+
+package rd_cp
+
+import (
+	"context"
+
+	api "go.fd.io/govpp/api"
+)
+
+// RPCService defines RPC service rd_cp.
+type RPCService interface {
+	IP6NdAddressAutoconfig(ctx context.Context, in *IP6NdAddressAutoconfig) (*IP6NdAddressAutoconfigReply, error)
+}
+
+type serviceClient struct {
+	conn api.Connection
+}
+
+func NewServiceClient(conn api.Connection) RPCService {
+	return &serviceClient{conn}
+}
+
+func (c *serviceClient) IP6NdAddressAutoconfig(ctx context.Context, in *IP6NdAddressAutoconfig) (*IP6NdAddressAutoconfigReply, error) {
+	out := new(IP6NdAddressAutoconfigReply)
+	err := c.conn.Invoke(ctx, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, api.RetvalToVPPApiError(out.Retval)
+}
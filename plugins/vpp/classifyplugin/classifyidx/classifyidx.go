@@ -0,0 +1,99 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package classifyidx
+
+import (
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+)
+
+// TableMetadataIndex provides read-only access to mapping between classifier
+// table indexes (assigned by VPP) and table names.
+type TableMetadataIndex interface {
+	// LookupByName looks up previously stored item identified by name in the mapping.
+	LookupByName(name string) (metadata *TableMetadata, exists bool)
+
+	// LookupByIndex looks up previously stored item identified by index in the mapping.
+	LookupByIndex(idx uint32) (name string, metadata *TableMetadata, exists bool)
+
+	// ListAllNames returns all names in the mapping.
+	ListAllNames() (names []string)
+}
+
+// TableMetadataIndexRW is mapping between classifier table indexes (assigned by VPP)
+// and table names.
+type TableMetadataIndexRW interface {
+	TableMetadataIndex
+	idxmap.NamedMappingRW
+}
+
+// TableMetadata represents metadata for classifier table.
+type TableMetadata struct {
+	Index uint32
+	// SkipNVectors, MatchNVectors and Mask are needed to build and correlate
+	// match values of table sessions.
+	SkipNVectors  uint32
+	MatchNVectors uint32
+	Mask          []byte
+}
+
+// GetIndex returns index of the classifier table.
+func (m *TableMetadata) GetIndex() uint32 {
+	return m.Index
+}
+
+type tableMetadataIndex struct {
+	idxmap.NamedMappingRW
+
+	log         logging.Logger
+	nameToIndex idxvpp.NameToIndex
+}
+
+// NewTableIndex creates new instance of tableMetadataIndex.
+func NewTableIndex(logger logging.Logger, title string) TableMetadataIndexRW {
+	mapping := idxvpp.NewNameToIndex(logger, title, nil)
+	return &tableMetadataIndex{
+		NamedMappingRW: mapping,
+		log:            logger,
+		nameToIndex:    mapping,
+	}
+}
+
+// LookupByName looks up previously stored item identified by name in mapping.
+func (idx *tableMetadataIndex) LookupByName(name string) (metadata *TableMetadata, exists bool) {
+	meta, found := idx.GetValue(name)
+	if found {
+		if typedMeta, ok := meta.(*TableMetadata); ok {
+			return typedMeta, found
+		}
+	}
+	return nil, false
+}
+
+// LookupByIndex looks up previously stored item identified by index in mapping.
+func (idx *tableMetadataIndex) LookupByIndex(index uint32) (name string, metadata *TableMetadata, exists bool) {
+	var item idxvpp.WithIndex
+	name, item, exists = idx.nameToIndex.LookupByIndex(index)
+	if exists {
+		var isTableMeta bool
+		metadata, isTableMeta = item.(*TableMetadata)
+		if !isTableMeta {
+			exists = false
+		}
+	}
+	return
+}
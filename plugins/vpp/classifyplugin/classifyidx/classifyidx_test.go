@@ -0,0 +1,65 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package classifyidx_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+)
+
+func TestTableIndexLookupByName(t *testing.T) {
+	RegisterTestingT(t)
+	tableIndex := classifyidx.NewTableIndex(logging.DefaultLogger, "classify-table-index")
+
+	tableIndex.Put("t1", &classifyidx.TableMetadata{Index: 0, SkipNVectors: 1, MatchNVectors: 2})
+	tableIndex.Put("t2", 10)
+
+	metadata, exists := tableIndex.LookupByName("t1")
+	Expect(exists).To(BeTrue())
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.Index).To(Equal(uint32(0)))
+	Expect(metadata.SkipNVectors).To(Equal(uint32(1)))
+	Expect(metadata.MatchNVectors).To(Equal(uint32(2)))
+
+	metadata, exists = tableIndex.LookupByName("t2")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+
+	metadata, exists = tableIndex.LookupByName("t3")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+}
+
+func TestTableIndexLookupByIndex(t *testing.T) {
+	RegisterTestingT(t)
+	tableIndex := classifyidx.NewTableIndex(logging.DefaultLogger, "classify-table-index")
+
+	tableIndex.Put("t1", &classifyidx.TableMetadata{Index: 5, MatchNVectors: 1})
+
+	name, metadata, exists := tableIndex.LookupByIndex(5)
+	Expect(exists).To(BeTrue())
+	Expect(name).To(Equal("t1"))
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.MatchNVectors).To(Equal(uint32(1)))
+
+	name, metadata, exists = tableIndex.LookupByIndex(6)
+	Expect(exists).To(BeFalse())
+	Expect(name).To(Equal(""))
+	Expect(metadata).To(BeNil())
+}
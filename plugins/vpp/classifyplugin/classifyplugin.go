@@ -0,0 +1,100 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Table --value-type *vpp_classify.Table --meta-type *classifyidx.TableMetadata --import "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name Session --value-type *vpp_classify.Session --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify" --output-dir "descriptor"
+
+package classifyplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2210"
+)
+
+// ClassifyPlugin configures VPP classifier tables, their sessions and attachment
+// of tables to interfaces.
+type ClassifyPlugin struct {
+	Deps
+	// handler
+	ClassifyHandler vppcalls.ClassifyVppAPI
+
+	// index map
+	tableIndex classifyidx.TableMetadataIndex
+}
+
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+func (p *ClassifyPlugin) Init() (err error) {
+	// init classifier handler
+	p.ClassifyHandler = vppcalls.CompatibleClassifyVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.ClassifyHandler == nil {
+		p.Log.Warnf("Classifier handler is not available for the VPP version, skipping")
+		return nil
+	}
+
+	tableDescriptor, tableDescrCtx := descriptor.NewTableDescriptor(p.ClassifyHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, tableDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(tableDescriptor.Name)
+	p.tableIndex, withIndex = metadataMap.(classifyidx.TableMetadataIndex)
+	if !withIndex {
+		return errors.New("missing index with classifier table metadata")
+	}
+	tableDescrCtx.SetTableIndex(p.tableIndex)
+
+	sessionDescriptor := descriptor.NewSessionDescriptor(p.ClassifyHandler, p.tableIndex, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, sessionDescriptor); err != nil {
+		return err
+	}
+	tableIfaceDescriptor := descriptor.NewTableInterfaceDescriptor(p.ClassifyHandler, p.tableIndex, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, tableIfaceDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *ClassifyPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetTableIndex returns read-only reference to index map with classifier table metadata.
+func (p *ClassifyPlugin) GetTableIndex() classifyidx.TableMetadataIndex {
+	return p.tableIndex
+}
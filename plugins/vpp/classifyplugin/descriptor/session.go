@@ -0,0 +1,210 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+const (
+	// SessionDescriptorName is the name of the descriptor for classifier sessions.
+	SessionDescriptorName = "vpp-classify-session"
+
+	// dependency labels
+	tableDep = "table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrSessionWithoutTable is returned when classifier session has no table defined.
+	ErrSessionWithoutTable = errors.New("classifier table of the session is not defined")
+	// ErrSessionWithoutMatch is returned when classifier session has no match defined.
+	ErrSessionWithoutMatch = errors.New("classifier session match is not defined")
+)
+
+// SessionDescriptor teaches KVScheduler how to configure VPP classifier sessions.
+type SessionDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      classifyidx.TableMetadataIndex
+}
+
+// NewSessionDescriptor creates a new instance of the classifier session descriptor.
+func NewSessionDescriptor(classifyHandler vppcalls.ClassifyVppAPI, tableIndex classifyidx.TableMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SessionDescriptor{
+		classifyHandler: classifyHandler,
+		tableIndex:      tableIndex,
+		log:             log.NewLogger("classify-session-descriptor"),
+	}
+
+	typedDescr := &adapter.SessionDescriptor{
+		Name:                 SessionDescriptorName,
+		NBKeyPrefix:          classify.ModelSession.KeyPrefix(),
+		ValueTypeName:        classify.ModelSession.ProtoName(),
+		KeySelector:          classify.ModelSession.IsKeyValid,
+		KeyLabel:             classify.ModelSession.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{TableDescriptorName},
+	}
+	return adapter.NewSessionDescriptor(typedDescr)
+}
+
+// Validate validates classifier session configuration.
+func (d *SessionDescriptor) Validate(key string, session *classify.Session) error {
+	if session.Table == "" {
+		return kvs.NewInvalidValueError(ErrSessionWithoutTable, "table")
+	}
+	if session.Match == "" {
+		return kvs.NewInvalidValueError(ErrSessionWithoutMatch, "match")
+	}
+	// the length of the match is checked against the table vectors in Create
+	if _, err := hex.DecodeString(session.Match); err != nil {
+		return kvs.NewInvalidValueError(err, "match")
+	}
+	return nil
+}
+
+// Create adds classifier session.
+func (d *SessionDescriptor) Create(key string, session *classify.Session) (metadata interface{}, err error) {
+	tableMeta, err := d.lookupTable(session)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err := d.classifyHandler.AddClassifySession(tableMeta, session); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Update updates classifier session (VPP updates session with the same match in-place).
+func (d *SessionDescriptor) Update(key string, oldSession, newSession *classify.Session,
+	oldMetadata interface{}) (newMetadata interface{}, err error) {
+	tableMeta, err := d.lookupTable(newSession)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err := d.classifyHandler.AddClassifySession(tableMeta, newSession); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes classifier session.
+func (d *SessionDescriptor) Delete(key string, session *classify.Session, metadata interface{}) error {
+	tableMeta, err := d.lookupTable(session)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if err := d.classifyHandler.DeleteClassifySession(tableMeta, session); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns sessions of all classifier tables. VPP dumps only the masked part
+// of the match, therefore sessions are correlated with NB values by the masked key.
+func (d *SessionDescriptor) Retrieve(correlate []adapter.SessionKVWithMetadata) (
+	retrieved []adapter.SessionKVWithMetadata, err error) {
+	for _, tableName := range d.tableIndex.ListAllNames() {
+		tableMeta, found := d.tableIndex.LookupByName(tableName)
+		if !found {
+			continue
+		}
+		sessions, err := d.classifyHandler.DumpClassifySessions(tableMeta)
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+		for _, session := range sessions {
+			session.Table = tableName
+			if nb := correlateSession(session, tableMeta, correlate); nb != nil {
+				// action and metadata are not provided by the VPP dump
+				session.Match = nb.Match
+				session.Action = nb.Action
+				session.Metadata = nb.Metadata
+			}
+			retrieved = append(retrieved, adapter.SessionKVWithMetadata{
+				Key:    classify.SessionKey(session.Table, session.Match),
+				Value:  session,
+				Origin: kvs.FromNB,
+			})
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the table of the session as the only dependency.
+func (d *SessionDescriptor) Dependencies(key string, session *classify.Session) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: tableDep,
+			Key:   classify.TableKey(session.Table),
+		},
+	}
+}
+
+func (d *SessionDescriptor) lookupTable(session *classify.Session) (*classifyidx.TableMetadata, error) {
+	tableMeta, found := d.tableIndex.LookupByName(session.Table)
+	if !found {
+		return nil, errors.Errorf("failed to get metadata for classifier table %s", session.Table)
+	}
+	return tableMeta, nil
+}
+
+// correlateSession returns NB session of the same table with the same masked key
+// as the retrieved session.
+func correlateSession(retrieved *classify.Session, tableMeta *classifyidx.TableMetadata,
+	correlate []adapter.SessionKVWithMetadata) *classify.Session {
+	retrievedMatch, err := vppcalls.ParseMatch(retrieved.Match, tableMeta.SkipNVectors, tableMeta.MatchNVectors)
+	if err != nil {
+		return nil
+	}
+	retrievedKey := vppcalls.MaskedKey(retrievedMatch, tableMeta)
+	for _, nb := range correlate {
+		if nb.Value.Table != retrieved.Table {
+			continue
+		}
+		nbMatch, err := vppcalls.ParseMatch(nb.Value.Match, tableMeta.SkipNVectors, tableMeta.MatchNVectors)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(vppcalls.MaskedKey(nbMatch, tableMeta), retrievedKey) {
+			return nb.Value
+		}
+	}
+	return nil
+}
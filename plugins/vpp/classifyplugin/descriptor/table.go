@@ -0,0 +1,309 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+const (
+	// TableDescriptorName is the name of the descriptor for classifier tables.
+	TableDescriptorName = "vpp-classify-table"
+
+	// dependency labels
+	nextTableDep = "next-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrTableWithoutName is returned when classifier table name is empty.
+	ErrTableWithoutName = errors.New("classifier table name is not defined")
+	// ErrTableChainedToItself is returned when next table of the classifier table is the table itself.
+	ErrTableChainedToItself = errors.New("classifier table cannot be chained to itself")
+	// ErrTableInterfaceWithoutName is returned when interface of the classifier table has no name.
+	ErrTableInterfaceWithoutName = errors.New("interface name is not defined")
+	// ErrDuplicateTableInterface is returned when the table is attached to the same interface
+	// with the same type and protocol more than once.
+	ErrDuplicateTableInterface = errors.New("duplicate interface with the same type and protocol")
+)
+
+// TableDescriptor teaches KVScheduler how to configure VPP classifier tables.
+type TableDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+
+	// runtime
+	tableIndex classifyidx.TableMetadataIndex
+}
+
+// NewTableDescriptor creates a new instance of the classifier table descriptor.
+func NewTableDescriptor(classifyHandler vppcalls.ClassifyVppAPI, log logging.PluginLogger) (
+	*kvs.KVDescriptor, *TableDescriptor) {
+	ctx := &TableDescriptor{
+		classifyHandler: classifyHandler,
+		log:             log.NewLogger("classify-table-descriptor"),
+	}
+
+	typedDescr := &adapter.TableDescriptor{
+		Name:                 TableDescriptorName,
+		NBKeyPrefix:          classify.ModelTable.KeyPrefix(),
+		ValueTypeName:        classify.ModelTable.ProtoName(),
+		KeySelector:          classify.ModelTable.IsKeyValid,
+		KeyLabel:             classify.ModelTable.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentTables,
+		WithMetadata:         true,
+		MetadataMapFactory:   ctx.MetadataFactory,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		DerivedValues:        ctx.DerivedValues,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewTableDescriptor(typedDescr), ctx
+}
+
+// SetTableIndex should be used to provide table index immediately after
+// the descriptor registration.
+func (d *TableDescriptor) SetTableIndex(tableIndex classifyidx.TableMetadataIndex) {
+	d.tableIndex = tableIndex
+}
+
+// MetadataFactory is a factory for index-map customized for classifier tables.
+func (d *TableDescriptor) MetadataFactory() idxmap.NamedMappingRW {
+	return classifyidx.NewTableIndex(d.log, "vpp-classify-table-index")
+}
+
+// EquivalentTables compares classifier tables, treating unset nbuckets and memory size
+// as VPP defaults. Interfaces are compared as derived values.
+func (d *TableDescriptor) EquivalentTables(key string, oldTable, newTable *classify.Table) bool {
+	return equivalentTableMasks(oldTable, newTable) &&
+		oldTable.SkipNVectors == newTable.SkipNVectors &&
+		withDefault(oldTable.Nbuckets, vppcalls.DefaultNbuckets) == withDefault(newTable.Nbuckets, vppcalls.DefaultNbuckets) &&
+		withDefault(oldTable.MemorySize, vppcalls.DefaultMemorySize) == withDefault(newTable.MemorySize, vppcalls.DefaultMemorySize) &&
+		oldTable.NextTable == newTable.NextTable &&
+		oldTable.MissNextIndex == newTable.MissNextIndex &&
+		oldTable.CurrentData == newTable.CurrentData &&
+		oldTable.CurrentDataOffset == newTable.CurrentDataOffset
+}
+
+// Validate validates classifier table configuration.
+func (d *TableDescriptor) Validate(key string, table *classify.Table) error {
+	if table.Name == "" {
+		return kvs.NewInvalidValueError(ErrTableWithoutName, "name")
+	}
+	if _, err := vppcalls.ParseMask(table.Mask); err != nil {
+		return kvs.NewInvalidValueError(err, "mask")
+	}
+	if table.NextTable == table.Name {
+		return kvs.NewInvalidValueError(ErrTableChainedToItself, "next_table")
+	}
+	attached := make(map[string]struct{})
+	for i, iface := range table.Interfaces {
+		if iface.Name == "" {
+			return kvs.NewInvalidValueError(ErrTableInterfaceWithoutName, fmt.Sprintf("interfaces[%d].name", i))
+		}
+		ifaceKey := classify.TableInterfaceKey(table.Name, iface)
+		if _, duplicate := attached[ifaceKey]; duplicate {
+			return kvs.NewInvalidValueError(ErrDuplicateTableInterface, fmt.Sprintf("interfaces[%d]", i))
+		}
+		attached[ifaceKey] = struct{}{}
+	}
+	return nil
+}
+
+// Create adds classifier table.
+func (d *TableDescriptor) Create(key string, table *classify.Table) (*classifyidx.TableMetadata, error) {
+	nextTableIndex, err := d.nextTableIndex(table)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	metadata, err := d.classifyHandler.AddClassifyTable(table, nextTableIndex)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// Update updates next table, miss next index and current data of the classifier table.
+func (d *TableDescriptor) Update(key string, oldTable, newTable *classify.Table,
+	oldMetadata *classifyidx.TableMetadata) (*classifyidx.TableMetadata, error) {
+	nextTableIndex, err := d.nextTableIndex(newTable)
+	if err != nil {
+		d.log.Error(err)
+		return oldMetadata, err
+	}
+	if err := d.classifyHandler.UpdateClassifyTable(oldMetadata, newTable, nextTableIndex); err != nil {
+		d.log.Error(err)
+		return oldMetadata, err
+	}
+	return oldMetadata, nil
+}
+
+// UpdateWithRecreate returns true if the mask or size of the classifier table has changed.
+func (d *TableDescriptor) UpdateWithRecreate(key string, oldTable, newTable *classify.Table,
+	metadata *classifyidx.TableMetadata) bool {
+	return !equivalentTableMasks(oldTable, newTable) ||
+		oldTable.SkipNVectors != newTable.SkipNVectors ||
+		withDefault(oldTable.Nbuckets, vppcalls.DefaultNbuckets) != withDefault(newTable.Nbuckets, vppcalls.DefaultNbuckets) ||
+		withDefault(oldTable.MemorySize, vppcalls.DefaultMemorySize) != withDefault(newTable.MemorySize, vppcalls.DefaultMemorySize)
+}
+
+// Delete removes classifier table.
+func (d *TableDescriptor) Delete(key string, table *classify.Table, metadata *classifyidx.TableMetadata) error {
+	if metadata == nil {
+		return fmt.Errorf("failed to delete classifier table %s - metadata is nil", table.Name)
+	}
+	if err := d.classifyHandler.DeleteClassifyTable(metadata.Index); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all classifier tables. VPP does not store table names, therefore
+// tables are correlated with NB values by mask, skipped vectors and miss next index.
+func (d *TableDescriptor) Retrieve(correlate []adapter.TableKVWithMetadata) (
+	retrieved []adapter.TableKVWithMetadata, err error) {
+	tables, err := d.classifyHandler.DumpClassifyTables()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// assign names first to resolve table chaining
+	names := make(map[uint32]string)
+	nbTables := make(map[uint32]*classify.Table)
+	used := make(map[string]struct{})
+	for _, details := range tables {
+		for _, nb := range correlate {
+			if _, isUsed := used[nb.Value.Name]; isUsed {
+				continue
+			}
+			if correlateTables(nb.Value, details.Table) {
+				names[details.Meta.Index] = nb.Value.Name
+				nbTables[details.Meta.Index] = nb.Value
+				used[nb.Value.Name] = struct{}{}
+				break
+			}
+		}
+		if _, named := names[details.Meta.Index]; !named {
+			names[details.Meta.Index] = fmt.Sprintf("table-%d", details.Meta.Index)
+		}
+	}
+
+	for _, details := range tables {
+		table := details.Table
+		table.Name = names[details.Meta.Index]
+		if details.NextTableIndex != vppcalls.NoTableIndex {
+			table.NextTable = names[details.NextTableIndex]
+		}
+		if nb, correlated := nbTables[details.Meta.Index]; correlated {
+			// attributes not provided by the VPP dump
+			table.Mask = nb.Mask
+			table.MemorySize = nb.MemorySize
+			table.CurrentData = nb.CurrentData
+			table.CurrentDataOffset = nb.CurrentDataOffset
+			for _, iface := range nb.Interfaces {
+				if iface.Type == classify.Table_Interface_OUTPUT_ACL {
+					table.Interfaces = append(table.Interfaces, iface)
+				}
+			}
+		}
+		retrieved = append(retrieved, adapter.TableKVWithMetadata{
+			Key:      classify.TableKey(table.Name),
+			Value:    table,
+			Metadata: details.Meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the next table of the chain as a dependency.
+func (d *TableDescriptor) Dependencies(key string, table *classify.Table) (deps []kvs.Dependency) {
+	if table.NextTable != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: nextTableDep,
+			Key:   classify.TableKey(table.NextTable),
+		})
+	}
+	return deps
+}
+
+// DerivedValues derives one value for every interface the table is attached to.
+func (d *TableDescriptor) DerivedValues(key string, table *classify.Table) (derived []kvs.KeyValuePair) {
+	for _, iface := range table.Interfaces {
+		derived = append(derived, kvs.KeyValuePair{
+			Key:   classify.TableInterfaceKey(table.Name, iface),
+			Value: iface,
+		})
+	}
+	return derived
+}
+
+// nextTableIndex returns index of the next table of the chain (or NoTableIndex).
+func (d *TableDescriptor) nextTableIndex(table *classify.Table) (uint32, error) {
+	if table.NextTable == "" {
+		return vppcalls.NoTableIndex, nil
+	}
+	nextTable, found := d.tableIndex.LookupByName(table.NextTable)
+	if !found {
+		return 0, errors.Errorf("failed to get metadata for next classifier table %s", table.NextTable)
+	}
+	return nextTable.Index, nil
+}
+
+// correlateTables returns true if the retrieved table may correspond to the NB table.
+func correlateTables(nb, retrieved *classify.Table) bool {
+	return equivalentTableMasks(nb, retrieved) &&
+		nb.SkipNVectors == retrieved.SkipNVectors &&
+		nb.MissNextIndex == retrieved.MissNextIndex &&
+		withDefault(nb.Nbuckets, vppcalls.DefaultNbuckets) == retrieved.Nbuckets
+}
+
+// equivalentTableMasks compares table masks padded to the vector size.
+func equivalentTableMasks(table1, table2 *classify.Table) bool {
+	mask1, err1 := vppcalls.ParseMask(table1.Mask)
+	mask2, err2 := vppcalls.ParseMask(table2.Mask)
+	if err1 != nil || err2 != nil {
+		return table1.Mask == table2.Mask
+	}
+	return bytes.Equal(mask1, mask2)
+}
+
+func withDefault(value, defaultValue uint32) uint32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
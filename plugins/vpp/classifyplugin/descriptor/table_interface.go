@@ -0,0 +1,120 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// TableInterfaceDescriptorName is the name of the descriptor for classifier tables
+	// attached to interfaces.
+	TableInterfaceDescriptorName = "vpp-classify-table-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+)
+
+// TableInterfaceDescriptor attaches classifier tables to interfaces (derived values
+// of tables).
+type TableInterfaceDescriptor struct {
+	log             logging.Logger
+	classifyHandler vppcalls.ClassifyVppAPI
+	tableIndex      classifyidx.TableMetadataIndex
+}
+
+// NewTableInterfaceDescriptor creates a new instance of the table interface descriptor.
+func NewTableInterfaceDescriptor(classifyHandler vppcalls.ClassifyVppAPI, tableIndex classifyidx.TableMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &TableInterfaceDescriptor{
+		classifyHandler: classifyHandler,
+		tableIndex:      tableIndex,
+		log:             log.NewLogger("classify-table-interface-descriptor"),
+	}
+	return &kvs.KVDescriptor{
+		Name:         TableInterfaceDescriptorName,
+		KeySelector:  ctx.IsTableInterfaceKey,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+}
+
+// IsTableInterfaceKey returns true if the key represents classifier table attached
+// to an interface.
+func (d *TableInterfaceDescriptor) IsTableInterfaceKey(key string) bool {
+	_, _, isTableInterfaceKey := classify.ParseTableInterfaceKey(key)
+	return isTableInterfaceKey
+}
+
+// Create attaches classifier table to the interface.
+func (d *TableInterfaceDescriptor) Create(key string, value proto.Message) (metadata kvs.Metadata, err error) {
+	tableIndex, iface, err := d.process(key)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err := d.classifyHandler.SetClassifyTableInterface(tableIndex, iface); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete detaches classifier table from the interface.
+func (d *TableInterfaceDescriptor) Delete(key string, value proto.Message, metadata kvs.Metadata) error {
+	tableIndex, iface, err := d.process(key)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if err := d.classifyHandler.UnsetClassifyTableInterface(tableIndex, iface); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies lists the interface as the only dependency.
+func (d *TableInterfaceDescriptor) Dependencies(key string, value proto.Message) []kvs.Dependency {
+	_, iface, _ := classify.ParseTableInterfaceKey(key)
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(iface.GetName()),
+		},
+	}
+}
+
+// process returns index of the table and the interface the table is attached to.
+func (d *TableInterfaceDescriptor) process(key string) (tableIndex uint32, iface *classify.Table_Interface, err error) {
+	table, iface, isValid := classify.ParseTableInterfaceKey(key)
+	if !isValid {
+		return 0, nil, errors.Errorf("classifier table interface key %s is not valid", key)
+	}
+	tableMeta, found := d.tableIndex.LookupByName(table)
+	if !found {
+		return 0, nil, errors.Errorf("failed to get metadata for classifier table %s", table)
+	}
+	return tableMeta.Index, iface, nil
+}
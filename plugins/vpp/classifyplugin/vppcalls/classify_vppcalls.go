@@ -0,0 +1,159 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+const (
+	// NoTableIndex is table index which means 'no table' (e.g. end of the chain).
+	NoTableIndex = ^uint32(0)
+	// DefaultNbuckets is the number of buckets used for tables without nbuckets defined.
+	DefaultNbuckets = 2
+	// DefaultMemorySize is the memory size used for tables without memory size defined.
+	DefaultMemorySize = 2 << 20
+	// VectorSize is the size of skip and match vectors in bytes.
+	VectorSize = 16
+)
+
+// ClassifyVppAPI provides methods for managing VPP classifier configuration.
+type ClassifyVppAPI interface {
+	ClassifyVppRead
+
+	// AddClassifyTable adds new classifier table chained to the table with the given
+	// index (or NoTableIndex) and returns its metadata.
+	AddClassifyTable(table *classify.Table, nextTableIndex uint32) (*classifyidx.TableMetadata, error)
+	// UpdateClassifyTable updates next table, miss next index and current data
+	// of an existing classifier table.
+	UpdateClassifyTable(tableMeta *classifyidx.TableMetadata, table *classify.Table, nextTableIndex uint32) error
+	// DeleteClassifyTable removes classifier table with the given index.
+	DeleteClassifyTable(tableIndex uint32) error
+	// AddClassifySession adds new session into the classifier table (or updates
+	// session with the same match).
+	AddClassifySession(tableMeta *classifyidx.TableMetadata, session *classify.Session) error
+	// DeleteClassifySession removes session from the classifier table.
+	DeleteClassifySession(tableMeta *classifyidx.TableMetadata, session *classify.Session) error
+	// SetClassifyTableInterface attaches classifier table to the interface.
+	SetClassifyTableInterface(tableIndex uint32, iface *classify.Table_Interface) error
+	// UnsetClassifyTableInterface detaches classifier table from the interface.
+	UnsetClassifyTableInterface(tableIndex uint32, iface *classify.Table_Interface) error
+}
+
+// ClassifyVppRead provides read methods for VPP classifier configuration.
+type ClassifyVppRead interface {
+	// DumpClassifyTables dumps all classifier tables. Interfaces are filled only
+	// for input ACL and policer attachments (output ACL cannot be dumped).
+	DumpClassifyTables() ([]*ClassifyTableDetails, error)
+	// DumpClassifySessions dumps sessions of the given classifier table.
+	DumpClassifySessions(tableMeta *classifyidx.TableMetadata) ([]*classify.Session, error)
+}
+
+// ClassifyTableDetails contains classifier table (without name and next table)
+// with its metadata.
+type ClassifyTableDetails struct {
+	Table          *classify.Table
+	NextTableIndex uint32
+	Meta           *classifyidx.TableMetadata
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "classify",
+	HandlerAPI: (*ClassifyVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) ClassifyVppAPI
+
+func AddHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleClassifyVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) ClassifyVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(ClassifyVppAPI)
+	}
+	return nil
+}
+
+// ParseMask decodes hexadecimal table mask and pads it with zeros to a multiple
+// of the vector size.
+func ParseMask(mask string) ([]byte, error) {
+	b, err := hex.DecodeString(mask)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid mask %q", mask)
+	}
+	if len(b) == 0 {
+		return nil, errors.New("mask is empty")
+	}
+	return padToVectors(b, (len(b)+VectorSize-1)/VectorSize), nil
+}
+
+// ParseMatch decodes hexadecimal session match and pads it with zeros to cover
+// both skipped and matched vectors of the table.
+func ParseMatch(match string, skipNVectors, matchNVectors uint32) ([]byte, error) {
+	b, err := hex.DecodeString(match)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid match %q", match)
+	}
+	nVectors := int(skipNVectors + matchNVectors)
+	if len(b) > nVectors*VectorSize {
+		return nil, errors.Errorf("match %q is longer than %d vectors of the table", match, nVectors)
+	}
+	return padToVectors(b, nVectors), nil
+}
+
+// MaskedKey returns the part of the session match compared by the table,
+// i.e. matched vectors with the mask applied.
+func MaskedKey(match []byte, tableMeta *classifyidx.TableMetadata) []byte {
+	skip := int(tableMeta.SkipNVectors) * VectorSize
+	key := make([]byte, len(tableMeta.Mask))
+	for i := range key {
+		if skip+i < len(match) {
+			key[i] = match[skip+i] & tableMeta.Mask[i]
+		}
+	}
+	return key
+}
+
+func padToVectors(b []byte, nVectors int) []byte {
+	padded := make([]byte, nVectors*VectorSize)
+	copy(padded, b)
+	return padded
+}
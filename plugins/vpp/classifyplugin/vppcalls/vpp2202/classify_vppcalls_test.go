@@ -0,0 +1,271 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+func TestAddClassifyTable(t *testing.T) {
+	ctx, classifyHandler, _ := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{
+		NewTableIndex: 3,
+	})
+	meta, err := classifyHandler.AddClassifyTable(&classify.Table{
+		Name:          "t1",
+		Mask:          "000000000000ffffffffffff",
+		SkipNVectors:  1,
+		MissNextIndex: 0,
+		CurrentData:   classify.Table_CURRENT_DATA,
+	}, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(meta.Index).To(BeEquivalentTo(3))
+	Expect(meta.SkipNVectors).To(BeEquivalentTo(1))
+	Expect(meta.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(meta.Mask).To(HaveLen(16))
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.TableIndex).To(Equal(vppcalls.NoTableIndex))
+	Expect(msg.NextTableIndex).To(BeEquivalentTo(2))
+	Expect(msg.Nbuckets).To(BeEquivalentTo(vppcalls.DefaultNbuckets))
+	Expect(msg.MemorySize).To(BeEquivalentTo(vppcalls.DefaultMemorySize))
+	Expect(msg.SkipNVectors).To(BeEquivalentTo(1))
+	Expect(msg.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(msg.CurrentDataFlag).To(BeEquivalentTo(1))
+	Expect(msg.MaskLen).To(BeEquivalentTo(16))
+	Expect(msg.Mask[6:12]).To(Equal([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+	Expect(msg.Mask[12:]).To(Equal([]byte{0, 0, 0, 0}))
+}
+
+func TestAddClassifyTableError(t *testing.T) {
+	ctx, classifyHandler, _ := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// invalid mask
+	_, err := classifyHandler.AddClassifyTable(&classify.Table{Mask: "xyz"}, vppcalls.NoTableIndex)
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{
+		Retval: -1,
+	})
+	_, err = classifyHandler.AddClassifyTable(&classify.Table{Mask: "ffff"}, vppcalls.NoTableIndex)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestUpdateAndDeleteClassifyTable(t *testing.T) {
+	ctx, classifyHandler, _ := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{})
+	err := classifyHandler.UpdateClassifyTable(&classifyidx.TableMetadata{Index: 3}, &classify.Table{
+		Mask:          "ffff",
+		MissNextIndex: 5,
+	}, vppcalls.NoTableIndex)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	updateMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(updateMsg.IsAdd).To(BeTrue())
+	Expect(updateMsg.TableIndex).To(BeEquivalentTo(3))
+	Expect(updateMsg.MissNextIndex).To(BeEquivalentTo(5))
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{})
+	err = classifyHandler.DeleteClassifyTable(3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	delMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(delMsg.IsAdd).To(BeFalse())
+	Expect(delMsg.DelChain).To(BeFalse())
+	Expect(delMsg.TableIndex).To(BeEquivalentTo(3))
+}
+
+func TestAddClassifySession(t *testing.T) {
+	ctx, classifyHandler, _ := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	tableMeta := &classifyidx.TableMetadata{Index: 3, SkipNVectors: 1, MatchNVectors: 1}
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelSessionReply{})
+	err := classifyHandler.AddClassifySession(tableMeta, &classify.Session{
+		Table:        "t1",
+		Match:        "0000000000000000000000000000000000000000000000000000000a000001",
+		HitNextIndex: ^uint32(0),
+		Action:       classify.Session_SET_IP4_FIB_INDEX,
+		Metadata:     10,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.TableIndex).To(BeEquivalentTo(3))
+	Expect(msg.HitNextIndex).To(Equal(^uint32(0)))
+	Expect(msg.Action).To(Equal(vpp_classify.CLASSIFY_API_ACTION_SET_IP4_FIB_INDEX))
+	Expect(msg.Metadata).To(BeEquivalentTo(10))
+	Expect(msg.MatchLen).To(BeEquivalentTo(32))
+	Expect(msg.Match[27:31]).To(Equal([]byte{0x0a, 0x00, 0x00, 0x01}))
+
+	// match longer than the table vectors
+	err = classifyHandler.DeleteClassifySession(&classifyidx.TableMetadata{Index: 3, MatchNVectors: 1},
+		&classify.Session{Match: "0000000000000000000000000000000000000000000000000000000a000001"})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestSetClassifyTableInterface(t *testing.T) {
+	ctx, classifyHandler, ifIndex := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_classify.InputACLSetInterfaceReply{})
+	err := classifyHandler.SetClassifyTableInterface(3, &classify.Table_Interface{
+		Name:     "if1",
+		Type:     classify.Table_Interface_INPUT_ACL,
+		Protocol: classify.Table_Interface_IP6,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	inputMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.InputACLSetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(inputMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(inputMsg.IsAdd).To(BeTrue())
+	Expect(inputMsg.IP4TableIndex).To(Equal(vppcalls.NoTableIndex))
+	Expect(inputMsg.IP6TableIndex).To(BeEquivalentTo(3))
+	Expect(inputMsg.L2TableIndex).To(Equal(vppcalls.NoTableIndex))
+
+	ctx.MockVpp.MockReply(&vpp_classify.PolicerClassifySetInterfaceReply{})
+	err = classifyHandler.UnsetClassifyTableInterface(4, &classify.Table_Interface{
+		Name:     "if1",
+		Type:     classify.Table_Interface_POLICER,
+		Protocol: classify.Table_Interface_L2,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	policerMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.PolicerClassifySetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(policerMsg.IsAdd).To(BeFalse())
+	Expect(policerMsg.L2TableIndex).To(BeEquivalentTo(4))
+
+	// missing interface
+	err = classifyHandler.SetClassifyTableInterface(3, &classify.Table_Interface{Name: "if2"})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpClassifyTables(t *testing.T) {
+	ctx, classifyHandler, ifIndex := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	mask := make([]byte, 16)
+	mask[0] = 0xff
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableIdsReply{
+		Count: 1,
+		Ids:   []uint32{3},
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:        3,
+		Nbuckets:       32,
+		MatchNVectors:  1,
+		SkipNVectors:   2,
+		NextTableIndex: ^uint32(0),
+		MissNextIndex:  1,
+		MaskLength:     16,
+		Mask:           mask,
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableByInterfaceReply{
+		SwIfIndex:  2,
+		IP4TableID: 3,
+		IP6TableID: ^uint32(0),
+		L2TableID:  ^uint32(0),
+	})
+	ctx.MockVpp.MockReply(&vpp_classify.PolicerClassifyDetails{
+		SwIfIndex:  2,
+		TableIndex: 3,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	tables, err := classifyHandler.DumpClassifyTables()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(tables).To(HaveLen(1))
+	Expect(tables[0].Meta.Index).To(BeEquivalentTo(3))
+	Expect(tables[0].Meta.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(tables[0].NextTableIndex).To(Equal(vppcalls.NoTableIndex))
+	table := tables[0].Table
+	Expect(table.Mask).To(Equal("ff000000000000000000000000000000"))
+	Expect(table.SkipNVectors).To(BeEquivalentTo(2))
+	Expect(table.Nbuckets).To(BeEquivalentTo(32))
+	Expect(table.MissNextIndex).To(BeEquivalentTo(1))
+	Expect(table.Interfaces).To(HaveLen(2))
+	Expect(table.Interfaces[0].Name).To(Equal("if1"))
+	Expect(table.Interfaces[0].Type).To(Equal(classify.Table_Interface_INPUT_ACL))
+	Expect(table.Interfaces[0].Protocol).To(Equal(classify.Table_Interface_IP4))
+	Expect(table.Interfaces[1].Type).To(Equal(classify.Table_Interface_POLICER))
+	Expect(table.Interfaces[1].Protocol).To(Equal(classify.Table_Interface_IP4))
+}
+
+func TestDumpClassifySessions(t *testing.T) {
+	ctx, classifyHandler, _ := classifyTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	key := make([]byte, 16)
+	key[15] = 0x01
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifySessionDetails{
+		TableID:      3,
+		HitNextIndex: 1,
+		OpaqueIndex:  7,
+		MatchLength:  16,
+		Match:        key,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := classifyHandler.DumpClassifySessions(&classifyidx.TableMetadata{
+		Index:         3,
+		SkipNVectors:  1,
+		MatchNVectors: 1,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(sessions).To(HaveLen(1))
+	Expect(sessions[0].Match).To(Equal("0000000000000000000000000000000000000000000000000000000000000001"))
+	Expect(sessions[0].HitNextIndex).To(BeEquivalentTo(1))
+	Expect(sessions[0].OpaqueIndex).To(BeEquivalentTo(7))
+}
+
+func classifyTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.ClassifyVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndex := ifaceidx.NewIfaceIndex(log, "classify-test-ifidx")
+	classifyHandler := vpp2202.NewClassifyVppHandler(ctx.MockChannel, ifIndex, log)
+	return ctx, classifyHandler, ifIndex
+}
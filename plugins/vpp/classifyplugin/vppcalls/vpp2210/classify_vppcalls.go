@@ -0,0 +1,226 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+// NoInterface is sw-if-idx which means 'no interface'
+const NoInterface = interface_types.InterfaceIndex(^uint32(0))
+
+// AddClassifyTable adds new classifier table chained to the table with the given
+// index (or NoTableIndex) and returns its metadata.
+func (h *ClassifyVppHandler) AddClassifyTable(table *classify.Table, nextTableIndex uint32) (*classifyidx.TableMetadata, error) {
+	req, err := tableToVpp(table, vppcalls.NoTableIndex, nextTableIndex)
+	if err != nil {
+		return nil, err
+	}
+	reply := &vpp_classify.ClassifyAddDelTableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, err
+	}
+	return &classifyidx.TableMetadata{
+		Index:         reply.NewTableIndex,
+		SkipNVectors:  req.SkipNVectors,
+		MatchNVectors: req.MatchNVectors,
+		Mask:          req.Mask,
+	}, nil
+}
+
+// UpdateClassifyTable updates next table, miss next index and current data
+// of an existing classifier table.
+func (h *ClassifyVppHandler) UpdateClassifyTable(tableMeta *classifyidx.TableMetadata, table *classify.Table,
+	nextTableIndex uint32) error {
+	req, err := tableToVpp(table, tableMeta.Index, nextTableIndex)
+	if err != nil {
+		return err
+	}
+	reply := &vpp_classify.ClassifyAddDelTableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteClassifyTable removes classifier table with the given index.
+func (h *ClassifyVppHandler) DeleteClassifyTable(tableIndex uint32) error {
+	req := &vpp_classify.ClassifyAddDelTable{
+		IsAdd:          false,
+		TableIndex:     tableIndex,
+		NextTableIndex: vppcalls.NoTableIndex,
+		MissNextIndex:  ^uint32(0),
+	}
+	reply := &vpp_classify.ClassifyAddDelTableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddClassifySession adds new session into the classifier table (or updates
+// session with the same match).
+func (h *ClassifyVppHandler) AddClassifySession(tableMeta *classifyidx.TableMetadata, session *classify.Session) error {
+	return h.addDelClassifySession(tableMeta, session, true)
+}
+
+// DeleteClassifySession removes session from the classifier table.
+func (h *ClassifyVppHandler) DeleteClassifySession(tableMeta *classifyidx.TableMetadata, session *classify.Session) error {
+	return h.addDelClassifySession(tableMeta, session, false)
+}
+
+// SetClassifyTableInterface attaches classifier table to the interface.
+func (h *ClassifyVppHandler) SetClassifyTableInterface(tableIndex uint32, iface *classify.Table_Interface) error {
+	return h.setClassifyTableInterface(tableIndex, iface, true)
+}
+
+// UnsetClassifyTableInterface detaches classifier table from the interface.
+func (h *ClassifyVppHandler) UnsetClassifyTableInterface(tableIndex uint32, iface *classify.Table_Interface) error {
+	return h.setClassifyTableInterface(tableIndex, iface, false)
+}
+
+func (h *ClassifyVppHandler) addDelClassifySession(tableMeta *classifyidx.TableMetadata, session *classify.Session,
+	isAdd bool) error {
+	match, err := vppcalls.ParseMatch(session.Match, tableMeta.SkipNVectors, tableMeta.MatchNVectors)
+	if err != nil {
+		return err
+	}
+	req := &vpp_classify.ClassifyAddDelSession{
+		IsAdd:        isAdd,
+		TableIndex:   tableMeta.Index,
+		HitNextIndex: session.HitNextIndex,
+		OpaqueIndex:  session.OpaqueIndex,
+		Advance:      session.Advance,
+		Action:       actionToVpp(session.Action),
+		Metadata:     session.Metadata,
+		MatchLen:     uint32(len(match)),
+		Match:        match,
+	}
+	reply := &vpp_classify.ClassifyAddDelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *ClassifyVppHandler) setClassifyTableInterface(tableIndex uint32, iface *classify.Table_Interface,
+	isAdd bool) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface.Name)
+	if !found {
+		return errors.Errorf("failed to get metadata for interface %s", iface.Name)
+	}
+	swIfIndex := interface_types.InterfaceIndex(ifaceMeta.SwIfIndex)
+
+	// tables of other protocols are left unchanged
+	ip4, ip6, l2 := vppcalls.NoTableIndex, vppcalls.NoTableIndex, vppcalls.NoTableIndex
+	switch iface.Protocol {
+	case classify.Table_Interface_IP4:
+		ip4 = tableIndex
+	case classify.Table_Interface_IP6:
+		ip6 = tableIndex
+	case classify.Table_Interface_L2:
+		l2 = tableIndex
+	}
+
+	var req, reply govppapi.Message
+	switch iface.Type {
+	case classify.Table_Interface_INPUT_ACL:
+		req = &vpp_classify.InputACLSetInterface{
+			SwIfIndex:     swIfIndex,
+			IP4TableIndex: ip4,
+			IP6TableIndex: ip6,
+			L2TableIndex:  l2,
+			IsAdd:         isAdd,
+		}
+		reply = &vpp_classify.InputACLSetInterfaceReply{}
+	case classify.Table_Interface_OUTPUT_ACL:
+		req = &vpp_classify.OutputACLSetInterface{
+			SwIfIndex:     swIfIndex,
+			IP4TableIndex: ip4,
+			IP6TableIndex: ip6,
+			L2TableIndex:  l2,
+			IsAdd:         isAdd,
+		}
+		reply = &vpp_classify.OutputACLSetInterfaceReply{}
+	case classify.Table_Interface_POLICER:
+		req = &vpp_classify.PolicerClassifySetInterface{
+			SwIfIndex:     swIfIndex,
+			IP4TableIndex: ip4,
+			IP6TableIndex: ip6,
+			L2TableIndex:  l2,
+			IsAdd:         isAdd,
+		}
+		reply = &vpp_classify.PolicerClassifySetInterfaceReply{}
+	default:
+		return errors.Errorf("unsupported classifier table interface type %v", iface.Type)
+	}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func tableToVpp(table *classify.Table, tableIndex, nextTableIndex uint32) (*vpp_classify.ClassifyAddDelTable, error) {
+	mask, err := vppcalls.ParseMask(table.Mask)
+	if err != nil {
+		return nil, err
+	}
+	req := &vpp_classify.ClassifyAddDelTable{
+		IsAdd:             true,
+		TableIndex:        tableIndex,
+		Nbuckets:          table.Nbuckets,
+		MemorySize:        table.MemorySize,
+		SkipNVectors:      table.SkipNVectors,
+		MatchNVectors:     uint32(len(mask) / vppcalls.VectorSize),
+		NextTableIndex:    nextTableIndex,
+		MissNextIndex:     table.MissNextIndex,
+		CurrentDataFlag:   uint8(table.CurrentData),
+		CurrentDataOffset: int16(table.CurrentDataOffset),
+		MaskLen:           uint32(len(mask)),
+		Mask:              mask,
+	}
+	if req.Nbuckets == 0 {
+		req.Nbuckets = vppcalls.DefaultNbuckets
+	}
+	if req.MemorySize == 0 {
+		req.MemorySize = vppcalls.DefaultMemorySize
+	}
+	return req, nil
+}
+
+func actionToVpp(action classify.Session_Action) vpp_classify.ClassifyAction {
+	switch action {
+	case classify.Session_SET_IP4_FIB_INDEX:
+		return vpp_classify.CLASSIFY_API_ACTION_SET_IP4_FIB_INDEX
+	case classify.Session_SET_IP6_FIB_INDEX:
+		return vpp_classify.CLASSIFY_API_ACTION_SET_IP6_FIB_INDEX
+	case classify.Session_SET_METADATA:
+		return vpp_classify.CLASSIFY_API_ACTION_SET_METADATA
+	default:
+		return vpp_classify.CLASSIFY_API_ACTION_NONE
+	}
+}
@@ -0,0 +1,173 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/classifyidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifyplugin/vppcalls"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+// DumpClassifyTables dumps all classifier tables. Interfaces are filled only
+// for input ACL and policer attachments (output ACL cannot be dumped).
+func (h *ClassifyVppHandler) DumpClassifyTables() (tables []*vppcalls.ClassifyTableDetails, err error) {
+	idsReq := &vpp_classify.ClassifyTableIds{}
+	idsReply := &vpp_classify.ClassifyTableIdsReply{}
+	if err := h.callsChannel.SendRequest(idsReq).ReceiveReply(idsReply); err != nil {
+		return nil, fmt.Errorf("failed to dump classifier table IDs: %v", err)
+	}
+
+	byIndex := make(map[uint32]*vppcalls.ClassifyTableDetails)
+	for _, id := range idsReply.Ids {
+		req := &vpp_classify.ClassifyTableInfo{
+			TableID: id,
+		}
+		reply := &vpp_classify.ClassifyTableInfoReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return nil, fmt.Errorf("failed to dump classifier table %d: %v", id, err)
+		}
+		details := &vppcalls.ClassifyTableDetails{
+			Table: &classify.Table{
+				Mask:          hex.EncodeToString(reply.Mask),
+				SkipNVectors:  reply.SkipNVectors,
+				Nbuckets:      reply.Nbuckets,
+				MissNextIndex: reply.MissNextIndex,
+			},
+			NextTableIndex: reply.NextTableIndex,
+			Meta: &classifyidx.TableMetadata{
+				Index:         id,
+				SkipNVectors:  reply.SkipNVectors,
+				MatchNVectors: reply.MatchNVectors,
+				Mask:          reply.Mask,
+			},
+		}
+		byIndex[id] = details
+		tables = append(tables, details)
+	}
+	if len(tables) == 0 {
+		return tables, nil
+	}
+
+	if err := h.dumpInputACLInterfaces(byIndex); err != nil {
+		return nil, err
+	}
+	if err := h.dumpPolicerInterfaces(byIndex); err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// DumpClassifySessions dumps sessions of the given classifier table.
+func (h *ClassifyVppHandler) DumpClassifySessions(tableMeta *classifyidx.TableMetadata) (sessions []*classify.Session, err error) {
+	req := &vpp_classify.ClassifySessionDump{
+		TableID: tableMeta.Index,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	skip := make([]byte, tableMeta.SkipNVectors*vppcalls.VectorSize)
+	for {
+		msg := &vpp_classify.ClassifySessionDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump sessions of classifier table %d: %v", tableMeta.Index, err)
+		}
+		if stop {
+			break
+		}
+		// VPP dumps the masked key only, skipped vectors are zeroed
+		sessions = append(sessions, &classify.Session{
+			Match:        hex.EncodeToString(append(skip, msg.Match...)),
+			HitNextIndex: msg.HitNextIndex,
+			OpaqueIndex:  msg.OpaqueIndex,
+			Advance:      msg.Advance,
+		})
+	}
+	return sessions, nil
+}
+
+// dumpInputACLInterfaces adds input ACL attachments of known interfaces to the tables.
+func (h *ClassifyVppHandler) dumpInputACLInterfaces(tables map[uint32]*vppcalls.ClassifyTableDetails) error {
+	for _, ifName := range h.ifIndexes.ListAllInterfaces() {
+		ifaceMeta, found := h.ifIndexes.LookupByName(ifName)
+		if !found {
+			continue
+		}
+		req := &vpp_classify.ClassifyTableByInterface{
+			SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		}
+		reply := &vpp_classify.ClassifyTableByInterfaceReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return fmt.Errorf("failed to dump classifier tables of interface %s: %v", ifName, err)
+		}
+		addTableInterface(tables, reply.IP4TableID, ifName, classify.Table_Interface_INPUT_ACL, classify.Table_Interface_IP4)
+		addTableInterface(tables, reply.IP6TableID, ifName, classify.Table_Interface_INPUT_ACL, classify.Table_Interface_IP6)
+		addTableInterface(tables, reply.L2TableID, ifName, classify.Table_Interface_INPUT_ACL, classify.Table_Interface_L2)
+	}
+	return nil
+}
+
+// dumpPolicerInterfaces adds policer classification attachments to the tables.
+func (h *ClassifyVppHandler) dumpPolicerInterfaces(tables map[uint32]*vppcalls.ClassifyTableDetails) error {
+	policerTables := []struct {
+		tableType vpp_classify.PolicerClassifyTable
+		protocol  classify.Table_Interface_Protocol
+	}{
+		{vpp_classify.POLICER_CLASSIFY_API_TABLE_IP4, classify.Table_Interface_IP4},
+		{vpp_classify.POLICER_CLASSIFY_API_TABLE_IP6, classify.Table_Interface_IP6},
+		{vpp_classify.POLICER_CLASSIFY_API_TABLE_L2, classify.Table_Interface_L2},
+	}
+	for _, policerTable := range policerTables {
+		req := &vpp_classify.PolicerClassifyDump{
+			Type:      policerTable.tableType,
+			SwIfIndex: NoInterface,
+		}
+		reqContext := h.callsChannel.SendMultiRequest(req)
+		for {
+			msg := &vpp_classify.PolicerClassifyDetails{}
+			stop, err := reqContext.ReceiveReply(msg)
+			if err != nil {
+				return fmt.Errorf("failed to dump policer classify interfaces: %v", err)
+			}
+			if stop {
+				break
+			}
+			ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+			if !found {
+				h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+				continue
+			}
+			addTableInterface(tables, msg.TableIndex, ifName, classify.Table_Interface_POLICER, policerTable.protocol)
+		}
+	}
+	return nil
+}
+
+func addTableInterface(tables map[uint32]*vppcalls.ClassifyTableDetails, tableIndex uint32, ifName string,
+	ifType classify.Table_Interface_Type, protocol classify.Table_Interface_Protocol) {
+	details, ok := tables[tableIndex]
+	if !ok {
+		return
+	}
+	details.Table.Interfaces = append(details.Table.Interfaces, &classify.Table_Interface{
+		Name:     ifName,
+		Type:     ifType,
+		Protocol: protocol,
+	})
+}
@@ -0,0 +1,85 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Translation --value-type *vpp_cnat.Translation --meta-type *cnatidx.TranslationMetadata --import "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/cnatidx" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name SnatPolicy --value-type *vpp_cnat.SnatPolicy --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name SnatPolicyInterface --value-type *vpp_cnat.SnatPolicyInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat" --output-dir "descriptor"
+
+package cnatplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls/vpp2210"
+)
+
+// CnatPlugin configures VPP cloud-native NAT (translations and source-NAT policy).
+type CnatPlugin struct {
+	Deps
+	// handler
+	CnatHandler vppcalls.CnatVppAPI
+}
+
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+func (p *CnatPlugin) Init() (err error) {
+	if !p.VPP.IsPluginLoaded("cnat") {
+		p.Log.Warnf("VPP plugin cnat was disabled by VPP")
+		return nil
+	}
+
+	// init CNAT handler
+	p.CnatHandler = vppcalls.CompatibleCnatVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.CnatHandler == nil {
+		return errors.New("CNAT handler is not available")
+	}
+
+	translationDescriptor := descriptor.NewTranslationDescriptor(p.CnatHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, translationDescriptor); err != nil {
+		return err
+	}
+	snatPolicyDescriptor := descriptor.NewSnatPolicyDescriptor(p.CnatHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, snatPolicyDescriptor); err != nil {
+		return err
+	}
+	snatPolicyIfaceDescriptor := descriptor.NewSnatPolicyInterfaceDescriptor(p.CnatHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, snatPolicyIfaceDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *CnatPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// SnatPolicyDescriptorName is the name of the descriptor for CNAT source-NAT policy.
+	SnatPolicyDescriptorName = "vpp-cnat-snat-policy"
+
+	// dependency labels
+	snatInterfaceDep = "snat-interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidSnatIPv4 is returned when source-NAT IPv4 address cannot be parsed.
+	ErrInvalidSnatIPv4 = errors.New("invalid source-NAT IPv4 address")
+	// ErrInvalidSnatIPv6 is returned when source-NAT IPv6 address cannot be parsed.
+	ErrInvalidSnatIPv6 = errors.New("invalid source-NAT IPv6 address")
+	// ErrInvalidExcludePrefix is returned when prefix excluded from source-NAT cannot be parsed.
+	ErrInvalidExcludePrefix = errors.New("invalid prefix excluded from source-NAT")
+)
+
+// SnatPolicyDescriptor teaches KVScheduler how to configure CNAT source-NAT policy.
+type SnatPolicyDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewSnatPolicyDescriptor creates a new instance of the CNAT source-NAT policy descriptor.
+func NewSnatPolicyDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SnatPolicyDescriptor{
+		cnatHandler: cnatHandler,
+		log:         log.NewLogger("cnat-snat-policy-descriptor"),
+	}
+
+	typedDescr := &adapter.SnatPolicyDescriptor{
+		Name:          SnatPolicyDescriptorName,
+		NBKeyPrefix:   cnat.ModelSnatPolicy.KeyPrefix(),
+		ValueTypeName: cnat.ModelSnatPolicy.ProtoName(),
+		KeySelector:   cnat.ModelSnatPolicy.IsKeyValid,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Update:        ctx.Update,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewSnatPolicyDescriptor(typedDescr)
+}
+
+// Validate validates CNAT source-NAT policy configuration.
+func (d *SnatPolicyDescriptor) Validate(key string, policy *cnat.SnatPolicy) error {
+	if policy.SnatIpv4 != "" && net.ParseIP(policy.SnatIpv4).To4() == nil {
+		return kvs.NewInvalidValueError(ErrInvalidSnatIPv4, "snat_ipv4")
+	}
+	if policy.SnatIpv6 != "" {
+		if ip := net.ParseIP(policy.SnatIpv6); ip == nil || ip.To4() != nil {
+			return kvs.NewInvalidValueError(ErrInvalidSnatIPv6, "snat_ipv6")
+		}
+	}
+	for i, prefix := range policy.ExcludePrefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return kvs.NewInvalidValueError(ErrInvalidExcludePrefix, fmt.Sprintf("exclude_prefixes[%d]", i))
+		}
+	}
+	return nil
+}
+
+// Create configures CNAT source-NAT policy.
+func (d *SnatPolicyDescriptor) Create(key string, policy *cnat.SnatPolicy) (metadata interface{}, err error) {
+	if err = d.cnatHandler.SetCnatSnatAddresses(policy.SnatIpv4, policy.SnatIpv6, policy.SnatInterface); err != nil {
+		return nil, err
+	}
+	if err = d.cnatHandler.SetCnatSnatPolicy(policy.Policy); err != nil {
+		return nil, err
+	}
+	for _, prefix := range policy.ExcludePrefixes {
+		if err = d.cnatHandler.AddCnatSnatExcludePrefix(prefix); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Update updates CNAT source-NAT policy.
+func (d *SnatPolicyDescriptor) Update(key string, oldPolicy, newPolicy *cnat.SnatPolicy, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	if oldPolicy.SnatIpv4 != newPolicy.SnatIpv4 ||
+		oldPolicy.SnatIpv6 != newPolicy.SnatIpv6 ||
+		oldPolicy.SnatInterface != newPolicy.SnatInterface {
+		err = d.cnatHandler.SetCnatSnatAddresses(newPolicy.SnatIpv4, newPolicy.SnatIpv6, newPolicy.SnatInterface)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if oldPolicy.Policy != newPolicy.Policy {
+		if err = d.cnatHandler.SetCnatSnatPolicy(newPolicy.Policy); err != nil {
+			return nil, err
+		}
+	}
+	toDel, toAdd := diffPrefixes(oldPolicy.ExcludePrefixes, newPolicy.ExcludePrefixes)
+	for _, prefix := range toDel {
+		if err = d.cnatHandler.DelCnatSnatExcludePrefix(prefix); err != nil {
+			return nil, err
+		}
+	}
+	for _, prefix := range toAdd {
+		if err = d.cnatHandler.AddCnatSnatExcludePrefix(prefix); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Delete resets CNAT source-NAT policy to defaults.
+func (d *SnatPolicyDescriptor) Delete(key string, policy *cnat.SnatPolicy, metadata interface{}) error {
+	for _, prefix := range policy.ExcludePrefixes {
+		if err := d.cnatHandler.DelCnatSnatExcludePrefix(prefix); err != nil {
+			return err
+		}
+	}
+	if err := d.cnatHandler.SetCnatSnatPolicy(cnat.SnatPolicy_NONE); err != nil {
+		return err
+	}
+	return d.cnatHandler.SetCnatSnatAddresses("", "", "")
+}
+
+// Retrieve returns the expected CNAT source-NAT policy.
+func (d *SnatPolicyDescriptor) Retrieve(correlate []adapter.SnatPolicyKVWithMetadata) (
+	retrieved []adapter.SnatPolicyKVWithMetadata, err error) {
+	// VPP does not allow to dump the policy and the excluded prefixes,
+	// assume that the configuration is as expected.
+	for _, policy := range correlate {
+		retrieved = append(retrieved, adapter.SnatPolicyKVWithMetadata{
+			Key:    policy.Key,
+			Value:  policy.Value,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface used for source-NAT as dependency.
+func (d *SnatPolicyDescriptor) Dependencies(key string, policy *cnat.SnatPolicy) (deps []kvs.Dependency) {
+	if policy.SnatInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: snatInterfaceDep,
+			Key:   interfaces.InterfaceKey(policy.SnatInterface),
+		})
+	}
+	return deps
+}
+
+// diffPrefixes returns prefixes to remove and to add when changing from old to new list.
+func diffPrefixes(oldPrefixes, newPrefixes []string) (toDel, toAdd []string) {
+	oldSet := make(map[string]struct{})
+	for _, prefix := range oldPrefixes {
+		oldSet[prefix] = struct{}{}
+	}
+	newSet := make(map[string]struct{})
+	for _, prefix := range newPrefixes {
+		newSet[prefix] = struct{}{}
+		if _, exists := oldSet[prefix]; !exists {
+			toAdd = append(toAdd, prefix)
+		}
+	}
+	for _, prefix := range oldPrefixes {
+		if _, exists := newSet[prefix]; !exists {
+			toDel = append(toDel, prefix)
+		}
+	}
+	return toDel, toAdd
+}
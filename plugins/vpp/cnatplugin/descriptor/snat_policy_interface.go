@@ -0,0 +1,81 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// SnatPolicyInterfaceDescriptorName is the name of the descriptor for interfaces
+	// in CNAT source-NAT policy tables.
+	SnatPolicyInterfaceDescriptorName = "vpp-cnat-snat-policy-interface"
+
+	// dependency labels
+	snatPolicyInterfaceDep = "interface-exists"
+)
+
+// SnatPolicyInterfaceDescriptor teaches KVScheduler how to add interfaces into
+// CNAT source-NAT policy tables.
+type SnatPolicyInterfaceDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewSnatPolicyInterfaceDescriptor creates a new instance of the SnatPolicyInterface descriptor.
+func NewSnatPolicyInterfaceDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &SnatPolicyInterfaceDescriptor{
+		cnatHandler: cnatHandler,
+		log:         log.NewLogger("cnat-snat-policy-iface-descriptor"),
+	}
+
+	typedDescr := &adapter.SnatPolicyInterfaceDescriptor{
+		Name:          SnatPolicyInterfaceDescriptorName,
+		NBKeyPrefix:   cnat.ModelSnatPolicyInterface.KeyPrefix(),
+		ValueTypeName: cnat.ModelSnatPolicyInterface.ProtoName(),
+		KeySelector:   cnat.ModelSnatPolicyInterface.IsKeyValid,
+		KeyLabel:      cnat.ModelSnatPolicyInterface.StripKeyPrefix,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewSnatPolicyInterfaceDescriptor(typedDescr)
+}
+
+// Create adds interface into the source-NAT policy table.
+func (d *SnatPolicyInterfaceDescriptor) Create(key string, policyIface *cnat.SnatPolicyInterface) (metadata interface{}, err error) {
+	return nil, d.cnatHandler.AddCnatSnatPolicyInterface(policyIface.Interface, policyIface.Table)
+}
+
+// Delete removes interface from the source-NAT policy table.
+func (d *SnatPolicyInterfaceDescriptor) Delete(key string, policyIface *cnat.SnatPolicyInterface, metadata interface{}) error {
+	return d.cnatHandler.DelCnatSnatPolicyInterface(policyIface.Interface, policyIface.Table)
+}
+
+// Dependencies lists the interface as dependency.
+func (d *SnatPolicyInterfaceDescriptor) Dependencies(key string, policyIface *cnat.SnatPolicyInterface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: snatPolicyInterfaceDep,
+			Key:   interfaces.InterfaceKey(policyIface.Interface),
+		},
+	}
+}
@@ -0,0 +1,287 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/cnatidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	vpp_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// TranslationDescriptorName is the name of the descriptor for CNAT translations.
+	TranslationDescriptorName = "vpp-cnat-translation"
+
+	// dependency labels
+	translationInterfaceDep = "interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrTranslationWithoutName is returned when translation name is empty.
+	ErrTranslationWithoutName = errors.New("CNAT translation name is not defined")
+	// ErrTranslationWithoutVip is returned when translation VIP is not defined.
+	ErrTranslationWithoutVip = errors.New("CNAT translation VIP is not defined")
+	// ErrInvalidEndpointAddress is returned when endpoint address cannot be parsed.
+	ErrInvalidEndpointAddress = errors.New("invalid endpoint IP address")
+	// ErrEndpointWithoutAddress is returned when endpoint has neither address nor interface.
+	ErrEndpointWithoutAddress = errors.New("endpoint address or interface has to be defined")
+)
+
+// TranslationDescriptor teaches KVScheduler how to configure VPP CNAT translations.
+type TranslationDescriptor struct {
+	log         logging.Logger
+	cnatHandler vppcalls.CnatVppAPI
+}
+
+// NewTranslationDescriptor creates a new instance of the CNAT translation descriptor.
+func NewTranslationDescriptor(cnatHandler vppcalls.CnatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &TranslationDescriptor{
+		cnatHandler: cnatHandler,
+		log:         log.NewLogger("cnat-translation-descriptor"),
+	}
+
+	typedDescr := &adapter.TranslationDescriptor{
+		Name:                 TranslationDescriptorName,
+		NBKeyPrefix:          cnat.ModelTranslation.KeyPrefix(),
+		ValueTypeName:        cnat.ModelTranslation.ProtoName(),
+		KeySelector:          cnat.ModelTranslation.IsKeyValid,
+		KeyLabel:             cnat.ModelTranslation.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentTranslations,
+		WithMetadata:         true,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewTranslationDescriptor(typedDescr)
+}
+
+// EquivalentTranslations compares translations treating unset and empty endpoints as equal.
+func (d *TranslationDescriptor) EquivalentTranslations(key string, oldTranslation, newTranslation *cnat.Translation) bool {
+	if oldTranslation.Protocol != newTranslation.Protocol ||
+		oldTranslation.IsRealIp != newTranslation.IsRealIp ||
+		oldTranslation.AllocPort != newTranslation.AllocPort ||
+		oldTranslation.LbType != newTranslation.LbType ||
+		!equivalentEndpoints(oldTranslation.Vip, newTranslation.Vip) ||
+		len(oldTranslation.Paths) != len(newTranslation.Paths) {
+		return false
+	}
+	for i := range oldTranslation.Paths {
+		oldPath, newPath := oldTranslation.Paths[i], newTranslation.Paths[i]
+		if oldPath.NoNat != newPath.NoNat ||
+			!equivalentEndpoints(oldPath.Dst, newPath.Dst) ||
+			!equivalentEndpoints(oldPath.Src, newPath.Src) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates CNAT translation configuration.
+func (d *TranslationDescriptor) Validate(key string, translation *cnat.Translation) error {
+	if translation.Name == "" {
+		return kvs.NewInvalidValueError(ErrTranslationWithoutName, "name")
+	}
+	if translation.Vip == nil {
+		return kvs.NewInvalidValueError(ErrTranslationWithoutVip, "vip")
+	}
+	if err := validateEndpoint(translation.Vip, true); err != nil {
+		return kvs.NewInvalidValueError(err, "vip")
+	}
+	for i, path := range translation.Paths {
+		if err := validateEndpoint(path.Dst, true); err != nil {
+			return kvs.NewInvalidValueError(err, fmt.Sprintf("paths[%d].dst", i))
+		}
+		if err := validateEndpoint(path.Src, false); err != nil {
+			return kvs.NewInvalidValueError(err, fmt.Sprintf("paths[%d].src", i))
+		}
+	}
+	return nil
+}
+
+// Create adds CNAT translation.
+func (d *TranslationDescriptor) Create(key string, translation *cnat.Translation) (*cnatidx.TranslationMetadata, error) {
+	id, err := d.cnatHandler.AddCnatTranslation(translation)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return &cnatidx.TranslationMetadata{ID: id}, nil
+}
+
+// Update updates backends of CNAT translation (VPP updates translation with the same VIP
+// and protocol in-place).
+func (d *TranslationDescriptor) Update(key string, oldTranslation, newTranslation *cnat.Translation,
+	oldMetadata *cnatidx.TranslationMetadata) (*cnatidx.TranslationMetadata, error) {
+	id, err := d.cnatHandler.AddCnatTranslation(newTranslation)
+	if err != nil {
+		d.log.Error(err)
+		return oldMetadata, err
+	}
+	return &cnatidx.TranslationMetadata{ID: id}, nil
+}
+
+// UpdateWithRecreate returns true if VIP or protocol of the translation has changed.
+func (d *TranslationDescriptor) UpdateWithRecreate(key string, oldTranslation, newTranslation *cnat.Translation,
+	metadata *cnatidx.TranslationMetadata) bool {
+	return oldTranslation.Protocol != newTranslation.Protocol ||
+		!equivalentEndpoints(oldTranslation.Vip, newTranslation.Vip)
+}
+
+// Delete removes CNAT translation.
+func (d *TranslationDescriptor) Delete(key string, translation *cnat.Translation, metadata *cnatidx.TranslationMetadata) error {
+	if metadata == nil {
+		return fmt.Errorf("failed to delete CNAT translation %s - metadata is nil", translation.Name)
+	}
+	if err := d.cnatHandler.DelCnatTranslation(metadata.ID); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all CNAT translations. Translations are correlated with NB values
+// by VIP and protocol.
+func (d *TranslationDescriptor) Retrieve(correlate []adapter.TranslationKVWithMetadata) (
+	retrieved []adapter.TranslationKVWithMetadata, err error) {
+	translations, err := d.cnatHandler.DumpCnatTranslations()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	for _, details := range translations {
+		translation := details.Translation
+		for _, nb := range correlate {
+			if nb.Value.Protocol == translation.Protocol && correlateEndpoints(nb.Value.Vip, translation.Vip) {
+				translation = withNBEndpoints(translation, nb.Value)
+				translation.Name = nb.Value.Name
+				break
+			}
+		}
+		if translation.Name == "" {
+			translation.Name = fmt.Sprintf("%s-%d-%s", translation.Vip.GetAddress(), translation.Vip.GetPort(),
+				translation.Protocol)
+		}
+		retrieved = append(retrieved, adapter.TranslationKVWithMetadata{
+			Key:      cnat.TranslationKey(translation.Name),
+			Value:    translation,
+			Metadata: details.Meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists interfaces used by the translation endpoints as dependencies.
+func (d *TranslationDescriptor) Dependencies(key string, translation *cnat.Translation) (deps []kvs.Dependency) {
+	ifaces := make(map[string]struct{})
+	addIface := func(endpoint *cnat.Endpoint) {
+		if endpoint.GetInterface() != "" {
+			ifaces[endpoint.GetInterface()] = struct{}{}
+		}
+	}
+	addIface(translation.Vip)
+	for _, path := range translation.Paths {
+		addIface(path.Dst)
+		addIface(path.Src)
+	}
+	for iface := range ifaces {
+		deps = append(deps, kvs.Dependency{
+			Label: translationInterfaceDep + "-" + iface,
+			Key:   interfaces.InterfaceKey(iface),
+		})
+	}
+	return deps
+}
+
+// withNBEndpoints returns copy of the retrieved translation where endpoints resolved
+// from interface addresses are replaced with corresponding NB endpoints (VPP dumps
+// only the resolved address).
+func withNBEndpoints(retrieved, nb *cnat.Translation) *cnat.Translation {
+	translation := proto.Clone(retrieved).(*cnat.Translation)
+	if nb.Vip.GetInterface() != "" {
+		translation.Vip = nb.Vip
+	}
+	for i, path := range translation.Paths {
+		if i >= len(nb.Paths) {
+			break
+		}
+		if nb.Paths[i].Dst.GetInterface() != "" && path.Dst.GetPort() == nb.Paths[i].Dst.GetPort() {
+			path.Dst = nb.Paths[i].Dst
+		}
+		if nb.Paths[i].Src.GetInterface() != "" && path.Src.GetPort() == nb.Paths[i].Src.GetPort() {
+			path.Src = nb.Paths[i].Src
+		}
+	}
+	return translation
+}
+
+// correlateEndpoints returns true if the retrieved endpoint may correspond to the NB endpoint.
+// Endpoints using interface address are matched by the port only.
+func correlateEndpoints(nb, retrieved *cnat.Endpoint) bool {
+	if nb.GetInterface() != "" {
+		return nb.GetPort() == retrieved.GetPort()
+	}
+	return equivalentEndpoints(nb, retrieved)
+}
+
+// equivalentEndpoints compares endpoints treating unset and empty endpoints as equal.
+func equivalentEndpoints(ep1, ep2 *cnat.Endpoint) bool {
+	if ep1.GetInterface() != "" || ep2.GetInterface() != "" {
+		return ep1.GetInterface() == ep2.GetInterface() &&
+			ep1.GetInterfaceIpv6() == ep2.GetInterfaceIpv6() &&
+			ep1.GetPort() == ep2.GetPort()
+	}
+	if ep1.GetPort() != ep2.GetPort() {
+		return false
+	}
+	ip1, ip2 := net.ParseIP(ep1.GetAddress()), net.ParseIP(ep2.GetAddress())
+	if ip1 == nil || ip2 == nil {
+		return ep1.GetAddress() == ep2.GetAddress()
+	}
+	return ip1.Equal(ip2)
+}
+
+func validateEndpoint(endpoint *cnat.Endpoint, required bool) error {
+	if endpoint.GetInterface() != "" {
+		return nil
+	}
+	if endpoint.GetAddress() == "" {
+		if required {
+			return ErrEndpointWithoutAddress
+		}
+		return nil
+	}
+	if net.ParseIP(endpoint.GetAddress()) == nil {
+		return ErrInvalidEndpointAddress
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/cnatidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// CnatVppAPI provides methods for managing VPP CNAT configuration.
+type CnatVppAPI interface {
+	CnatVppRead
+
+	// AddCnatTranslation adds new CNAT translation (or updates translation with the same VIP
+	// and protocol) and returns its index.
+	AddCnatTranslation(translation *cnat.Translation) (id uint32, err error)
+	// DelCnatTranslation removes CNAT translation with the given index.
+	DelCnatTranslation(id uint32) error
+	// SetCnatSnatAddresses sets addresses (or interface with addresses) used for source-NAT.
+	SetCnatSnatAddresses(ipv4, ipv6, iface string) error
+	// SetCnatSnatPolicy sets the source-NAT policy.
+	SetCnatSnatPolicy(policy cnat.SnatPolicy_Policy) error
+	// AddCnatSnatExcludePrefix excludes destination prefix from source-NAT.
+	AddCnatSnatExcludePrefix(prefix string) error
+	// DelCnatSnatExcludePrefix removes prefix excluded from source-NAT.
+	DelCnatSnatExcludePrefix(prefix string) error
+	// AddCnatSnatPolicyInterface adds interface into the source-NAT policy table.
+	AddCnatSnatPolicyInterface(iface string, table cnat.SnatPolicyInterface_Table) error
+	// DelCnatSnatPolicyInterface removes interface from the source-NAT policy table.
+	DelCnatSnatPolicyInterface(iface string, table cnat.SnatPolicyInterface_Table) error
+}
+
+// CnatVppRead provides read methods for VPP CNAT configuration.
+type CnatVppRead interface {
+	// DumpCnatTranslations dumps all CNAT translations.
+	DumpCnatTranslations() ([]*CnatTranslationDetails, error)
+}
+
+// CnatTranslationDetails contains CNAT translation with its metadata.
+type CnatTranslationDetails struct {
+	Translation *cnat.Translation
+	Meta        *cnatidx.TranslationMetadata
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "cnat",
+	HandlerAPI: (*CnatVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) CnatVppAPI
+
+func AddHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleCnatVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) CnatVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(CnatVppAPI)
+	}
+	return nil
+}
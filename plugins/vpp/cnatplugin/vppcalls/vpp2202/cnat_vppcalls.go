@@ -0,0 +1,236 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// NoInterface is sw-if-idx which means 'no interface'
+const NoInterface = interface_types.InterfaceIndex(^uint32(0))
+
+// AddCnatTranslation adds new CNAT translation (or updates translation with the same VIP
+// and protocol) and returns its index.
+func (h *CnatVppHandler) AddCnatTranslation(translation *cnat.Translation) (uint32, error) {
+	vip, err := h.endpointToVpp(translation.Vip)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid VIP")
+	}
+	vppTranslation := vpp_cnat.CnatTranslation{
+		Vip:     vip,
+		IPProto: protocolToVpp(translation.Protocol),
+		LbType:  vpp_cnat.CnatLbType(translation.LbType),
+	}
+	if translation.IsRealIp {
+		vppTranslation.IsRealIP = 1
+	}
+	if translation.AllocPort {
+		vppTranslation.Flags = uint8(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT)
+	}
+	for i, path := range translation.Paths {
+		dst, err := h.endpointToVpp(path.Dst)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid destination of path %d", i)
+		}
+		src, err := h.endpointToVpp(path.Src)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid source of path %d", i)
+		}
+		vppPath := vpp_cnat.CnatEndpointTuple{
+			DstEp: dst,
+			SrcEp: src,
+		}
+		if path.NoNat {
+			vppPath.Flags = uint8(vpp_cnat.CNAT_EPT_NO_NAT)
+		}
+		vppTranslation.Paths = append(vppTranslation.Paths, vppPath)
+	}
+	vppTranslation.NPaths = uint32(len(vppTranslation.Paths))
+
+	req := &vpp_cnat.CnatTranslationUpdate{
+		Translation: vppTranslation,
+	}
+	reply := &vpp_cnat.CnatTranslationUpdateReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.ID, nil
+}
+
+// DelCnatTranslation removes CNAT translation with the given index.
+func (h *CnatVppHandler) DelCnatTranslation(id uint32) error {
+	req := &vpp_cnat.CnatTranslationDel{
+		ID: id,
+	}
+	reply := &vpp_cnat.CnatTranslationDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetCnatSnatAddresses sets addresses (or interface with addresses) used for source-NAT.
+func (h *CnatVppHandler) SetCnatSnatAddresses(ipv4, ipv6, iface string) error {
+	req := &vpp_cnat.CnatSetSnatAddresses{
+		SwIfIndex: NoInterface,
+	}
+	if ipv4 != "" {
+		addr, err := ip_types.ParseIP4Address(ipv4)
+		if err != nil {
+			return errors.Wrapf(err, "invalid source-NAT IPv4 address %s", ipv4)
+		}
+		req.SnatIP4 = addr
+	}
+	if ipv6 != "" {
+		addr, err := ip_types.ParseIP6Address(ipv6)
+		if err != nil {
+			return errors.Wrapf(err, "invalid source-NAT IPv6 address %s", ipv6)
+		}
+		req.SnatIP6 = addr
+	}
+	if iface != "" {
+		ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+		if !found {
+			return errors.Errorf("failed to get metadata for interface %s", iface)
+		}
+		req.SwIfIndex = interface_types.InterfaceIndex(ifaceMeta.SwIfIndex)
+	}
+	reply := &vpp_cnat.CnatSetSnatAddressesReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetCnatSnatPolicy sets the source-NAT policy.
+func (h *CnatVppHandler) SetCnatSnatPolicy(policy cnat.SnatPolicy_Policy) error {
+	req := &vpp_cnat.CnatSetSnatPolicy{
+		Policy: vpp_cnat.CnatSnatPolicies(policy),
+	}
+	reply := &vpp_cnat.CnatSetSnatPolicyReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddCnatSnatExcludePrefix excludes destination prefix from source-NAT.
+func (h *CnatVppHandler) AddCnatSnatExcludePrefix(prefix string) error {
+	return h.handleCnatSnatExcludePrefix(prefix, true)
+}
+
+// DelCnatSnatExcludePrefix removes prefix excluded from source-NAT.
+func (h *CnatVppHandler) DelCnatSnatExcludePrefix(prefix string) error {
+	return h.handleCnatSnatExcludePrefix(prefix, false)
+}
+
+// AddCnatSnatPolicyInterface adds interface into the source-NAT policy table.
+func (h *CnatVppHandler) AddCnatSnatPolicyInterface(iface string, table cnat.SnatPolicyInterface_Table) error {
+	return h.handleCnatSnatPolicyInterface(iface, table, true)
+}
+
+// DelCnatSnatPolicyInterface removes interface from the source-NAT policy table.
+func (h *CnatVppHandler) DelCnatSnatPolicyInterface(iface string, table cnat.SnatPolicyInterface_Table) error {
+	return h.handleCnatSnatPolicyInterface(iface, table, false)
+}
+
+func (h *CnatVppHandler) handleCnatSnatExcludePrefix(prefix string, isAdd bool) error {
+	vppPrefix, err := ip_types.ParsePrefix(prefix)
+	if err != nil {
+		return errors.Wrapf(err, "invalid prefix %s", prefix)
+	}
+	req := &vpp_cnat.CnatSnatPolicyAddDelExcludePfx{
+		Prefix: vppPrefix,
+		IsAdd:  boolToUint(isAdd),
+	}
+	reply := &vpp_cnat.CnatSnatPolicyAddDelExcludePfxReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *CnatVppHandler) handleCnatSnatPolicyInterface(iface string, table cnat.SnatPolicyInterface_Table, isAdd bool) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.Errorf("failed to get metadata for interface %s", iface)
+	}
+	req := &vpp_cnat.CnatSnatPolicyAddDelIf{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		IsAdd:     boolToUint(isAdd),
+		Table:     vpp_cnat.CnatSnatPolicyTable(table),
+	}
+	reply := &vpp_cnat.CnatSnatPolicyAddDelIfReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// endpointToVpp converts endpoint into the VPP representation. Nil endpoint is converted
+// into an empty one (e.g. unchanged source of the translated packets).
+func (h *CnatVppHandler) endpointToVpp(endpoint *cnat.Endpoint) (vpp_cnat.CnatEndpoint, error) {
+	vppEndpoint := vpp_cnat.CnatEndpoint{
+		SwIfIndex: NoInterface,
+	}
+	if endpoint == nil {
+		return vppEndpoint, nil
+	}
+	vppEndpoint.Port = uint16(endpoint.Port)
+	if endpoint.Interface != "" {
+		ifaceMeta, found := h.ifIndexes.LookupByName(endpoint.Interface)
+		if !found {
+			return vppEndpoint, errors.Errorf("failed to get metadata for interface %s", endpoint.Interface)
+		}
+		vppEndpoint.SwIfIndex = interface_types.InterfaceIndex(ifaceMeta.SwIfIndex)
+		if endpoint.InterfaceIpv6 {
+			vppEndpoint.IfAf = ip_types.ADDRESS_IP6
+		}
+		return vppEndpoint, nil
+	}
+	if endpoint.Address != "" {
+		addr, err := ip_types.ParseAddress(endpoint.Address)
+		if err != nil {
+			return vppEndpoint, err
+		}
+		vppEndpoint.Addr = addr
+	}
+	return vppEndpoint, nil
+}
+
+func protocolToVpp(protocol cnat.Translation_Protocol) ip_types.IPProto {
+	if protocol == cnat.Translation_UDP {
+		return ip_types.IP_API_PROTO_UDP
+	}
+	return ip_types.IP_API_PROTO_TCP
+}
+
+func boolToUint(input bool) uint8 {
+	if input {
+		return 1
+	}
+	return 0
+}
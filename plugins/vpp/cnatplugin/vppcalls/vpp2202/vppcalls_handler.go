@@ -0,0 +1,48 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202"
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_cnat.AllMessages()...)
+
+	vppcalls.AddHandlerVersion(vpp2202.Version, msgs, NewCnatVppHandler)
+}
+
+// CnatVppHandler is accessor for CNAT-related vppcalls methods.
+type CnatVppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewCnatVppHandler creates new instance of CNAT vppcalls handler.
+func NewCnatVppHandler(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.CnatVppAPI {
+	return &CnatVppHandler{
+		callsChannel: ch,
+		ifIndexes:    ifIdx,
+		log:          log,
+	}
+}
@@ -0,0 +1,200 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+func TestAddCnatTranslation(t *testing.T) {
+	ctx, cnatHandler, ifIndex := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationUpdateReply{
+		ID: 5,
+	})
+	id, err := cnatHandler.AddCnatTranslation(&cnat.Translation{
+		Name: "svc1",
+		Vip: &cnat.Endpoint{
+			Address: "10.96.0.10",
+			Port:    80,
+		},
+		Protocol:  cnat.Translation_UDP,
+		AllocPort: true,
+		LbType:    cnat.Translation_MAGLEV,
+		Paths: []*cnat.Translation_Path{
+			{
+				Dst: &cnat.Endpoint{Address: "192.168.1.1", Port: 8080},
+			},
+			{
+				Dst:   &cnat.Endpoint{Address: "192.168.1.2", Port: 8080},
+				Src:   &cnat.Endpoint{Interface: "if1"},
+				NoNat: true,
+			},
+		},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(id).To(BeEquivalentTo(5))
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatTranslationUpdate)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Translation.Vip.Addr.ToIP().String()).To(Equal("10.96.0.10"))
+	Expect(msg.Translation.Vip.Port).To(BeEquivalentTo(80))
+	Expect(msg.Translation.Vip.SwIfIndex).To(Equal(vpp2210.NoInterface))
+	Expect(msg.Translation.IPProto).To(Equal(ip_types.IP_API_PROTO_UDP))
+	Expect(msg.Translation.Flags).To(BeEquivalentTo(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT))
+	Expect(msg.Translation.LbType).To(Equal(vpp_cnat.CNAT_LB_TYPE_MAGLEV))
+	Expect(msg.Translation.NPaths).To(BeEquivalentTo(2))
+	Expect(msg.Translation.Paths[0].DstEp.Addr.ToIP().String()).To(Equal("192.168.1.1"))
+	Expect(msg.Translation.Paths[0].SrcEp.SwIfIndex).To(Equal(vpp2210.NoInterface))
+	Expect(msg.Translation.Paths[0].Flags).To(BeEquivalentTo(0))
+	Expect(msg.Translation.Paths[1].SrcEp.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(msg.Translation.Paths[1].Flags).To(BeEquivalentTo(vpp_cnat.CNAT_EPT_NO_NAT))
+}
+
+func TestAddCnatTranslationError(t *testing.T) {
+	ctx, cnatHandler, _ := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// missing interface
+	_, err := cnatHandler.AddCnatTranslation(&cnat.Translation{
+		Vip: &cnat.Endpoint{Interface: "if1", Port: 80},
+	})
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationUpdateReply{
+		Retval: -1,
+	})
+	_, err = cnatHandler.AddCnatTranslation(&cnat.Translation{
+		Vip: &cnat.Endpoint{Address: "10.96.0.10", Port: 80},
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelCnatTranslation(t *testing.T) {
+	ctx, cnatHandler, _ := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationDelReply{})
+	err := cnatHandler.DelCnatTranslation(5)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatTranslationDel)
+	Expect(ok).To(BeTrue())
+	Expect(msg.ID).To(BeEquivalentTo(5))
+}
+
+func TestSetCnatSnatPolicy(t *testing.T) {
+	ctx, cnatHandler, ifIndex := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSetSnatAddressesReply{})
+	err := cnatHandler.SetCnatSnatAddresses("10.0.0.1", "2001:db8::1", "")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	addrMsg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatSetSnatAddresses)
+	Expect(ok).To(BeTrue())
+	Expect(addrMsg.SnatIP4.String()).To(Equal("10.0.0.1"))
+	Expect(addrMsg.SnatIP6.String()).To(Equal("2001:db8::1"))
+	Expect(addrMsg.SwIfIndex).To(Equal(vpp2210.NoInterface))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSetSnatPolicyReply{})
+	err = cnatHandler.SetCnatSnatPolicy(cnat.SnatPolicy_K8S)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	policyMsg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatSetSnatPolicy)
+	Expect(ok).To(BeTrue())
+	Expect(policyMsg.Policy).To(Equal(vpp_cnat.CNAT_POLICY_K8S))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSnatPolicyAddDelExcludePfxReply{})
+	err = cnatHandler.AddCnatSnatExcludePrefix("10.96.0.0/12")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	pfxMsg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatSnatPolicyAddDelExcludePfx)
+	Expect(ok).To(BeTrue())
+	Expect(pfxMsg.IsAdd).To(BeEquivalentTo(1))
+	Expect(pfxMsg.Prefix.String()).To(Equal("10.96.0.0/12"))
+
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatSnatPolicyAddDelIfReply{})
+	err = cnatHandler.DelCnatSnatPolicyInterface("if1", cnat.SnatPolicyInterface_POD)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ifMsg, ok := ctx.MockChannel.Msg.(*vpp_cnat.CnatSnatPolicyAddDelIf)
+	Expect(ok).To(BeTrue())
+	Expect(ifMsg.IsAdd).To(BeEquivalentTo(0))
+	Expect(ifMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(ifMsg.Table).To(Equal(vpp_cnat.CNAT_POLICY_POD))
+}
+
+func TestDumpCnatTranslations(t *testing.T) {
+	ctx, cnatHandler, _ := cnatTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	vip, _ := ip_types.ParseAddress("10.96.0.10")
+	backend, _ := ip_types.ParseAddress("192.168.1.1")
+	ctx.MockVpp.MockReply(&vpp_cnat.CnatTranslationDetails{
+		Translation: vpp_cnat.CnatTranslation{
+			Vip:     vpp_cnat.CnatEndpoint{Addr: vip, Port: 80},
+			ID:      3,
+			IPProto: ip_types.IP_API_PROTO_TCP,
+			NPaths:  1,
+			Paths: []vpp_cnat.CnatEndpointTuple{
+				{
+					DstEp: vpp_cnat.CnatEndpoint{Addr: backend, Port: 8080},
+					Flags: uint8(vpp_cnat.CNAT_EPT_NO_NAT),
+				},
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	translations, err := cnatHandler.DumpCnatTranslations()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(translations).To(HaveLen(1))
+	Expect(translations[0].Meta.ID).To(BeEquivalentTo(3))
+	translation := translations[0].Translation
+	Expect(translation.Vip.Address).To(Equal("10.96.0.10"))
+	Expect(translation.Vip.Port).To(BeEquivalentTo(80))
+	Expect(translation.Protocol).To(Equal(cnat.Translation_TCP))
+	Expect(translation.Paths).To(HaveLen(1))
+	Expect(translation.Paths[0].Dst.Address).To(Equal("192.168.1.1"))
+	Expect(translation.Paths[0].Dst.Port).To(BeEquivalentTo(8080))
+	Expect(translation.Paths[0].Src).To(BeNil())
+	Expect(translation.Paths[0].NoNat).To(BeTrue())
+}
+
+func cnatTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.CnatVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndex := ifaceidx.NewIfaceIndex(log, "cnat-test-ifidx")
+	cnatHandler := vpp2210.NewCnatVppHandler(ctx.MockChannel, ifIndex, log)
+	return ctx, cnatHandler, ifIndex
+}
@@ -0,0 +1,95 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"fmt"
+
+	vpp_cnat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/cnat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/cnatidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/cnatplugin/vppcalls"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+)
+
+// DumpCnatTranslations dumps all CNAT translations.
+func (h *CnatVppHandler) DumpCnatTranslations() (translations []*vppcalls.CnatTranslationDetails, err error) {
+	req := &vpp_cnat.CnatTranslationDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_cnat.CnatTranslationDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump CNAT translations: %v", err)
+		}
+		if stop {
+			break
+		}
+		vppTranslation := msg.Translation
+		translation := &cnat.Translation{
+			Vip:       h.endpointFromVpp(vppTranslation.Vip),
+			Protocol:  protocolFromVpp(vppTranslation.IPProto),
+			IsRealIp:  vppTranslation.IsRealIP != 0,
+			AllocPort: vppTranslation.Flags&uint8(vpp_cnat.CNAT_TRANSLATION_ALLOC_PORT) != 0,
+			LbType:    cnat.Translation_LbType(vppTranslation.LbType),
+		}
+		for _, vppPath := range vppTranslation.Paths {
+			translation.Paths = append(translation.Paths, &cnat.Translation_Path{
+				Dst:   h.endpointFromVpp(vppPath.DstEp),
+				Src:   h.endpointFromVpp(vppPath.SrcEp),
+				NoNat: vppPath.Flags&uint8(vpp_cnat.CNAT_EPT_NO_NAT) != 0,
+			})
+		}
+		translations = append(translations, &vppcalls.CnatTranslationDetails{
+			Translation: translation,
+			Meta: &cnatidx.TranslationMetadata{
+				ID: vppTranslation.ID,
+			},
+		})
+	}
+	return translations, nil
+}
+
+// endpointFromVpp converts endpoint from the VPP representation. Endpoints resolved
+// from interface addresses are returned with the resolved address. Empty endpoint
+// is returned as nil.
+func (h *CnatVppHandler) endpointFromVpp(vppEndpoint vpp_cnat.CnatEndpoint) *cnat.Endpoint {
+	endpoint := &cnat.Endpoint{
+		Port: uint32(vppEndpoint.Port),
+	}
+	if ip := vppEndpoint.Addr.ToIP(); !ip.IsUnspecified() {
+		endpoint.Address = ip.String()
+	} else if vppEndpoint.SwIfIndex != NoInterface && vppEndpoint.SwIfIndex != 0 {
+		// endpoint not resolved yet (interface without address)
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(vppEndpoint.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", vppEndpoint.SwIfIndex)
+		}
+		endpoint.Interface = ifName
+		endpoint.InterfaceIpv6 = vppEndpoint.Addr.Af == ip_types.ADDRESS_IP6
+	}
+	if endpoint.Address == "" && endpoint.Interface == "" && endpoint.Port == 0 {
+		return nil
+	}
+	return endpoint
+}
+
+func protocolFromVpp(protocol ip_types.IPProto) cnat.Translation_Protocol {
+	if protocol == ip_types.IP_API_PROTO_UDP {
+		return cnat.Translation_UDP
+	}
+	return cnat.Translation_TCP
+}
@@ -526,6 +526,9 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 				}
 			}
 
+			// IPv6 ND config (incl. router advertisements) cannot be dumped
+			intf.Interface.Ip6Nd = expCfg.GetIp6Nd()
+
 			// remove rx-placement entries for queues with configuration not defined by NB
 			rxPlacementDump := intf.Interface.GetRxPlacements()
 			rxPlacementCfg := expCfg.GetRxPlacements()
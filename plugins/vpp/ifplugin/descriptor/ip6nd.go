@@ -16,20 +16,62 @@ package descriptor
 
 import (
 	"context"
+	"net"
 
 	"github.com/go-errors/errors"
 	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
 
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 )
 
 const (
 	// IP6ndDescriptorName is the name of the descriptor.
 	IP6ndDescriptorName = "vpp-ip6nd"
+
+	// dependency labels
+	ip6EnabledDep = "interface-has-IPv6-address"
+
+	// limits of router advertisement parameters (as enforced by VPP)
+	minRAMaxInterval      = 4
+	maxRAMaxInterval      = 1800
+	minRAMinInterval      = 3
+	maxRARouterLifetime   = 9000
+	maxRAInitialCount     = 3
+	maxRAInitialInterval  = 16
+	defaultRAMaxInterval  = 200
+	raMinToMaxIntervalPct = 75
+)
+
+// A list of errors returned by IP6ND descriptor.
+var (
+	// ErrRAMaxIntervalOutOfRange is returned when RA max interval is out of range.
+	ErrRAMaxIntervalOutOfRange = errors.Errorf("router advertisement max interval must be between %d and %d seconds",
+		minRAMaxInterval, maxRAMaxInterval)
+
+	// ErrRAMinIntervalOutOfRange is returned when RA min interval is out of range.
+	ErrRAMinIntervalOutOfRange = errors.Errorf("router advertisement min interval must be between %d seconds "+
+		"and %d%% of the max interval", minRAMinInterval, raMinToMaxIntervalPct)
+
+	// ErrRARouterLifetimeOutOfRange is returned when RA router lifetime is out of range.
+	ErrRARouterLifetimeOutOfRange = errors.Errorf("router lifetime must be greater than max interval "+
+		"and not greater than %d seconds", maxRARouterLifetime)
+
+	// ErrRAInitialCountOutOfRange is returned when number of initial RAs is out of range.
+	ErrRAInitialCountOutOfRange = errors.Errorf("number of initial router advertisements must not exceed %d",
+		maxRAInitialCount)
+
+	// ErrRAInitialIntervalOutOfRange is returned when interval between initial RAs is out of range.
+	ErrRAInitialIntervalOutOfRange = errors.Errorf("interval between initial router advertisements "+
+		"must not exceed %d seconds", maxRAInitialInterval)
+
+	// ErrRADuplicatePrefix is returned when the same prefix is advertised more than once.
+	ErrRADuplicatePrefix = errors.New("prefix is advertised more than once")
 )
 
 // IP6ndDescriptor instructs KVScheduler how to configure VPP IP6ND entries.
@@ -52,12 +94,15 @@ func NewIP6ndDescriptor(scheduler kvs.KVScheduler, handler vppcalls.IP6ndVppAPI,
 	}
 
 	typedDescr := &adapter.IP6NDDescriptor{
-		Name:        IP6ndDescriptorName,
-		KeySelector: ctx.IsIP6NDRelatedKey,
-		KeyLabel:    ctx.InterfaceNameFromKey,
-		Create:      ctx.Create,
-		Delete:      ctx.Delete,
-		//Retrieve:             ctx.Retrieve,
+		Name:                 IP6ndDescriptorName,
+		KeySelector:          ctx.IsIP6NDRelatedKey,
+		KeyLabel:             ctx.InterfaceNameFromKey,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		Dependencies:         ctx.Dependencies,
+		DerivedValues:        ctx.DerivedValues,
 		RetrieveDependencies: []string{InterfaceDescriptorName},
 	}
 	return adapter.NewIP6NDDescriptor(typedDescr)
@@ -79,6 +124,41 @@ func (d *IP6ndDescriptor) InterfaceNameFromKey(key string) string {
 	return key
 }
 
+// Validate validates router advertisement parameters and advertised prefixes.
+func (d *IP6ndDescriptor) Validate(key string, entry *interfaces.Interface_IP6ND) error {
+	if ra := entry.GetRouterAdvertisement(); ra != nil {
+		maxInterval := ra.MaxInterval
+		if maxInterval != 0 && (maxInterval < minRAMaxInterval || maxInterval > maxRAMaxInterval) {
+			return kvs.NewInvalidValueError(ErrRAMaxIntervalOutOfRange, "router_advertisement.max_interval")
+		}
+		if maxInterval == 0 {
+			maxInterval = defaultRAMaxInterval
+		}
+		if ra.MinInterval != 0 && (ra.MinInterval < minRAMinInterval ||
+			ra.MinInterval*100 > maxInterval*raMinToMaxIntervalPct) {
+			return kvs.NewInvalidValueError(ErrRAMinIntervalOutOfRange, "router_advertisement.min_interval")
+		}
+		if ra.RouterLifetime != 0 && !ra.NoDefaultRouter &&
+			(ra.RouterLifetime <= maxInterval || ra.RouterLifetime > maxRARouterLifetime) {
+			return kvs.NewInvalidValueError(ErrRARouterLifetimeOutOfRange, "router_advertisement.router_lifetime")
+		}
+		if ra.InitialCount > maxRAInitialCount {
+			return kvs.NewInvalidValueError(ErrRAInitialCountOutOfRange, "router_advertisement.initial_count")
+		}
+		if ra.InitialInterval > maxRAInitialInterval {
+			return kvs.NewInvalidValueError(ErrRAInitialIntervalOutOfRange, "router_advertisement.initial_interval")
+		}
+	}
+	prefixes := make(map[string]struct{})
+	for _, prefix := range entry.GetPrefixes() {
+		if _, duplicate := prefixes[prefix.GetPrefix()]; duplicate {
+			return kvs.NewInvalidValueError(ErrRADuplicatePrefix, "prefixes.prefix")
+		}
+		prefixes[prefix.GetPrefix()] = struct{}{}
+	}
+	return nil
+}
+
 // Create adds a VPP IP6ND entry.
 func (d *IP6ndDescriptor) Create(key string, entry *interfaces.Interface_IP6ND) (metadata interface{}, err error) {
 	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
@@ -95,9 +175,48 @@ func (d *IP6ndDescriptor) Create(key string, entry *interfaces.Interface_IP6ND)
 		return nil, err
 	}
 
+	if ra := entry.GetRouterAdvertisement(); ra != nil {
+		if err := d.handler.SetIP6ndRouterAdvertisement(context.Background(), ifMeta.SwIfIndex, ra); err != nil {
+			err = errors.Errorf("failed to configure router advertisements for interface %s: %v", ifName, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
 	return nil, err
 }
 
+// Update updates IPv6 ND address autoconfiguration and router advertisements
+// of the interface (advertised prefixes are updated as derived values).
+func (d *IP6ndDescriptor) Update(key string, oldEntry, newEntry *interfaces.Interface_IP6ND, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err = errors.Errorf("failed to find IP6ND-enabled interface %s", ifName)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	if oldEntry.AddressAutoconfig != newEntry.AddressAutoconfig ||
+		oldEntry.InstallDefaultRoutes != newEntry.InstallDefaultRoutes {
+		if err := d.handler.SetIP6ndAutoconfig(context.Background(), ifMeta.SwIfIndex, newEntry.AddressAutoconfig, newEntry.InstallDefaultRoutes); err != nil {
+			err = errors.Errorf("failed to update IP6ND for interface %s", ifName)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	if !proto.Equal(oldEntry.GetRouterAdvertisement(), newEntry.GetRouterAdvertisement()) {
+		if err := d.handler.SetIP6ndRouterAdvertisement(context.Background(), ifMeta.SwIfIndex, newEntry.GetRouterAdvertisement()); err != nil {
+			err = errors.Errorf("failed to update router advertisements for interface %s: %v", ifName, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
 // Delete removes a VPP IP6ND entry.
 func (d *IP6ndDescriptor) Delete(key string, entry *interfaces.Interface_IP6ND, metadata interface{}) (err error) {
 	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
@@ -108,6 +227,14 @@ func (d *IP6ndDescriptor) Delete(key string, entry *interfaces.Interface_IP6ND,
 		return err
 	}
 
+	if entry.GetRouterAdvertisement() != nil {
+		if err := d.handler.SetIP6ndRouterAdvertisement(context.Background(), ifMeta.SwIfIndex, nil); err != nil {
+			err = errors.Errorf("failed to reset router advertisements for interface %s: %v", ifName, err)
+			d.log.Error(err)
+			return err
+		}
+	}
+
 	if err := d.handler.SetIP6ndAutoconfig(context.Background(), ifMeta.SwIfIndex, false, false); err != nil {
 		err = errors.Errorf("failed to disable IP6ND for interface %s", ifName)
 		d.log.Error(err)
@@ -117,21 +244,41 @@ func (d *IP6ndDescriptor) Delete(key string, entry *interfaces.Interface_IP6ND,
 	return nil
 }
 
-// Retrieve returns all IP6ND entries.
-// TODO: implement retrieve
-/*func (d *IP6ndDescriptor) Retrieve(correlate []adapter.IP6NDKVWithMetadata) (
-	retrieved []adapter.IP6NDKVWithMetadata, err error,
-) {
-	entries, err := d.handler.DumpIP6ND()
-	if err != nil {
-		return nil, err
+// Dependencies informs scheduler that router advertisements cannot be configured
+// until IPv6 is enabled on the interface, i.e. until the interface has
+// at least one IPv6 address assigned.
+func (d *IP6ndDescriptor) Dependencies(key string, entry *interfaces.Interface_IP6ND) []kvs.Dependency {
+	if entry.GetRouterAdvertisement() == nil && len(entry.GetPrefixes()) == 0 {
+		return nil
+	}
+	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
+	return []kvs.Dependency{
+		{
+			Label: ip6EnabledDep,
+			AnyOf: kvs.AnyOfDependency{
+				KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(ifName)},
+				KeySelector: func(key string) bool {
+					_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
+					if source == netalloc_api.IPAddressSource_ALLOC_REF {
+						// TODO: handle the case when IPv6 address is allocated
+						return false
+					}
+					ip, _, err := net.ParseCIDR(ifaceAddr)
+					return err == nil && ip.To4() == nil
+				},
+			},
+		},
 	}
-	for _, entry := range entries {
-		retrieved = append(retrieved, adapter.IP6NDKVWithMetadata{
-			Key:    models.Key(entry),
-			Value:  entry,
-			Origin: kvs.UnknownOrigin,
+}
+
+// DerivedValues derives prefixes advertised in router advertisements.
+func (d *IP6ndDescriptor) DerivedValues(key string, entry *interfaces.Interface_IP6ND) (derValues []kvs.KeyValuePair) {
+	ifName, _ := interfaces.ParseNameFromIP6NDKey(key)
+	for _, prefix := range entry.GetPrefixes() {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   interfaces.IP6NDPrefixKey(ifName, prefix.GetPrefix()),
+			Value: prefix,
 		})
 	}
-	return
-}*/
+	return derValues
+}
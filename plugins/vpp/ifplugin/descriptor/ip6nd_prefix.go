@@ -0,0 +1,136 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// IP6ndPrefixDescriptorName is the name of the descriptor for prefixes
+	// advertised in IPv6 router advertisements.
+	IP6ndPrefixDescriptorName = "vpp-ip6nd-prefix"
+)
+
+// A list of errors returned by IP6ND prefix descriptor.
+var (
+	// ErrRAPrefixInvalid is returned when advertised prefix is not a valid IPv6 prefix.
+	ErrRAPrefixInvalid = errors.New("advertised prefix must be a valid IPv6 prefix")
+
+	// ErrRAPrefixLifetime is returned when preferred lifetime exceeds valid lifetime.
+	ErrRAPrefixLifetime = errors.New("preferred lifetime of advertised prefix must not exceed valid lifetime")
+)
+
+// IP6ndPrefixDescriptor configures prefixes advertised in IPv6 router advertisements.
+type IP6ndPrefixDescriptor struct {
+	log     logging.Logger
+	handler vppcalls.IP6ndVppAPI
+	ifIndex ifaceidx.IfaceMetadataIndex
+}
+
+// NewIP6ndPrefixDescriptor creates a new instance of IP6ndPrefixDescriptor.
+func NewIP6ndPrefixDescriptor(handler vppcalls.IP6ndVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &IP6ndPrefixDescriptor{
+		handler: handler,
+		ifIndex: ifIndex,
+		log:     log.NewLogger("ip6nd-prefix-descriptor"),
+	}
+
+	typedDescr := &adapter.IP6NDPrefixDescriptor{
+		Name:        IP6ndPrefixDescriptorName,
+		KeySelector: ctx.IsIP6NDPrefixKey,
+		Validate:    ctx.Validate,
+		Create:      ctx.Create,
+		Update:      ctx.Update,
+		Delete:      ctx.Delete,
+	}
+	return adapter.NewIP6NDPrefixDescriptor(typedDescr)
+}
+
+// IsIP6NDPrefixKey returns true if the key is identifying prefix advertised
+// in IPv6 router advertisements.
+func (d *IP6ndPrefixDescriptor) IsIP6NDPrefixKey(key string) bool {
+	_, _, isValid := interfaces.ParseIP6NDPrefixKey(key)
+	return isValid
+}
+
+// Validate validates advertised prefix and its lifetimes.
+func (d *IP6ndPrefixDescriptor) Validate(key string, prefix *interfaces.Interface_IP6ND_Prefix) error {
+	ip, _, err := net.ParseCIDR(prefix.GetPrefix())
+	if err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(ErrRAPrefixInvalid, "prefix")
+	}
+	if prefix.PreferredLifetime > prefix.ValidLifetime {
+		return kvs.NewInvalidValueError(ErrRAPrefixLifetime, "preferred_lifetime")
+	}
+	return nil
+}
+
+// Create starts advertising the prefix on the interface.
+func (d *IP6ndPrefixDescriptor) Create(key string, prefix *interfaces.Interface_IP6ND_Prefix) (metadata interface{}, err error) {
+	ifName, _, _ := interfaces.ParseIP6NDPrefixKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ifName)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	if err = d.handler.AddIP6ndPrefix(context.Background(), ifMeta.SwIfIndex, prefix); err != nil {
+		err = errors.Errorf("failed to add advertised prefix %s to interface %s: %v",
+			prefix.Prefix, ifName, err)
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Update changes parameters of the advertised prefix (VPP overwrites
+// parameters of already advertised prefix).
+func (d *IP6ndPrefixDescriptor) Update(key string, oldPrefix, newPrefix *interfaces.Interface_IP6ND_Prefix,
+	oldMetadata interface{}) (newMetadata interface{}, err error) {
+	return d.Create(key, newPrefix)
+}
+
+// Delete stops advertising the prefix on the interface.
+func (d *IP6ndPrefixDescriptor) Delete(key string, prefix *interfaces.Interface_IP6ND_Prefix, metadata interface{}) error {
+	ifName, _, _ := interfaces.ParseIP6NDPrefixKey(key)
+	ifMeta, found := d.ifIndex.LookupByName(ifName)
+	if !found {
+		err := errors.Errorf("failed to find interface %s", ifName)
+		d.log.Error(err)
+		return err
+	}
+
+	if err := d.handler.DeleteIP6ndPrefix(context.Background(), ifMeta.SwIfIndex, prefix); err != nil {
+		err = errors.Errorf("failed to remove advertised prefix %s from interface %s: %v",
+			prefix.Prefix, ifName, err)
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
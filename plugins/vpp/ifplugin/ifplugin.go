@@ -19,6 +19,7 @@
 //go:generate descriptor-adapter --descriptor-name BondedInterface  --value-type *vpp_interfaces.BondLink_BondedInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name Span  --value-type *vpp_interfaces.Span --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name IP6ND --value-type *vpp_interfaces.Interface_IP6ND --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IP6NDPrefix --value-type *vpp_interfaces.Interface_IP6ND_Prefix --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 
 package ifplugin
 
@@ -51,6 +52,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls/vpp2210"
 )
 
 func init() {
@@ -73,6 +75,9 @@ type IfPlugin struct {
 	intfIndex ifaceidx.IfaceMetadataIndex
 	dhcpIndex idxmap.NamedMapping
 
+	// interface index maps of additional VPP instances
+	instanceIndexes map[string]ifaceidx.IfaceMetadataIndex
+
 	// descriptors
 	linkStateDescriptor *descriptor.LinkStateDescriptor
 	dhcpDescriptor      *descriptor.DHCPDescriptor
@@ -150,7 +155,7 @@ func (p *IfPlugin) Init() (err error) {
 	//   -> base interface descriptor
 	ifaceDescriptor, ifaceDescrCtx := descriptor.NewInterfaceDescriptor(p.ifHandler,
 		p.AddrAlloc, p.defaultMtu, p.linuxIfHandler, p.LinuxIfPlugin, p.NsPlugin, p.Log)
-	err = p.KVScheduler.RegisterKVDescriptor(ifaceDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, ifaceDescriptor)
 	if err != nil {
 		return err
 	}
@@ -182,8 +187,9 @@ func (p *IfPlugin) Init() (err error) {
 	spanDescriptor, spanDescriptorCtx := descriptor.NewSpanDescriptor(p.ifHandler, p.Log)
 	spanDescriptorCtx.SetInterfaceIndex(p.intfIndex)
 	ip6ndDescriptor := descriptor.NewIP6ndDescriptor(p.KVScheduler, p.ifHandler, p.intfIndex, p.Log)
+	ip6ndPrefixDescriptor := descriptor.NewIP6ndPrefixDescriptor(p.ifHandler, p.intfIndex, p.Log)
 
-	err = p.KVScheduler.RegisterKVDescriptor(
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler,
 		dhcpDescriptor,
 		linkStateDescriptor,
 		rxModeDescriptor,
@@ -195,11 +201,17 @@ func (p *IfPlugin) Init() (err error) {
 		withAddrDescriptor,
 		spanDescriptor,
 		ip6ndDescriptor,
+		ip6ndPrefixDescriptor,
 	)
 	if err != nil {
 		return err
 	}
 
+	// register descriptors for additional VPP instances
+	if err = p.initInstances(); err != nil {
+		return err
+	}
+
 	// start watching for DHCP notifications
 	p.dhcpIndex = p.KVScheduler.GetMetadataMap(dhcpDescriptor.Name)
 	if p.dhcpIndex == nil {
@@ -258,6 +270,69 @@ func (p *IfPlugin) Init() (err error) {
 	return nil
 }
 
+// initInstances registers descriptors handling interfaces of additional VPP instances
+// (keys of their values are scoped by the instance). Interface state (DHCP, link state,
+// statistics) is watched and published only for the default instance.
+func (p *IfPlugin) initInstances() error {
+	multiVPP, ok := p.VPP.(govppmux.MultiInstanceAPI)
+	if !ok {
+		return nil
+	}
+	p.instanceIndexes = make(map[string]ifaceidx.IfaceMetadataIndex)
+	for _, instance := range multiVPP.Instances() {
+		vppAPI, err := multiVPP.Instance(instance)
+		if err != nil {
+			return err
+		}
+		ifHandler := vppcalls.CompatibleInterfaceVppHandler(vppAPI, p.Log)
+		if ifHandler == nil {
+			return errors.Errorf("interface VPP handler is not available for VPP instance %q", instance)
+		}
+
+		ifaceDescriptor, ifaceDescrCtx := descriptor.NewInterfaceDescriptor(ifHandler,
+			p.AddrAlloc, p.defaultMtu, nil, nil, nil, p.Log)
+		instanceDescriptor, err := kvs.InstanceDescriptor(instance, ifaceDescriptor)
+		if err != nil {
+			return err
+		}
+		if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+			return err
+		}
+		metadataMap := p.KVScheduler.GetMetadataMap(kvs.InstanceDescriptorName(instance, ifaceDescriptor.Name))
+		intfIndex, withIndex := metadataMap.(ifaceidx.IfaceMetadataIndex)
+		if !withIndex {
+			return errors.Errorf("missing index with interface metadata of VPP instance %q", instance)
+		}
+		ifaceDescrCtx.SetInterfaceIndex(intfIndex)
+		p.instanceIndexes[instance] = intfIndex
+
+		bondIfDescriptor, _ := descriptor.NewBondedInterfaceDescriptor(ifHandler, intfIndex, p.Log)
+		spanDescriptor, spanDescriptorCtx := descriptor.NewSpanDescriptor(ifHandler, p.Log)
+		spanDescriptorCtx.SetInterfaceIndex(intfIndex)
+		for _, d := range []*kvs.KVDescriptor{
+			descriptor.NewRxModeDescriptor(ifHandler, intfIndex, p.Log),
+			descriptor.NewRxPlacementDescriptor(ifHandler, intfIndex, p.Log),
+			descriptor.NewInterfaceAddressDescriptor(ifHandler, p.AddrAlloc, intfIndex, p.Log),
+			descriptor.NewUnnumberedIfDescriptor(ifHandler, intfIndex, p.Log),
+			bondIfDescriptor,
+			descriptor.NewInterfaceVrfDescriptor(ifHandler, intfIndex, p.Log),
+			descriptor.NewInterfaceWithAddrDescriptor(p.Log),
+			spanDescriptor,
+			descriptor.NewIP6ndDescriptor(p.KVScheduler, ifHandler, intfIndex, p.Log),
+			descriptor.NewIP6ndPrefixDescriptor(ifHandler, intfIndex, p.Log),
+		} {
+			instanceDescriptor, err := kvs.InstanceDescriptor(instance, d)
+			if err != nil {
+				return err
+			}
+			if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (p *IfPlugin) subscribeWatcher() (err error) {
 	keyPrefixes := []string{interfaces.StatePrefix}
 
@@ -315,6 +390,13 @@ func (p *IfPlugin) GetInterfaceIndex() ifaceidx.IfaceMetadataIndex {
 
 // GetDHCPIndex gives read-only access to (untyped) map with DHCP leases.
 // Cast metadata to "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces".DHCPLease
+func (p *IfPlugin) GetInstanceInterfaceIndex(instance string) ifaceidx.IfaceMetadataIndex {
+	if instance == "" {
+		return p.intfIndex
+	}
+	return p.instanceIndexes[instance]
+}
+
 func (p *IfPlugin) GetDHCPIndex() idxmap.NamedMapping {
 	return p.dhcpIndex
 }
@@ -27,6 +27,11 @@ type API interface {
 	// VPP interfaces.
 	GetInterfaceIndex() ifaceidx.IfaceMetadataIndex
 
+	// GetInstanceInterfaceIndex gives read-only access to map with metadata of all
+	// configured interfaces of the given VPP instance (default instance for empty name).
+	// Returns nil for unknown instance.
+	GetInstanceInterfaceIndex(instance string) ifaceidx.IfaceMetadataIndex
+
 	// GetDHCPIndex gives read-only access to (untyped) map with DHCP leases.
 	// Cast metadata to "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces".DHCPLease
 	GetDHCPIndex() idxmap.NamedMapping
@@ -300,7 +300,15 @@ type InterfaceVppRead interface {
 
 // IP6ndVppAPI provides methods for managing IPv6 ND configuration.
 type IP6ndVppAPI interface {
+	// SetIP6ndAutoconfig enables/disables IPv6 ND address autoconfiguration on the interface.
 	SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error
+	// SetIP6ndRouterAdvertisement configures router advertisements sent on the interface.
+	// All parameters not set in <ra> are reset to VPP defaults (nil resets everything).
+	SetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32, ra *interfaces.Interface_IP6ND_RouterAdvertisement) error
+	// AddIP6ndPrefix adds (or updates) prefix advertised in router advertisements.
+	AddIP6ndPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_Prefix) error
+	// DeleteIP6ndPrefix removes prefix advertised in router advertisements.
+	DeleteIP6ndPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_Prefix) error
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
@@ -0,0 +1,171 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip6_nd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestSetIP6ndRouterAdvertisement(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(context.Background(), 1,
+		&interfaces.Interface_IP6ND_RouterAdvertisement{
+			Managed:         true,
+			Other:           true,
+			MaxInterval:     30,
+			MinInterval:     10,
+			InitialCount:    2,
+			InitialInterval: 4,
+		})
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	reset, ok := ctx.MockChannel.Msgs[0].(*ip6_nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(reset.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(reset.IsNo).To(BeTrue())
+	Expect(reset.Suppress).To(BeEquivalentTo(1))
+	Expect(reset.DefaultRouter).To(BeEquivalentTo(1))
+	Expect(reset.MaxInterval).To(BeEquivalentTo(1))
+	vppMsg, ok := ctx.MockChannel.Msgs[1].(*ip6_nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.IsNo).To(BeFalse())
+	Expect(vppMsg.Suppress).To(BeEquivalentTo(0))
+	Expect(vppMsg.Managed).To(BeEquivalentTo(1))
+	Expect(vppMsg.Other).To(BeEquivalentTo(1))
+	Expect(vppMsg.MaxInterval).To(BeEquivalentTo(30))
+	Expect(vppMsg.MinInterval).To(BeEquivalentTo(10))
+	Expect(vppMsg.DefaultRouter).To(BeEquivalentTo(1))
+	Expect(vppMsg.Lifetime).To(BeEquivalentTo(90))
+	Expect(vppMsg.InitialCount).To(BeEquivalentTo(2))
+	Expect(vppMsg.InitialInterval).To(BeEquivalentTo(4))
+}
+
+func TestSetIP6ndRouterAdvertisementNoDefaultRouter(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(context.Background(), 1,
+		&interfaces.Interface_IP6ND_RouterAdvertisement{
+			NoDefaultRouter: true,
+			RouterLifetime:  1800,
+		})
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	vppMsg, ok := ctx.MockChannel.Msgs[1].(*ip6_nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.DefaultRouter).To(BeEquivalentTo(1))
+	Expect(vppMsg.Lifetime).To(BeEquivalentTo(0))
+}
+
+func TestResetIP6ndRouterAdvertisement(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(context.Background(), 1, nil)
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(1))
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeTrue())
+}
+
+func TestSetIP6ndRouterAdvertisementRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetIP6ndRouterAdvertisement(context.Background(), 1, nil)
+
+	Expect(err).ToNot(BeNil())
+}
+
+func TestAddIP6ndPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.AddIP6ndPrefix(context.Background(), 1, &interfaces.Interface_IP6ND_Prefix{
+		Prefix:            "2001:db8::/64",
+		ValidLifetime:     3600,
+		PreferredLifetime: 1800,
+		OffLink:           true,
+	})
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Prefix.Address.Af).To(Equal(ip_types.ADDRESS_IP6))
+	Expect(vppMsg.Prefix.Len).To(BeEquivalentTo(64))
+	Expect(vppMsg.IsNo).To(BeFalse())
+	Expect(vppMsg.UseDefault).To(BeFalse())
+	Expect(vppMsg.OffLink).To(BeTrue())
+	Expect(vppMsg.NoAutoconfig).To(BeFalse())
+	Expect(vppMsg.ValLifetime).To(BeEquivalentTo(3600))
+	Expect(vppMsg.PrefLifetime).To(BeEquivalentTo(1800))
+}
+
+func TestDeleteIP6ndPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.DeleteIP6ndPrefix(context.Background(), 1, &interfaces.Interface_IP6ND_Prefix{
+		Prefix: "2001:db8::/64",
+	})
+
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeTrue())
+	Expect(vppMsg.UseDefault).To(BeTrue())
+}
+
+func TestAddIP6ndPrefixInvalid(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := ifHandler.AddIP6ndPrefix(context.Background(), 1, &interfaces.Interface_IP6ND_Prefix{
+		Prefix: "invalid",
+	})
+
+	Expect(err).ToNot(BeNil())
+}
@@ -17,10 +17,18 @@ package vpp2106
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip6_nd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/rd_cp"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 )
 
+// maxRouterLifetime is the maximum router lifetime accepted by VPP (in seconds).
+const maxRouterLifetime = 9000
+
 func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error {
 	_, err := h.rpcRdCp.IP6NdAddressAutoconfig(ctx, &rd_cp.IP6NdAddressAutoconfig{
 		SwIfIndex:            interface_types.InterfaceIndex(ifIdx),
@@ -32,3 +40,94 @@ func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint
 	}
 	return nil
 }
+
+func (h *InterfaceVppHandler) SetIP6ndRouterAdvertisement(ctx context.Context, ifIdx uint32, ra *interfaces.Interface_IP6ND_RouterAdvertisement) error {
+	// VPP changes only those RA parameters which are flagged in the request,
+	// therefore everything is first reset to defaults and then the requested
+	// parameters are set on top of that
+	_, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		Suppress:        1,
+		Managed:         1,
+		Other:           1,
+		LlOption:        1,
+		SendUnicast:     1,
+		Cease:           1,
+		IsNo:            true,
+		DefaultRouter:   1,
+		MaxInterval:     1,
+		MinInterval:     1,
+		InitialCount:    1,
+		InitialInterval: 1,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to reset IPv6 router advertisement config")
+	}
+	if ra == nil {
+		return nil
+	}
+
+	req := &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		Suppress:        boolToUint(ra.Suppress),
+		Managed:         boolToUint(ra.Managed),
+		Other:           boolToUint(ra.Other),
+		LlOption:        boolToUint(ra.SuppressLlOption),
+		SendUnicast:     boolToUint(ra.SendUnicast),
+		Cease:           boolToUint(ra.Cease),
+		MaxInterval:     ra.MaxInterval,
+		MinInterval:     ra.MinInterval,
+		InitialCount:    ra.InitialCount,
+		InitialInterval: ra.InitialInterval,
+	}
+	switch {
+	case ra.NoDefaultRouter:
+		req.DefaultRouter = 1
+		req.Lifetime = 0
+	case ra.RouterLifetime != 0:
+		req.DefaultRouter = 1
+		req.Lifetime = ra.RouterLifetime
+	case ra.MaxInterval != 0:
+		// router lifetime defaults to 3*max_interval (RFC 4861),
+		// VPP would otherwise keep its default lifetime unrelated to the interval
+		req.DefaultRouter = 1
+		req.Lifetime = 3 * ra.MaxInterval
+		if req.Lifetime > maxRouterLifetime {
+			req.Lifetime = maxRouterLifetime
+		}
+	}
+	if _, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set IPv6 router advertisement config")
+	}
+	return nil
+}
+
+func (h *InterfaceVppHandler) AddIP6ndPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_Prefix) error {
+	return h.setIP6ndPrefix(ctx, ifIdx, prefix, false)
+}
+
+func (h *InterfaceVppHandler) DeleteIP6ndPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_Prefix) error {
+	return h.setIP6ndPrefix(ctx, ifIdx, prefix, true)
+}
+
+func (h *InterfaceVppHandler) setIP6ndPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.Interface_IP6ND_Prefix, isDel bool) error {
+	ipPrefix, err := ip_types.ParsePrefix(prefix.GetPrefix())
+	if err != nil {
+		return err
+	}
+	req := &ip6_nd.SwInterfaceIP6ndRaPrefix{
+		SwIfIndex:    interface_types.InterfaceIndex(ifIdx),
+		Prefix:       ipPrefix,
+		UseDefault:   prefix.ValidLifetime == 0 && prefix.PreferredLifetime == 0,
+		NoAdvertise:  prefix.NoAdvertise,
+		OffLink:      prefix.OffLink,
+		NoAutoconfig: prefix.NoAutoconfig,
+		IsNo:         isDel,
+		ValLifetime:  prefix.ValidLifetime,
+		PrefLifetime: prefix.PreferredLifetime,
+	}
+	if _, err := h.rpcIP6nd.SwInterfaceIP6ndRaPrefix(ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
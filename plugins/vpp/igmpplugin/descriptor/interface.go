@@ -0,0 +1,242 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// InterfaceDescriptorName is the name of the descriptor for IGMP-enabled interfaces.
+	InterfaceDescriptorName = "vpp-igmp-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMissingInterface is returned when interface is not defined.
+	ErrMissingInterface = errors.New("missing interface")
+	// ErrGroupsInRouterMode is returned when groups are joined by the interface in the router mode.
+	ErrGroupsInRouterMode = errors.New("groups can be joined only in the host mode")
+	// ErrInvalidGroupAddress is returned when group address is not an IPv4 multicast address.
+	ErrInvalidGroupAddress = errors.New("group address must be an IPv4 multicast address")
+	// ErrDuplicateGroup is returned when group is defined more than once.
+	ErrDuplicateGroup = errors.New("duplicate group")
+	// ErrMissingSources is returned when group is defined without sources.
+	ErrMissingSources = errors.New("at least one source address has to be defined (only SSM joins are supported)")
+	// ErrInvalidSourceAddress is returned when source address is not an IPv4 unicast address.
+	ErrInvalidSourceAddress = errors.New("source address must be an IPv4 unicast address")
+)
+
+// InterfaceDescriptor teaches KVScheduler how to enable IGMP on VPP interfaces
+// and join multicast groups. VPP does not allow to dump the IGMP configuration,
+// therefore the descriptor does not implement Retrieve.
+type InterfaceDescriptor struct {
+	log         logging.Logger
+	igmpHandler vppcalls.IgmpVppAPI
+}
+
+// NewInterfaceDescriptor creates a new instance of the IGMP interface descriptor.
+func NewInterfaceDescriptor(igmpHandler vppcalls.IgmpVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &InterfaceDescriptor{
+		igmpHandler: igmpHandler,
+		log:         log.NewLogger("igmp-interface-descriptor"),
+	}
+
+	typedDescr := &adapter.InterfaceDescriptor{
+		Name:               InterfaceDescriptorName,
+		NBKeyPrefix:        igmp.ModelInterface.KeyPrefix(),
+		ValueTypeName:      igmp.ModelInterface.ProtoName(),
+		KeySelector:        igmp.ModelInterface.IsKeyValid,
+		KeyLabel:           igmp.ModelInterface.StripKeyPrefix,
+		ValueComparator:    ctx.EquivalentInterfaces,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Dependencies:       ctx.Dependencies,
+		DerivedValues:      ctx.DerivedValues,
+	}
+	return adapter.NewInterfaceDescriptor(typedDescr)
+}
+
+// EquivalentInterfaces compares IGMP interfaces, ignoring the order of groups and sources.
+func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf *igmp.Interface) bool {
+	if oldIntf.Mode != newIntf.Mode {
+		return false
+	}
+	added, removed := diffGroupSources(oldIntf, newIntf)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// Validate validates IGMP interface configuration.
+func (d *InterfaceDescriptor) Validate(key string, intf *igmp.Interface) error {
+	if intf.Interface == "" {
+		return kvs.NewInvalidValueError(ErrMissingInterface, "interface")
+	}
+	if intf.Mode != igmp.Interface_HOST && len(intf.Groups) > 0 {
+		return kvs.NewInvalidValueError(ErrGroupsInRouterMode, "mode", "groups")
+	}
+	groups := make(map[string]struct{})
+	for i, group := range intf.Groups {
+		field := fmt.Sprintf("groups[%d]", i)
+		grpIP := net.ParseIP(group.GroupAddress)
+		if grpIP == nil || grpIP.To4() == nil || !grpIP.IsMulticast() {
+			return kvs.NewInvalidValueError(ErrInvalidGroupAddress, field+".group_address")
+		}
+		if _, duplicate := groups[grpIP.String()]; duplicate {
+			return kvs.NewInvalidValueError(ErrDuplicateGroup, field+".group_address")
+		}
+		groups[grpIP.String()] = struct{}{}
+		if len(group.SourceAddresses) == 0 {
+			return kvs.NewInvalidValueError(ErrMissingSources, field+".source_addresses")
+		}
+		for _, src := range group.SourceAddresses {
+			srcIP := net.ParseIP(src)
+			if srcIP == nil || srcIP.To4() == nil || srcIP.IsMulticast() {
+				return kvs.NewInvalidValueError(ErrInvalidSourceAddress, field+".source_addresses")
+			}
+		}
+	}
+	return nil
+}
+
+// Create enables IGMP on the interface and joins the configured groups.
+func (d *InterfaceDescriptor) Create(key string, intf *igmp.Interface) (metadata interface{}, err error) {
+	if err = d.igmpHandler.EnableIgmp(intf.Interface, intf.Mode); err != nil {
+		return nil, err
+	}
+	for _, group := range intf.Groups {
+		if err = d.igmpHandler.JoinIgmpGroup(intf.Interface, group); err != nil {
+			return nil, errors.Errorf("failed to join group %s: %v", group.GroupAddress, err)
+		}
+	}
+	return nil, nil
+}
+
+// Delete disables IGMP on the interface (VPP removes joined groups as well).
+func (d *InterfaceDescriptor) Delete(key string, intf *igmp.Interface, metadata interface{}) error {
+	return d.igmpHandler.DisableIgmp(intf.Interface, intf.Mode)
+}
+
+// Update leaves removed and joins added (S,G) pairs.
+func (d *InterfaceDescriptor) Update(key string, oldIntf, newIntf *igmp.Interface, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	added, removed := diffGroupSources(oldIntf, newIntf)
+	for _, group := range removed {
+		if err = d.igmpHandler.LeaveIgmpGroup(newIntf.Interface, group); err != nil {
+			return nil, errors.Errorf("failed to leave group %s: %v", group.GroupAddress, err)
+		}
+	}
+	for _, group := range added {
+		if err = d.igmpHandler.JoinIgmpGroup(newIntf.Interface, group); err != nil {
+			return nil, errors.Errorf("failed to join group %s: %v", group.GroupAddress, err)
+		}
+	}
+	return nil, nil
+}
+
+// UpdateWithRecreate returns true if IGMP mode has changed.
+func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldIntf, newIntf *igmp.Interface, metadata interface{}) bool {
+	return oldIntf.Mode != newIntf.Mode
+}
+
+// Dependencies lists the interface as the only dependency.
+func (d *InterfaceDescriptor) Dependencies(key string, intf *igmp.Interface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(intf.Interface),
+		},
+	}
+}
+
+// DerivedValues derives empty value representing the mode in which IGMP is enabled
+// on the interface (used as a dependency by the proxy device).
+func (d *InterfaceDescriptor) DerivedValues(key string, intf *igmp.Interface) (derValues []kvs.KeyValuePair) {
+	return []kvs.KeyValuePair{
+		{
+			Key:   igmp.InterfaceModeKey(intf.Interface, intf.Mode),
+			Value: &emptypb.Empty{},
+		},
+	}
+}
+
+// diffGroupSources returns (S,G) pairs added in the new configuration and removed
+// from the old one, grouped by the group address.
+func diffGroupSources(oldIntf, newIntf *igmp.Interface) (added, removed []*igmp.Interface_Group) {
+	oldSources := groupSources(oldIntf)
+	newSources := groupSources(newIntf)
+	return subtractGroupSources(newSources, oldSources), subtractGroupSources(oldSources, newSources)
+}
+
+// groupSources returns sources of the interface groups indexed by the group address.
+func groupSources(intf *igmp.Interface) map[string]map[string]struct{} {
+	groups := make(map[string]map[string]struct{})
+	for _, group := range intf.GetGroups() {
+		grpAddr := normalizeIP(group.GroupAddress)
+		if groups[grpAddr] == nil {
+			groups[grpAddr] = make(map[string]struct{})
+		}
+		for _, src := range group.SourceAddresses {
+			groups[grpAddr][normalizeIP(src)] = struct{}{}
+		}
+	}
+	return groups
+}
+
+// subtractGroupSources returns (S,G) pairs from <groups1> which are not in <groups2>.
+func subtractGroupSources(groups1, groups2 map[string]map[string]struct{}) (diff []*igmp.Interface_Group) {
+	for grpAddr, sources := range groups1 {
+		group := &igmp.Interface_Group{GroupAddress: grpAddr}
+		for src := range sources {
+			if _, has := groups2[grpAddr][src]; !has {
+				group.SourceAddresses = append(group.SourceAddresses, src)
+			}
+		}
+		if len(group.SourceAddresses) > 0 {
+			sort.Strings(group.SourceAddresses)
+			diff = append(diff, group)
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].GroupAddress < diff[j].GroupAddress
+	})
+	return diff
+}
+
+// normalizeIP returns IP address in the canonical form (or unchanged input
+// if it cannot be parsed).
+func normalizeIP(addr string) string {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip.String()
+	}
+	return addr
+}
@@ -0,0 +1,189 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// ProxyDeviceDescriptorName is the name of the descriptor for IGMP proxy devices.
+	ProxyDeviceDescriptorName = "vpp-igmp-proxy-device"
+
+	// dependency labels
+	upstreamIgmpHostDep     = "upstream-igmp-host-mode"
+	downstreamIgmpRouterDep = "downstream-igmp-router-mode"
+	vrfTableDep             = "vrf-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrMissingUpstreamInterface is returned when upstream interface of the proxy device is not defined.
+	ErrMissingUpstreamInterface = errors.New("missing upstream interface")
+	// ErrInvalidDownstreamInterface is returned when downstream interface is undefined,
+	// duplicate or equal to the upstream interface.
+	ErrInvalidDownstreamInterface = errors.New("downstream interface is undefined, duplicate or same as upstream")
+)
+
+// ProxyDeviceDescriptor teaches KVScheduler how to configure VPP IGMP proxy devices.
+// VPP does not allow to dump the IGMP configuration, therefore the descriptor
+// does not implement Retrieve.
+type ProxyDeviceDescriptor struct {
+	log         logging.Logger
+	igmpHandler vppcalls.IgmpVppAPI
+}
+
+// NewProxyDeviceDescriptor creates a new instance of the IGMP proxy device descriptor.
+func NewProxyDeviceDescriptor(igmpHandler vppcalls.IgmpVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &ProxyDeviceDescriptor{
+		igmpHandler: igmpHandler,
+		log:         log.NewLogger("igmp-proxy-device-descriptor"),
+	}
+
+	typedDescr := &adapter.ProxyDeviceDescriptor{
+		Name:               ProxyDeviceDescriptorName,
+		NBKeyPrefix:        igmp.ModelProxyDevice.KeyPrefix(),
+		ValueTypeName:      igmp.ModelProxyDevice.ProtoName(),
+		KeySelector:        igmp.ModelProxyDevice.IsKeyValid,
+		KeyLabel:           igmp.ModelProxyDevice.StripKeyPrefix,
+		ValueComparator:    ctx.EquivalentProxyDevices,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Dependencies:       ctx.Dependencies,
+	}
+	return adapter.NewProxyDeviceDescriptor(typedDescr)
+}
+
+// EquivalentProxyDevices compares proxy devices, ignoring the order of downstream interfaces.
+func (d *ProxyDeviceDescriptor) EquivalentProxyDevices(key string, oldDev, newDev *igmp.ProxyDevice) bool {
+	if oldDev.VrfId != newDev.VrfId || oldDev.UpstreamInterface != newDev.UpstreamInterface {
+		return false
+	}
+	added, removed := diffDownstreamInterfaces(oldDev, newDev)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// Validate validates IGMP proxy device configuration.
+func (d *ProxyDeviceDescriptor) Validate(key string, dev *igmp.ProxyDevice) error {
+	if dev.UpstreamInterface == "" {
+		return kvs.NewInvalidValueError(ErrMissingUpstreamInterface, "upstream_interface")
+	}
+	ifaces := map[string]struct{}{dev.UpstreamInterface: {}}
+	for _, iface := range dev.DownstreamInterfaces {
+		if _, duplicate := ifaces[iface]; duplicate || iface == "" {
+			return kvs.NewInvalidValueError(ErrInvalidDownstreamInterface, "downstream_interfaces")
+		}
+		ifaces[iface] = struct{}{}
+	}
+	return nil
+}
+
+// Create creates proxy device and adds downstream interfaces.
+func (d *ProxyDeviceDescriptor) Create(key string, dev *igmp.ProxyDevice) (metadata interface{}, err error) {
+	if err = d.igmpHandler.AddIgmpProxyDevice(dev.VrfId, dev.UpstreamInterface); err != nil {
+		return nil, err
+	}
+	for _, iface := range dev.DownstreamInterfaces {
+		if err = d.igmpHandler.AddIgmpProxyDeviceInterface(dev.VrfId, iface); err != nil {
+			return nil, errors.Errorf("failed to add downstream interface %s: %v", iface, err)
+		}
+	}
+	return nil, nil
+}
+
+// Delete removes downstream interfaces and the proxy device.
+func (d *ProxyDeviceDescriptor) Delete(key string, dev *igmp.ProxyDevice, metadata interface{}) error {
+	for _, iface := range dev.DownstreamInterfaces {
+		if err := d.igmpHandler.DelIgmpProxyDeviceInterface(dev.VrfId, iface); err != nil {
+			return errors.Errorf("failed to remove downstream interface %s: %v", iface, err)
+		}
+	}
+	return d.igmpHandler.DelIgmpProxyDevice(dev.VrfId, dev.UpstreamInterface)
+}
+
+// Update adds and removes downstream interfaces.
+func (d *ProxyDeviceDescriptor) Update(key string, oldDev, newDev *igmp.ProxyDevice, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	added, removed := diffDownstreamInterfaces(oldDev, newDev)
+	for _, iface := range removed {
+		if err = d.igmpHandler.DelIgmpProxyDeviceInterface(newDev.VrfId, iface); err != nil {
+			return nil, errors.Errorf("failed to remove downstream interface %s: %v", iface, err)
+		}
+	}
+	for _, iface := range added {
+		if err = d.igmpHandler.AddIgmpProxyDeviceInterface(newDev.VrfId, iface); err != nil {
+			return nil, errors.Errorf("failed to add downstream interface %s: %v", iface, err)
+		}
+	}
+	return nil, nil
+}
+
+// UpdateWithRecreate returns true if the upstream interface has changed.
+func (d *ProxyDeviceDescriptor) UpdateWithRecreate(key string, oldDev, newDev *igmp.ProxyDevice, metadata interface{}) bool {
+	return oldDev.UpstreamInterface != newDev.UpstreamInterface
+}
+
+// Dependencies lists IGMP interfaces in the required modes and the (IPv4) VRF table.
+func (d *ProxyDeviceDescriptor) Dependencies(key string, dev *igmp.ProxyDevice) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: upstreamIgmpHostDep,
+		Key:   igmp.InterfaceModeKey(dev.UpstreamInterface, igmp.Interface_HOST),
+	})
+	for _, iface := range dev.DownstreamInterfaces {
+		deps = append(deps, kvs.Dependency{
+			Label: downstreamIgmpRouterDep + "-" + iface,
+			Key:   igmp.InterfaceModeKey(iface, igmp.Interface_ROUTER),
+		})
+	}
+	if dev.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: vrfTableDep,
+			Key:   l3.VrfTableKey(dev.VrfId, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
+
+// diffDownstreamInterfaces returns downstream interfaces added to and removed
+// from the proxy device.
+func diffDownstreamInterfaces(oldDev, newDev *igmp.ProxyDevice) (added, removed []string) {
+	oldIfaces := make(map[string]struct{})
+	for _, iface := range oldDev.GetDownstreamInterfaces() {
+		oldIfaces[iface] = struct{}{}
+	}
+	newIfaces := make(map[string]struct{})
+	for _, iface := range newDev.GetDownstreamInterfaces() {
+		newIfaces[iface] = struct{}{}
+		if _, has := oldIfaces[iface]; !has {
+			added = append(added, iface)
+		}
+	}
+	for _, iface := range oldDev.GetDownstreamInterfaces() {
+		if _, has := newIfaces[iface]; !has {
+			removed = append(removed, iface)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,81 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Interface --value-type *vpp_igmp.Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ProxyDevice --value-type *vpp_igmp.ProxyDevice --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp" --output-dir "descriptor"
+
+package igmpplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls/vpp2210"
+)
+
+// IgmpPlugin enables IGMP on VPP interfaces (host and router mode), joins
+// source-specific multicast groups and configures IGMP proxy devices.
+type IgmpPlugin struct {
+	Deps
+	// handler
+	IgmpHandler vppcalls.IgmpVppAPI
+}
+
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+func (p *IgmpPlugin) Init() (err error) {
+	if !p.VPP.IsPluginLoaded("igmp") {
+		p.Log.Warnf("VPP plugin igmp was disabled by VPP")
+		return nil
+	}
+
+	// init IGMP handler
+	p.IgmpHandler = vppcalls.CompatibleIgmpVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.IgmpHandler == nil {
+		return errors.New("IGMP handler is not available")
+	}
+
+	interfaceDescriptor := descriptor.NewInterfaceDescriptor(p.IgmpHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, interfaceDescriptor); err != nil {
+		return err
+	}
+	proxyDeviceDescriptor := descriptor.NewProxyDeviceDescriptor(p.IgmpHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, proxyDeviceDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *IgmpPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
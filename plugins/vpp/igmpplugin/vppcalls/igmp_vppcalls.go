@@ -0,0 +1,99 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+)
+
+// IgmpVppAPI provides methods for managing VPP IGMP configuration.
+type IgmpVppAPI interface {
+	IgmpVppRead
+
+	// EnableIgmp enables IGMP on the interface in the given mode.
+	EnableIgmp(iface string, mode igmp.Interface_Mode) error
+	// DisableIgmp disables IGMP on the interface. Groups joined by the interface
+	// are removed by VPP as well.
+	DisableIgmp(iface string, mode igmp.Interface_Mode) error
+	// JoinIgmpGroup joins sources of the multicast group on the interface
+	// (INCLUDE mode). IGMP has to be enabled on the interface in the host mode.
+	JoinIgmpGroup(iface string, group *igmp.Interface_Group) error
+	// LeaveIgmpGroup leaves sources of the multicast group on the interface.
+	// The group itself is removed once all its sources are left.
+	LeaveIgmpGroup(iface string, group *igmp.Interface_Group) error
+	// AddIgmpProxyDevice creates IGMP proxy device in the VRF with the given
+	// upstream interface.
+	AddIgmpProxyDevice(vrf uint32, upstreamIface string) error
+	// DelIgmpProxyDevice removes IGMP proxy device from the VRF.
+	DelIgmpProxyDevice(vrf uint32, upstreamIface string) error
+	// AddIgmpProxyDeviceInterface adds downstream interface to the IGMP proxy device.
+	AddIgmpProxyDeviceInterface(vrf uint32, iface string) error
+	// DelIgmpProxyDeviceInterface removes downstream interface from the IGMP proxy device.
+	DelIgmpProxyDeviceInterface(vrf uint32, iface string) error
+}
+
+// IgmpVppRead provides read methods for VPP IGMP state.
+type IgmpVppRead interface {
+	// DumpIgmpGroups dumps (S,G) state of all IGMP-enabled interfaces - groups
+	// joined in the host mode and groups reported by listeners in the router mode.
+	DumpIgmpGroups() ([]*IgmpGroupDetails, error)
+}
+
+// IgmpGroupDetails is a single (S,G) entry of the IGMP-enabled interface.
+type IgmpGroupDetails struct {
+	Interface     string `json:"interface"`
+	SwIfIndex     uint32 `json:"sw_if_index"`
+	GroupAddress  string `json:"group_address"`
+	SourceAddress string `json:"source_address"`
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "igmp",
+	HandlerAPI: (*IgmpVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) IgmpVppAPI
+
+func AddIgmpHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleIgmpVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) IgmpVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(IgmpVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_igmp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/igmp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+)
+
+// EnableIgmp implements IGMP handler.
+func (h *IgmpVppHandler) EnableIgmp(iface string, mode igmp.Interface_Mode) error {
+	return h.igmpEnableDisable(iface, mode, true)
+}
+
+// DisableIgmp implements IGMP handler.
+func (h *IgmpVppHandler) DisableIgmp(iface string, mode igmp.Interface_Mode) error {
+	return h.igmpEnableDisable(iface, mode, false)
+}
+
+func (h *IgmpVppHandler) igmpEnableDisable(iface string, mode igmp.Interface_Mode, enable bool) error {
+	swIfIndex, err := h.getSwIfIndex(iface)
+	if err != nil {
+		return err
+	}
+	req := &vpp_igmp.IgmpEnableDisable{
+		Enable:    enable,
+		Mode:      uint8(mode),
+		SwIfIndex: swIfIndex,
+	}
+	reply := &vpp_igmp.IgmpEnableDisableReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// JoinIgmpGroup implements IGMP handler.
+func (h *IgmpVppHandler) JoinIgmpGroup(iface string, group *igmp.Interface_Group) error {
+	return h.igmpListen(iface, group, vpp_igmp.INCLUDE)
+}
+
+// LeaveIgmpGroup implements IGMP handler.
+func (h *IgmpVppHandler) LeaveIgmpGroup(iface string, group *igmp.Interface_Group) error {
+	// sources listed in EXCLUDE mode are removed from the group
+	return h.igmpListen(iface, group, vpp_igmp.EXCLUDE)
+}
+
+func (h *IgmpVppHandler) igmpListen(iface string, group *igmp.Interface_Group, filter vpp_igmp.FilterMode) error {
+	swIfIndex, err := h.getSwIfIndex(iface)
+	if err != nil {
+		return err
+	}
+	gaddr, err := ip_types.ParseIP4Address(group.GroupAddress)
+	if err != nil {
+		return errors.Errorf("invalid group address %q: %v", group.GroupAddress, err)
+	}
+	saddrs := make([]ip_types.IP4Address, 0, len(group.SourceAddresses))
+	for _, src := range group.SourceAddresses {
+		saddr, err := ip_types.ParseIP4Address(src)
+		if err != nil {
+			return errors.Errorf("invalid source address %q: %v", src, err)
+		}
+		saddrs = append(saddrs, saddr)
+	}
+	req := &vpp_igmp.IgmpListen{
+		Group: vpp_igmp.IgmpGroup{
+			Filter:    filter,
+			NSrcs:     uint8(len(saddrs)),
+			SwIfIndex: swIfIndex,
+			Gaddr:     gaddr,
+			Saddrs:    saddrs,
+		},
+	}
+	reply := &vpp_igmp.IgmpListenReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddIgmpProxyDevice implements IGMP handler.
+func (h *IgmpVppHandler) AddIgmpProxyDevice(vrf uint32, upstreamIface string) error {
+	return h.igmpProxyDeviceAddDel(vrf, upstreamIface, true)
+}
+
+// DelIgmpProxyDevice implements IGMP handler.
+func (h *IgmpVppHandler) DelIgmpProxyDevice(vrf uint32, upstreamIface string) error {
+	return h.igmpProxyDeviceAddDel(vrf, upstreamIface, false)
+}
+
+func (h *IgmpVppHandler) igmpProxyDeviceAddDel(vrf uint32, upstreamIface string, isAdd bool) error {
+	swIfIndex, err := h.getSwIfIndex(upstreamIface)
+	if err != nil {
+		return err
+	}
+	req := &vpp_igmp.IgmpProxyDeviceAddDel{
+		VrfID:     vrf,
+		SwIfIndex: swIfIndex,
+	}
+	if isAdd {
+		req.Add = 1
+	}
+	reply := &vpp_igmp.IgmpProxyDeviceAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddIgmpProxyDeviceInterface implements IGMP handler.
+func (h *IgmpVppHandler) AddIgmpProxyDeviceInterface(vrf uint32, iface string) error {
+	return h.igmpProxyDeviceAddDelInterface(vrf, iface, true)
+}
+
+// DelIgmpProxyDeviceInterface implements IGMP handler.
+func (h *IgmpVppHandler) DelIgmpProxyDeviceInterface(vrf uint32, iface string) error {
+	return h.igmpProxyDeviceAddDelInterface(vrf, iface, false)
+}
+
+func (h *IgmpVppHandler) igmpProxyDeviceAddDelInterface(vrf uint32, iface string, isAdd bool) error {
+	swIfIndex, err := h.getSwIfIndex(iface)
+	if err != nil {
+		return err
+	}
+	req := &vpp_igmp.IgmpProxyDeviceAddDelInterface{
+		Add:       isAdd,
+		VrfID:     vrf,
+		SwIfIndex: swIfIndex,
+	}
+	reply := &vpp_igmp.IgmpProxyDeviceAddDelInterfaceReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DumpIgmpGroups implements IGMP handler.
+func (h *IgmpVppHandler) DumpIgmpGroups() (groups []*vppcalls.IgmpGroupDetails, err error) {
+	// dump groups of all IGMP-enabled interfaces
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_igmp.IgmpDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		details := &vpp_igmp.IgmpDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(details.SwIfIndex))
+		if !exists {
+			h.log.Warnf("IGMP group dump: interface name for index %d not found", details.SwIfIndex)
+		}
+		groups = append(groups, &vppcalls.IgmpGroupDetails{
+			Interface:     ifName,
+			SwIfIndex:     uint32(details.SwIfIndex),
+			GroupAddress:  details.Gaddr.String(),
+			SourceAddress: details.Saddr.String(),
+		})
+	}
+	return groups, nil
+}
+
+func (h *IgmpVppHandler) getSwIfIndex(iface string) (interface_types.InterfaceIndex, error) {
+	meta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return 0, errors.Errorf("interface %s not found", iface)
+	}
+	return interface_types.InterfaceIndex(meta.SwIfIndex), nil
+}
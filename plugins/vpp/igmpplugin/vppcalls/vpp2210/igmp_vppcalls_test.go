@@ -0,0 +1,179 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_igmp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/igmp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/igmpplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+)
+
+func TestEnableDisableIgmp(t *testing.T) {
+	ctx, igmpHandler := igmpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpEnableDisableReply{})
+	err := igmpHandler.EnableIgmp("if1", igmp.Interface_ROUTER)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_igmp.IgmpEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.Mode).To(BeEquivalentTo(1))
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpEnableDisableReply{})
+	err = igmpHandler.DisableIgmp("if1", igmp.Interface_ROUTER)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_igmp.IgmpEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeFalse())
+
+	// unknown interface
+	err = igmpHandler.EnableIgmp("if3", igmp.Interface_HOST)
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpEnableDisableReply{Retval: 1})
+	err = igmpHandler.EnableIgmp("if1", igmp.Interface_HOST)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestJoinLeaveIgmpGroup(t *testing.T) {
+	ctx, igmpHandler := igmpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	group := &igmp.Interface_Group{
+		GroupAddress:    "232.1.1.1",
+		SourceAddresses: []string{"10.0.0.1", "10.0.0.2"},
+	}
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpListenReply{})
+	err := igmpHandler.JoinIgmpGroup("if2", group)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_igmp.IgmpListen)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Group.Filter).To(Equal(vpp_igmp.INCLUDE))
+	Expect(msg.Group.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(msg.Group.Gaddr.String()).To(Equal("232.1.1.1"))
+	Expect(msg.Group.NSrcs).To(BeEquivalentTo(2))
+	Expect(msg.Group.Saddrs).To(Equal([]ip_types.IP4Address{{10, 0, 0, 1}, {10, 0, 0, 2}}))
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpListenReply{})
+	err = igmpHandler.LeaveIgmpGroup("if2", group)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_igmp.IgmpListen)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Group.Filter).To(Equal(vpp_igmp.EXCLUDE))
+
+	// invalid (IPv6) source address
+	err = igmpHandler.JoinIgmpGroup("if2", &igmp.Interface_Group{
+		GroupAddress:    "232.1.1.1",
+		SourceAddresses: []string{"2001:db8::1"},
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestIgmpProxyDevice(t *testing.T) {
+	ctx, igmpHandler := igmpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpProxyDeviceAddDelReply{})
+	err := igmpHandler.AddIgmpProxyDevice(2, "if2")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	devMsg, ok := ctx.MockChannel.Msg.(*vpp_igmp.IgmpProxyDeviceAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(devMsg.Add).To(BeEquivalentTo(1))
+	Expect(devMsg.VrfID).To(BeEquivalentTo(2))
+	Expect(devMsg.SwIfIndex).To(BeEquivalentTo(2))
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpProxyDeviceAddDelInterfaceReply{})
+	err = igmpHandler.AddIgmpProxyDeviceInterface(2, "if1")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ifMsg, ok := ctx.MockChannel.Msg.(*vpp_igmp.IgmpProxyDeviceAddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(ifMsg.Add).To(BeTrue())
+	Expect(ifMsg.VrfID).To(BeEquivalentTo(2))
+	Expect(ifMsg.SwIfIndex).To(BeEquivalentTo(1))
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpProxyDeviceAddDelInterfaceReply{})
+	err = igmpHandler.DelIgmpProxyDeviceInterface(2, "if1")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpProxyDeviceAddDelReply{})
+	err = igmpHandler.DelIgmpProxyDevice(2, "if2")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	devMsg, ok = ctx.MockChannel.Msg.(*vpp_igmp.IgmpProxyDeviceAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(devMsg.Add).To(BeEquivalentTo(0))
+}
+
+func TestDumpIgmpGroups(t *testing.T) {
+	ctx, igmpHandler := igmpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_igmp.IgmpDetails{
+		SwIfIndex: 1,
+		Saddr:     ip_types.IP4Address{10, 0, 0, 1},
+		Gaddr:     ip_types.IP4Address{232, 1, 1, 1},
+	}, &vpp_igmp.IgmpDetails{
+		SwIfIndex: 2,
+		Saddr:     ip_types.IP4Address{10, 0, 0, 2},
+		Gaddr:     ip_types.IP4Address{232, 1, 1, 2},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	groups, err := igmpHandler.DumpIgmpGroups()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(groups).To(Equal([]*vppcalls.IgmpGroupDetails{
+		{
+			Interface:     "if1",
+			SwIfIndex:     1,
+			GroupAddress:  "232.1.1.1",
+			SourceAddress: "10.0.0.1",
+		},
+		{
+			Interface:     "if2",
+			SwIfIndex:     2,
+			GroupAddress:  "232.1.1.2",
+			SourceAddress: "10.0.0.2",
+		},
+	}))
+}
+
+func igmpTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.IgmpVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test"), "test")
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	igmpHandler := vpp2210.NewIgmpVppHandler(ctx.MockChannel, ifIndexes, log)
+	return ctx, igmpHandler
+}
@@ -32,6 +32,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin/vppcalls/vpp2210"
 )
 
 // IPFIXPlugin is a plugin that manages IPFIX configuration in VPP.
@@ -76,13 +77,13 @@ func (p *IPFIXPlugin) Init() (err error) {
 	}
 
 	ipfixDescriptor := descriptor.NewIPFIXDescriptor(p.ipfixHandler, p.Log)
-	err = p.KVScheduler.RegisterKVDescriptor(ipfixDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, ipfixDescriptor)
 	if err != nil {
 		return err
 	}
 
 	fpFeatureDescriptor := descriptor.NewFPFeatureDescriptor(p.ipfixHandler, p.Log)
-	err = p.KVScheduler.RegisterKVDescriptor(fpFeatureDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, fpFeatureDescriptor)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (p *IPFIXPlugin) Init() (err error) {
 	fpFeatureMM := p.KVScheduler.GetMetadataMap(fpFeatureDescriptor.Name)
 
 	fpParamsDescriptor := descriptor.NewFPParamsDescriptor(p.ipfixHandler, fpFeatureMM, p.Log)
-	err = p.KVScheduler.RegisterKVDescriptor(fpParamsDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, fpParamsDescriptor)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,178 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	vppIfDescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+const (
+	// IKEv2ProfileDescriptorName is the name of the descriptor for VPP IKEv2 profiles.
+	IKEv2ProfileDescriptorName = "vpp-ikev2-profile"
+
+	// dependency labels
+	tunnelInterfaceDep    = "tunnel-interface-exists"
+	responderInterfaceDep = "responder-interface-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrIKEv2ProfileWithoutAuth is returned when IKEv2 profile was defined without authentication data.
+	ErrIKEv2ProfileWithoutAuth = errors.New("IKEv2 profile defined without authentication data")
+	// ErrIKEv2InvalidTsAddress is returned when traffic selector contains invalid IP address.
+	ErrIKEv2InvalidTsAddress = errors.New("invalid IP address in IKEv2 traffic selector")
+	// ErrIKEv2InvalidResponder is returned when responder address is not a valid IP address.
+	ErrIKEv2InvalidResponder = errors.New("invalid IKEv2 responder address")
+	// ErrIKEv2InitiateWithoutResponder is returned when initiation is requested without responder.
+	ErrIKEv2InitiateWithoutResponder = errors.New("IKEv2 negotiation cannot be initiated without responder")
+)
+
+// IKEv2ProfileDescriptor teaches KVScheduler how to configure VPP IKEv2 profiles.
+type IKEv2ProfileDescriptor struct {
+	// dependencies
+	log          logging.Logger
+	ikev2Handler vppcalls.IKEv2VppAPI
+}
+
+// NewIKEv2ProfileDescriptor creates a new instance of the IKEv2 profile descriptor.
+func NewIKEv2ProfileDescriptor(ikev2Handler vppcalls.IKEv2VppAPI, log logging.PluginLogger) *IKEv2ProfileDescriptor {
+	return &IKEv2ProfileDescriptor{
+		ikev2Handler: ikev2Handler,
+		log:          log.NewLogger("ikev2-profile-descriptor"),
+	}
+}
+
+// GetDescriptor returns a new IKEv2 profile descriptor suitable for registration with the KVScheduler.
+func (d *IKEv2ProfileDescriptor) GetDescriptor() *adapter.IKEv2ProfileDescriptor {
+	return &adapter.IKEv2ProfileDescriptor{
+		Name:                 IKEv2ProfileDescriptorName,
+		NBKeyPrefix:          ipsec.ModelIKEv2Profile.KeyPrefix(),
+		ValueTypeName:        ipsec.ModelIKEv2Profile.ProtoName(),
+		KeySelector:          ipsec.ModelIKEv2Profile.IsKeyValid,
+		KeyLabel:             ipsec.ModelIKEv2Profile.StripKeyPrefix,
+		Validate:             d.Validate,
+		Create:               d.Create,
+		Delete:               d.Delete,
+		Retrieve:             d.Retrieve,
+		Dependencies:         d.Dependencies,
+		RetrieveDependencies: []string{vppIfDescriptor.InterfaceDescriptorName},
+	}
+}
+
+// Validate validates IKEv2 profile configuration.
+func (d *IKEv2ProfileDescriptor) Validate(key string, profile *ipsec.IKEv2Profile) error {
+	if profile.GetAuth().GetData() == "" {
+		return kvs.NewInvalidValueError(ErrIKEv2ProfileWithoutAuth, "auth.data")
+	}
+	if err := validateTrafficSelector(profile.LocalTs); err != nil {
+		return kvs.NewInvalidValueError(err, "local_ts")
+	}
+	if err := validateTrafficSelector(profile.RemoteTs); err != nil {
+		return kvs.NewInvalidValueError(err, "remote_ts")
+	}
+	if profile.Responder != nil && net.ParseIP(profile.Responder.Address) == nil {
+		return kvs.NewInvalidValueError(ErrIKEv2InvalidResponder, "responder.address")
+	}
+	if profile.Initiate && profile.Responder == nil {
+		return kvs.NewInvalidValueError(ErrIKEv2InitiateWithoutResponder, "initiate", "responder")
+	}
+	return nil
+}
+
+// Create adds a new IKEv2 profile and optionally initiates the negotiation.
+func (d *IKEv2ProfileDescriptor) Create(key string, profile *ipsec.IKEv2Profile) (metadata interface{}, err error) {
+	if err = d.ikev2Handler.AddIKEv2Profile(profile); err != nil {
+		return nil, err
+	}
+	if profile.Initiate {
+		if err = d.ikev2Handler.InitiateIKEv2SA(profile.Name); err != nil {
+			// remove the profile so that the whole creation is retried
+			if delErr := d.ikev2Handler.DeleteIKEv2Profile(profile.Name); delErr != nil {
+				d.log.Warnf("failed to remove IKEv2 profile %s: %v", profile.Name, delErr)
+			}
+			return nil, errors.Wrapf(err, "failed to initiate IKEv2 negotiation for profile %s", profile.Name)
+		}
+	}
+	return nil, nil
+}
+
+// Delete removes IKEv2 profile.
+func (d *IKEv2ProfileDescriptor) Delete(key string, profile *ipsec.IKEv2Profile, metadata interface{}) error {
+	return d.ikev2Handler.DeleteIKEv2Profile(profile.Name)
+}
+
+// Retrieve returns all configured IKEv2 profiles.
+func (d *IKEv2ProfileDescriptor) Retrieve(correlate []adapter.IKEv2ProfileKVWithMetadata) (
+	dump []adapter.IKEv2ProfileKVWithMetadata, err error) {
+	// VPP does not dump all the attributes in the same form as they were
+	// configured (e.g. initiation), expected values are used for existing profiles
+	nbProfiles := make(map[string]*ipsec.IKEv2Profile)
+	for _, kv := range correlate {
+		nbProfiles[kv.Value.Name] = kv.Value
+	}
+
+	profiles, err := d.ikev2Handler.DumpIKEv2Profiles()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump IKEv2 profiles")
+	}
+	for _, profile := range profiles {
+		if nbProfile, ok := nbProfiles[profile.Name]; ok {
+			profile = nbProfile
+		}
+		dump = append(dump, adapter.IKEv2ProfileKVWithMetadata{
+			Key:    ipsec.IKEv2ProfileKey(profile.Name),
+			Value:  profile,
+			Origin: kvs.FromNB,
+		})
+	}
+	return dump, nil
+}
+
+// Dependencies lists the tunnel and responder interfaces as dependencies.
+func (d *IKEv2ProfileDescriptor) Dependencies(key string, profile *ipsec.IKEv2Profile) (deps []kvs.Dependency) {
+	if profile.TunnelInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: tunnelInterfaceDep,
+			Key:   interfaces.InterfaceKey(profile.TunnelInterface),
+		})
+	}
+	if profile.GetResponder().GetInterface() != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: responderInterfaceDep,
+			Key:   interfaces.InterfaceKey(profile.Responder.Interface),
+		})
+	}
+	return deps
+}
+
+func validateTrafficSelector(ts *ipsec.IKEv2Profile_TrafficSelector) error {
+	if ts == nil {
+		return nil
+	}
+	if net.ParseIP(ts.StartAddr) == nil || net.ParseIP(ts.EndAddr) == nil {
+		return ErrIKEv2InvalidTsAddress
+	}
+	return nil
+}
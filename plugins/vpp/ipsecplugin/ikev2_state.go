@@ -0,0 +1,149 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecplugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.ligato.io/cn-infra/v2/datasync"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// IKEv2StatePollPeriod is the period between dumps of the negotiated IKE security associations.
+var IKEv2StatePollPeriod = time.Second * 10
+
+// ikev2StatePublisher periodically publishes state of the IKE security associations
+// for every IKEv2 profile.
+type ikev2StatePublisher struct {
+	log       logging.Logger
+	handler   vppcalls.IKEv2VppRead
+	publisher datasync.KeyProtoValWriter
+
+	// last published state for each profile
+	published map[string]*ipsec.IKEv2ProfileState
+	// time when the child SA was seen for the first time (VPP does not
+	// provide the remaining lifetime of child SAs)
+	childSeen map[string]time.Time
+	// now is replaceable in tests
+	now func() time.Time
+}
+
+func newIKEv2StatePublisher(handler vppcalls.IKEv2VppRead, publisher datasync.KeyProtoValWriter,
+	log logging.Logger) *ikev2StatePublisher {
+	return &ikev2StatePublisher{
+		log:       log,
+		handler:   handler,
+		publisher: publisher,
+		published: make(map[string]*ipsec.IKEv2ProfileState),
+		childSeen: make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// watch publishes the state periodically until the context is cancelled.
+func (s *ikev2StatePublisher) watch(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(IKEv2StatePollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.publish(); err != nil {
+				s.log.Warnf("failed to publish IKEv2 state: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// publish dumps IKE security associations and publishes the state of the profiles
+// that has changed since the last publishing.
+func (s *ikev2StatePublisher) publish() error {
+	profiles, err := s.handler.DumpIKEv2Profiles()
+	if err != nil {
+		return err
+	}
+	saList, err := s.handler.DumpIKEv2SAs()
+	if err != nil {
+		return err
+	}
+
+	states := make(map[string]*ipsec.IKEv2ProfileState)
+	lifetimes := make(map[string]uint64)
+	for _, profile := range profiles {
+		states[profile.Name] = &ipsec.IKEv2ProfileState{Name: profile.Name}
+		lifetimes[profile.Name] = profile.Lifetime
+	}
+
+	now := s.now()
+	childSeen := make(map[string]time.Time)
+	for _, details := range saList {
+		state, ok := states[details.Profile]
+		if !ok {
+			continue
+		}
+		for _, child := range details.Sa.ChildSas {
+			childKey := fmt.Sprintf("%s/%d/%d/%d", details.Profile, details.Sa.Index, child.Index, child.InitiatorSpi)
+			seen, ok := s.childSeen[childKey]
+			if !ok {
+				seen = now
+			}
+			childSeen[childKey] = seen
+			child.LifetimeRemaining = remainingLifetime(lifetimes[details.Profile], now.Sub(seen))
+		}
+		state.Sas = append(state.Sas, details.Sa)
+	}
+	s.childSeen = childSeen
+
+	for name, state := range states {
+		if proto.Equal(s.published[name], state) {
+			continue
+		}
+		if err := s.publisher.Put(ipsec.IKEv2ProfileStateKey(name), state); err != nil {
+			return err
+		}
+		s.published[name] = state
+	}
+	for name := range s.published {
+		if _, exists := states[name]; exists {
+			continue
+		}
+		if err := s.publisher.Put(ipsec.IKEv2ProfileStateKey(name), nil); err != nil {
+			return err
+		}
+		delete(s.published, name)
+	}
+	return nil
+}
+
+// remainingLifetime returns the number of seconds remaining until the SA
+// with the given lifetime is rekeyed, 0 if the lifetime is not known.
+func remainingLifetime(lifetime uint64, elapsed time.Duration) uint64 {
+	elapsedSec := uint64(elapsed / time.Second)
+	if lifetime == 0 || elapsedSec >= lifetime {
+		return 0
+	}
+	return lifetime - elapsedSec
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecplugin
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/datasync"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+type mockIKEv2Handler struct {
+	profiles []*ipsec.IKEv2Profile
+	sas      []*vppcalls.IKEv2SaDetails
+}
+
+func (h *mockIKEv2Handler) DumpIKEv2Profiles() ([]*ipsec.IKEv2Profile, error) {
+	return h.profiles, nil
+}
+
+func (h *mockIKEv2Handler) DumpIKEv2SAs() ([]*vppcalls.IKEv2SaDetails, error) {
+	// the publisher modifies the dumped SAs
+	var sas []*vppcalls.IKEv2SaDetails
+	for _, sa := range h.sas {
+		sas = append(sas, &vppcalls.IKEv2SaDetails{
+			Profile: sa.Profile,
+			Sa:      proto.Clone(sa.Sa).(*ipsec.IKEv2ProfileState_SA),
+		})
+	}
+	return sas, nil
+}
+
+type mockStatePublisher struct {
+	published map[string]proto.Message
+	puts      int
+}
+
+func (p *mockStatePublisher) Put(key string, data proto.Message, opts ...datasync.PutOption) error {
+	p.puts++
+	if data == nil {
+		delete(p.published, key)
+		return nil
+	}
+	p.published[key] = data
+	return nil
+}
+
+func (p *mockStatePublisher) childLifetimes(profile string) map[uint32]uint64 {
+	lifetimes := make(map[uint32]uint64)
+	state, _ := p.published[ipsec.IKEv2ProfileStateKey(profile)].(*ipsec.IKEv2ProfileState)
+	for _, sa := range state.GetSas() {
+		for _, child := range sa.GetChildSas() {
+			lifetimes[child.GetIndex()] = child.GetLifetimeRemaining()
+		}
+	}
+	return lifetimes
+}
+
+func ikev2SA(profile string, children ...uint32) *vppcalls.IKEv2SaDetails {
+	sa := &ipsec.IKEv2ProfileState_SA{Index: 1, InitiatorSpi: 0x1234}
+	for _, child := range children {
+		sa.ChildSas = append(sa.ChildSas, &ipsec.IKEv2ProfileState_ChildSA{
+			Index:        child,
+			InitiatorSpi: 100 + child,
+		})
+	}
+	return &vppcalls.IKEv2SaDetails{Profile: profile, Sa: sa}
+}
+
+func TestIKEv2StateLifetime(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := &mockIKEv2Handler{
+		profiles: []*ipsec.IKEv2Profile{
+			{Name: "p1", Lifetime: 3600},
+			{Name: "p2"},
+		},
+		sas: []*vppcalls.IKEv2SaDetails{
+			ikev2SA("p1", 1),
+			ikev2SA("unknown-profile", 1),
+		},
+	}
+	publisher := &mockStatePublisher{published: make(map[string]proto.Message)}
+	s := newIKEv2StatePublisher(handler, publisher, logrus.NewLogger("test-log"))
+	start := time.Now()
+	now := start
+	s.now = func() time.Time { return now }
+
+	// child SA seen for the first time has the full lifetime
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.published).To(HaveLen(2))
+	Expect(publisher.childLifetimes("p1")).To(Equal(map[uint32]uint64{1: 3600}))
+	Expect(publisher.puts).To(Equal(2))
+
+	// lifetime is counted from the first time the child SA was seen,
+	// unchanged state of p2 is not published again
+	now = start.Add(100 * time.Second)
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.childLifetimes("p1")).To(Equal(map[uint32]uint64{1: 3500}))
+	Expect(publisher.puts).To(Equal(3))
+
+	// nothing is published when the state has not changed
+	now = start.Add(100*time.Second + 500*time.Millisecond)
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.puts).To(Equal(3))
+
+	// expired child SA waiting for rekey
+	now = start.Add(4000 * time.Second)
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.childLifetimes("p1")).To(Equal(map[uint32]uint64{1: 0}))
+
+	// rekeyed child SA replaces the expired one with the full lifetime
+	handler.sas = []*vppcalls.IKEv2SaDetails{ikev2SA("p1", 2)}
+	now = start.Add(4010 * time.Second)
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.childLifetimes("p1")).To(Equal(map[uint32]uint64{2: 3600}))
+	Expect(s.childSeen).To(HaveLen(1))
+
+	// state of removed profile is removed
+	handler.profiles = handler.profiles[:1]
+	Expect(s.publish()).To(Succeed())
+	Expect(publisher.published).To(HaveLen(1))
+	Expect(publisher.published).To(HaveKey(ipsec.IKEv2ProfileStateKey("p1")))
+}
+
+func TestRemainingLifetime(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(remainingLifetime(0, time.Minute)).To(BeZero())
+	Expect(remainingLifetime(3600, 0)).To(BeEquivalentTo(3600))
+	Expect(remainingLifetime(3600, 1500*time.Millisecond)).To(BeEquivalentTo(3599))
+	Expect(remainingLifetime(3600, time.Hour)).To(BeZero())
+	Expect(remainingLifetime(3600, 2*time.Hour)).To(BeZero())
+}
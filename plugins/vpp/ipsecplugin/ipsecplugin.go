@@ -17,11 +17,16 @@
 //go:generate descriptor-adapter --descriptor-name SP --value-type *vpp_ipsec.SecurityPolicy --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name SA  --value-type *vpp_ipsec.SecurityAssociation --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name TunProtect --value-type *vpp_ipsec.TunnelProtection --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IKEv2Profile --value-type *vpp_ipsec.IKEv2Profile --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec" --output-dir "descriptor"
 
 package ipsecplugin
 
 import (
+	"context"
+	"sync"
+
 	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/datasync"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
 
@@ -37,6 +42,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2210"
 )
 
 func init() {
@@ -47,23 +53,32 @@ func init() {
 type IPSecPlugin struct {
 	Deps
 
-	// handler
+	// handlers
 	ipSecHandler vppcalls.IPSecVppAPI
+	ikev2Handler vppcalls.IKEv2VppAPI
 
 	// descriptors
 	spdDescriptor        *descriptor.IPSecSPDDescriptor
 	saDescriptor         *descriptor.IPSecSADescriptor
 	spdIfDescriptor      *descriptor.SPDInterfaceDescriptor
 	tunProtectDescriptor *descriptor.TunnelProtectDescriptor
+	ikev2Descriptor      *descriptor.IKEv2ProfileDescriptor
+
+	// state publishing
+	ikev2State *ikev2StatePublisher
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
 }
 
 // Deps lists dependencies of the IPSec plugin.
 type Deps struct {
 	infra.PluginDeps
-	KVScheduler kvs.KVScheduler
-	VPP         govppmux.API
-	IfPlugin    ifplugin.API
-	StatusCheck statuscheck.PluginStatusWriter // optional
+	KVScheduler  kvs.KVScheduler
+	VPP          govppmux.API
+	IfPlugin     ifplugin.API
+	StatusCheck  statuscheck.PluginStatusWriter // optional
+	PublishState datasync.KeyProtoValWriter     // optional
 }
 
 // Init registers IPSec-related descriptors.
@@ -77,14 +92,14 @@ func (p *IPSecPlugin) Init() (err error) {
 	// init and register security policy database descriptor
 	p.spdDescriptor = descriptor.NewIPSecSPDDescriptor(p.ipSecHandler, p.Log)
 	spdDescriptor := adapter.NewSPDDescriptor(p.spdDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(spdDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, spdDescriptor)
 	if err != nil {
 		return err
 	}
 
 	// init and register security policy descriptor
 	spDescriptor := descriptor.NewIPSecSPDescriptor(p.ipSecHandler, p.Log)
-	err = p.KVScheduler.RegisterKVDescriptor(spDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, spDescriptor)
 	if err != nil {
 		return err
 	}
@@ -92,7 +107,7 @@ func (p *IPSecPlugin) Init() (err error) {
 	// init and register security association descriptor
 	p.saDescriptor = descriptor.NewIPSecSADescriptor(p.ipSecHandler, p.Log)
 	saDescriptor := adapter.NewSADescriptor(p.saDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(saDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, saDescriptor)
 	if err != nil {
 		return err
 	}
@@ -100,7 +115,7 @@ func (p *IPSecPlugin) Init() (err error) {
 	// init and register tunnel protection descriptor
 	p.tunProtectDescriptor = descriptor.NewTunnelProtectDescriptor(p.ipSecHandler, p.Log)
 	tunProtectDescriptor := adapter.NewTunProtectDescriptor(p.tunProtectDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(tunProtectDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, tunProtectDescriptor)
 	if err != nil {
 		return err
 	}
@@ -108,12 +123,29 @@ func (p *IPSecPlugin) Init() (err error) {
 	// init & register other descriptors for derived types
 	p.spdIfDescriptor = descriptor.NewSPDInterfaceDescriptor(p.ipSecHandler, p.Log)
 	spdIfDescriptor := adapter.NewSPDInterfaceDescriptor(p.spdIfDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(spdIfDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, spdIfDescriptor)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return p.initIKEv2()
+}
+
+// initIKEv2 registers IKEv2 profile descriptor if the IKEv2 plugin is loaded in VPP.
+func (p *IPSecPlugin) initIKEv2() error {
+	if !p.VPP.IsPluginLoaded("ikev2") {
+		p.Log.Warnf("VPP plugin ikev2 was disabled by VPP")
+		return nil
+	}
+	p.ikev2Handler = vppcalls.CompatibleIKEv2VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.ikev2Handler == nil {
+		p.Log.Warnf("IKEv2 handler is not available for the connected VPP version")
+		return nil
+	}
+
+	p.ikev2Descriptor = descriptor.NewIKEv2ProfileDescriptor(p.ikev2Handler, p.Log)
+	ikev2Descriptor := adapter.NewIKEv2ProfileDescriptor(p.ikev2Descriptor.GetDescriptor())
+	return p.VPP.RegisterKVDescriptor(p.KVScheduler, ikev2Descriptor)
 }
 
 // AfterInit registers plugin with StatusCheck.
@@ -121,5 +153,22 @@ func (p *IPSecPlugin) AfterInit() error {
 	if p.StatusCheck != nil {
 		p.StatusCheck.Register(p.PluginName, nil)
 	}
+
+	// publish state of negotiated IKE security associations
+	if p.ikev2Handler != nil && p.PublishState != nil {
+		p.ctx, p.cancel = context.WithCancel(context.Background())
+		p.ikev2State = newIKEv2StatePublisher(p.ikev2Handler, p.PublishState, p.Log)
+		p.wg.Add(1)
+		go p.ikev2State.watch(p.ctx, &p.wg)
+	}
+	return nil
+}
+
+// Close stops publishing of the IKEv2 state.
+func (p *IPSecPlugin) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
 	return nil
 }
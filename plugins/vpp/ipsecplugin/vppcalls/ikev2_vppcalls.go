@@ -0,0 +1,87 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// IKEv2SaDetails holds IKE security association negotiated by VPP
+// together with the name of the profile it belongs to.
+type IKEv2SaDetails struct {
+	// Profile is empty if the SA could not be matched with any profile.
+	Profile string
+	Sa      *ipsec.IKEv2ProfileState_SA
+}
+
+// IKEv2VppAPI provides methods for managing IKEv2 profiles
+type IKEv2VppAPI interface {
+	IKEv2VppRead
+
+	// AddIKEv2Profile creates IKEv2 profile with all its attributes
+	AddIKEv2Profile(profile *ipsec.IKEv2Profile) error
+	// DeleteIKEv2Profile removes IKEv2 profile
+	DeleteIKEv2Profile(name string) error
+	// InitiateIKEv2SA starts IKE negotiation towards the responder of the profile
+	InitiateIKEv2SA(name string) error
+}
+
+// IKEv2VppRead provides read methods for IKEv2
+type IKEv2VppRead interface {
+	// DumpIKEv2Profiles returns a list of configured IKEv2 profiles
+	DumpIKEv2Profiles() ([]*ipsec.IKEv2Profile, error)
+	// DumpIKEv2SAs returns a list of negotiated IKE security associations
+	// including their child SAs
+	DumpIKEv2SAs() ([]*IKEv2SaDetails, error)
+}
+
+var IKEv2Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "ikev2",
+	HandlerAPI: (*IKEv2VppAPI)(nil),
+})
+
+type NewIKEv2HandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) IKEv2VppAPI
+
+func AddIKEv2HandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewIKEv2HandlerFunc) {
+	IKEv2Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleIKEv2VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) IKEv2VppAPI {
+	if v := IKEv2Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(IKEv2VppAPI)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 
 	vpp2202 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202"
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ikev2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
@@ -33,6 +34,7 @@ func init() {
 	msgs = append(msgs, vpp_ipsec.AllMessages()...)
 
 	vppcalls.AddHandlerVersion(vpp2202.Version, msgs, NewIPSecVppHandler)
+	vppcalls.AddIKEv2HandlerVersion(vpp2202.Version, vpp_ikev2.AllMessages(), NewIKEv2VppHandler)
 }
 
 // IPSecVppHandler is accessor for IPSec-related vppcalls methods
@@ -46,6 +48,17 @@ func NewIPSecVppHandler(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex,
 	return &IPSecVppHandler{ch, ifIdx, log}
 }
 
+// IKEv2VppHandler is accessor for IKEv2-related vppcalls methods
+type IKEv2VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+func NewIKEv2VppHandler(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.IKEv2VppAPI {
+	return &IKEv2VppHandler{ch, ifIdx, log}
+}
+
 func ipsecAddrToIP(addr ip_types.Address) net.IP {
 	if addr.Af == ip_types.ADDRESS_IP6 {
 		addrIP := addr.Un.GetIP6()
@@ -0,0 +1,285 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"net"
+
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// DumpIKEv2Profiles implements IKEv2 handler.
+func (h *IKEv2VppHandler) DumpIKEv2Profiles() (profiles []*ipsec.IKEv2Profile, err error) {
+	vppProfiles, err := h.dumpProfiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, vppProfile := range vppProfiles {
+		profiles = append(profiles, h.profileFromVpp(vppProfile))
+	}
+	return profiles, nil
+}
+
+// DumpIKEv2SAs implements IKEv2 handler.
+func (h *IKEv2VppHandler) DumpIKEv2SAs() (saList []*vppcalls.IKEv2SaDetails, err error) {
+	vppProfiles, err := h.dumpProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var vppSAs []ikev2_types.Ikev2Sa
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2SaDump{})
+	for {
+		saDetails := &vpp_ikev2.Ikev2SaDetails{}
+		stop, err := reqCtx.ReceiveReply(saDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		vppSAs = append(vppSAs, saDetails.Sa)
+	}
+
+	for _, vppSA := range vppSAs {
+		sa := &ipsec.IKEv2ProfileState_SA{
+			Index:         vppSA.SaIndex,
+			InitiatorSpi:  vppSA.Ispi,
+			ResponderSpi:  vppSA.Rspi,
+			InitiatorAddr: ipsecAddrToIP(vppSA.Iaddr).String(),
+			ResponderAddr: ipsecAddrToIP(vppSA.Raddr).String(),
+			RekeyRequests: uint32(vppSA.Stats.NRekeyReq),
+			Retransmits:   uint32(vppSA.Stats.NRetransmit),
+		}
+		sa.ChildSas, err = h.dumpChildSAs(vppSA.SaIndex)
+		if err != nil {
+			return nil, err
+		}
+		var profileName string
+		for _, vppProfile := range vppProfiles {
+			if saMatchesProfile(vppSA, vppProfile) {
+				profileName = vppProfile.Name
+				break
+			}
+		}
+		saList = append(saList, &vppcalls.IKEv2SaDetails{
+			Profile: profileName,
+			Sa:      sa,
+		})
+	}
+
+	return saList, nil
+}
+
+func (h *IKEv2VppHandler) dumpProfiles() (profiles []ikev2_types.Ikev2Profile, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2ProfileDump{})
+	for {
+		profileDetails := &vpp_ikev2.Ikev2ProfileDetails{}
+		stop, err := reqCtx.ReceiveReply(profileDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profileDetails.Profile)
+	}
+	return profiles, nil
+}
+
+func (h *IKEv2VppHandler) dumpChildSAs(saIndex uint32) (childSAs []*ipsec.IKEv2ProfileState_ChildSA, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ikev2.Ikev2ChildSaDump{
+		SaIndex: saIndex,
+	})
+	for {
+		childDetails := &vpp_ikev2.Ikev2ChildSaDetails{}
+		stop, err := reqCtx.ReceiveReply(childDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		childSAs = append(childSAs, &ipsec.IKEv2ProfileState_ChildSA{
+			Index:        childDetails.ChildSa.ChildSaIndex,
+			InitiatorSpi: childDetails.ChildSa.ISpi,
+			ResponderSpi: childDetails.ChildSa.RSpi,
+		})
+	}
+	return childSAs, nil
+}
+
+func (h *IKEv2VppHandler) profileFromVpp(vppProfile ikev2_types.Ikev2Profile) *ipsec.IKEv2Profile {
+	profile := &ipsec.IKEv2Profile{
+		Name:            vppProfile.Name,
+		LocalId:         identityFromVpp(vppProfile.LocID),
+		RemoteId:        identityFromVpp(vppProfile.RemID),
+		LocalTs:         trafficSelectorFromVpp(vppProfile.LocTs),
+		RemoteTs:        trafficSelectorFromVpp(vppProfile.RemTs),
+		Lifetime:        vppProfile.Lifetime,
+		LifetimeJitter:  vppProfile.LifetimeJitter,
+		Handover:        vppProfile.Handover,
+		LifetimeMaxdata: vppProfile.LifetimeMaxdata,
+		UdpEncap:        vppProfile.UDPEncap,
+	}
+	if len(vppProfile.Auth.Data) > 0 {
+		method := ipsec.IKEv2Profile_Authentication_SHARED_KEY
+		if vppProfile.Auth.Method == ikev2AuthMethodRsaSig {
+			method = ipsec.IKEv2Profile_Authentication_RSA_SIG
+		}
+		profile.Auth = &ipsec.IKEv2Profile_Authentication{
+			Method: method,
+			Data:   string(vppProfile.Auth.Data),
+			IsHex:  vppProfile.Auth.Hex != 0,
+		}
+	}
+	if responderIP := ipsecAddrToIP(vppProfile.Responder.Addr); !responderIP.IsUnspecified() {
+		profile.Responder = &ipsec.IKEv2Profile_Responder{
+			Interface: h.ifaceName(uint32(vppProfile.Responder.SwIfIndex)),
+			Address:   responderIP.String(),
+		}
+	}
+	if vppProfile.IkeTs.CryptoAlg != 0 {
+		profile.IkeTransforms = &ipsec.IKEv2Profile_Transforms{
+			CryptoAlg:     encrAlgFromVpp(vppProfile.IkeTs.CryptoAlg),
+			CryptoKeySize: vppProfile.IkeTs.CryptoKeySize,
+			IntegAlg:      integAlgFromVpp(vppProfile.IkeTs.IntegAlg),
+			DhGroup:       dhGroupFromVpp(vppProfile.IkeTs.DhGroup),
+		}
+	}
+	if vppProfile.EspTs.CryptoAlg != 0 {
+		profile.EspTransforms = &ipsec.IKEv2Profile_Transforms{
+			CryptoAlg:     encrAlgFromVpp(vppProfile.EspTs.CryptoAlg),
+			CryptoKeySize: vppProfile.EspTs.CryptoKeySize,
+			IntegAlg:      integAlgFromVpp(vppProfile.EspTs.IntegAlg),
+		}
+	}
+	profile.TunnelInterface = h.ifaceName(vppProfile.TunItf)
+	return profile
+}
+
+// ifaceName returns name of the interface with the given index or empty
+// string if the interface is not set or not known.
+func (h *IKEv2VppHandler) ifaceName(swIfIndex uint32) string {
+	if swIfIndex == ^uint32(0) {
+		return ""
+	}
+	name, _, found := h.ifIndexes.LookupBySwIfIndex(swIfIndex)
+	if !found {
+		h.log.Warnf("IKEv2 profile refers to unknown interface with index %d", swIfIndex)
+		return ""
+	}
+	return name
+}
+
+func identityFromVpp(id ikev2_types.Ikev2ID) *ipsec.IKEv2Profile_Identity {
+	data := identityData(id)
+	if id.Type == 0 || len(data) == 0 {
+		return nil
+	}
+	switch id.Type {
+	case ikev2IDTypeIPv4Addr, ikev2IDTypeIPv6Addr:
+		idType := ipsec.IKEv2Profile_Identity_IPV4_ADDR
+		if id.Type == ikev2IDTypeIPv6Addr {
+			idType = ipsec.IKEv2Profile_Identity_IPV6_ADDR
+		}
+		return &ipsec.IKEv2Profile_Identity{
+			Type: idType,
+			Data: net.IP(data).String(),
+		}
+	case ikev2IDTypeRFC822Addr:
+		return &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_RFC822_ADDR,
+			Data: string(data),
+		}
+	case ikev2IDTypeKeyID:
+		return &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_KEY_ID,
+			Data: string(data),
+		}
+	default:
+		return &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_FQDN,
+			Data: string(data),
+		}
+	}
+}
+
+// identityData returns the significant part of the identification data.
+func identityData(id ikev2_types.Ikev2ID) []byte {
+	data := []byte(id.Data)
+	if int(id.DataLen) < len(data) {
+		data = data[:id.DataLen]
+	}
+	return data
+}
+
+func trafficSelectorFromVpp(ts ikev2_types.Ikev2Ts) *ipsec.IKEv2Profile_TrafficSelector {
+	startAddr, endAddr := ipsecAddrToIP(ts.StartAddr), ipsecAddrToIP(ts.EndAddr)
+	if startAddr.IsUnspecified() && endAddr.IsUnspecified() && ts.EndPort == 0 {
+		return nil
+	}
+	return &ipsec.IKEv2Profile_TrafficSelector{
+		Protocol:  uint32(ts.ProtocolID),
+		StartPort: uint32(ts.StartPort),
+		EndPort:   uint32(ts.EndPort),
+		StartAddr: startAddr.String(),
+		EndAddr:   endAddr.String(),
+	}
+}
+
+// saMatchesProfile returns true if the identities exchanged in the SA
+// are those configured in the profile (VPP does not dump the profile index
+// in a form that could be mapped to the profile name).
+func saMatchesProfile(sa ikev2_types.Ikev2Sa, profile ikev2_types.Ikev2Profile) bool {
+	if sameIdentity(sa.IID, profile.LocID) && sameIdentity(sa.RID, profile.RemID) {
+		return true
+	}
+	return sameIdentity(sa.IID, profile.RemID) && sameIdentity(sa.RID, profile.LocID)
+}
+
+func sameIdentity(a, b ikev2_types.Ikev2ID) bool {
+	return a.Type == b.Type && string(identityData(a)) == string(identityData(b))
+}
+
+func encrAlgFromVpp(alg uint8) ipsec.IKEv2Profile_Transforms_EncryptionAlg {
+	for nbAlg, vppAlg := range ikev2EncrAlgs {
+		if vppAlg == alg {
+			return nbAlg
+		}
+	}
+	return ipsec.IKEv2Profile_Transforms_ENCR_AES_CBC
+}
+
+func integAlgFromVpp(alg uint8) ipsec.IKEv2Profile_Transforms_IntegrityAlg {
+	for nbAlg, vppAlg := range ikev2IntegAlgs {
+		if vppAlg == alg {
+			return nbAlg
+		}
+	}
+	return ipsec.IKEv2Profile_Transforms_AUTH_NONE
+}
+
+func dhGroupFromVpp(group uint8) ipsec.IKEv2Profile_Transforms_DhGroup {
+	for nbGroup, vppGroup := range ikev2DhGroups {
+		if vppGroup == group {
+			return nbGroup
+		}
+	}
+	return ipsec.IKEv2Profile_Transforms_DH_NONE
+}
@@ -0,0 +1,336 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+// IKEv2 authentication methods (vpp/src/plugins/ikev2/ikev2.h)
+const (
+	ikev2AuthMethodRsaSig       uint8 = 1
+	ikev2AuthMethodSharedKeyMic uint8 = 2
+)
+
+// IKEv2 identification types (vpp/src/plugins/ikev2/ikev2.h)
+const (
+	ikev2IDTypeIPv4Addr   uint8 = 1
+	ikev2IDTypeFQDN       uint8 = 2
+	ikev2IDTypeRFC822Addr uint8 = 3
+	ikev2IDTypeIPv6Addr   uint8 = 5
+	ikev2IDTypeKeyID      uint8 = 11
+)
+
+// IKEv2 transform IDs as assigned by IANA
+var (
+	ikev2EncrAlgs = map[ipsec.IKEv2Profile_Transforms_EncryptionAlg]uint8{
+		ipsec.IKEv2Profile_Transforms_ENCR_AES_CBC:    12,
+		ipsec.IKEv2Profile_Transforms_ENCR_AES_CTR:    13,
+		ipsec.IKEv2Profile_Transforms_ENCR_AES_GCM_16: 20,
+	}
+	ikev2IntegAlgs = map[ipsec.IKEv2Profile_Transforms_IntegrityAlg]uint8{
+		ipsec.IKEv2Profile_Transforms_AUTH_NONE:              0,
+		ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA1_96:      2,
+		ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA2_256_128: 12,
+		ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA2_384_192: 13,
+		ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA2_512_256: 14,
+	}
+	ikev2DhGroups = map[ipsec.IKEv2Profile_Transforms_DhGroup]uint8{
+		ipsec.IKEv2Profile_Transforms_DH_NONE:   0,
+		ipsec.IKEv2Profile_Transforms_MODP_1024: 2,
+		ipsec.IKEv2Profile_Transforms_MODP_1536: 5,
+		ipsec.IKEv2Profile_Transforms_MODP_2048: 14,
+		ipsec.IKEv2Profile_Transforms_MODP_3072: 15,
+		ipsec.IKEv2Profile_Transforms_MODP_4096: 16,
+		ipsec.IKEv2Profile_Transforms_ECP_256:   19,
+		ipsec.IKEv2Profile_Transforms_ECP_384:   20,
+		ipsec.IKEv2Profile_Transforms_ECP_521:   21,
+	}
+)
+
+// AddIKEv2Profile implements IKEv2 handler.
+func (h *IKEv2VppHandler) AddIKEv2Profile(profile *ipsec.IKEv2Profile) error {
+	if err := h.profileAddDel(profile.Name, true); err != nil {
+		return err
+	}
+	if err := h.setProfileAttributes(profile); err != nil {
+		if delErr := h.profileAddDel(profile.Name, false); delErr != nil {
+			h.log.Warnf("failed to remove partially configured IKEv2 profile %s: %v", profile.Name, delErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// DeleteIKEv2Profile implements IKEv2 handler.
+func (h *IKEv2VppHandler) DeleteIKEv2Profile(name string) error {
+	return h.profileAddDel(name, false)
+}
+
+// InitiateIKEv2SA implements IKEv2 handler.
+func (h *IKEv2VppHandler) InitiateIKEv2SA(name string) error {
+	req := &vpp_ikev2.Ikev2InitiateSaInit{
+		Name: name,
+	}
+	reply := &vpp_ikev2.Ikev2InitiateSaInitReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) profileAddDel(name string, isAdd bool) error {
+	req := &vpp_ikev2.Ikev2ProfileAddDel{
+		Name:  name,
+		IsAdd: isAdd,
+	}
+	reply := &vpp_ikev2.Ikev2ProfileAddDelReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setProfileAttributes(profile *ipsec.IKEv2Profile) error {
+	if auth := profile.GetAuth(); auth != nil {
+		if err := h.profileSetAuth(profile.Name, auth); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 authentication")
+		}
+	}
+	if profile.GetLocalId() != nil {
+		if err := h.profileSetID(profile.Name, profile.LocalId, true); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 local ID")
+		}
+	}
+	if profile.GetRemoteId() != nil {
+		if err := h.profileSetID(profile.Name, profile.RemoteId, false); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 remote ID")
+		}
+	}
+	if profile.GetLocalTs() != nil {
+		if err := h.profileSetTs(profile.Name, profile.LocalTs, true); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 local traffic selector")
+		}
+	}
+	if profile.GetRemoteTs() != nil {
+		if err := h.profileSetTs(profile.Name, profile.RemoteTs, false); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 remote traffic selector")
+		}
+	}
+	if profile.GetResponder() != nil {
+		if err := h.setResponder(profile.Name, profile.Responder); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 responder")
+		}
+	}
+	if profile.GetIkeTransforms() != nil {
+		if err := h.setIkeTransforms(profile.Name, profile.IkeTransforms); err != nil {
+			return errors.Wrap(err, "failed to set IKE transforms")
+		}
+	}
+	if profile.GetEspTransforms() != nil {
+		if err := h.setEspTransforms(profile.Name, profile.EspTransforms); err != nil {
+			return errors.Wrap(err, "failed to set ESP transforms")
+		}
+	}
+	if profile.Lifetime != 0 || profile.LifetimeJitter != 0 || profile.Handover != 0 || profile.LifetimeMaxdata != 0 {
+		if err := h.setSaLifetime(profile); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 SA lifetime")
+		}
+	}
+	if profile.TunnelInterface != "" {
+		if err := h.setTunnelInterface(profile.Name, profile.TunnelInterface); err != nil {
+			return errors.Wrap(err, "failed to set IKEv2 tunnel interface")
+		}
+	}
+	if profile.UdpEncap {
+		req := &vpp_ikev2.Ikev2ProfileSetUDPEncap{
+			Name: profile.Name,
+		}
+		reply := &vpp_ikev2.Ikev2ProfileSetUDPEncapReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return errors.Wrap(err, "failed to enable UDP encapsulation")
+		}
+	}
+	return nil
+}
+
+func (h *IKEv2VppHandler) profileSetAuth(name string, auth *ipsec.IKEv2Profile_Authentication) error {
+	method := ikev2AuthMethodSharedKeyMic
+	if auth.Method == ipsec.IKEv2Profile_Authentication_RSA_SIG {
+		method = ikev2AuthMethodRsaSig
+	}
+	data := []byte(auth.Data)
+	req := &vpp_ikev2.Ikev2ProfileSetAuth{
+		Name:       name,
+		AuthMethod: method,
+		IsHex:      auth.IsHex,
+		DataLen:    uint32(len(data)),
+		Data:       data,
+	}
+	reply := &vpp_ikev2.Ikev2ProfileSetAuthReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) profileSetID(name string, id *ipsec.IKEv2Profile_Identity, isLocal bool) error {
+	idType, data, err := identityToVpp(id)
+	if err != nil {
+		return err
+	}
+	req := &vpp_ikev2.Ikev2ProfileSetID{
+		Name:    name,
+		IsLocal: isLocal,
+		IDType:  idType,
+		DataLen: uint32(len(data)),
+		Data:    data,
+	}
+	reply := &vpp_ikev2.Ikev2ProfileSetIDReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) profileSetTs(name string, ts *ipsec.IKEv2Profile_TrafficSelector, isLocal bool) error {
+	startAddr, err := IPToAddress(ts.StartAddr)
+	if err != nil {
+		return err
+	}
+	endAddr, err := IPToAddress(ts.EndAddr)
+	if err != nil {
+		return err
+	}
+	req := &vpp_ikev2.Ikev2ProfileSetTs{
+		Name: name,
+		Ts: ikev2_types.Ikev2Ts{
+			IsLocal:    isLocal,
+			ProtocolID: uint8(ts.Protocol),
+			StartPort:  uint16(ts.StartPort),
+			EndPort:    uint16(ts.EndPort),
+			StartAddr:  startAddr,
+			EndAddr:    endAddr,
+		},
+	}
+	reply := &vpp_ikev2.Ikev2ProfileSetTsReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setResponder(name string, responder *ipsec.IKEv2Profile_Responder) error {
+	addr, err := IPToAddress(responder.Address)
+	if err != nil {
+		return err
+	}
+	swIfIndex := ^uint32(0)
+	if responder.Interface != "" {
+		ifaceMeta, found := h.ifIndexes.LookupByName(responder.Interface)
+		if !found {
+			return errors.Errorf("failed to get metadata for interface %s", responder.Interface)
+		}
+		swIfIndex = ifaceMeta.SwIfIndex
+	}
+	req := &vpp_ikev2.Ikev2SetResponder{
+		Name: name,
+		Responder: ikev2_types.Ikev2Responder{
+			SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+			Addr:      addr,
+		},
+	}
+	reply := &vpp_ikev2.Ikev2SetResponderReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setIkeTransforms(name string, tr *ipsec.IKEv2Profile_Transforms) error {
+	req := &vpp_ikev2.Ikev2SetIkeTransforms{
+		Name: name,
+		Tr: ikev2_types.Ikev2IkeTransforms{
+			CryptoAlg:     ikev2EncrAlgs[tr.CryptoAlg],
+			CryptoKeySize: tr.CryptoKeySize,
+			IntegAlg:      ikev2IntegAlgs[tr.IntegAlg],
+			DhGroup:       ikev2DhGroups[tr.DhGroup],
+		},
+	}
+	reply := &vpp_ikev2.Ikev2SetIkeTransformsReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setEspTransforms(name string, tr *ipsec.IKEv2Profile_Transforms) error {
+	req := &vpp_ikev2.Ikev2SetEspTransforms{
+		Name: name,
+		Tr: ikev2_types.Ikev2EspTransforms{
+			CryptoAlg:     ikev2EncrAlgs[tr.CryptoAlg],
+			CryptoKeySize: tr.CryptoKeySize,
+			IntegAlg:      ikev2IntegAlgs[tr.IntegAlg],
+		},
+	}
+	reply := &vpp_ikev2.Ikev2SetEspTransformsReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setSaLifetime(profile *ipsec.IKEv2Profile) error {
+	req := &vpp_ikev2.Ikev2SetSaLifetime{
+		Name:            profile.Name,
+		Lifetime:        profile.Lifetime,
+		LifetimeJitter:  profile.LifetimeJitter,
+		Handover:        profile.Handover,
+		LifetimeMaxdata: profile.LifetimeMaxdata,
+	}
+	reply := &vpp_ikev2.Ikev2SetSaLifetimeReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func (h *IKEv2VppHandler) setTunnelInterface(name, iface string) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.Errorf("failed to get metadata for interface %s", iface)
+	}
+	req := &vpp_ikev2.Ikev2SetTunnelInterface{
+		Name:      name,
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+	}
+	reply := &vpp_ikev2.Ikev2SetTunnelInterfaceReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// identityToVpp converts identity into VPP ID type and data. IP addresses
+// are passed to VPP in the binary form.
+func identityToVpp(id *ipsec.IKEv2Profile_Identity) (idType uint8, data []byte, err error) {
+	switch id.Type {
+	case ipsec.IKEv2Profile_Identity_IPV4_ADDR:
+		ip := net.ParseIP(id.Data).To4()
+		if ip == nil {
+			return 0, nil, errors.Errorf("invalid IPv4 identity: %q", id.Data)
+		}
+		return ikev2IDTypeIPv4Addr, ip, nil
+	case ipsec.IKEv2Profile_Identity_IPV6_ADDR:
+		ip := net.ParseIP(id.Data)
+		if ip == nil || ip.To4() != nil {
+			return 0, nil, errors.Errorf("invalid IPv6 identity: %q", id.Data)
+		}
+		return ikev2IDTypeIPv6Addr, ip.To16(), nil
+	case ipsec.IKEv2Profile_Identity_RFC822_ADDR:
+		return ikev2IDTypeRFC822Addr, []byte(id.Data), nil
+	case ipsec.IKEv2Profile_Identity_KEY_ID:
+		return ikev2IDTypeKeyID, []byte(id.Data), nil
+	default:
+		return ikev2IDTypeFQDN, []byte(id.Data), nil
+	}
+}
@@ -0,0 +1,354 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_ikev2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ikev2_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+)
+
+func TestVppAddIKEv2Profile(t *testing.T) {
+	ctx, ikev2Handler, ifIndex := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("ipip0", &ifaceidx.IfaceMetadata{SwIfIndex: 3})
+	ifIndex.Put("eth0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetAuthReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetIDReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetIDReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetTsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetResponderReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetIkeTransformsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetEspTransformsReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetSaLifetimeReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2SetTunnelInterfaceReply{})
+
+	err := ikev2Handler.AddIKEv2Profile(&ipsec.IKEv2Profile{
+		Name: "site-a",
+		Auth: &ipsec.IKEv2Profile_Authentication{
+			Method: ipsec.IKEv2Profile_Authentication_SHARED_KEY,
+			Data:   "secret",
+		},
+		LocalId: &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_IPV4_ADDR,
+			Data: "10.0.0.1",
+		},
+		RemoteId: &ipsec.IKEv2Profile_Identity{
+			Type: ipsec.IKEv2Profile_Identity_FQDN,
+			Data: "site-b.example.com",
+		},
+		LocalTs: &ipsec.IKEv2Profile_TrafficSelector{
+			EndPort:   65535,
+			StartAddr: "192.168.1.0",
+			EndAddr:   "192.168.1.255",
+		},
+		Responder: &ipsec.IKEv2Profile_Responder{
+			Interface: "eth0",
+			Address:   "10.0.0.2",
+		},
+		IkeTransforms: &ipsec.IKEv2Profile_Transforms{
+			CryptoAlg:     ipsec.IKEv2Profile_Transforms_ENCR_AES_CBC,
+			CryptoKeySize: 256,
+			IntegAlg:      ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA2_256_128,
+			DhGroup:       ipsec.IKEv2Profile_Transforms_MODP_2048,
+		},
+		EspTransforms: &ipsec.IKEv2Profile_Transforms{
+			CryptoAlg:     ipsec.IKEv2Profile_Transforms_ENCR_AES_GCM_16,
+			CryptoKeySize: 128,
+		},
+		Lifetime:        3600,
+		Handover:        10,
+		TunnelInterface: "ipip0",
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(10))
+	Expect(ctx.MockChannel.Msgs[0]).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name:  "site-a",
+		IsAdd: true,
+	}))
+	Expect(ctx.MockChannel.Msgs[1]).To(Equal(&vpp_ikev2.Ikev2ProfileSetAuth{
+		Name:       "site-a",
+		AuthMethod: 2,
+		DataLen:    6,
+		Data:       []byte("secret"),
+	}))
+	Expect(ctx.MockChannel.Msgs[2]).To(Equal(&vpp_ikev2.Ikev2ProfileSetID{
+		Name:    "site-a",
+		IsLocal: true,
+		IDType:  1,
+		DataLen: 4,
+		Data:    []byte{10, 0, 0, 1},
+	}))
+	Expect(ctx.MockChannel.Msgs[3]).To(Equal(&vpp_ikev2.Ikev2ProfileSetID{
+		Name:    "site-a",
+		IDType:  2,
+		DataLen: 18,
+		Data:    []byte("site-b.example.com"),
+	}))
+	Expect(ctx.MockChannel.Msgs[4]).To(Equal(&vpp_ikev2.Ikev2ProfileSetTs{
+		Name: "site-a",
+		Ts: ikev2_types.Ikev2Ts{
+			IsLocal:   true,
+			EndPort:   65535,
+			StartAddr: ipToAddr("192.168.1.0"),
+			EndAddr:   ipToAddr("192.168.1.255"),
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[5]).To(Equal(&vpp_ikev2.Ikev2SetResponder{
+		Name: "site-a",
+		Responder: ikev2_types.Ikev2Responder{
+			SwIfIndex: 1,
+			Addr:      ipToAddr("10.0.0.2"),
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[6]).To(Equal(&vpp_ikev2.Ikev2SetIkeTransforms{
+		Name: "site-a",
+		Tr: ikev2_types.Ikev2IkeTransforms{
+			CryptoAlg:     12,
+			CryptoKeySize: 256,
+			IntegAlg:      12,
+			DhGroup:       14,
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[7]).To(Equal(&vpp_ikev2.Ikev2SetEspTransforms{
+		Name: "site-a",
+		Tr: ikev2_types.Ikev2EspTransforms{
+			CryptoAlg:     20,
+			CryptoKeySize: 128,
+		},
+	}))
+	Expect(ctx.MockChannel.Msgs[8]).To(Equal(&vpp_ikev2.Ikev2SetSaLifetime{
+		Name:     "site-a",
+		Lifetime: 3600,
+		Handover: 10,
+	}))
+	Expect(ctx.MockChannel.Msgs[9]).To(Equal(&vpp_ikev2.Ikev2SetTunnelInterface{
+		Name:      "site-a",
+		SwIfIndex: 3,
+	}))
+}
+
+func TestVppAddIKEv2ProfileError(t *testing.T) {
+	ctx, ikev2Handler, _ := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileSetAuthReply{Retval: -1})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+
+	err := ikev2Handler.AddIKEv2Profile(&ipsec.IKEv2Profile{
+		Name: "site-a",
+		Auth: &ipsec.IKEv2Profile_Authentication{
+			Data: "secret",
+		},
+	})
+
+	Expect(err).Should(HaveOccurred())
+	// partially configured profile is removed
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name: "site-a",
+	}))
+}
+
+func TestVppDeleteIKEv2Profile(t *testing.T) {
+	ctx, ikev2Handler, _ := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileAddDelReply{})
+
+	err := ikev2Handler.DeleteIKEv2Profile("site-a")
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2ProfileAddDel{
+		Name: "site-a",
+	}))
+}
+
+func TestVppInitiateIKEv2SA(t *testing.T) {
+	ctx, ikev2Handler, _ := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2InitiateSaInitReply{})
+
+	err := ikev2Handler.InitiateIKEv2SA("site-a")
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_ikev2.Ikev2InitiateSaInit{
+		Name: "site-a",
+	}))
+}
+
+func TestVppDumpIKEv2Profiles(t *testing.T) {
+	ctx, ikev2Handler, ifIndex := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndex.Put("ipip0", &ifaceidx.IfaceMetadata{SwIfIndex: 3})
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileDetails{
+		Profile: ikev2_types.Ikev2Profile{
+			Name: "site-a",
+			LocID: ikev2_types.Ikev2ID{
+				Type:    1,
+				DataLen: 4,
+				Data:    string([]byte{10, 0, 0, 1}),
+			},
+			RemID: ikev2_types.Ikev2ID{
+				Type:    2,
+				DataLen: 18,
+				Data:    "site-b.example.com",
+			},
+			Responder: ikev2_types.Ikev2Responder{
+				SwIfIndex: interface_types.InterfaceIndex(^uint32(0)),
+				Addr:      ipToAddr("10.0.0.2"),
+			},
+			IkeTs: ikev2_types.Ikev2IkeTransforms{
+				CryptoAlg:     12,
+				CryptoKeySize: 256,
+				IntegAlg:      12,
+				DhGroup:       14,
+			},
+			Lifetime: 3600,
+			TunItf:   3,
+			Auth: ikev2_types.Ikev2Auth{
+				Method:  2,
+				DataLen: 6,
+				Data:    []byte("secret"),
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	profiles, err := ikev2Handler.DumpIKEv2Profiles()
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(profiles).To(HaveLen(1))
+	Expect(profiles[0].Name).To(Equal("site-a"))
+	Expect(profiles[0].LocalId).To(Equal(&ipsec.IKEv2Profile_Identity{
+		Type: ipsec.IKEv2Profile_Identity_IPV4_ADDR,
+		Data: "10.0.0.1",
+	}))
+	Expect(profiles[0].RemoteId).To(Equal(&ipsec.IKEv2Profile_Identity{
+		Type: ipsec.IKEv2Profile_Identity_FQDN,
+		Data: "site-b.example.com",
+	}))
+	Expect(profiles[0].Responder.Interface).To(BeEmpty())
+	Expect(profiles[0].Responder.Address).To(Equal("10.0.0.2"))
+	Expect(profiles[0].IkeTransforms.CryptoAlg).To(Equal(ipsec.IKEv2Profile_Transforms_ENCR_AES_CBC))
+	Expect(profiles[0].IkeTransforms.IntegAlg).To(Equal(ipsec.IKEv2Profile_Transforms_AUTH_HMAC_SHA2_256_128))
+	Expect(profiles[0].IkeTransforms.DhGroup).To(Equal(ipsec.IKEv2Profile_Transforms_MODP_2048))
+	Expect(profiles[0].EspTransforms).To(BeNil())
+	Expect(profiles[0].Lifetime).To(BeEquivalentTo(3600))
+	Expect(profiles[0].TunnelInterface).To(Equal("ipip0"))
+	Expect(profiles[0].Auth.Method).To(Equal(ipsec.IKEv2Profile_Authentication_SHARED_KEY))
+	Expect(profiles[0].Auth.Data).To(Equal("secret"))
+}
+
+func TestVppDumpIKEv2SAs(t *testing.T) {
+	ctx, ikev2Handler, _ := ikev2TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	localID := ikev2_types.Ikev2ID{Type: 2, DataLen: 6, Data: "site-a"}
+	remoteID := ikev2_types.Ikev2ID{Type: 2, DataLen: 6, Data: "site-b"}
+
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ProfileDetails{
+		Profile: ikev2_types.Ikev2Profile{
+			Name:  "to-site-b",
+			LocID: localID,
+			RemID: remoteID,
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(
+		&vpp_ikev2.Ikev2SaDetails{
+			Sa: ikev2_types.Ikev2Sa{
+				SaIndex: 0,
+				Ispi:    0x1111,
+				Rspi:    0x2222,
+				Iaddr:   ipToAddr("10.0.0.2"),
+				Raddr:   ipToAddr("10.0.0.1"),
+				IID:     remoteID,
+				RID:     localID,
+				Stats: ikev2_types.Ikev2SaStats{
+					NRekeyReq: 2,
+				},
+			},
+		},
+		&vpp_ikev2.Ikev2SaDetails{
+			Sa: ikev2_types.Ikev2Sa{
+				SaIndex: 1,
+				IID:     ikev2_types.Ikev2ID{Type: 2, DataLen: 7, Data: "unknown"},
+				RID:     localID,
+			},
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&vpp_ikev2.Ikev2ChildSaDetails{
+		ChildSa: ikev2_types.Ikev2ChildSa{
+			SaIndex:      0,
+			ChildSaIndex: 5,
+			ISpi:         0xaaaa,
+			RSpi:         0xbbbb,
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	saList, err := ikev2Handler.DumpIKEv2SAs()
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(saList).To(HaveLen(2))
+	Expect(saList[0]).To(Equal(&vppcalls.IKEv2SaDetails{
+		Profile: "to-site-b",
+		Sa: &ipsec.IKEv2ProfileState_SA{
+			Index:         0,
+			InitiatorSpi:  0x1111,
+			ResponderSpi:  0x2222,
+			InitiatorAddr: "10.0.0.2",
+			ResponderAddr: "10.0.0.1",
+			RekeyRequests: 2,
+			ChildSas: []*ipsec.IKEv2ProfileState_ChildSA{
+				{
+					Index:        5,
+					InitiatorSpi: 0xaaaa,
+					ResponderSpi: 0xbbbb,
+				},
+			},
+		},
+	}))
+	Expect(saList[1].Profile).To(BeEmpty())
+	Expect(saList[1].Sa.ChildSas).To(BeEmpty())
+}
+
+func ikev2TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.IKEv2VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndex := ifaceidx.NewIfaceIndex(log, "ikev2-test-ifidx")
+	ikev2Handler := vpp2210.NewIKEv2VppHandler(ctx.MockChannel, ifIndex, log)
+	return ctx, ikev2Handler, ifIndex
+}
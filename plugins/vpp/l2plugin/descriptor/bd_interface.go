@@ -36,22 +36,38 @@ const (
 	interfaceDep = "interface-exists"
 )
 
+// MacLimiter enforces limits of MACs learned on bridge domain interfaces.
+type MacLimiter interface {
+	// SetLimit starts to enforce MAC learn limit for the bridge domain interface.
+	SetLimit(bdName string, bdIface *l2.BridgeDomain_Interface) error
+	// ClearLimit stops to enforce MAC learn limit for the interface.
+	ClearLimit(iface string) error
+	// GetLimit returns MAC learn limit currently enforced for the interface (0 if none).
+	GetLimit(iface string) uint32
+	// DisabledFeatures returns L2 features currently disabled on the interface
+	// because the MAC learn limit was exceeded.
+	DisabledFeatures(iface string) vppcalls.L2Features
+}
+
 // BDInterfaceDescriptor teaches KVScheduler how to put interface into VPP bridge
 // domain.
 type BDInterfaceDescriptor struct {
 	// dependencies
-	log       logging.Logger
-	bdIndex   idxvpp.NameToIndex
-	bdHandler vppcalls.BridgeDomainVppAPI
+	log        logging.Logger
+	bdIndex    idxvpp.NameToIndex
+	bdHandler  vppcalls.BridgeDomainVppAPI
+	macLimiter MacLimiter
 }
 
 // NewBDInterfaceDescriptor creates a new instance of the BDInterface descriptor.
-func NewBDInterfaceDescriptor(bdIndex idxvpp.NameToIndex, bdHandler vppcalls.BridgeDomainVppAPI, log logging.PluginLogger) *BDInterfaceDescriptor {
+func NewBDInterfaceDescriptor(bdIndex idxvpp.NameToIndex, bdHandler vppcalls.BridgeDomainVppAPI,
+	macLimiter MacLimiter, log logging.PluginLogger) *BDInterfaceDescriptor {
 
 	return &BDInterfaceDescriptor{
-		bdIndex:   bdIndex,
-		bdHandler: bdHandler,
-		log:       log.NewLogger("bd-iface-descriptor"),
+		bdIndex:    bdIndex,
+		bdHandler:  bdHandler,
+		macLimiter: macLimiter,
+		log:        log.NewLogger("bd-iface-descriptor"),
 	}
 }
 
@@ -59,12 +75,14 @@ func NewBDInterfaceDescriptor(bdIndex idxvpp.NameToIndex, bdHandler vppcalls.Bri
 // the KVScheduler.
 func (d *BDInterfaceDescriptor) GetDescriptor() *adapter.BDInterfaceDescriptor {
 	return &adapter.BDInterfaceDescriptor{
-		Name:          BDInterfaceDescriptorName,
-		KeySelector:   d.IsBDInterfaceKey,
-		ValueTypeName: string(proto.MessageName(&l2.BridgeDomain_Interface{})),
-		Create:        d.Create,
-		Delete:        d.Delete,
-		Dependencies:  d.Dependencies,
+		Name:               BDInterfaceDescriptorName,
+		KeySelector:        d.IsBDInterfaceKey,
+		ValueTypeName:      string(proto.MessageName(&l2.BridgeDomain_Interface{})),
+		Create:             d.Create,
+		Delete:             d.Delete,
+		Update:             d.Update,
+		UpdateWithRecreate: d.UpdateWithRecreate,
+		Dependencies:       d.Dependencies,
 	}
 }
 
@@ -93,6 +111,37 @@ func (d *BDInterfaceDescriptor) Create(key string, bdIface *l2.BridgeDomain_Inte
 		return nil, err
 
 	}
+
+	// override L2 features of the bridge domain
+	enable, disable := featureOverrides(bdIface)
+	if enable != 0 {
+		if err = d.bdHandler.SetInterfaceL2Features(bdIface.Name, enable, true); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if disable != 0 {
+		if err = d.bdHandler.SetInterfaceL2Features(bdIface.Name, disable, false); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	// VLAN tag rewrite
+	if bdIface.GetTagRewrite().GetOperation() != l2.BridgeDomain_Interface_TagRewrite_DISABLED {
+		if err = d.bdHandler.SetInterfaceTagRewrite(bdIface.Name, bdIface.TagRewrite); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	// MAC learn limit
+	if bdIface.MacLearnLimit != 0 {
+		if err = d.macLimiter.SetLimit(bdName, bdIface); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
 	return nil, nil
 }
 
@@ -107,6 +156,19 @@ func (d *BDInterfaceDescriptor) Delete(key string, bdIface *l2.BridgeDomain_Inte
 		return err
 	}
 
+	if bdIface.MacLearnLimit != 0 {
+		if err := d.macLimiter.ClearLimit(bdIface.Name); err != nil {
+			d.log.Error(err)
+			return err
+		}
+	}
+	if bdIface.GetTagRewrite().GetOperation() != l2.BridgeDomain_Interface_TagRewrite_DISABLED {
+		if err := d.bdHandler.SetInterfaceTagRewrite(bdIface.Name, nil); err != nil {
+			d.log.Error(err)
+			return err
+		}
+	}
+
 	err := d.bdHandler.DeleteInterfaceFromBridgeDomain(bdMeta.GetIndex(), bdIface)
 	if err != nil {
 		d.log.Error(err)
@@ -116,6 +178,44 @@ func (d *BDInterfaceDescriptor) Delete(key string, bdIface *l2.BridgeDomain_Inte
 	return nil
 }
 
+// UpdateWithRecreate returns true if the interface has to be re-added to the bridge
+// domain, which is needed also to reset overridden L2 features.
+func (d *BDInterfaceDescriptor) UpdateWithRecreate(key string, oldBDIface, newBDIface *l2.BridgeDomain_Interface, metadata interface{}) bool {
+	oldEnable, oldDisable := featureOverrides(oldBDIface)
+	newEnable, newDisable := featureOverrides(newBDIface)
+	return oldBDIface.BridgedVirtualInterface != newBDIface.BridgedVirtualInterface ||
+		oldBDIface.SplitHorizonGroup != newBDIface.SplitHorizonGroup ||
+		oldEnable != newEnable || oldDisable != newDisable
+}
+
+// Update is able to change VLAN tag rewrite and MAC learn limit.
+func (d *BDInterfaceDescriptor) Update(key string, oldBDIface, newBDIface *l2.BridgeDomain_Interface, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if !proto.Equal(oldBDIface.TagRewrite, newBDIface.TagRewrite) {
+		if err = d.bdHandler.SetInterfaceTagRewrite(newBDIface.Name, newBDIface.TagRewrite); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
+	if oldBDIface.MacLearnLimit != newBDIface.MacLearnLimit ||
+		oldBDIface.MacLimitAction != newBDIface.MacLimitAction {
+		if oldBDIface.MacLearnLimit != 0 {
+			if err = d.macLimiter.ClearLimit(oldBDIface.Name); err != nil {
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+		if newBDIface.MacLearnLimit != 0 {
+			bdName, _, _ := l2.ParseBDInterfaceKey(key)
+			if err = d.macLimiter.SetLimit(bdName, newBDIface); err != nil {
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+	}
+	return nil, nil
+}
+
 // Dependencies lists the interface as the only dependency for the binding.
 func (d *BDInterfaceDescriptor) Dependencies(key string, value *l2.BridgeDomain_Interface) []kvs.Dependency {
 	return []kvs.Dependency{
@@ -125,3 +225,37 @@ func (d *BDInterfaceDescriptor) Dependencies(key string, value *l2.BridgeDomain_
 		},
 	}
 }
+
+// featureOverrides returns L2 features explicitly enabled and disabled for the interface.
+func featureOverrides(bdIface *l2.BridgeDomain_Interface) (enable, disable vppcalls.L2Features) {
+	overrides := []struct {
+		override l2.BridgeDomain_Interface_FeatureOverride
+		feature  vppcalls.L2Features
+	}{
+		{bdIface.GetLearn(), vppcalls.L2FeatureLearn},
+		{bdIface.GetForward(), vppcalls.L2FeatureForward},
+		{bdIface.GetFlood(), vppcalls.L2FeatureFlood},
+		{bdIface.GetUnknownUnicastFlood(), vppcalls.L2FeatureUnknownUnicastFlood},
+	}
+	for _, o := range overrides {
+		switch o.override {
+		case l2.BridgeDomain_Interface_ENABLED:
+			enable |= o.feature
+		case l2.BridgeDomain_Interface_DISABLED:
+			disable |= o.feature
+		}
+	}
+	return enable, disable
+}
+
+// IsFeatureEnabled returns true if the L2 feature is enabled for the interface.
+func IsFeatureEnabled(bdEnabled bool, override l2.BridgeDomain_Interface_FeatureOverride) bool {
+	switch override {
+	case l2.BridgeDomain_Interface_ENABLED:
+		return true
+	case l2.BridgeDomain_Interface_DISABLED:
+		return false
+	default:
+		return bdEnabled
+	}
+}
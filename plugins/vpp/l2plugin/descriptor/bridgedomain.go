@@ -49,25 +49,39 @@ var (
 	// ErrBridgeDomainWithMultipleBVI is returned when bridge domain is defined with
 	// multiple BVI interfaces.
 	ErrBridgeDomainWithMultipleBVI = errors.New("VPP bridge domain defined with mutliple BVIs")
+
+	// ErrTagRewriteWithoutTag is returned when VLAN tag rewrite of the bridge domain
+	// interface is defined without tag required by the operation.
+	ErrTagRewriteWithoutTag = errors.New("VLAN tag rewrite defined without required tag")
+
+	// ErrTagRewriteInvalidTag is returned when VLAN tag rewrite of the bridge domain
+	// interface is defined with VLAN ID out of range.
+	ErrTagRewriteInvalidTag = errors.New("VLAN tag rewrite defined with invalid VLAN ID")
 )
 
+// maxVlanID is the maximum valid VLAN ID.
+const maxVlanID = 4095
+
 // BridgeDomainDescriptor teaches KVScheduler how to configure VPP bridge domains.
 type BridgeDomainDescriptor struct {
 	// dependencies
-	log       logging.Logger
-	bdHandler vppcalls.BridgeDomainVppAPI
+	log        logging.Logger
+	bdHandler  vppcalls.BridgeDomainVppAPI
+	macLimiter MacLimiter
 
 	// runtime
 	bdIDSeq uint32
 }
 
 // NewBridgeDomainDescriptor creates a new instance of the BridgeDomain descriptor.
-func NewBridgeDomainDescriptor(bdHandler vppcalls.BridgeDomainVppAPI, log logging.PluginLogger) *BridgeDomainDescriptor {
+func NewBridgeDomainDescriptor(bdHandler vppcalls.BridgeDomainVppAPI, macLimiter MacLimiter,
+	log logging.PluginLogger) *BridgeDomainDescriptor {
 
 	return &BridgeDomainDescriptor{
-		bdHandler: bdHandler,
-		log:       log.NewLogger("bd-descriptor"),
-		bdIDSeq:   1,
+		bdHandler:  bdHandler,
+		macLimiter: macLimiter,
+		log:        log.NewLogger("bd-descriptor"),
+		bdIDSeq:    1,
 	}
 }
 
@@ -98,7 +112,7 @@ func (d *BridgeDomainDescriptor) GetDescriptor() *adapter.BridgeDomainDescriptor
 // l2.BridgeDomain, also ignoring the order of assigned ARP termination entries.
 func (d *BridgeDomainDescriptor) EquivalentBridgeDomains(key string, oldBD, newBD *l2.BridgeDomain) bool {
 	// BD parameters
-	if !equalBDParameters(oldBD, newBD) {
+	if !equalBDParameters(oldBD, newBD) || oldBD.MacLearnLimit != newBD.MacLearnLimit {
 		return false
 	}
 
@@ -128,6 +142,32 @@ func (d *BridgeDomainDescriptor) Validate(key string, bd *l2.BridgeDomain) error
 			}
 			hasBVI = true
 		}
+		if err := validateTagRewrite(bdIface.TagRewrite); err != nil {
+			return kvs.NewInvalidValueError(err, "interfaces.tag_rewrite")
+		}
+	}
+	return nil
+}
+
+// validateTagRewrite checks that all VLAN tags required by the tag rewrite
+// operation are defined.
+func validateTagRewrite(tagRw *l2.BridgeDomain_Interface_TagRewrite) error {
+	var needTag1, needTag2 bool
+	switch tagRw.GetOperation() {
+	case l2.BridgeDomain_Interface_TagRewrite_PUSH1,
+		l2.BridgeDomain_Interface_TagRewrite_TRANSLATE11,
+		l2.BridgeDomain_Interface_TagRewrite_TRANSLATE21:
+		needTag1 = true
+	case l2.BridgeDomain_Interface_TagRewrite_PUSH2,
+		l2.BridgeDomain_Interface_TagRewrite_TRANSLATE12,
+		l2.BridgeDomain_Interface_TagRewrite_TRANSLATE22:
+		needTag1, needTag2 = true, true
+	}
+	if (needTag1 && tagRw.GetTag1() == 0) || (needTag2 && tagRw.GetTag2() == 0) {
+		return ErrTagRewriteWithoutTag
+	}
+	if tagRw.GetTag1() > maxVlanID || tagRw.GetTag2() > maxVlanID {
+		return ErrTagRewriteInvalidTag
 	}
 	return nil
 }
@@ -146,6 +186,14 @@ func (d *BridgeDomainDescriptor) Create(key string, bd *l2.BridgeDomain) (metada
 		return nil, err
 	}
 
+	// set MAC learn limit
+	if bd.MacLearnLimit != 0 {
+		if err := d.bdHandler.SetBridgeDomainLearnLimit(bdIdx, bd.MacLearnLimit); err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
 	// add ARP termination entries
 	for _, arp := range bd.ArpTerminationTable {
 		if err := d.bdHandler.AddArpTerminationTableEntry(bdIdx, arp.PhysAddress, arp.IpAddress); err != nil {
@@ -175,10 +223,19 @@ func (d *BridgeDomainDescriptor) UpdateWithRecreate(key string, oldBD, newBD *l2
 	return !equalBDParameters(oldBD, newBD)
 }
 
-// Update is able to change ARP termination entries.
+// Update is able to change MAC learn limit and ARP termination entries.
 func (d *BridgeDomainDescriptor) Update(key string, oldBD, newBD *l2.BridgeDomain, oldMetadata *idxvpp.OnlyIndex) (newMetadata *idxvpp.OnlyIndex, err error) {
-	// update ARP termination entries
 	bdIdx := oldMetadata.Index
+
+	// update MAC learn limit
+	if oldBD.MacLearnLimit != newBD.MacLearnLimit {
+		if err := d.bdHandler.SetBridgeDomainLearnLimit(bdIdx, newBD.MacLearnLimit); err != nil {
+			d.log.Error(err)
+			return oldMetadata, err
+		}
+	}
+
+	// update ARP termination entries
 	obsoleteARPs, newARPs := calculateARPDiff(oldBD.GetArpTerminationTable(), newBD.GetArpTerminationTable())
 	for _, arp := range obsoleteARPs { // remove obsolete first to avoid collisions
 		if err := d.bdHandler.RemoveArpTerminationTableEntry(bdIdx, arp.PhysAddress, arp.IpAddress); err != nil {
@@ -204,6 +261,12 @@ func (d *BridgeDomainDescriptor) Retrieve(correlate []adapter.BridgeDomainKVWith
 	// sequence number for untagged interfaces
 	var untaggedSeq int
 
+	// NB bridge domains used to fill attributes which cannot be dumped
+	nbBDs := make(map[string]*l2.BridgeDomain)
+	for _, kv := range correlate {
+		nbBDs[kv.Value.Name] = kv.Value
+	}
+
 	// dump bridge domains
 	bridgeDomains, err := d.bdHandler.DumpBridgeDomains()
 	if err != nil {
@@ -223,6 +286,14 @@ func (d *BridgeDomainDescriptor) Retrieve(correlate []adapter.BridgeDomainKVWith
 			untaggedSeq++
 		}
 
+		if nbBD, ok := nbBDs[bd.Bd.Name]; ok {
+			d.correlateBridgeDomain(bd.Bd, nbBD)
+		}
+		// MAC learn limits of interfaces are enforced by the agent
+		for _, bdIface := range bd.Bd.Interfaces {
+			bdIface.MacLearnLimit = d.macLimiter.GetLimit(bdIface.Name)
+		}
+
 		retrieved = append(retrieved, adapter.BridgeDomainKVWithMetadata{
 			Key:      l2.BridgeDomainKey(bd.Bd.Name),
 			Value:    bd.Bd,
@@ -250,6 +321,42 @@ func (d *BridgeDomainDescriptor) DerivedValues(key string, bd *l2.BridgeDomain)
 	return derValues
 }
 
+// correlateBridgeDomain copies attributes which cannot be dumped from VPP
+// from the NB configuration. L2 features dumped for interfaces are replaced
+// with NB overrides which have the same effect, or which are temporarily
+// overridden by the MAC limiter.
+func (d *BridgeDomainDescriptor) correlateBridgeDomain(bd, nbBD *l2.BridgeDomain) {
+	bd.MacLearnLimit = nbBD.MacLearnLimit
+	for _, bdIface := range bd.Interfaces {
+		for _, nbBDIface := range nbBD.Interfaces {
+			if bdIface.Name != nbBDIface.Name {
+				continue
+			}
+			limited := d.macLimiter.DisabledFeatures(bdIface.Name)
+			bdIface.Learn = correlateFeature(bd.Learn, bdIface.Learn, nbBDIface.Learn,
+				limited&vppcalls.L2FeatureLearn != 0)
+			bdIface.Forward = correlateFeature(bd.Forward, bdIface.Forward, nbBDIface.Forward,
+				limited&vppcalls.L2FeatureForward != 0)
+			bdIface.Flood = correlateFeature(bd.Flood, bdIface.Flood, nbBDIface.Flood,
+				limited&vppcalls.L2FeatureFlood != 0)
+			bdIface.UnknownUnicastFlood = correlateFeature(bd.UnknownUnicastFlood, bdIface.UnknownUnicastFlood,
+				nbBDIface.UnknownUnicastFlood, limited&vppcalls.L2FeatureUnknownUnicastFlood != 0)
+			bdIface.MacLimitAction = nbBDIface.MacLimitAction
+			break
+		}
+	}
+}
+
+// correlateFeature returns the NB override of the interface L2 feature if it has
+// the same effect as the override dumped from VPP, or if the feature is disabled
+// by the MAC limiter. Otherwise the dumped override is returned.
+func correlateFeature(bdEnabled bool, override, nbOverride l2.BridgeDomain_Interface_FeatureOverride, limited bool) l2.BridgeDomain_Interface_FeatureOverride {
+	if limited || IsFeatureEnabled(bdEnabled, override) == IsFeatureEnabled(bdEnabled, nbOverride) {
+		return nbOverride
+	}
+	return override
+}
+
 // equalBDParameters compares all base bridge domain parameters for equality.
 func equalBDParameters(bd1, bd2 *l2.BridgeDomain) bool {
 	return bd1.ArpTermination == bd2.ArpTermination && bd1.Flood == bd2.Flood &&
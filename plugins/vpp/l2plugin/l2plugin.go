@@ -20,6 +20,8 @@
 package l2plugin
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
@@ -35,6 +37,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls/vpp2210"
 )
 
 // L2Plugin configures VPP bridge domains, L2 FIBs and xConnects using GoVPP.
@@ -52,6 +55,12 @@ type L2Plugin struct {
 
 	// index maps
 	bdIndex idxvpp.NameToIndex
+
+	// MAC learn limits of bridge domain interfaces
+	macLimiter *MacLimiter
+
+	// MAC limiters of additional VPP instances
+	instanceMacLimiters []*MacLimiter
 }
 
 // Deps lists dependencies of the L2 plugin.
@@ -71,10 +80,13 @@ func (p *L2Plugin) Init() (err error) {
 		return errors.Errorf("could not find compatible L2VppHandler")
 	}
 
+	// MAC limiter is initialized once the BD index is available
+	p.macLimiter = &MacLimiter{}
+
 	// init and register bridge domain descriptor
-	p.bdDescriptor = descriptor.NewBridgeDomainDescriptor(p.l2Handler, p.Log)
+	p.bdDescriptor = descriptor.NewBridgeDomainDescriptor(p.l2Handler, p.macLimiter, p.Log)
 	bdDescriptor := adapter.NewBridgeDomainDescriptor(p.bdDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(bdDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, bdDescriptor)
 	if err != nil {
 		return err
 	}
@@ -90,39 +102,128 @@ func (p *L2Plugin) Init() (err error) {
 	// we set l2Handler again here, because bdIndex was nil before
 	p.l2Handler = vppcalls.CompatibleL2VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.bdIndex, p.Log)
 
+	// init MAC limiter
+	err = p.macLimiter.Init(context.Background(), p.Log, p.KVScheduler, p.VPP,
+		p.IfPlugin.GetInterfaceIndex(), p.bdIndex)
+	if err != nil {
+		return err
+	}
+
 	// init & register descriptors
-	p.bdIfaceDescriptor = descriptor.NewBDInterfaceDescriptor(p.bdIndex, p.l2Handler, p.Log)
+	p.bdIfaceDescriptor = descriptor.NewBDInterfaceDescriptor(p.bdIndex, p.l2Handler, p.macLimiter, p.Log)
 	bdIfaceDescriptor := adapter.NewBDInterfaceDescriptor(p.bdIfaceDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(bdIfaceDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, bdIfaceDescriptor)
 	if err != nil {
 		return err
 	}
 
 	p.fibDescriptor = descriptor.NewFIBDescriptor(p.l2Handler, p.Log)
 	fibDescriptor := adapter.NewFIBDescriptor(p.fibDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(fibDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, fibDescriptor)
 	if err != nil {
 		return err
 	}
 
 	p.xcDescriptor = descriptor.NewXConnectDescriptor(p.l2Handler, p.Log)
 	xcDescriptor := adapter.NewXConnectDescriptor(p.xcDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(xcDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, xcDescriptor)
 	if err != nil {
 		return err
 	}
 
+	// register descriptors for additional VPP instances
+	return p.initInstances()
+}
+
+// initInstances registers L2 descriptors for additional VPP instances
+// (keys of their values are scoped by the instance).
+func (p *L2Plugin) initInstances() error {
+	multiVPP, ok := p.VPP.(govppmux.MultiInstanceAPI)
+	if !ok {
+		return nil
+	}
+	for _, instance := range multiVPP.Instances() {
+		vppAPI, err := multiVPP.Instance(instance)
+		if err != nil {
+			return err
+		}
+		ifIndex := p.IfPlugin.GetInstanceInterfaceIndex(instance)
+		if ifIndex == nil {
+			return errors.Errorf("missing index with interface metadata of VPP instance %q", instance)
+		}
+		l2Handler := vppcalls.CompatibleL2VppHandler(vppAPI, ifIndex, nil, p.Log)
+		if l2Handler == nil {
+			return errors.Errorf("could not find compatible L2VppHandler for VPP instance %q", instance)
+		}
+		macLimiter := &MacLimiter{}
+
+		bdDescriptor := adapter.NewBridgeDomainDescriptor(
+			descriptor.NewBridgeDomainDescriptor(l2Handler, macLimiter, p.Log).GetDescriptor())
+		instanceDescriptor, err := kvs.InstanceDescriptor(instance, bdDescriptor)
+		if err != nil {
+			return err
+		}
+		if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+			return err
+		}
+		metadataMap := p.KVScheduler.GetMetadataMap(kvs.InstanceDescriptorName(instance, bdDescriptor.Name))
+		bdIndex, withIndex := metadataMap.(idxvpp.NameToIndex)
+		if !withIndex {
+			return errors.Errorf("missing index with bridge domain metadata of VPP instance %q", instance)
+		}
+		l2Handler = vppcalls.CompatibleL2VppHandler(vppAPI, ifIndex, bdIndex, p.Log)
+
+		err = macLimiter.Init(context.Background(), p.Log, p.KVScheduler, vppAPI, ifIndex, bdIndex)
+		if err != nil {
+			return err
+		}
+		p.instanceMacLimiters = append(p.instanceMacLimiters, macLimiter)
+
+		for _, d := range []*kvs.KVDescriptor{
+			adapter.NewBDInterfaceDescriptor(
+				descriptor.NewBDInterfaceDescriptor(bdIndex, l2Handler, macLimiter, p.Log).GetDescriptor()),
+			adapter.NewFIBDescriptor(descriptor.NewFIBDescriptor(l2Handler, p.Log).GetDescriptor()),
+			adapter.NewXConnectDescriptor(descriptor.NewXConnectDescriptor(l2Handler, p.Log).GetDescriptor()),
+		} {
+			instanceDescriptor, err := kvs.InstanceDescriptor(instance, d)
+			if err != nil {
+				return err
+			}
+			if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
 // AfterInit registers plugin with StatusCheck.
 func (p *L2Plugin) AfterInit() error {
+	if err := p.macLimiter.AfterInit(); err != nil {
+		return err
+	}
+	for _, macLimiter := range p.instanceMacLimiters {
+		if err := macLimiter.AfterInit(); err != nil {
+			return err
+		}
+	}
 	if p.StatusCheck != nil {
 		p.StatusCheck.Register(p.PluginName, nil)
 	}
 	return nil
 }
 
+// Close stops enforcing of MAC learn limits.
+func (p *L2Plugin) Close() error {
+	for _, macLimiter := range p.instanceMacLimiters {
+		macLimiter.Close()
+	}
+	if p.macLimiter != nil {
+		return p.macLimiter.Close()
+	}
+	return nil
+}
+
 // GetBDIndex return bridge domain index.
 func (p *L2Plugin) GetBDIndex() idxvpp.NameToIndex {
 	return p.bdIndex
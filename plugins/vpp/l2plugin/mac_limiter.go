@@ -0,0 +1,331 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package l2plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
+	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
+)
+
+// MacLimiter enforces limits of MACs learned on bridge domain interfaces.
+// VPP reports learned MACs with some delay, therefore the number of MACs
+// learned on the interface may exceed the limit for a short time.
+type MacLimiter struct {
+	log logging.Logger
+
+	kvScheduler kvs.KVScheduler
+	vppClient   vpp.Client
+	ifIndexes   ifaceidx.IfaceMetadataIndex
+	bdIndexes   idxvpp.NameToIndex
+	l2Handler   vppcalls.L2VppAPI
+
+	// access guards the maps below and also the use of the l2Handler
+	access  sync.Mutex
+	limits  map[string]*macLimit // interface name
+	macs    map[string]uint32    // MAC -> sw_if_index of the interface where it was learned
+	learned map[uint32]uint32    // sw_if_index -> number of learned MACs
+
+	watching     bool
+	events       chan *vppcalls.MacEvent
+	cancelEvents func()
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// macLimit is MAC learn limit configured for the bridge domain interface.
+type macLimit struct {
+	bdName   string
+	bdIface  *l2.BridgeDomain_Interface
+	disabled vppcalls.L2Features // features disabled because the limit was exceeded
+}
+
+// Init initializes MAC limiter and starts processing of MAC events.
+// MAC events are enabled in VPP only once the first limit is set.
+func (m *MacLimiter) Init(
+	ctx context.Context,
+	logger logging.PluginLogger,
+	kvScheduler kvs.KVScheduler,
+	vppClient vpp.Client,
+	ifIndexes ifaceidx.IfaceMetadataIndex,
+	bdIndexes idxvpp.NameToIndex,
+) error {
+	m.log = logger.NewLogger("mac-limiter")
+	m.kvScheduler = kvScheduler
+	m.vppClient = vppClient
+	m.ifIndexes = ifIndexes
+	m.bdIndexes = bdIndexes
+
+	m.limits = make(map[string]*macLimit)
+	m.macs = make(map[string]uint32)
+	m.learned = make(map[uint32]uint32)
+
+	// separate handler (and thus channel) is used by the limiter
+	m.l2Handler = vppcalls.CompatibleL2VppHandler(vppClient, ifIndexes, bdIndexes, logger.NewLogger("mac-limiter-handler"))
+	if m.l2Handler == nil {
+		return errors.New("could not find compatible L2VppHandler")
+	}
+
+	m.events = make(chan *vppcalls.MacEvent, 1000)
+	m.ctx, m.cancel = context.WithCancel(ctx)
+
+	m.wg.Add(1)
+	go m.watchMacEvents(m.ctx)
+
+	return nil
+}
+
+// AfterInit re-subscribes to MAC events after VPP reconnect.
+func (m *MacLimiter) AfterInit() error {
+	m.vppClient.OnReconnect(func() {
+		m.access.Lock()
+		defer m.access.Unlock()
+
+		if !m.watching {
+			return
+		}
+		m.cancelEvents()
+		m.watching = false
+		// all learned MACs are flushed when MAC events are enabled
+		m.macs = make(map[string]uint32)
+		m.learned = make(map[uint32]uint32)
+		if err := m.subscribeMacEvents(); err != nil {
+			m.log.Warnf("WatchMacEvents failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Close stops processing of MAC events.
+func (m *MacLimiter) Close() error {
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+// SetLimit starts to enforce MAC learn limit for the bridge domain interface.
+func (m *MacLimiter) SetLimit(bdName string, bdIface *l2.BridgeDomain_Interface) error {
+	m.access.Lock()
+	defer m.access.Unlock()
+
+	if !m.watching {
+		if err := m.subscribeMacEvents(); err != nil {
+			return err
+		}
+	}
+	limit := &macLimit{
+		bdName:  bdName,
+		bdIface: bdIface,
+	}
+	if oldLimit, ok := m.limits[bdIface.Name]; ok {
+		limit.disabled = oldLimit.disabled
+	}
+	m.limits[bdIface.Name] = limit
+
+	if ifMeta, found := m.ifIndexes.LookupByName(bdIface.Name); found {
+		return m.checkLimit(ifMeta.GetIndex())
+	}
+	return nil
+}
+
+// ClearLimit stops to enforce MAC learn limit for the interface. Features disabled
+// due to the exceeded limit are enabled back.
+func (m *MacLimiter) ClearLimit(iface string) error {
+	m.access.Lock()
+	defer m.access.Unlock()
+
+	limit, ok := m.limits[iface]
+	if !ok {
+		return nil
+	}
+	delete(m.limits, iface)
+	return m.restoreFeatures(limit)
+}
+
+// GetLimit returns MAC learn limit currently enforced for the interface (0 if none).
+func (m *MacLimiter) GetLimit(iface string) uint32 {
+	m.access.Lock()
+	defer m.access.Unlock()
+
+	if limit, ok := m.limits[iface]; ok {
+		return limit.bdIface.GetMacLearnLimit()
+	}
+	return 0
+}
+
+// DisabledFeatures returns L2 features currently disabled on the interface
+// because the MAC learn limit was exceeded.
+func (m *MacLimiter) DisabledFeatures(iface string) vppcalls.L2Features {
+	m.access.Lock()
+	defer m.access.Unlock()
+
+	if limit, ok := m.limits[iface]; ok {
+		return limit.disabled
+	}
+	return 0
+}
+
+// subscribeMacEvents enables MAC events in VPP.
+func (m *MacLimiter) subscribeMacEvents() error {
+	var ctx context.Context
+	ctx, m.cancelEvents = context.WithCancel(m.ctx)
+	if err := m.l2Handler.WatchMacEvents(ctx, m.events); err != nil {
+		m.cancelEvents()
+		return errors.Errorf("failed to watch MAC events: %v", err)
+	}
+	m.watching = true
+	return nil
+}
+
+// watchMacEvents processes MAC events delivered from VPP.
+func (m *MacLimiter) watchMacEvents(ctx context.Context) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case event := <-m.events:
+			// if the event is a result of a configuration change,
+			// make sure the associated transaction has already finalized
+			m.kvScheduler.TransactionBarrier()
+
+			m.processMacEvent(event)
+
+		case <-ctx.Done():
+			m.log.Debug("MAC event watcher stopped")
+			return
+		}
+	}
+}
+
+// processMacEvent updates number of MACs learned on the interface and applies
+// the limit action if needed.
+func (m *MacLimiter) processMacEvent(event *vppcalls.MacEvent) {
+	m.access.Lock()
+	defer m.access.Unlock()
+
+	// Note: MAC events do not carry the bridge domain ID, the same MAC learned
+	// in multiple bridge domains is therefore counted only once.
+	prevIfIdx, learned := m.macs[event.Mac]
+	if learned {
+		delete(m.macs, event.Mac)
+		if m.learned[prevIfIdx] > 0 {
+			m.learned[prevIfIdx]--
+		}
+	}
+	if event.Action != vppcalls.MacDeleted {
+		m.macs[event.Mac] = event.SwIfIndex
+		m.learned[event.SwIfIndex]++
+	}
+
+	if learned && prevIfIdx != event.SwIfIndex {
+		if err := m.checkLimit(prevIfIdx); err != nil {
+			m.log.Warn(err)
+		}
+	}
+	if err := m.checkLimit(event.SwIfIndex); err != nil {
+		m.log.Warn(err)
+	}
+}
+
+// checkLimit applies the limit action if number of MACs learned on the interface
+// exceeds the limit, or reverts it once the number of MACs drops back.
+func (m *MacLimiter) checkLimit(swIfIndex uint32) error {
+	ifName, _, found := m.ifIndexes.LookupBySwIfIndex(swIfIndex)
+	if !found {
+		return nil
+	}
+	limit, ok := m.limits[ifName]
+	if !ok || limit.bdIface.GetMacLearnLimit() == 0 {
+		return nil
+	}
+	count := m.learned[swIfIndex]
+
+	if count > limit.bdIface.GetMacLearnLimit() && limit.disabled == 0 {
+		features := vppcalls.L2FeatureLearn
+		if limit.bdIface.GetMacLimitAction() == l2.BridgeDomain_Interface_DISABLE_FORWARDING {
+			features = vppcalls.L2FeatureForward | vppcalls.L2FeatureFlood | vppcalls.L2FeatureUnknownUnicastFlood
+		}
+		m.log.Warnf("number of MACs learned on interface %s (%d) exceeded the limit %d, applying action %v",
+			ifName, count, limit.bdIface.GetMacLearnLimit(), limit.bdIface.GetMacLimitAction())
+		if err := m.l2Handler.SetInterfaceL2Features(ifName, features, false); err != nil {
+			return errors.Errorf("failed to apply MAC limit action on interface %s: %v", ifName, err)
+		}
+		limit.disabled = features
+		return nil
+	}
+	if count <= limit.bdIface.GetMacLearnLimit() && limit.disabled != 0 {
+		m.log.Infof("number of MACs learned on interface %s (%d) is back within the limit %d",
+			ifName, count, limit.bdIface.GetMacLearnLimit())
+		return m.restoreFeatures(limit)
+	}
+	return nil
+}
+
+// restoreFeatures enables back features disabled due to the exceeded limit,
+// but only those which are enabled by the configuration.
+func (m *MacLimiter) restoreFeatures(limit *macLimit) error {
+	if limit.disabled == 0 {
+		return nil
+	}
+	bdIface := limit.bdIface
+	bdMeta, found := m.bdIndexes.LookupByName(limit.bdName)
+	if !found {
+		// interface was removed from the bridge domain together with the features
+		limit.disabled = 0
+		return nil
+	}
+	bdDetails, err := m.l2Handler.DumpBridgeDomain(bdMeta.GetIndex())
+	if err != nil {
+		return errors.Errorf("failed to dump bridge domain %s: %v", limit.bdName, err)
+	}
+	if bdDetails == nil {
+		limit.disabled = 0
+		return nil
+	}
+	bd := bdDetails.Bd
+
+	var enabled vppcalls.L2Features
+	if descriptor.IsFeatureEnabled(bd.Learn, bdIface.Learn) {
+		enabled |= vppcalls.L2FeatureLearn
+	}
+	if descriptor.IsFeatureEnabled(bd.Forward, bdIface.Forward) {
+		enabled |= vppcalls.L2FeatureForward
+	}
+	if descriptor.IsFeatureEnabled(bd.Flood, bdIface.Flood) {
+		enabled |= vppcalls.L2FeatureFlood
+	}
+	if descriptor.IsFeatureEnabled(bd.UnknownUnicastFlood, bdIface.UnknownUnicastFlood) {
+		enabled |= vppcalls.L2FeatureUnknownUnicastFlood
+	}
+	if restore := limit.disabled & enabled; restore != 0 {
+		if err := m.l2Handler.SetInterfaceL2Features(bdIface.Name, restore, true); err != nil {
+			return errors.Errorf("failed to revert MAC limit action on interface %s: %v", bdIface.Name, err)
+		}
+	}
+	limit.disabled = 0
+	return nil
+}
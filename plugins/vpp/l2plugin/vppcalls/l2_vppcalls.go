@@ -15,6 +15,8 @@
 package vppcalls
 
 import (
+	"context"
+
 	govppapi "go.fd.io/govpp/api"
 	"go.ligato.io/cn-infra/v2/logging"
 
@@ -36,6 +38,58 @@ type BridgeDomainMeta struct {
 	BdID uint32 `json:"bridge_domain_id"`
 }
 
+// BridgeDomainStats contains statistics of the bridge domain.
+type BridgeDomainStats struct {
+	BdName string `json:"bridge_domain"`
+	BdID   uint32 `json:"bridge_domain_id"`
+	// NumInterfaces is the number of interfaces in the bridge domain.
+	NumInterfaces uint32 `json:"num_interfaces"`
+	// NumStaticMacs is the number of static L2 FIB entries of the bridge domain.
+	NumStaticMacs uint32 `json:"num_static_macs"`
+	// NumLearnedMacs is the number of MACs learned in the bridge domain.
+	NumLearnedMacs uint32 `json:"num_learned_macs"`
+	// LearnedMacs is the number of MACs learned per interface of the bridge domain.
+	LearnedMacs map[string]uint32 `json:"learned_macs_per_interface,omitempty"`
+}
+
+// DefaultBridgeDomainLearnLimit is the max number of MACs learned in the bridge domain
+// used by VPP if not configured otherwise.
+const DefaultBridgeDomainLearnLimit = 64 * 1024 * 16
+
+// L2Features is a bitmask of L2 features which can be enabled or disabled
+// on the bridge domain interface.
+type L2Features uint32
+
+const (
+	// L2FeatureLearn is MAC learning.
+	L2FeatureLearn L2Features = 1 << iota
+	// L2FeatureForward is L2 forwarding.
+	L2FeatureForward
+	// L2FeatureFlood is broadcast/multicast flooding.
+	L2FeatureFlood
+	// L2FeatureUnknownUnicastFlood is unknown unicast flooding.
+	L2FeatureUnknownUnicastFlood
+)
+
+// MacEventAction is the type of the MAC event.
+type MacEventAction int
+
+const (
+	// MacLearned is reported for newly learned MAC.
+	MacLearned MacEventAction = iota
+	// MacDeleted is reported for MAC which was aged out or flushed.
+	MacDeleted
+	// MacMoved is reported for MAC which was learned on a different interface.
+	MacMoved
+)
+
+// MacEvent is reported when MAC is learned, moved or removed from L2 FIB.
+type MacEvent struct {
+	SwIfIndex uint32
+	Mac       string
+	Action    MacEventAction
+}
+
 // L2VppAPI groups L2 Vpp APIs.
 type L2VppAPI interface {
 	BridgeDomainVppAPI
@@ -59,6 +113,15 @@ type BridgeDomainVppAPI interface {
 	AddArpTerminationTableEntry(bdID uint32, mac string, ip string) error
 	// RemoveArpTerminationTableEntry removes ARP termination entry from bridge domain.
 	RemoveArpTerminationTableEntry(bdID uint32, mac string, ip string) error
+	// SetBridgeDomainLearnLimit sets max number of MACs learned in the bridge domain
+	// (0 sets the VPP default limit).
+	SetBridgeDomainLearnLimit(bdIdx uint32, limit uint32) error
+	// SetInterfaceL2Features enables or disables L2 features on the bridge domain interface.
+	SetInterfaceL2Features(iface string, features L2Features, enable bool) error
+	// SetInterfaceTagRewrite sets VLAN tag rewrite on the bridge domain interface.
+	SetInterfaceTagRewrite(iface string, tagRw *l2.BridgeDomain_Interface_TagRewrite) error
+	// WatchMacEvents starts watching for events about learned MACs.
+	WatchMacEvents(ctx context.Context, events chan<- *MacEvent) error
 }
 
 // BridgeDomainVppRead provides read methods for bridge domains.
@@ -66,6 +129,10 @@ type BridgeDomainVppRead interface {
 	// DumpBridgeDomains dumps VPP bridge domain data into the northbound API data structure
 	// map indexed by bridge domain ID.
 	DumpBridgeDomains() ([]*BridgeDomainDetails, error)
+	// DumpBridgeDomain dumps VPP bridge domain with the given ID (nil if it does not exist).
+	DumpBridgeDomain(bdID uint32) (*BridgeDomainDetails, error)
+	// DumpBridgeDomainStats dumps statistics of all bridge domains.
+	DumpBridgeDomainStats() ([]*BridgeDomainStats, error)
 }
 
 // FibTableDetails is the wrapper structure for the FIB table entry northbound API structure.
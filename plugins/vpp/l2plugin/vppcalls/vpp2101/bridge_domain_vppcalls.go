@@ -15,6 +15,8 @@
 package vpp2101
 
 import (
+	"errors"
+
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/l2"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
@@ -55,3 +57,12 @@ func (h *BridgeDomainVppHandler) DeleteBridgeDomain(bdIdx uint32) error {
 
 	return nil
 }
+
+// SetBridgeDomainLearnLimit is not supported by VPP 21.01.
+func (h *BridgeDomainVppHandler) SetBridgeDomainLearnLimit(bdIdx uint32, limit uint32) error {
+	if limit == 0 {
+		// VPP default limit is always used
+		return nil
+	}
+	return errors.New("bridge domain MAC learn limit is not supported by VPP 21.01")
+}
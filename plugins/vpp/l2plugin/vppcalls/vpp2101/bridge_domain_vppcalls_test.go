@@ -113,6 +113,18 @@ func TestVppDeleteBridgeDomainError(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+func TestVppSetBridgeDomainLearnLimit(t *testing.T) {
+	ctx, bdHandler, _ := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := bdHandler.SetBridgeDomainLearnLimit(dummyBridgeDomain, 0)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	err = bdHandler.SetBridgeDomainLearnLimit(dummyBridgeDomain, 100)
+	Expect(err).Should(HaveOccurred())
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+}
+
 func bdTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.BridgeDomainVppAPI, ifaceidx.IfaceMetadataIndexRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
@@ -16,6 +16,7 @@ package vpp2106
 
 import (
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
 
@@ -55,3 +56,21 @@ func (h *BridgeDomainVppHandler) DeleteBridgeDomain(bdIdx uint32) error {
 
 	return nil
 }
+
+// SetBridgeDomainLearnLimit sets max number of MACs learned in the bridge domain.
+func (h *BridgeDomainVppHandler) SetBridgeDomainLearnLimit(bdIdx uint32, limit uint32) error {
+	if limit == 0 {
+		limit = vppcalls.DefaultBridgeDomainLearnLimit
+	}
+	req := &vpp_l2.BridgeDomainSetLearnLimit{
+		BdID:       bdIdx,
+		LearnLimit: limit,
+	}
+	reply := &vpp_l2.BridgeDomainSetLearnLimitReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/l2"
@@ -27,23 +28,46 @@ import (
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
 
+// Bits of the L2 input feature bitmap returned in L2FlagsReply
+// (see L2INPUT_FEAT_* in src/vnet/l2/l2_input.h).
+const (
+	l2InputFeatFlood   = 1 << 2
+	l2InputFeatUUFlood = 1 << 5
+	l2InputFeatFwd     = 1 << 8
+	l2InputFeatLearn   = 1 << 10
+)
+
 // DumpBridgeDomains implements bridge domain handler.
 func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDetails, error) {
+	return h.dumpBridgeDomains(^uint32(0))
+}
+
+// DumpBridgeDomain implements bridge domain handler.
+func (h *BridgeDomainVppHandler) DumpBridgeDomain(bdID uint32) (*vppcalls.BridgeDomainDetails, error) {
+	bds, err := h.dumpBridgeDomains(bdID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bds) == 0 {
+		return nil, nil
+	}
+	return bds[0], nil
+}
+
+// dumpBridgeDomains dumps bridge domain with the given ID, or all bridge domains if the ID is ~0.
+func (h *BridgeDomainVppHandler) dumpBridgeDomains(bdID uint32) ([]*vppcalls.BridgeDomainDetails, error) {
 	// At first prepare bridge domain ARP termination table which needs to be dumped separately.
-	bdArpTab, err := h.dumpBridgeDomainMacTable()
+	bdArpTab, err := h.dumpBridgeDomainMacTable(bdID)
 	if err != nil {
 		return nil, errors.Errorf("failed to dump arp termination table: %v", err)
 	}
 
-	// list of resulting BDs
-	var bds []*vppcalls.BridgeDomainDetails
-
 	// dump bridge domains
+	var bdDump []*vpp_l2.BridgeDomainDetails
 	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.BridgeDomainDump{
-		BdID:      ^uint32(0),
+		BdID:      bdID,
 		SwIfIndex: ^interface_types.InterfaceIndex(0),
 	})
-
 	for {
 		bdDetails := &vpp_l2.BridgeDomainDetails{}
 		stop, err := reqCtx.ReceiveReply(bdDetails)
@@ -53,7 +77,30 @@ func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDe
 		if err != nil {
 			return nil, err
 		}
+		bdDump = append(bdDump, bdDetails)
+	}
+
+	// VLAN tag rewrite of interfaces is also dumped separately, either for all
+	// interfaces at once, or only for interfaces of the dumped bridge domain.
+	tagRwTab := make(map[uint32]*l2.BridgeDomain_Interface_TagRewrite)
+	if bdID == ^uint32(0) {
+		if err = h.dumpInterfaceTagRewrites(^uint32(0), tagRwTab); err != nil {
+			return nil, errors.Errorf("failed to dump interface tag rewrites: %v", err)
+		}
+	} else {
+		for _, bdDetails := range bdDump {
+			for _, iface := range bdDetails.SwIfDetails {
+				if err = h.dumpInterfaceTagRewrites(uint32(iface.SwIfIndex), tagRwTab); err != nil {
+					return nil, errors.Errorf("failed to dump interface tag rewrites: %v", err)
+				}
+			}
+		}
+	}
 
+	// list of resulting BDs
+	var bds []*vppcalls.BridgeDomainDetails
+
+	for _, bdDetails := range bdDump {
 		// bridge domain metadata
 		bdData := &vppcalls.BridgeDomainDetails{
 			Bd: &l2.BridgeDomain{
@@ -82,11 +129,21 @@ func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDe
 			if iface.SwIfIndex == bdDetails.BviSwIfIndex {
 				bvi = true
 			}
+			// L2 features of the interface
+			features, err := h.dumpInterfaceL2Features(iface.SwIfIndex)
+			if err != nil {
+				return nil, errors.Errorf("failed to dump L2 features of interface %s: %v", ifaceName, err)
+			}
 			// add interface entry
 			bdData.Bd.Interfaces = append(bdData.Bd.Interfaces, &l2.BridgeDomain_Interface{
 				Name:                    ifaceName,
 				BridgedVirtualInterface: bvi,
 				SplitHorizonGroup:       uint32(iface.Shg),
+				TagRewrite:              tagRwTab[uint32(iface.SwIfIndex)],
+				Learn:                   featureOverride(bdDetails.Learn, features&l2InputFeatLearn != 0),
+				Forward:                 featureOverride(bdDetails.Forward, features&l2InputFeatFwd != 0),
+				Flood:                   featureOverride(bdDetails.Flood, features&l2InputFeatFlood != 0),
+				UnknownUnicastFlood:     featureOverride(bdDetails.UuFlood, features&l2InputFeatUUFlood != 0),
 			})
 		}
 
@@ -102,10 +159,125 @@ func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDe
 	return bds, nil
 }
 
-// Reads ARP termination table from all bridge domains. Result is then added to bridge domains.
-func (h *BridgeDomainVppHandler) dumpBridgeDomainMacTable() (map[uint32][]*l2.BridgeDomain_ArpTerminationEntry, error) {
+// Reads L2 input feature bitmap of the interface. The bitmap is returned in reply to
+// the request to set no features.
+func (h *BridgeDomainVppHandler) dumpInterfaceL2Features(swIfIndex interface_types.InterfaceIndex) (uint32, error) {
+	req := &vpp_l2.L2Flags{
+		SwIfIndex:     swIfIndex,
+		IsSet:         false,
+		FeatureBitmap: 0,
+	}
+	reply := &vpp_l2.L2FlagsReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.ResultingFeatureBitmap, nil
+}
+
+// featureOverride returns override of the bridge domain L2 feature for the interface.
+func featureOverride(bdEnabled, ifEnabled bool) l2.BridgeDomain_Interface_FeatureOverride {
+	switch {
+	case ifEnabled == bdEnabled:
+		return l2.BridgeDomain_Interface_INHERIT
+	case ifEnabled:
+		return l2.BridgeDomain_Interface_ENABLED
+	default:
+		return l2.BridgeDomain_Interface_DISABLED
+	}
+}
+
+// Reads VLAN tag rewrite of the interface (or all interfaces if the index is ~0).
+// Tag rewrite of sub-interfaces is not returned since it is configured as a part
+// of the sub-interface.
+func (h *BridgeDomainVppHandler) dumpInterfaceTagRewrites(swIfIndex uint32, tagRwTab map[uint32]*l2.BridgeDomain_Interface_TagRewrite) error {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ifs.SwInterfaceDump{
+		SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+	})
+	for {
+		ifDetails := &vpp_ifs.SwInterfaceDetails{}
+		stop, err := reqCtx.ReceiveReply(ifDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if ifDetails.VtrOp == 0 || ifDetails.SupSwIfIndex != uint32(ifDetails.SwIfIndex) {
+			continue
+		}
+		tagRwTab[uint32(ifDetails.SwIfIndex)] = &l2.BridgeDomain_Interface_TagRewrite{
+			Operation: l2.BridgeDomain_Interface_TagRewrite_Operation(ifDetails.VtrOp),
+			PushDot1Q: ifDetails.VtrPushDot1q != 0,
+			Tag1:      ifDetails.VtrTag1,
+			Tag2:      ifDetails.VtrTag2,
+		}
+	}
+	return nil
+}
+
+// DumpBridgeDomainStats dumps statistics of all bridge domains.
+func (h *BridgeDomainVppHandler) DumpBridgeDomainStats() ([]*vppcalls.BridgeDomainStats, error) {
+	var stats []*vppcalls.BridgeDomainStats
+	bdStats := make(map[uint32]*vppcalls.BridgeDomainStats)
+
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.BridgeDomainDump{
+		BdID:      ^uint32(0),
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		bdDetails := &vpp_l2.BridgeDomainDetails{}
+		stop, err := reqCtx.ReceiveReply(bdDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		bdStat := &vppcalls.BridgeDomainStats{
+			BdName:        strings.Trim(bdDetails.BdTag, "\x00"),
+			BdID:          bdDetails.BdID,
+			NumInterfaces: uint32(len(bdDetails.SwIfDetails)),
+			LearnedMacs:   make(map[string]uint32),
+		}
+		bdStats[bdDetails.BdID] = bdStat
+		stats = append(stats, bdStat)
+	}
+
+	reqCtx = h.callsChannel.SendMultiRequest(&vpp_l2.L2FibTableDump{BdID: ^uint32(0)})
+	for {
+		fibDetails := &vpp_l2.L2FibTableDetails{}
+		stop, err := reqCtx.ReceiveReply(fibDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		bdStat, ok := bdStats[fibDetails.BdID]
+		if !ok {
+			continue
+		}
+		if fibDetails.StaticMac || fibDetails.BviMac {
+			bdStat.NumStaticMacs++
+			continue
+		}
+		bdStat.NumLearnedMacs++
+		ifaceName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(fibDetails.SwIfIndex))
+		if !exists {
+			h.log.Warnf("Bridge domain stats: interface name for index %d not found", fibDetails.SwIfIndex)
+			continue
+		}
+		bdStat.LearnedMacs[ifaceName]++
+	}
+
+	return stats, nil
+}
+
+// Reads ARP termination table from the bridge domain (or all bridge domains if the ID is ~0).
+// Result is then added to bridge domains.
+func (h *BridgeDomainVppHandler) dumpBridgeDomainMacTable(bdID uint32) (map[uint32][]*l2.BridgeDomain_ArpTerminationEntry, error) {
 	bdArpTable := make(map[uint32][]*l2.BridgeDomain_ArpTerminationEntry)
-	req := &vpp_l2.BdIPMacDump{BdID: ^uint32(0)}
+	req := &vpp_l2.BdIPMacDump{BdID: bdID}
 
 	reqCtx := h.callsChannel.SendMultiRequest(req)
 	for {
@@ -22,6 +22,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ethernet_types"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/l2"
@@ -66,6 +67,9 @@ var testDataOutMessage = []*vppcalls.BridgeDomainDetails{
 				},
 				{
 					Name: "if2",
+					TagRewrite: &l2.BridgeDomain_Interface_TagRewrite{
+						Operation: l2.BridgeDomain_Interface_TagRewrite_POP1,
+					},
 				},
 			},
 		},
@@ -115,11 +119,26 @@ func TestDumpBridgeDomains(t *testing.T) {
 			Ping:    true,
 			Message: &vpp_l2.BdIPMacDetails{},
 		},
+		{
+			Name: (&vpp_ifs.SwInterfaceDump{}).GetMessageName(),
+			Ping: true,
+			Message: &vpp_ifs.SwInterfaceDetails{
+				SwIfIndex:    7,
+				SupSwIfIndex: 7,
+				VtrOp:        3,
+			},
+		},
 		{
 			Name:    (&vpp_l2.BridgeDomainDump{}).GetMessageName(),
 			Ping:    true,
 			Message: testDataInMessagesBDs[0],
 		},
+		{
+			Name: (&vpp_l2.L2Flags{}).GetMessageName(),
+			Message: &vpp_l2.L2FlagsReply{
+				ResultingFeatureBitmap: 1<<2 | 1<<5 | 1<<8 | 1<<10,
+			},
+		},
 	})
 
 	bridgeDomains, err := bdHandler.DumpBridgeDomains()
@@ -158,6 +177,11 @@ func TestDumpBridgeDomainsWithARP(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceDump{}).GetMessageName(),
+			Ping:    true,
+			Message: &vpp_ifs.SwInterfaceDetails{},
+		},
 		{
 			Name:    (&vpp_l2.BridgeDomainDump{}).GetMessageName(),
 			Ping:    true,
@@ -176,6 +200,97 @@ func TestDumpBridgeDomainsWithARP(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+// TestDumpBridgeDomain tests DumpBridgeDomain method
+func TestDumpBridgeDomain(t *testing.T) {
+	ctx, bdHandler, ifIndexes := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 5})
+	ifIndexes.Put("if3", &ifaceidx.IfaceMetadata{SwIfIndex: 8})
+
+	ctx.MockReplies([]*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_l2.BdIPMacDump{}).GetMessageName(),
+			Ping:    true,
+			Message: &vpp_l2.BdIPMacDetails{},
+		},
+		{
+			Name:    (&vpp_l2.BridgeDomainDump{}).GetMessageName(),
+			Ping:    true,
+			Message: testDataInMessagesBDs[1],
+		},
+		{
+			Name:    (&vpp_ifs.SwInterfaceDump{}).GetMessageName(),
+			Ping:    true,
+			Message: &vpp_ifs.SwInterfaceDetails{},
+		},
+		{
+			Name: (&vpp_l2.L2Flags{}).GetMessageName(),
+			Message: &vpp_l2.L2FlagsReply{
+				ResultingFeatureBitmap: 1<<8 | 1<<10,
+			},
+		},
+	})
+
+	bd, err := bdHandler.DumpBridgeDomain(5)
+
+	Expect(err).To(BeNil())
+	Expect(bd.Meta.BdID).To(BeEquivalentTo(5))
+	Expect(bd.Bd.Interfaces).To(HaveLen(2))
+	for _, bdIface := range bd.Bd.Interfaces {
+		Expect(bdIface.Learn).To(Equal(l2.BridgeDomain_Interface_ENABLED))
+		Expect(bdIface.Forward).To(Equal(l2.BridgeDomain_Interface_ENABLED))
+		Expect(bdIface.Flood).To(Equal(l2.BridgeDomain_Interface_INHERIT))
+		Expect(bdIface.UnknownUnicastFlood).To(Equal(l2.BridgeDomain_Interface_INHERIT))
+	}
+	// only the requested bridge domain and its interfaces are dumped
+	Expect(ctx.MockChannel.Msgs).To(ConsistOf(
+		&vpp_l2.BdIPMacDump{BdID: 5},
+		&vpp_l2.BridgeDomainDump{BdID: 5, SwIfIndex: ^interface_types.InterfaceIndex(0)},
+		&vpp_ifs.SwInterfaceDump{SwIfIndex: 5},
+		&vpp_ifs.SwInterfaceDump{SwIfIndex: 8},
+		&vpp_l2.L2Flags{SwIfIndex: 5},
+		&vpp_l2.L2Flags{SwIfIndex: 8},
+	))
+}
+
+// TestDumpBridgeDomainStats tests DumpBridgeDomainStats method
+func TestDumpBridgeDomainStats(t *testing.T) {
+	ctx, bdHandler, ifIndexes := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 5})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 7})
+
+	ctx.MockReplies([]*vppmock.HandleReplies{
+		{
+			Name:    (&vpp_l2.BridgeDomainDump{}).GetMessageName(),
+			Ping:    true,
+			Message: testDataInMessagesBDs[0],
+		},
+		{
+			Name: (&vpp_l2.L2FibTableDump{}).GetMessageName(),
+			Ping: true,
+			Message: &vpp_l2.L2FibTableDetails{
+				BdID:      4,
+				Mac:       ethernet_types.MacAddress{0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA},
+				SwIfIndex: 7,
+			},
+		},
+	})
+
+	stats, err := bdHandler.DumpBridgeDomainStats()
+
+	Expect(err).To(BeNil())
+	Expect(stats).To(HaveLen(1))
+	Expect(stats[0]).To(Equal(&vppcalls.BridgeDomainStats{
+		BdID:           4,
+		NumInterfaces:  2,
+		NumLearnedMacs: 1,
+		LearnedMacs:    map[string]uint32{"if2": 1},
+	}))
+}
+
 var testDataInMessagesFIBs = []govppapi.Message{
 	&vpp_l2.L2FibTableDetails{
 		BdID:   10,
@@ -21,6 +21,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
 
@@ -48,6 +49,64 @@ func (h *BridgeDomainVppHandler) DeleteInterfaceFromBridgeDomain(bdIdx uint32, i
 	return nil
 }
 
+// SetInterfaceL2Features enables or disables L2 features on the bridge domain interface.
+func (h *BridgeDomainVppHandler) SetInterfaceL2Features(iface string, features vppcalls.L2Features, enable bool) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+	var bitmap vpp_l2.BdFlags
+	if features&vppcalls.L2FeatureLearn != 0 {
+		bitmap |= vpp_l2.BRIDGE_API_FLAG_LEARN
+	}
+	if features&vppcalls.L2FeatureForward != 0 {
+		bitmap |= vpp_l2.BRIDGE_API_FLAG_FWD
+	}
+	if features&vppcalls.L2FeatureFlood != 0 {
+		bitmap |= vpp_l2.BRIDGE_API_FLAG_FLOOD
+	}
+	if features&vppcalls.L2FeatureUnknownUnicastFlood != 0 {
+		bitmap |= vpp_l2.BRIDGE_API_FLAG_UU_FLOOD
+	}
+	req := &vpp_l2.L2Flags{
+		SwIfIndex:     interface_types.InterfaceIndex(ifaceMeta.GetIndex()),
+		IsSet:         enable,
+		FeatureBitmap: uint32(bitmap),
+	}
+	reply := &vpp_l2.L2FlagsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("%s returned error: %v", reply.GetMessageName(), err)
+	}
+
+	return nil
+}
+
+// SetInterfaceTagRewrite sets VLAN tag rewrite on the bridge domain interface
+// (nil or DISABLED operation disables the rewrite).
+func (h *BridgeDomainVppHandler) SetInterfaceTagRewrite(iface string, tagRw *l2.BridgeDomain_Interface_TagRewrite) error {
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+	req := &vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.GetIndex()),
+		VtrOp:     uint32(tagRw.GetOperation()),
+		Tag1:      tagRw.GetTag1(),
+		Tag2:      tagRw.GetTag2(),
+	}
+	if tagRw.GetPushDot1Q() {
+		req.PushDot1q = 1
+	}
+	reply := &vpp_l2.L2InterfaceVlanTagRewriteReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return fmt.Errorf("%s returned error: %v", reply.GetMessageName(), err)
+	}
+
+	return nil
+}
+
 func (h *BridgeDomainVppHandler) addDelInterfaceToBridgeDomain(bdIdx uint32, ifaceCfg *l2.BridgeDomain_Interface,
 	ifIdx uint32, add bool) error {
 	req := &vpp_l2.SwInterfaceSetL2Bridge{
@@ -22,6 +22,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/l2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
 
@@ -142,3 +143,58 @@ func TestDeleteMissingInterfaceFromBridgeDomain(t *testing.T) {
 	Expect(err).ToNot(BeNil())
 	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
 }
+
+func TestSetInterfaceL2Features(t *testing.T) {
+	ctx, bdHandler, ifaceIdx := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{})
+	err := bdHandler.SetInterfaceL2Features("if1",
+		vppcalls.L2FeatureLearn|vppcalls.L2FeatureUnknownUnicastFlood, false)
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_l2.L2Flags{
+		SwIfIndex:     interface_types.InterfaceIndex(1),
+		IsSet:         false,
+		FeatureBitmap: uint32(vpp_l2.BRIDGE_API_FLAG_LEARN | vpp_l2.BRIDGE_API_FLAG_UU_FLOOD),
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2FlagsReply{Retval: 1})
+	err = bdHandler.SetInterfaceL2Features("if1", vppcalls.L2FeatureForward, true)
+	Expect(err).ToNot(BeNil())
+
+	err = bdHandler.SetInterfaceL2Features("if2", vppcalls.L2FeatureForward, true)
+	Expect(err).ToNot(BeNil())
+}
+
+func TestSetInterfaceTagRewrite(t *testing.T) {
+	ctx, bdHandler, ifaceIdx := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2InterfaceVlanTagRewriteReply{})
+	err := bdHandler.SetInterfaceTagRewrite("if1", &l2.BridgeDomain_Interface_TagRewrite{
+		Operation: l2.BridgeDomain_Interface_TagRewrite_PUSH1,
+		PushDot1Q: true,
+		Tag1:      100,
+	})
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: interface_types.InterfaceIndex(1),
+		VtrOp:     1,
+		PushDot1q: 1,
+		Tag1:      100,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2InterfaceVlanTagRewriteReply{})
+	err = bdHandler.SetInterfaceTagRewrite("if1", nil)
+
+	Expect(err).To(BeNil())
+	Expect(ctx.MockChannel.Msg).To(BeEquivalentTo(&vpp_l2.L2InterfaceVlanTagRewrite{
+		SwIfIndex: interface_types.InterfaceIndex(1),
+	}))
+}
@@ -113,6 +113,33 @@ func TestVppDeleteBridgeDomainError(t *testing.T) {
 	Expect(err).Should(HaveOccurred())
 }
 
+func TestVppSetBridgeDomainLearnLimit(t *testing.T) {
+	ctx, bdHandler, _ := bdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_l2.BridgeDomainSetLearnLimitReply{})
+	err := bdHandler.SetBridgeDomainLearnLimit(dummyBridgeDomain, 100)
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_l2.BridgeDomainSetLearnLimit{
+		BdID:       dummyBridgeDomain,
+		LearnLimit: 100,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_l2.BridgeDomainSetLearnLimitReply{})
+	err = bdHandler.SetBridgeDomainLearnLimit(dummyBridgeDomain, 0)
+
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.MockChannel.Msg).To(Equal(&vpp_l2.BridgeDomainSetLearnLimit{
+		BdID:       dummyBridgeDomain,
+		LearnLimit: vppcalls.DefaultBridgeDomainLearnLimit,
+	}))
+
+	ctx.MockVpp.MockReply(&vpp_l2.BridgeDomainSetLearnLimitReply{Retval: 1})
+	err = bdHandler.SetBridgeDomainLearnLimit(dummyBridgeDomain, 100)
+	Expect(err).Should(HaveOccurred())
+}
+
 func bdTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.BridgeDomainVppAPI, ifaceidx.IfaceMetadataIndexRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
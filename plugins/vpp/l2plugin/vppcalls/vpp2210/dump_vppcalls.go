@@ -0,0 +1,417 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
+	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
+)
+
+// Bits of the L2 input feature bitmap returned in L2FlagsReply
+// (see L2INPUT_FEAT_* in src/vnet/l2/l2_input.h).
+const (
+	l2InputFeatFlood   = 1 << 2
+	l2InputFeatUUFlood = 1 << 5
+	l2InputFeatFwd     = 1 << 7
+	l2InputFeatLearn   = 1 << 9
+)
+
+// DumpBridgeDomains implements bridge domain handler.
+func (h *BridgeDomainVppHandler) DumpBridgeDomains() ([]*vppcalls.BridgeDomainDetails, error) {
+	return h.dumpBridgeDomains(^uint32(0))
+}
+
+// DumpBridgeDomain implements bridge domain handler.
+func (h *BridgeDomainVppHandler) DumpBridgeDomain(bdID uint32) (*vppcalls.BridgeDomainDetails, error) {
+	bds, err := h.dumpBridgeDomains(bdID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bds) == 0 {
+		return nil, nil
+	}
+	return bds[0], nil
+}
+
+// dumpBridgeDomains dumps bridge domain with the given ID, or all bridge domains if the ID is ~0.
+func (h *BridgeDomainVppHandler) dumpBridgeDomains(bdID uint32) ([]*vppcalls.BridgeDomainDetails, error) {
+	// At first prepare bridge domain ARP termination table which needs to be dumped separately.
+	bdArpTab, err := h.dumpBridgeDomainMacTable(bdID)
+	if err != nil {
+		return nil, errors.Errorf("failed to dump arp termination table: %v", err)
+	}
+
+	// dump bridge domains
+	var bdDump []*vpp_l2.BridgeDomainDetails
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.BridgeDomainDump{
+		BdID:      bdID,
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		bdDetails := &vpp_l2.BridgeDomainDetails{}
+		stop, err := reqCtx.ReceiveReply(bdDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		bdDump = append(bdDump, bdDetails)
+	}
+
+	// VLAN tag rewrite of interfaces is also dumped separately, either for all
+	// interfaces at once, or only for interfaces of the dumped bridge domain.
+	tagRwTab := make(map[uint32]*l2.BridgeDomain_Interface_TagRewrite)
+	if bdID == ^uint32(0) {
+		if err = h.dumpInterfaceTagRewrites(^uint32(0), tagRwTab); err != nil {
+			return nil, errors.Errorf("failed to dump interface tag rewrites: %v", err)
+		}
+	} else {
+		for _, bdDetails := range bdDump {
+			for _, iface := range bdDetails.SwIfDetails {
+				if err = h.dumpInterfaceTagRewrites(uint32(iface.SwIfIndex), tagRwTab); err != nil {
+					return nil, errors.Errorf("failed to dump interface tag rewrites: %v", err)
+				}
+			}
+		}
+	}
+
+	// list of resulting BDs
+	var bds []*vppcalls.BridgeDomainDetails
+
+	for _, bdDetails := range bdDump {
+		// bridge domain metadata
+		bdData := &vppcalls.BridgeDomainDetails{
+			Bd: &l2.BridgeDomain{
+				Name:                strings.Trim(bdDetails.BdTag, "\x00"),
+				Flood:               bdDetails.Flood,
+				UnknownUnicastFlood: bdDetails.UuFlood,
+				Forward:             bdDetails.Forward,
+				Learn:               bdDetails.Learn,
+				ArpTermination:      bdDetails.ArpTerm,
+				MacAge:              uint32(bdDetails.MacAge),
+			},
+			Meta: &vppcalls.BridgeDomainMeta{
+				BdID: bdDetails.BdID,
+			},
+		}
+
+		// bridge domain interfaces
+		for _, iface := range bdDetails.SwIfDetails {
+			ifaceName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(iface.SwIfIndex))
+			if !exists {
+				h.log.Warnf("Bridge domain dump: interface name for index %d not found", iface.SwIfIndex)
+				continue
+			}
+			// Bvi
+			var bvi bool
+			if iface.SwIfIndex == bdDetails.BviSwIfIndex {
+				bvi = true
+			}
+			// L2 features of the interface
+			features, err := h.dumpInterfaceL2Features(iface.SwIfIndex)
+			if err != nil {
+				return nil, errors.Errorf("failed to dump L2 features of interface %s: %v", ifaceName, err)
+			}
+			// add interface entry
+			bdData.Bd.Interfaces = append(bdData.Bd.Interfaces, &l2.BridgeDomain_Interface{
+				Name:                    ifaceName,
+				BridgedVirtualInterface: bvi,
+				SplitHorizonGroup:       uint32(iface.Shg),
+				TagRewrite:              tagRwTab[uint32(iface.SwIfIndex)],
+				Learn:                   featureOverride(bdDetails.Learn, features&l2InputFeatLearn != 0),
+				Forward:                 featureOverride(bdDetails.Forward, features&l2InputFeatFwd != 0),
+				Flood:                   featureOverride(bdDetails.Flood, features&l2InputFeatFlood != 0),
+				UnknownUnicastFlood:     featureOverride(bdDetails.UuFlood, features&l2InputFeatUUFlood != 0),
+			})
+		}
+
+		// Add ARP termination entries.
+		arpTable, ok := bdArpTab[bdDetails.BdID]
+		if ok {
+			bdData.Bd.ArpTerminationTable = arpTable
+		}
+
+		bds = append(bds, bdData)
+	}
+
+	return bds, nil
+}
+
+// Reads L2 input feature bitmap of the interface. The bitmap is returned in reply to
+// the request to set no features.
+func (h *BridgeDomainVppHandler) dumpInterfaceL2Features(swIfIndex interface_types.InterfaceIndex) (uint32, error) {
+	req := &vpp_l2.L2Flags{
+		SwIfIndex:     swIfIndex,
+		IsSet:         false,
+		FeatureBitmap: 0,
+	}
+	reply := &vpp_l2.L2FlagsReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.ResultingFeatureBitmap, nil
+}
+
+// featureOverride returns override of the bridge domain L2 feature for the interface.
+func featureOverride(bdEnabled, ifEnabled bool) l2.BridgeDomain_Interface_FeatureOverride {
+	switch {
+	case ifEnabled == bdEnabled:
+		return l2.BridgeDomain_Interface_INHERIT
+	case ifEnabled:
+		return l2.BridgeDomain_Interface_ENABLED
+	default:
+		return l2.BridgeDomain_Interface_DISABLED
+	}
+}
+
+// Reads VLAN tag rewrite of the interface (or all interfaces if the index is ~0).
+// Tag rewrite of sub-interfaces is not returned since it is configured as a part
+// of the sub-interface.
+func (h *BridgeDomainVppHandler) dumpInterfaceTagRewrites(swIfIndex uint32, tagRwTab map[uint32]*l2.BridgeDomain_Interface_TagRewrite) error {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ifs.SwInterfaceDump{
+		SwIfIndex: interface_types.InterfaceIndex(swIfIndex),
+	})
+	for {
+		ifDetails := &vpp_ifs.SwInterfaceDetails{}
+		stop, err := reqCtx.ReceiveReply(ifDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if ifDetails.VtrOp == 0 || ifDetails.SupSwIfIndex != uint32(ifDetails.SwIfIndex) {
+			continue
+		}
+		tagRwTab[uint32(ifDetails.SwIfIndex)] = &l2.BridgeDomain_Interface_TagRewrite{
+			Operation: l2.BridgeDomain_Interface_TagRewrite_Operation(ifDetails.VtrOp),
+			PushDot1Q: ifDetails.VtrPushDot1q != 0,
+			Tag1:      ifDetails.VtrTag1,
+			Tag2:      ifDetails.VtrTag2,
+		}
+	}
+	return nil
+}
+
+// DumpBridgeDomainStats dumps statistics of all bridge domains.
+func (h *BridgeDomainVppHandler) DumpBridgeDomainStats() ([]*vppcalls.BridgeDomainStats, error) {
+	var stats []*vppcalls.BridgeDomainStats
+	bdStats := make(map[uint32]*vppcalls.BridgeDomainStats)
+
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.BridgeDomainDump{
+		BdID:      ^uint32(0),
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		bdDetails := &vpp_l2.BridgeDomainDetails{}
+		stop, err := reqCtx.ReceiveReply(bdDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		bdStat := &vppcalls.BridgeDomainStats{
+			BdName:        strings.Trim(bdDetails.BdTag, "\x00"),
+			BdID:          bdDetails.BdID,
+			NumInterfaces: uint32(len(bdDetails.SwIfDetails)),
+			LearnedMacs:   make(map[string]uint32),
+		}
+		bdStats[bdDetails.BdID] = bdStat
+		stats = append(stats, bdStat)
+	}
+
+	reqCtx = h.callsChannel.SendMultiRequest(&vpp_l2.L2FibTableDump{BdID: ^uint32(0)})
+	for {
+		fibDetails := &vpp_l2.L2FibTableDetails{}
+		stop, err := reqCtx.ReceiveReply(fibDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		bdStat, ok := bdStats[fibDetails.BdID]
+		if !ok {
+			continue
+		}
+		if fibDetails.StaticMac || fibDetails.BviMac {
+			bdStat.NumStaticMacs++
+			continue
+		}
+		bdStat.NumLearnedMacs++
+		ifaceName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(fibDetails.SwIfIndex))
+		if !exists {
+			h.log.Warnf("Bridge domain stats: interface name for index %d not found", fibDetails.SwIfIndex)
+			continue
+		}
+		bdStat.LearnedMacs[ifaceName]++
+	}
+
+	return stats, nil
+}
+
+// Reads ARP termination table from the bridge domain (or all bridge domains if the ID is ~0).
+// Result is then added to bridge domains.
+func (h *BridgeDomainVppHandler) dumpBridgeDomainMacTable(bdID uint32) (map[uint32][]*l2.BridgeDomain_ArpTerminationEntry, error) {
+	bdArpTable := make(map[uint32][]*l2.BridgeDomain_ArpTerminationEntry)
+	req := &vpp_l2.BdIPMacDump{BdID: bdID}
+
+	reqCtx := h.callsChannel.SendMultiRequest(req)
+	for {
+		msg := &vpp_l2.BdIPMacDetails{}
+		stop, err := reqCtx.ReceiveReply(msg)
+		if err != nil {
+			return nil, err
+		}
+		if stop {
+			break
+		}
+
+		// Prepare ARP entry
+		arpEntry := &l2.BridgeDomain_ArpTerminationEntry{}
+		arpEntry.IpAddress = parseAddressToString(msg.Entry.IP)
+		arpEntry.PhysAddress = net.HardwareAddr(msg.Entry.Mac[:]).String()
+
+		// Add ARP entry to result map
+		bdArpTable[msg.Entry.BdID] = append(bdArpTable[msg.Entry.BdID], arpEntry)
+	}
+
+	return bdArpTable, nil
+}
+
+// DumpL2FIBs dumps VPP L2 FIB table entries into the northbound API
+// data structure map indexed by destination MAC address.
+func (h *FIBVppHandler) DumpL2FIBs() (map[string]*vppcalls.FibTableDetails, error) {
+	// map for the resulting FIBs
+	fibs := make(map[string]*vppcalls.FibTableDetails)
+
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.L2FibTableDump{BdID: ^uint32(0)})
+	for {
+		fibDetails := &vpp_l2.L2FibTableDetails{}
+		stop, err := reqCtx.ReceiveReply(fibDetails)
+		if stop {
+			break // Break from the loop.
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		mac := net.HardwareAddr(fibDetails.Mac[:]).String()
+		var action l2.FIBEntry_Action
+		if fibDetails.FilterMac {
+			action = l2.FIBEntry_DROP
+		} else {
+			action = l2.FIBEntry_FORWARD
+		}
+
+		// Interface name (only for FORWARD entries)
+		var ifName string
+		if action == l2.FIBEntry_FORWARD {
+			var exists bool
+			ifName, _, exists = h.ifIndexes.LookupBySwIfIndex(uint32(fibDetails.SwIfIndex))
+			if !exists {
+				h.log.Warnf("FIB dump: interface name for index %d not found", fibDetails.SwIfIndex)
+				continue
+			}
+		}
+		// Bridge domain name
+		bdName, _, exists := h.bdIndexes.LookupByIndex(fibDetails.BdID)
+		if !exists {
+			h.log.Warnf("FIB dump: bridge domain name for index %d not found", fibDetails.BdID)
+			continue
+		}
+
+		fibs[mac] = &vppcalls.FibTableDetails{
+			Fib: &l2.FIBEntry{
+				PhysAddress:             mac,
+				BridgeDomain:            bdName,
+				Action:                  action,
+				OutgoingInterface:       ifName,
+				StaticConfig:            fibDetails.StaticMac,
+				BridgedVirtualInterface: fibDetails.BviMac,
+			},
+			Meta: &vppcalls.FibMeta{
+				BdID:  fibDetails.BdID,
+				IfIdx: uint32(fibDetails.SwIfIndex),
+			},
+		}
+	}
+
+	return fibs, nil
+}
+
+// DumpXConnectPairs implements xconnect handler.
+func (h *XConnectVppHandler) DumpXConnectPairs() (map[uint32]*vppcalls.XConnectDetails, error) {
+	// map for the resulting xconnect pairs
+	xpairs := make(map[uint32]*vppcalls.XConnectDetails)
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_l2.L2XconnectDump{})
+	for {
+		pairs := &vpp_l2.L2XconnectDetails{}
+		stop, err := reqCtx.ReceiveReply(pairs)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Find interface names
+		rxIfaceName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(pairs.RxSwIfIndex))
+		if !exists {
+			h.log.Warnf("XConnect dump: rx interface name for index %d not found", pairs.RxSwIfIndex)
+			continue
+		}
+		txIfaceName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(pairs.TxSwIfIndex))
+		if !exists {
+			h.log.Warnf("XConnect dump: tx interface name for index %d not found", pairs.TxSwIfIndex)
+			continue
+		}
+
+		xpairs[uint32(pairs.RxSwIfIndex)] = &vppcalls.XConnectDetails{
+			Xc: &l2.XConnectPair{
+				ReceiveInterface:  rxIfaceName,
+				TransmitInterface: txIfaceName,
+			},
+			Meta: &vppcalls.XcMeta{
+				ReceiveInterfaceSwIfIdx:  uint32(pairs.RxSwIfIndex),
+				TransmitInterfaceSwIfIdx: uint32(pairs.TxSwIfIndex),
+			},
+		}
+	}
+	return xpairs, nil
+}
+
+func parseAddressToString(address ip_types.Address) string {
+	var nhIP net.IP = make([]byte, 16)
+	copy(nhIP[:], address.Un.XXX_UnionData[:])
+	if address.Af == ip_types.ADDRESS_IP4 {
+		return nhIP[:4].To4().String()
+	}
+	if address.Af == ip_types.ADDRESS_IP6 {
+		return nhIP.To16().String()
+	}
+	return ""
+}
@@ -0,0 +1,141 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+
+	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
+)
+
+var (
+	// EventDeliverTimeout defines maximum time to deliver event upstream.
+	EventDeliverTimeout = time.Second
+	// NotifChanBufferSize defines size of notification channel buffer.
+	NotifChanBufferSize = 10
+)
+
+const (
+	// macEventScanDelay is the delay between L2 FIB scans in 10ms units.
+	macEventScanDelay = 10
+	// maxMacsInEvent is the max number of MACs reported in one event (in units of 10 MACs).
+	maxMacsInEvent = 10
+)
+
+// WatchMacEvents starts watching for events about learned MACs.
+// Note that enabling of MAC events flushes all MACs learned so far.
+func (h *BridgeDomainVppHandler) WatchMacEvents(ctx context.Context, eventsCh chan<- *vppcalls.MacEvent) error {
+	notifChan := make(chan govppapi.Message, NotifChanBufferSize)
+
+	// subscribe to L2MacsEvent notifications
+	sub, err := h.callsChannel.SubscribeNotification(notifChan, &vpp_l2.L2MacsEvent{})
+	if err != nil {
+		return errors.Errorf("subscribing to VPP notification (l2_macs_event) failed: %v", err)
+	}
+	unsub := func() {
+		if err := sub.Unsubscribe(); err != nil {
+			h.log.Warnf("unsubscribing VPP notification (l2_macs_event) failed: %v", err)
+		}
+	}
+
+	go func() {
+		h.log.Debugf("start watching MAC events")
+		defer h.log.Debugf("done watching MAC events (%v)", ctx.Err())
+
+		for {
+			select {
+			case e, open := <-notifChan:
+				if !open {
+					h.log.Debugf("MAC events channel was closed")
+					unsub()
+					return
+				}
+
+				macsEvent, ok := e.(*vpp_l2.L2MacsEvent)
+				if !ok {
+					h.log.Debugf("unexpected notification type: %#v", macsEvent)
+					continue
+				}
+
+				for _, entry := range macsEvent.Mac {
+					macEvent := toMacEvent(entry)
+					// try to send event
+					select {
+					case eventsCh <- macEvent:
+						// sent ok
+					case <-ctx.Done():
+						unsub()
+						return
+					default:
+						// channel full send event in goroutine for later processing
+						go func() {
+							select {
+							case eventsCh <- macEvent:
+								// sent ok
+							case <-time.After(EventDeliverTimeout):
+								h.log.Warnf("unable to deliver MAC event, dropping it: %+v", macEvent)
+							}
+						}()
+					}
+				}
+			case <-ctx.Done():
+				unsub()
+				return
+			}
+		}
+	}()
+
+	// enable MAC events from VPP
+	req := &vpp_l2.WantL2MacsEvents{
+		LearnLimit:     vppcalls.DefaultBridgeDomainLearnLimit,
+		ScanDelay:      macEventScanDelay,
+		MaxMacsInEvent: maxMacsInEvent,
+		EnableDisable:  true,
+		PID:            uint32(os.Getpid()),
+	}
+	reply := &vpp_l2.WantL2MacsEventsReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		if errors.Is(err, govppapi.VPPApiError(govppapi.INVALID_REGISTRATION)) {
+			h.log.Warnf("already subscribed to MAC events: %v", err)
+			return nil
+		}
+		return errors.Errorf("failed to watch MAC events: %v", err)
+	}
+
+	return nil
+}
+
+func toMacEvent(entry vpp_l2.MacEntry) *vppcalls.MacEvent {
+	event := &vppcalls.MacEvent{
+		SwIfIndex: uint32(entry.SwIfIndex),
+		Mac:       net.HardwareAddr(entry.MacAddr[:]).String(),
+	}
+	switch entry.Action {
+	case vpp_l2.MAC_EVENT_ACTION_API_DELETE:
+		event.Action = vppcalls.MacDeleted
+	case vpp_l2.MAC_EVENT_ACTION_API_MOVE:
+		event.Action = vppcalls.MacMoved
+	default:
+		event.Action = vppcalls.MacLearned
+	}
+	return event
+}
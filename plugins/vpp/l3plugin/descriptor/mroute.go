@@ -0,0 +1,267 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MRouteDescriptorName is the name of the descriptor for multicast routes.
+	MRouteDescriptorName = "vpp-mroute"
+
+	// dependency labels
+	mroutePathInterfaceDep = "path-interface-exists"
+)
+
+// A list of multicast route validation errors.
+var (
+	ErrMRouteInvalidGroup    = errors.New("group must be a multicast IP network")
+	ErrMRouteInvalidSource   = errors.New("source address must be a unicast IP address of the same IP version as the group")
+	ErrMRouteMissingPathIf   = errors.New("path interface is not defined")
+	ErrMRouteMissingPathFlag = errors.New("path must have at least one interface flag set")
+	ErrMRouteDuplicatePath   = errors.New("path interface is defined more than once")
+)
+
+// MRouteDescriptor teaches KVScheduler how to configure VPP multicast routes.
+type MRouteDescriptor struct {
+	log           logging.Logger
+	mrouteHandler vppcalls.MRouteVppAPI
+}
+
+// NewMRouteDescriptor creates a new instance of the MRoute descriptor.
+func NewMRouteDescriptor(mrouteHandler vppcalls.MRouteVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &MRouteDescriptor{
+		mrouteHandler: mrouteHandler,
+		log:           log.NewLogger("mroute-descriptor"),
+	}
+
+	typedDescr := &adapter.MRouteDescriptor{
+		Name:            MRouteDescriptorName,
+		NBKeyPrefix:     l3.ModelMRoute.KeyPrefix(),
+		ValueTypeName:   l3.ModelMRoute.ProtoName(),
+		KeySelector:     l3.ModelMRoute.IsKeyValid,
+		KeyLabel:        l3.ModelMRoute.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentMRoutes,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Update:          ctx.Update,
+		Retrieve:        ctx.Retrieve,
+		Dependencies:    ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			VrfTableDescriptorName},
+	}
+	return adapter.NewMRouteDescriptor(typedDescr)
+}
+
+// EquivalentMRoutes compares multicast routes, ignoring the order of paths.
+func (d *MRouteDescriptor) EquivalentMRoutes(key string, oldMRoute, newMRoute *l3.MRoute) bool {
+	if oldMRoute.GetVrfId() != newMRoute.GetVrfId() ||
+		!equalMRouteFlags(oldMRoute, newMRoute) ||
+		!equalNetworks(oldMRoute.GetGroup(), newMRoute.GetGroup()) ||
+		!equalAddrs(oldMRoute.GetSourceAddress(), newMRoute.GetSourceAddress()) {
+		return false
+	}
+	oldPaths := mroutePathsByInterface(oldMRoute)
+	newPaths := mroutePathsByInterface(newMRoute)
+	if len(oldPaths) != len(newPaths) {
+		return false
+	}
+	for ifName, oldPath := range oldPaths {
+		if newPath, has := newPaths[ifName]; !has || !equalMRoutePaths(oldPath, newPath) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates VPP multicast route configuration.
+func (d *MRouteDescriptor) Validate(key string, mroute *l3.MRoute) error {
+	grpIP, grpNet, err := net.ParseCIDR(mroute.Group)
+	if err != nil || !grpNet.IP.Equal(grpIP) || !grpIP.IsMulticast() {
+		return kvs.NewInvalidValueError(ErrMRouteInvalidGroup, "group")
+	}
+	if mroute.SourceAddress != "" {
+		srcIP := net.ParseIP(mroute.SourceAddress)
+		if srcIP == nil || srcIP.IsMulticast() || (srcIP.To4() == nil) != (grpIP.To4() == nil) {
+			return kvs.NewInvalidValueError(ErrMRouteInvalidSource, "source_address")
+		}
+	}
+	ifNames := make(map[string]struct{})
+	for _, path := range mroute.Paths {
+		if path.Interface == "" {
+			return kvs.NewInvalidValueError(ErrMRouteMissingPathIf, "paths.interface")
+		}
+		if !path.Accept && !path.Forward && !path.SignalPresent && !path.NegateSignal {
+			return kvs.NewInvalidValueError(ErrMRouteMissingPathFlag, "paths")
+		}
+		if _, duplicate := ifNames[path.Interface]; duplicate {
+			return kvs.NewInvalidValueError(ErrMRouteDuplicatePath, "paths.interface")
+		}
+		ifNames[path.Interface] = struct{}{}
+	}
+	return nil
+}
+
+// Create adds VPP multicast route.
+func (d *MRouteDescriptor) Create(key string, mroute *l3.MRoute) (metadata interface{}, err error) {
+	err = d.mrouteHandler.VppAddMRoute(context.TODO(), mroute)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes VPP multicast route.
+func (d *MRouteDescriptor) Delete(key string, mroute *l3.MRoute, metadata interface{}) error {
+	return d.mrouteHandler.VppDelMRoute(context.TODO(), mroute)
+}
+
+// Update updates entry flags and paths of VPP multicast route.
+func (d *MRouteDescriptor) Update(key string, oldMRoute, newMRoute *l3.MRoute, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	ctx := context.TODO()
+	if !equalMRouteFlags(oldMRoute, newMRoute) {
+		if err = d.mrouteHandler.VppSetMRouteFlags(ctx, newMRoute); err != nil {
+			return nil, err
+		}
+	}
+
+	oldPaths := mroutePathsByInterface(oldMRoute)
+	newPaths := mroutePathsByInterface(newMRoute)
+	var removed, added []*l3.MRoute_Path
+	for ifName, oldPath := range oldPaths {
+		if _, has := newPaths[ifName]; !has {
+			removed = append(removed, oldPath)
+		}
+	}
+	for ifName, newPath := range newPaths {
+		// path update with the same interface only replaces its flags
+		if oldPath, has := oldPaths[ifName]; !has || !equalMRoutePaths(oldPath, newPath) {
+			added = append(added, newPath)
+		}
+	}
+	if len(removed) > 0 {
+		if err = d.mrouteHandler.VppDelMRoutePaths(ctx, oldMRoute, removed); err != nil {
+			return nil, err
+		}
+	}
+	if len(added) > 0 {
+		if err = d.mrouteHandler.VppAddMRoutePaths(ctx, newMRoute, added); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Retrieve returns all multicast routes from VRF tables known to the agent.
+func (d *MRouteDescriptor) Retrieve(correlate []adapter.MRouteKVWithMetadata) (
+	retrieved []adapter.MRouteKVWithMetadata, err error,
+) {
+	nbCfg := make(map[string]*l3.MRoute)
+	for _, kv := range correlate {
+		nbCfg[models.Key(kv.Value)] = kv.Value
+	}
+
+	mroutes, err := d.mrouteHandler.DumpMRoutes()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP multicast routes: %v", err)
+	}
+
+	for _, mroute := range mroutes {
+		key := models.Key(mroute.MRoute)
+		value := mroute.MRoute
+		origin := kvs.UnknownOrigin
+
+		// correlate with the expected configuration
+		if nbMRoute, hasNbCfg := nbCfg[key]; hasNbCfg {
+			if d.EquivalentMRoutes(key, value, nbMRoute) {
+				value = nbMRoute
+				origin = kvs.FromNB
+			}
+		}
+
+		retrieved = append(retrieved, adapter.MRouteKVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: origin,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists dependencies for a VPP multicast route.
+func (d *MRouteDescriptor) Dependencies(key string, mroute *l3.MRoute) (dependencies []kvs.Dependency) {
+	// all path interfaces must exist
+	for _, path := range mroute.Paths {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: mroutePathInterfaceDep + "-" + path.Interface,
+			Key:   interfaces.InterfaceKey(path.Interface),
+		})
+	}
+
+	// non-zero VRFs
+	if mroute.VrfId != 0 {
+		var protocol l3.VrfTable_Protocol
+		if grpIP, _, err := net.ParseCIDR(mroute.Group); err == nil && grpIP.To4() == nil {
+			protocol = l3.VrfTable_IPV6
+		}
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: vrfTableDep,
+			Key:   l3.VrfTableKey(mroute.VrfId, protocol),
+		})
+	}
+	return dependencies
+}
+
+// equalMRouteFlags compares entry flags and RPF ID of two multicast routes.
+func equalMRouteFlags(mroute1, mroute2 *l3.MRoute) bool {
+	return mroute1.GetSignal() == mroute2.GetSignal() &&
+		mroute1.GetDrop() == mroute2.GetDrop() &&
+		mroute1.GetConnected() == mroute2.GetConnected() &&
+		mroute1.GetAcceptAllInterfaces() == mroute2.GetAcceptAllInterfaces() &&
+		mroute1.GetRpfId() == mroute2.GetRpfId()
+}
+
+// equalMRoutePaths compares interface flags of two multicast route paths.
+func equalMRoutePaths(path1, path2 *l3.MRoute_Path) bool {
+	return path1.GetAccept() == path2.GetAccept() &&
+		path1.GetForward() == path2.GetForward() &&
+		path1.GetSignalPresent() == path2.GetSignalPresent() &&
+		path1.GetNegateSignal() == path2.GetNegateSignal()
+}
+
+// mroutePathsByInterface returns paths of the multicast route indexed by interface name.
+func mroutePathsByInterface(mroute *l3.MRoute) map[string]*l3.MRoute_Path {
+	paths := make(map[string]*l3.MRoute_Path, len(mroute.GetPaths()))
+	for _, path := range mroute.GetPaths() {
+		paths[path.GetInterface()] = path
+	}
+	return paths
+}
@@ -22,6 +22,7 @@
 //go:generate descriptor-adapter --descriptor-name L3XC --value-type *vpp_l3.L3XConnect --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name TeibEntry --value-type *vpp_l3.TeibEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name VRRPEntry --value-type *vpp_l3.VRRPEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MRoute --value-type *vpp_l3.MRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 
 package l3plugin
 
@@ -42,6 +43,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2210"
 )
 
 func init() {
@@ -83,7 +85,7 @@ func (p *L3Plugin) Init() (err error) {
 
 	// init and register VRF descriptor
 	vrfTableDescriptor := descriptor.NewVrfTableDescriptor(p.l3Handler, p.Log)
-	if err = p.Deps.KVScheduler.RegisterKVDescriptor(vrfTableDescriptor); err != nil {
+	if err = p.VPP.RegisterKVDescriptor(p.Deps.KVScheduler, vrfTableDescriptor); err != nil {
 		return err
 	}
 	metadataMap := p.KVScheduler.GetMetadataMap(vrfTableDescriptor.Name)
@@ -99,6 +101,7 @@ func (p *L3Plugin) Init() (err error) {
 
 	// init & register descriptors
 	routeDescriptor := descriptor.NewRouteDescriptor(p.l3Handler, p.AddrAlloc, p.Log)
+	mrouteDescriptor := descriptor.NewMRouteDescriptor(p.l3Handler, p.Log)
 	arpDescriptor := descriptor.NewArpDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	proxyArpDescriptor := descriptor.NewProxyArpDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	proxyArpIfaceDescriptor := descriptor.NewProxyArpInterfaceDescriptor(p.KVScheduler, p.l3Handler, p.Log)
@@ -108,8 +111,9 @@ func (p *L3Plugin) Init() (err error) {
 	teibDescriptor := descriptor.NewTeibDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	vrrpDescriptor := descriptor.NewVrrpDescriptor(p.l3Handler, p.Log)
 
-	err = p.Deps.KVScheduler.RegisterKVDescriptor(
+	err = p.VPP.RegisterKVDescriptor(p.Deps.KVScheduler,
 		routeDescriptor,
+		mrouteDescriptor,
 		arpDescriptor,
 		proxyArpDescriptor,
 		proxyArpIfaceDescriptor,
@@ -123,6 +127,67 @@ func (p *L3Plugin) Init() (err error) {
 		return err
 	}
 
+	// register descriptors for additional VPP instances
+	return p.initInstances()
+}
+
+// initInstances registers L3 descriptors for additional VPP instances
+// (keys of their values are scoped by the instance).
+func (p *L3Plugin) initInstances() error {
+	multiVPP, ok := p.VPP.(govppmux.MultiInstanceAPI)
+	if !ok {
+		return nil
+	}
+	for _, instance := range multiVPP.Instances() {
+		vppAPI, err := multiVPP.Instance(instance)
+		if err != nil {
+			return err
+		}
+		ifIndex := p.IfPlugin.GetInstanceInterfaceIndex(instance)
+		if ifIndex == nil {
+			return errors.Errorf("missing index with interface metadata of VPP instance %q", instance)
+		}
+		l3Handler := vppcalls.CompatibleL3VppHandler(vppAPI, ifIndex, nil, p.AddrAlloc, p.Log)
+		if l3Handler == nil {
+			return errors.Errorf("could not find compatible L3VppHandler for VPP instance %q", instance)
+		}
+
+		vrfTableDescriptor := descriptor.NewVrfTableDescriptor(l3Handler, p.Log)
+		instanceDescriptor, err := kvs.InstanceDescriptor(instance, vrfTableDescriptor)
+		if err != nil {
+			return err
+		}
+		if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+			return err
+		}
+		metadataMap := p.KVScheduler.GetMetadataMap(kvs.InstanceDescriptorName(instance, vrfTableDescriptor.Name))
+		vrfIndex, withIndex := metadataMap.(vrfidx.VRFMetadataIndex)
+		if !withIndex {
+			return errors.Errorf("missing index with VRF metadata of VPP instance %q", instance)
+		}
+		l3Handler = vppcalls.CompatibleL3VppHandler(vppAPI, ifIndex, vrfIndex, p.AddrAlloc, p.Log)
+
+		for _, d := range []*kvs.KVDescriptor{
+			descriptor.NewRouteDescriptor(l3Handler, p.AddrAlloc, p.Log),
+			descriptor.NewMRouteDescriptor(l3Handler, p.Log),
+			descriptor.NewArpDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewProxyArpDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewProxyArpInterfaceDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewIPScanNeighborDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewDHCPProxyDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewL3XCDescriptor(l3Handler, ifIndex, p.Log),
+			descriptor.NewTeibDescriptor(p.KVScheduler, l3Handler, p.Log),
+			descriptor.NewVrrpDescriptor(l3Handler, p.Log),
+		} {
+			instanceDescriptor, err := kvs.InstanceDescriptor(instance, d)
+			if err != nil {
+				return err
+			}
+			if err = vppAPI.RegisterKVDescriptor(p.KVScheduler, instanceDescriptor); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
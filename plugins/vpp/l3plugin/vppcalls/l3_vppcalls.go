@@ -45,6 +45,7 @@ type L3VppAPI interface {
 	ArpVppAPI
 	ProxyArpVppAPI
 	RouteVppAPI
+	MRouteVppAPI
 	IPNeighVppAPI
 	VrfTableVppAPI
 	DHCPProxyAPI
@@ -201,6 +202,44 @@ type RouteVppRead interface {
 	DumpRoutes() ([]*RouteDetails, error)
 }
 
+// MRouteDetails is object returned as a VPP dump. It contains multicast route
+// data in proto format, and VPP-specific metadata.
+type MRouteDetails struct {
+	MRoute *l3.MRoute
+	Meta   *MRouteMeta
+}
+
+// MRouteMeta holds fields returned from the VPP as details which are not in the model.
+type MRouteMeta struct {
+	TableName string
+	// PathIfIdxs contains interface indexes of the route paths (in the same order).
+	PathIfIdxs []uint32
+}
+
+// MRouteVppAPI provides methods for managing multicast routes.
+type MRouteVppAPI interface {
+	MRouteVppRead
+
+	// VppAddMRoute adds new multicast route including all its paths.
+	VppAddMRoute(ctx context.Context, mroute *l3.MRoute) error
+	// VppDelMRoute removes multicast route including all its paths.
+	VppDelMRoute(ctx context.Context, mroute *l3.MRoute) error
+	// VppSetMRouteFlags updates entry flags and RPF ID of the multicast route
+	// (paths are left untouched).
+	VppSetMRouteFlags(ctx context.Context, mroute *l3.MRoute) error
+	// VppAddMRoutePaths adds (or updates interface flags of) given paths
+	// of the multicast route.
+	VppAddMRoutePaths(ctx context.Context, mroute *l3.MRoute, paths []*l3.MRoute_Path) error
+	// VppDelMRoutePaths removes given paths from the multicast route.
+	VppDelMRoutePaths(ctx context.Context, mroute *l3.MRoute, paths []*l3.MRoute_Path) error
+}
+
+// MRouteVppRead provides read methods for multicast routes.
+type MRouteVppRead interface {
+	// DumpMRoutes dumps multicast routes of all VRF tables.
+	DumpMRoutes() ([]*MRouteDetails, error)
+}
+
 // VrfTableVppAPI provides methods for managing VRF tables.
 type VrfTableVppAPI interface {
 	VrfTableVppRead
@@ -0,0 +1,189 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/mfib_types"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// MfibRPFIDNone is the value of RPF ID used by VPP for multicast routes
+// without RPF ID (equals to MFIB_RPF_ID_NONE defined in VPP).
+const MfibRPFIDNone = ^uint32(0)
+
+// VppAddMRoute implements multicast route handler.
+func (h *RouteHandler) VppAddMRoute(ctx context.Context, mroute *l3.MRoute) error {
+	// entry flags and RPF ID can be set only without paths
+	if err := h.VppSetMRouteFlags(ctx, mroute); err != nil {
+		return err
+	}
+	if len(mroute.Paths) == 0 {
+		return nil
+	}
+	return h.VppAddMRoutePaths(ctx, mroute, mroute.Paths)
+}
+
+// VppDelMRoute implements multicast route handler.
+func (h *RouteHandler) VppDelMRoute(ctx context.Context, mroute *l3.MRoute) error {
+	if len(mroute.Paths) > 0 {
+		if err := h.VppDelMRoutePaths(ctx, mroute, mroute.Paths); err != nil {
+			return err
+		}
+	}
+	// route without paths and entry flags is removed by VPP
+	return h.vppAddDelMRoute(mroute, &l3.MRoute{}, nil, true)
+}
+
+// VppSetMRouteFlags implements multicast route handler.
+func (h *RouteHandler) VppSetMRouteFlags(ctx context.Context, mroute *l3.MRoute) error {
+	return h.vppAddDelMRoute(mroute, mroute, nil, true)
+}
+
+// VppAddMRoutePaths implements multicast route handler.
+func (h *RouteHandler) VppAddMRoutePaths(ctx context.Context, mroute *l3.MRoute, paths []*l3.MRoute_Path) error {
+	return h.vppAddDelMRoute(mroute, mroute, paths, true)
+}
+
+// VppDelMRoutePaths implements multicast route handler.
+func (h *RouteHandler) VppDelMRoutePaths(ctx context.Context, mroute *l3.MRoute, paths []*l3.MRoute_Path) error {
+	return h.vppAddDelMRoute(mroute, mroute, paths, false)
+}
+
+// vppAddDelMRoute adds or removes paths of the multicast route. Without paths,
+// entry flags and RPF ID (taken from <flags>) of the route are updated instead.
+func (h *RouteHandler) vppAddDelMRoute(mroute, flags *l3.MRoute, paths []*l3.MRoute_Path, isAdd bool) error {
+	prefix, isIPv6, err := mrouteToMprefix(mroute)
+	if err != nil {
+		return err
+	}
+	pathProto := fib_types.FIB_API_PATH_NH_PROTO_IP4
+	if isIPv6 {
+		pathProto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+	}
+
+	rpfID := MfibRPFIDNone
+	if flags.RpfId != 0 {
+		rpfID = flags.RpfId
+	}
+	req := &vpp_ip.IPMrouteAddDel{
+		IsAdd:       isAdd,
+		IsMultipath: true,
+		Route: vpp_ip.IPMroute{
+			TableID:    mroute.VrfId,
+			EntryFlags: mrouteEntryFlags(flags),
+			RpfID:      rpfID,
+			Prefix:     prefix,
+			NPaths:     uint8(len(paths)),
+		},
+	}
+	for _, path := range paths {
+		meta, found := h.ifIndexes.LookupByName(path.Interface)
+		if !found {
+			return errors.Errorf("interface %s not found", path.Interface)
+		}
+		req.Route.Paths = append(req.Route.Paths, mfib_types.MfibPath{
+			ItfFlags: mroutePathFlags(path),
+			Path: fib_types.FibPath{
+				SwIfIndex: meta.SwIfIndex,
+				TableID:   mroute.VrfId,
+				Proto:     pathProto,
+				Nh: fib_types.FibPathNh{
+					ViaLabel:           NextHopViaLabelUnset,
+					ClassifyTableIndex: ClassifyTableIndexUnset,
+				},
+			},
+		})
+	}
+
+	reply := &vpp_ip.IPMrouteAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// mrouteToMprefix converts group and source of the multicast route into VPP mprefix.
+func mrouteToMprefix(mroute *l3.MRoute) (prefix ip_types.Mprefix, isIPv6 bool, err error) {
+	_, grpNet, err := net.ParseCIDR(mroute.Group)
+	if err != nil {
+		return prefix, false, errors.Errorf("invalid multicast group %q: %v", mroute.Group, err)
+	}
+	grpLen, _ := grpNet.Mask.Size()
+	prefix.GrpAddressLength = uint16(grpLen)
+
+	var srcIP net.IP
+	if mroute.SourceAddress != "" {
+		if srcIP = net.ParseIP(mroute.SourceAddress); srcIP == nil {
+			return prefix, false, errors.Errorf("invalid multicast source address %q", mroute.SourceAddress)
+		}
+	}
+
+	if grpNet.IP.To4() == nil {
+		var grpAddr, srcAddr ip_types.IP6Address
+		copy(grpAddr[:], grpNet.IP.To16())
+		copy(srcAddr[:], srcIP.To16())
+		prefix.Af = ip_types.ADDRESS_IP6
+		prefix.GrpAddress.SetIP6(grpAddr)
+		prefix.SrcAddress.SetIP6(srcAddr)
+		return prefix, true, nil
+	}
+	var grpAddr, srcAddr ip_types.IP4Address
+	copy(grpAddr[:], grpNet.IP.To4())
+	copy(srcAddr[:], srcIP.To4())
+	prefix.Af = ip_types.ADDRESS_IP4
+	prefix.GrpAddress.SetIP4(grpAddr)
+	prefix.SrcAddress.SetIP4(srcAddr)
+	return prefix, false, nil
+}
+
+func mrouteEntryFlags(mroute *l3.MRoute) (flags mfib_types.MfibEntryFlags) {
+	if mroute.Signal {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL
+	}
+	if mroute.Drop {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_DROP
+	}
+	if mroute.Connected {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED
+	}
+	if mroute.AcceptAllInterfaces {
+		flags |= mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF
+	}
+	return flags
+}
+
+func mroutePathFlags(path *l3.MRoute_Path) (flags mfib_types.MfibItfFlags) {
+	if path.Accept {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_ACCEPT
+	}
+	if path.Forward {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_FORWARD
+	}
+	if path.SignalPresent {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_SIGNAL_PRESENT
+	}
+	if path.NegateSignal {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_NEGATE_SIGNAL
+	}
+	return flags
+}
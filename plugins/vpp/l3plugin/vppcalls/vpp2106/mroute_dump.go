@@ -0,0 +1,112 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2106
+
+import (
+	"fmt"
+	"net"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/mfib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// DumpMRoutes implements multicast route handler.
+func (h *RouteHandler) DumpMRoutes() (mroutes []*vppcalls.MRouteDetails, err error) {
+	// dump multicast routes for every VRF and for both IP versions
+	for _, vrfMeta := range h.vrfIndexes.ListAllVrfMetadata() {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_ip.IPMrouteDump{
+			Table: vpp_ip.IPTable{
+				TableID: vrfMeta.GetIndex(),
+				IsIP6:   protoToUint(vrfMeta.GetProtocol()),
+			},
+		})
+		for {
+			details := &vpp_ip.IPMrouteDetails{}
+			stop, err := reqCtx.ReceiveReply(details)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			// skip the default (*,*) entry installed by VPP into every table
+			if details.Route.Prefix.GrpAddressLength == 0 {
+				continue
+			}
+			mroutes = append(mroutes, h.dumpMRouteDetails(details.Route))
+		}
+	}
+	return mroutes, nil
+}
+
+// dumpMRouteDetails converts multicast route from binary API into the NB model.
+func (h *RouteHandler) dumpMRouteDetails(ipMroute vpp_ip.IPMroute) *vppcalls.MRouteDetails {
+	prefix := ipMroute.Prefix
+	var grpIP, srcIP net.IP
+	if prefix.Af == ip_types.ADDRESS_IP6 {
+		grpAddr, srcAddr := prefix.GrpAddress.GetIP6(), prefix.SrcAddress.GetIP6()
+		grpIP, srcIP = net.IP(grpAddr[:]).To16(), net.IP(srcAddr[:]).To16()
+	} else {
+		grpAddr, srcAddr := prefix.GrpAddress.GetIP4(), prefix.SrcAddress.GetIP4()
+		grpIP, srcIP = net.IP(grpAddr[:]).To4(), net.IP(srcAddr[:]).To4()
+	}
+
+	mroute := &l3.MRoute{
+		VrfId:               ipMroute.TableID,
+		Group:               fmt.Sprintf("%s/%d", grpIP, prefix.GrpAddressLength),
+		Signal:              ipMroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL != 0,
+		Drop:                ipMroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_DROP != 0,
+		Connected:           ipMroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED != 0,
+		AcceptAllInterfaces: ipMroute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF != 0,
+	}
+	if !srcIP.IsUnspecified() {
+		mroute.SourceAddress = srcIP.String()
+	}
+	if ipMroute.RpfID != MfibRPFIDNone {
+		mroute.RpfId = ipMroute.RpfID
+	}
+
+	meta := &vppcalls.MRouteMeta{}
+	for _, path := range ipMroute.Paths {
+		if path.Path.Type != fib_types.FIB_API_PATH_TYPE_NORMAL {
+			continue
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(path.Path.SwIfIndex)
+		if !exists {
+			h.log.Warnf("Multicast route dump: interface name for index %d not found", path.Path.SwIfIndex)
+		}
+		mroute.Paths = append(mroute.Paths, &l3.MRoute_Path{
+			Interface:     ifName,
+			Accept:        path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_ACCEPT != 0,
+			Forward:       path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_FORWARD != 0,
+			SignalPresent: path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_SIGNAL_PRESENT != 0,
+			NegateSignal:  path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_NEGATE_SIGNAL != 0,
+		})
+		meta.PathIfIdxs = append(meta.PathIfIdxs, path.Path.SwIfIndex)
+	}
+	// Note: VPP does not return table name, the field is filled using index map
+	if vrfName, _, exists := h.vrfIndexes.LookupByVRFIndex(ipMroute.TableID); exists {
+		meta.TableName = vrfName
+	}
+
+	return &vppcalls.MRouteDetails{
+		MRoute: mroute,
+		Meta:   meta,
+	}
+}
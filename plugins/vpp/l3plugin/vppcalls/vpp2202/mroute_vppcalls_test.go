@@ -0,0 +1,173 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	netallock_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/mfib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	vpp2202 "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+var mroute = &l3.MRoute{
+	VrfId:         1,
+	Group:         "232.1.1.1/32",
+	SourceAddress: "10.0.0.1",
+	Paths: []*l3.MRoute_Path{
+		{Interface: "iface1", Accept: true},
+		{Interface: "iface2", Forward: true},
+	},
+}
+
+// Test adding multicast route
+func TestAddMRoute(t *testing.T) {
+	ctx, mrHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrHandler.VppAddMRoute(ctx.Context, mroute)
+	Expect(err).To(Succeed())
+
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	flagsReq, ok := ctx.MockChannel.Msgs[0].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(flagsReq.Route.Paths).To(BeEmpty())
+	Expect(flagsReq.Route.RpfID).To(Equal(vpp2202.MfibRPFIDNone))
+	pathsReq, ok := ctx.MockChannel.Msgs[1].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(pathsReq.IsAdd).To(BeTrue())
+	Expect(pathsReq.Route.TableID).To(BeEquivalentTo(1))
+	Expect(pathsReq.Route.Prefix.Af).To(Equal(ip_types.ADDRESS_IP4))
+	Expect(pathsReq.Route.Prefix.GrpAddressLength).To(BeEquivalentTo(32))
+	Expect(pathsReq.Route.Prefix.GrpAddress.GetIP4()).To(Equal(ip_types.IP4Address{232, 1, 1, 1}))
+	Expect(pathsReq.Route.Prefix.SrcAddress.GetIP4()).To(Equal(ip_types.IP4Address{10, 0, 0, 1}))
+	Expect(pathsReq.Route.Paths).To(HaveLen(2))
+	Expect(pathsReq.Route.Paths[0].ItfFlags).To(Equal(mfib_types.MFIB_API_ITF_FLAG_ACCEPT))
+	Expect(pathsReq.Route.Paths[0].Path.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(pathsReq.Route.Paths[1].ItfFlags).To(Equal(mfib_types.MFIB_API_ITF_FLAG_FORWARD))
+	Expect(pathsReq.Route.Paths[1].Path.SwIfIndex).To(BeEquivalentTo(2))
+}
+
+// Test adding multicast route with unknown interface
+func TestAddMRouteError(t *testing.T) {
+	ctx, mrHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrHandler.VppAddMRoute(ctx.Context, &l3.MRoute{
+		Group: "232.1.1.1/32",
+		Paths: []*l3.MRoute_Path{{Interface: "iface3", Forward: true}},
+	})
+	Expect(err).To(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{Retval: 1})
+	err = mrHandler.VppSetMRouteFlags(ctx.Context, &l3.MRoute{Group: "232.1.1.1/32", Drop: true})
+	Expect(err).To(HaveOccurred())
+}
+
+// Test deleting multicast route
+func TestDeleteMRoute(t *testing.T) {
+	ctx, mrHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrHandler.VppDelMRoute(ctx.Context, mroute)
+	Expect(err).To(Succeed())
+
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	pathsReq, ok := ctx.MockChannel.Msgs[0].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(pathsReq.IsAdd).To(BeFalse())
+	Expect(pathsReq.Route.Paths).To(HaveLen(2))
+	flagsReq, ok := ctx.MockChannel.Msgs[1].(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(flagsReq.Route.Paths).To(BeEmpty())
+	Expect(flagsReq.Route.EntryFlags).To(Equal(mfib_types.MFIB_API_ENTRY_FLAG_NONE))
+}
+
+// Test dumping multicast routes
+func TestDumpMRoutes(t *testing.T) {
+	ctx, mrHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// default (*,*) entry is skipped
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteDetails{
+		Route: vpp_ip.IPMroute{
+			TableID:    1,
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_DROP,
+			RpfID:      vpp2202.MfibRPFIDNone,
+		},
+	}, &vpp_ip.IPMrouteDetails{
+		Route: vpp_ip.IPMroute{
+			TableID:    1,
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL,
+			RpfID:      vpp2202.MfibRPFIDNone,
+			Prefix: ip_types.Mprefix{
+				Af:               ip_types.ADDRESS_IP4,
+				GrpAddressLength: 32,
+				GrpAddress:       ip_types.AddressUnionIP4(ip_types.IP4Address{232, 1, 1, 1}),
+				SrcAddress:       ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1}),
+			},
+			NPaths: 2,
+			Paths: []mfib_types.MfibPath{
+				{ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT, Path: fib_types.FibPath{SwIfIndex: 1}},
+				{ItfFlags: mfib_types.MFIB_API_ITF_FLAG_FORWARD, Path: fib_types.FibPath{SwIfIndex: 2}},
+			},
+		},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	details, err := mrHandler.DumpMRoutes()
+	Expect(err).To(Succeed())
+
+	Expect(details).To(HaveLen(1))
+	Expect(details[0].MRoute.VrfId).To(BeEquivalentTo(1))
+	Expect(details[0].MRoute.Group).To(Equal("232.1.1.1/32"))
+	Expect(details[0].MRoute.SourceAddress).To(Equal("10.0.0.1"))
+	Expect(details[0].MRoute.Signal).To(BeTrue())
+	Expect(details[0].MRoute.RpfId).To(BeZero())
+	Expect(details[0].MRoute.Paths).To(HaveLen(2))
+	Expect(details[0].MRoute.Paths[0].Interface).To(Equal("iface1"))
+	Expect(details[0].MRoute.Paths[0].Accept).To(BeTrue())
+	Expect(details[0].MRoute.Paths[1].Interface).To(Equal("iface2"))
+	Expect(details[0].MRoute.Paths[1].Forward).To(BeTrue())
+	Expect(details[0].Meta.TableName).To(Equal("vrf1"))
+}
+
+func mrouteTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.MRouteVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("iface1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("iface2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	vrfIndexes := vrfidx.NewVRFIndex(logrus.NewLogger("test-vrf"), "test-vrf")
+	vrfIndexes.Put("vrf1", &vrfidx.VRFMetadata{Index: 1, Protocol: l3.VrfTable_IPV4})
+	mrHandler := vpp2202.NewRouteVppHandler(ctx.MockChannel, ifIndexes, vrfIndexes, netallock_mock.NewMockNetAlloc(), log)
+	return ctx, mrHandler
+}
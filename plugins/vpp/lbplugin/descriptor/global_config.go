@@ -0,0 +1,166 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+const (
+	// GlobalConfigDescriptorName is the name of the descriptor for the global
+	// configuration of the load-balancer.
+	GlobalConfigDescriptorName = "vpp-lb-global-config"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidIPv4SrcAddress is returned when IPv4 source address cannot be parsed.
+	ErrInvalidIPv4SrcAddress = errors.New("invalid IPv4 source address")
+	// ErrInvalidIPv6SrcAddress is returned when IPv6 source address cannot be parsed.
+	ErrInvalidIPv6SrcAddress = errors.New("invalid IPv6 source address")
+	// ErrStickyBucketsNotPowerOf2 is returned when the number of sticky buckets
+	// is not a power of 2.
+	ErrStickyBucketsNotPowerOf2 = errors.New("number of sticky buckets per core is not a power of 2")
+)
+
+// GlobalConfigDescriptor teaches KVScheduler how to configure the global configuration
+// of the VPP load-balancer.
+type GlobalConfigDescriptor struct {
+	log       logging.Logger
+	lbHandler vppcalls.LbVppAPI
+}
+
+// NewGlobalConfigDescriptor creates a new instance of the LB global config descriptor.
+func NewGlobalConfigDescriptor(lbHandler vppcalls.LbVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &GlobalConfigDescriptor{
+		lbHandler: lbHandler,
+		log:       log.NewLogger("lb-global-config-descriptor"),
+	}
+
+	typedDescr := &adapter.GlobalConfigDescriptor{
+		Name:            GlobalConfigDescriptorName,
+		NBKeyPrefix:     lb.ModelGlobalConfig.KeyPrefix(),
+		ValueTypeName:   lb.ModelGlobalConfig.ProtoName(),
+		KeySelector:     lb.ModelGlobalConfig.IsKeyValid,
+		ValueComparator: ctx.EquivalentGlobalConfigs,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Update:          ctx.Update,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewGlobalConfigDescriptor(typedDescr)
+}
+
+// EquivalentGlobalConfigs compares global configurations, treating unset values
+// as VPP defaults.
+func (d *GlobalConfigDescriptor) EquivalentGlobalConfigs(key string, oldConf, newConf *lb.GlobalConfig) bool {
+	return equivalentIPs(oldConf.Ip4SrcAddress, newConf.Ip4SrcAddress) &&
+		equivalentIPs(oldConf.Ip6SrcAddress, newConf.Ip6SrcAddress) &&
+		withDefault(oldConf.StickyBucketsPerCore, vppcalls.DefaultStickyBucketsPerCore) ==
+			withDefault(newConf.StickyBucketsPerCore, vppcalls.DefaultStickyBucketsPerCore) &&
+		withDefault(oldConf.FlowTimeout, vppcalls.DefaultFlowTimeout) ==
+			withDefault(newConf.FlowTimeout, vppcalls.DefaultFlowTimeout)
+}
+
+// Validate validates the LB global configuration.
+func (d *GlobalConfigDescriptor) Validate(key string, conf *lb.GlobalConfig) error {
+	if conf.Ip4SrcAddress != "" && net.ParseIP(conf.Ip4SrcAddress).To4() == nil {
+		return kvs.NewInvalidValueError(ErrInvalidIPv4SrcAddress, "ip4_src_address")
+	}
+	if conf.Ip6SrcAddress != "" {
+		if ip := net.ParseIP(conf.Ip6SrcAddress); ip == nil || ip.To4() != nil {
+			return kvs.NewInvalidValueError(ErrInvalidIPv6SrcAddress, "ip6_src_address")
+		}
+	}
+	if !isPowerOf2(conf.StickyBucketsPerCore) {
+		return kvs.NewInvalidValueError(ErrStickyBucketsNotPowerOf2, "sticky_buckets_per_core")
+	}
+	return nil
+}
+
+// Create applies the LB global configuration.
+func (d *GlobalConfigDescriptor) Create(key string, conf *lb.GlobalConfig) (metadata interface{}, err error) {
+	if err = d.lbHandler.SetLbConf(conf); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Update applies the changed LB global configuration.
+func (d *GlobalConfigDescriptor) Update(key string, oldConf, newConf *lb.GlobalConfig, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	return d.Create(key, newConf)
+}
+
+// Delete resets the LB global configuration to defaults.
+func (d *GlobalConfigDescriptor) Delete(key string, conf *lb.GlobalConfig, metadata interface{}) error {
+	if err := d.lbHandler.SetLbConf(&lb.GlobalConfig{}); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns the expected LB global configuration.
+func (d *GlobalConfigDescriptor) Retrieve(correlate []adapter.GlobalConfigKVWithMetadata) (
+	retrieved []adapter.GlobalConfigKVWithMetadata, err error) {
+	// VPP does not allow to dump the global configuration,
+	// assume that the configuration is as expected.
+	for _, conf := range correlate {
+		retrieved = append(retrieved, adapter.GlobalConfigKVWithMetadata{
+			Key:    conf.Key,
+			Value:  conf.Value,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// equivalentIPs compares IP addresses, treating unset address as unspecified address.
+func equivalentIPs(ip1, ip2 string) bool {
+	if ip1 == ip2 {
+		return true
+	}
+	parsed1, parsed2 := net.ParseIP(ip1), net.ParseIP(ip2)
+	if parsed1 == nil && ip1 == "" {
+		return parsed2 != nil && parsed2.IsUnspecified()
+	}
+	if parsed2 == nil && ip2 == "" {
+		return parsed1 != nil && parsed1.IsUnspecified()
+	}
+	return parsed1.Equal(parsed2)
+}
+
+// isPowerOf2 returns true for zero (VPP default is used) and powers of 2.
+func isPowerOf2(value uint32) bool {
+	return value&(value-1) == 0
+}
+
+func withDefault(value, defaultValue uint32) uint32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,219 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+const (
+	// VIPDescriptorName is the name of the descriptor for LB VIPs.
+	VIPDescriptorName = "vpp-lb-vip"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidVIPPrefix is returned when VIP prefix cannot be parsed.
+	ErrInvalidVIPPrefix = errors.New("invalid VIP prefix")
+	// ErrVIPPortWithAnyProtocol is returned when port is defined for VIP matching all protocols.
+	ErrVIPPortWithAnyProtocol = errors.New("VIP port cannot be defined without protocol")
+	// ErrVIPEncapMismatch is returned when the encapsulation does not match the VIP address family.
+	ErrVIPEncapMismatch = errors.New("encapsulation does not match the address family of the VIP")
+	// ErrVIPNodePortWithoutService is returned when node port is defined for non-NODEPORT service.
+	ErrVIPNodePortWithoutService = errors.New("node port can be defined only for NODEPORT service")
+	// ErrNewFlowsTableNotPowerOf2 is returned when the length of the new flows table is not a power of 2.
+	ErrNewFlowsTableNotPowerOf2 = errors.New("length of the new flows table is not a power of 2")
+	// ErrInvalidASAddress is returned when application server address cannot be parsed
+	// or does not match the encapsulation.
+	ErrInvalidASAddress = errors.New("invalid application server address")
+	// ErrDuplicateAS is returned when application server is defined more than once.
+	ErrDuplicateAS = errors.New("duplicate application server")
+)
+
+// VIPDescriptor teaches KVScheduler how to configure VPP load-balancer VIPs.
+// Application servers are derived values, therefore changes in the set of application
+// servers do not re-create the VIP.
+type VIPDescriptor struct {
+	log       logging.Logger
+	lbHandler vppcalls.LbVppAPI
+}
+
+// NewVIPDescriptor creates a new instance of the LB VIP descriptor.
+func NewVIPDescriptor(lbHandler vppcalls.LbVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &VIPDescriptor{
+		lbHandler: lbHandler,
+		log:       log.NewLogger("lb-vip-descriptor"),
+	}
+
+	typedDescr := &adapter.VIPDescriptor{
+		Name:            VIPDescriptorName,
+		NBKeyPrefix:     lb.ModelVIP.KeyPrefix(),
+		ValueTypeName:   lb.ModelVIP.ProtoName(),
+		KeySelector:     lb.ModelVIP.IsKeyValid,
+		KeyLabel:        lb.ModelVIP.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentVIPs,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+		DerivedValues:   ctx.DerivedValues,
+	}
+	return adapter.NewVIPDescriptor(typedDescr)
+}
+
+// EquivalentVIPs compares VIPs, treating unset length of the new flows table as the default.
+// VPP cannot update VIP in-place, therefore any change re-creates the VIP. Application
+// servers are compared as derived values.
+func (d *VIPDescriptor) EquivalentVIPs(key string, oldVIP, newVIP *lb.VIP) bool {
+	return oldVIP.Encap == newVIP.Encap &&
+		oldVIP.Dscp == newVIP.Dscp &&
+		oldVIP.ServiceType == newVIP.ServiceType &&
+		oldVIP.TargetPort == newVIP.TargetPort &&
+		oldVIP.NodePort == newVIP.NodePort &&
+		withDefault(oldVIP.NewFlowsTableLength, vppcalls.DefaultNewFlowsTableLength) ==
+			withDefault(newVIP.NewFlowsTableLength, vppcalls.DefaultNewFlowsTableLength)
+}
+
+// Validate validates LB VIP configuration.
+func (d *VIPDescriptor) Validate(key string, vip *lb.VIP) error {
+	vipIP, _, err := net.ParseCIDR(vip.Prefix)
+	if err != nil {
+		return kvs.NewInvalidValueError(ErrInvalidVIPPrefix, "prefix")
+	}
+	if vip.Protocol == lb.VIP_ANY && vip.Port != 0 {
+		return kvs.NewInvalidValueError(ErrVIPPortWithAnyProtocol, "protocol", "port")
+	}
+	if !encapMatchesIP(vip.Encap, vipIP) {
+		return kvs.NewInvalidValueError(ErrVIPEncapMismatch, "prefix", "encap")
+	}
+	if vip.NodePort != 0 && vip.ServiceType != lb.VIP_NODEPORT {
+		return kvs.NewInvalidValueError(ErrVIPNodePortWithoutService, "service_type", "node_port")
+	}
+	if !isPowerOf2(vip.NewFlowsTableLength) {
+		return kvs.NewInvalidValueError(ErrNewFlowsTableNotPowerOf2, "new_flows_table_length")
+	}
+	addresses := make(map[string]struct{})
+	for i, as := range vip.ApplicationServers {
+		field := fmt.Sprintf("application_servers[%d].address", i)
+		asIP := net.ParseIP(as.Address)
+		if asIP == nil || !asMatchesEncap(vip.Encap, asIP) {
+			return kvs.NewInvalidValueError(ErrInvalidASAddress, field)
+		}
+		if _, duplicate := addresses[asIP.String()]; duplicate {
+			return kvs.NewInvalidValueError(ErrDuplicateAS, field)
+		}
+		addresses[asIP.String()] = struct{}{}
+	}
+	return nil
+}
+
+// Create adds LB VIP (application servers are added as derived values).
+func (d *VIPDescriptor) Create(key string, vip *lb.VIP) (metadata interface{}, err error) {
+	if err = d.lbHandler.AddLbVip(vip); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes LB VIP (application servers are removed before as derived values).
+func (d *VIPDescriptor) Delete(key string, vip *lb.VIP, metadata interface{}) error {
+	if err := d.lbHandler.DelLbVip(vip); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all LB VIPs with application servers which are in use.
+func (d *VIPDescriptor) Retrieve(correlate []adapter.VIPKVWithMetadata) (
+	retrieved []adapter.VIPKVWithMetadata, err error) {
+	nbVIPs := make(map[string]*lb.VIP)
+	for _, nb := range correlate {
+		nbVIPs[nb.Key] = nb.Value
+	}
+
+	vips, err := d.lbHandler.DumpLbVips()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	for _, vip := range vips {
+		key := lb.VIPKey(vip.Prefix, vip.Protocol, vip.Port)
+		if nb, ok := nbVIPs[key]; ok {
+			// node port is not dumped and the length of the new flows table is dumped
+			// truncated to 16 bits
+			vip.Prefix = nb.Prefix
+			vip.NodePort = nb.NodePort
+			nbFlowsTableLength := withDefault(nb.NewFlowsTableLength, vppcalls.DefaultNewFlowsTableLength)
+			if uint16(nbFlowsTableLength) == uint16(vip.NewFlowsTableLength) {
+				vip.NewFlowsTableLength = nb.NewFlowsTableLength
+			}
+			if vip.Encap != lb.VIP_L3DSR {
+				vip.Dscp = nb.Dscp
+			}
+		}
+		retrieved = append(retrieved, adapter.VIPKVWithMetadata{
+			Key:    key,
+			Value:  vip,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// DerivedValues derives one value for every application server of the VIP.
+func (d *VIPDescriptor) DerivedValues(key string, vip *lb.VIP) (derived []kvs.KeyValuePair) {
+	for _, as := range vip.ApplicationServers {
+		derived = append(derived, kvs.KeyValuePair{
+			Key:   lb.VIPApplicationServerKey(vip, as.Address),
+			Value: as,
+		})
+	}
+	return derived
+}
+
+// encapMatchesIP returns true if the encapsulation can be used with VIP of the given
+// address family.
+func encapMatchesIP(encap lb.VIP_Encap, vipIP net.IP) bool {
+	switch encap {
+	case lb.VIP_L3DSR, lb.VIP_NAT4:
+		return vipIP.To4() != nil
+	case lb.VIP_NAT6:
+		return vipIP.To4() == nil
+	default:
+		return true
+	}
+}
+
+// asMatchesEncap returns true if the application server of the given address family
+// can be reached with the encapsulation.
+func asMatchesEncap(encap lb.VIP_Encap, asIP net.IP) bool {
+	switch encap {
+	case lb.VIP_GRE4, lb.VIP_L3DSR, lb.VIP_NAT4:
+		return asIP.To4() != nil
+	default:
+		return asIP.To4() == nil
+	}
+}
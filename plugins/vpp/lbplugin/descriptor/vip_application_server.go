@@ -0,0 +1,96 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+const (
+	// VIPApplicationServerDescriptorName is the name of the descriptor for application
+	// servers of LB VIPs.
+	VIPApplicationServerDescriptorName = "vpp-lb-vip-application-server"
+)
+
+// VIPApplicationServerDescriptor adds application servers to LB VIPs (derived values
+// of VIPs).
+type VIPApplicationServerDescriptor struct {
+	log       logging.Logger
+	lbHandler vppcalls.LbVppAPI
+}
+
+// NewVIPApplicationServerDescriptor creates a new instance of the VIP application server
+// descriptor.
+func NewVIPApplicationServerDescriptor(lbHandler vppcalls.LbVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &VIPApplicationServerDescriptor{
+		lbHandler: lbHandler,
+		log:       log.NewLogger("lb-vip-application-server-descriptor"),
+	}
+	return &kvs.KVDescriptor{
+		Name:        VIPApplicationServerDescriptorName,
+		KeySelector: ctx.IsVIPApplicationServerKey,
+		Create:      ctx.Create,
+		Delete:      ctx.Delete,
+	}
+}
+
+// IsVIPApplicationServerKey returns true if the key represents application server of a VIP.
+func (d *VIPApplicationServerDescriptor) IsVIPApplicationServerKey(key string) bool {
+	_, _, isVIPApplicationServerKey := lb.ParseVIPApplicationServerKey(key)
+	return isVIPApplicationServerKey
+}
+
+// Create adds application server to the VIP.
+func (d *VIPApplicationServerDescriptor) Create(key string, value proto.Message) (metadata kvs.Metadata, err error) {
+	vip, address, err := d.process(key)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err := d.lbHandler.AddLbAs(vip, address); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes application server from the VIP.
+func (d *VIPApplicationServerDescriptor) Delete(key string, value proto.Message, metadata kvs.Metadata) error {
+	vip, address, err := d.process(key)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if err := d.lbHandler.DelLbAs(vip, address); err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// process returns VIP and address of the application server represented by the key.
+func (d *VIPApplicationServerDescriptor) process(key string) (vip *lb.VIP, address string, err error) {
+	vip, address, isValid := lb.ParseVIPApplicationServerKey(key)
+	if !isValid {
+		return nil, "", errors.Errorf("VIP application server key %s is not valid", key)
+	}
+	return vip, address, nil
+}
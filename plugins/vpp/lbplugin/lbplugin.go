@@ -0,0 +1,83 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name GlobalConfig --value-type *vpp_lb.GlobalConfig --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name VIP --value-type *vpp_lb.VIP --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb" --output-dir "descriptor"
+
+package lbplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls/vpp2210"
+)
+
+// LbPlugin configures VPP load-balancer (Maglev) VIPs, their application servers
+// and the global configuration.
+type LbPlugin struct {
+	Deps
+	// handler
+	LbHandler vppcalls.LbVppAPI
+}
+
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+func (p *LbPlugin) Init() (err error) {
+	if !p.VPP.IsPluginLoaded("lb") {
+		p.Log.Warnf("VPP plugin lb was disabled by VPP")
+		return nil
+	}
+
+	// init LB handler
+	p.LbHandler = vppcalls.CompatibleLbVppHandler(p.VPP, p.Log)
+	if p.LbHandler == nil {
+		return errors.New("LB handler is not available")
+	}
+
+	globalConfigDescriptor := descriptor.NewGlobalConfigDescriptor(p.LbHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, globalConfigDescriptor); err != nil {
+		return err
+	}
+	vipDescriptor := descriptor.NewVIPDescriptor(p.LbHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, vipDescriptor); err != nil {
+		return err
+	}
+	vipASDescriptor := descriptor.NewVIPApplicationServerDescriptor(p.LbHandler, p.Log)
+	if err = p.VPP.RegisterKVDescriptor(p.KVScheduler, vipASDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *LbPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+const (
+	// DefaultStickyBucketsPerCore is the number of sticky buckets per core used by VPP
+	// if not configured otherwise.
+	DefaultStickyBucketsPerCore = 1 << 10
+	// DefaultFlowTimeout is the flow timeout (in seconds) used by VPP if not configured
+	// otherwise.
+	DefaultFlowTimeout = 40
+	// DefaultNewFlowsTableLength is the length of the new flows table of VIP used
+	// if not configured otherwise.
+	DefaultNewFlowsTableLength = 1024
+)
+
+// LbVppAPI provides methods for managing VPP load-balancer configuration.
+type LbVppAPI interface {
+	LbVppRead
+
+	// SetLbConf sets the global configuration of the load-balancer. Zero values
+	// are replaced with VPP defaults.
+	SetLbConf(conf *lb.GlobalConfig) error
+	// AddLbVip adds new VIP (without application servers).
+	AddLbVip(vip *lb.VIP) error
+	// DelLbVip removes VIP. All application servers have to be removed before.
+	DelLbVip(vip *lb.VIP) error
+	// AddLbAs adds application server to the VIP.
+	AddLbAs(vip *lb.VIP, address string) error
+	// DelLbAs removes application server from the VIP. Existing flows are not flushed,
+	// they are kept until they time out.
+	DelLbAs(vip *lb.VIP, address string) error
+}
+
+// LbVppRead provides read methods for VPP load-balancer configuration.
+type LbVppRead interface {
+	// DumpLbVips dumps all VIPs together with application servers which are in use.
+	DumpLbVips() ([]*lb.VIP, error)
+	// DumpLbAppServers dumps application servers of all VIPs, including servers
+	// which were removed but still have flows assigned.
+	DumpLbAppServers() ([]*LbAppServerDetails, error)
+}
+
+// LbAppServerDetails contains application server of the VIP with its state.
+type LbAppServerDetails struct {
+	// VIP has only prefix, protocol and port defined.
+	VIP     *lb.VIP `json:"vip"`
+	Address string  `json:"address"`
+	// InUse is false for application servers which were removed, but still
+	// have flows assigned.
+	InUse bool `json:"in_use"`
+	// InUseSince is the VPP time (in seconds) of the last change of the state.
+	InUseSince uint32 `json:"in_use_since"`
+}
+
+var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "lb",
+	HandlerAPI: (*LbVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, log logging.Logger) LbVppAPI
+
+func AddHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleLbVppHandler(c vpp.Client, log logging.Logger) LbVppAPI {
+	if v := Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, log).(LbVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	vpp_lb "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lb"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lb_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+// asFlagUsed marks application servers which were not removed (LB_AS_FLAGS_USED).
+const asFlagUsed = 0x1
+
+// DumpLbVips dumps all VIPs together with application servers which are in use.
+// Node port of the VIP is not provided by VPP.
+func (h *LbVppHandler) DumpLbVips() (vips []*lb.VIP, err error) {
+	req := &vpp_lb.LbVipDump{
+		Protocol: protocolAny,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	vipByKey := make(map[string]*lb.VIP)
+	for {
+		msg := &vpp_lb.LbVipDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump LB VIPs: %v", err)
+		}
+		if stop {
+			break
+		}
+		vip := vipFromVpp(msg.Vip)
+		vip.Encap = encapFromVipType(lb_types.LbVipType(msg.Encap))
+		vip.Dscp = uint32(msg.Dscp)
+		vip.ServiceType = lb.VIP_ServiceType(msg.SrvType)
+		vip.TargetPort = uint32(msg.TargetPort)
+		vip.NewFlowsTableLength = uint32(msg.FlowTableLength)
+		vips = append(vips, vip)
+		vipByKey[lb.VIPKey(vip.Prefix, vip.Protocol, vip.Port)] = vip
+	}
+
+	appServers, err := h.DumpLbAppServers()
+	if err != nil {
+		return nil, err
+	}
+	for _, as := range appServers {
+		if !as.InUse {
+			continue
+		}
+		vip, ok := vipByKey[lb.VIPKey(as.VIP.Prefix, as.VIP.Protocol, as.VIP.Port)]
+		if !ok {
+			h.log.Warnf("VIP %v of the application server %s was not dumped", as.VIP, as.Address)
+			continue
+		}
+		vip.ApplicationServers = append(vip.ApplicationServers, &lb.VIP_ApplicationServer{
+			Address: as.Address,
+		})
+	}
+	return vips, nil
+}
+
+// DumpLbAppServers dumps application servers of all VIPs, including servers
+// which were removed but still have flows assigned.
+func (h *LbVppHandler) DumpLbAppServers() (appServers []*vppcalls.LbAppServerDetails, err error) {
+	// all-zero prefix dumps application servers of all VIPs
+	req := &vpp_lb.LbAsDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_lb.LbAsDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump LB application servers: %v", err)
+		}
+		if stop {
+			break
+		}
+		appServers = append(appServers, &vppcalls.LbAppServerDetails{
+			VIP:        vipFromVpp(msg.Vip),
+			Address:    msg.AppSrv.String(),
+			InUse:      msg.Flags&asFlagUsed != 0,
+			InUseSince: msg.InUseSince,
+		})
+	}
+	return appServers, nil
+}
+
+// vipFromVpp converts VIP identification from the VPP representation. VPP stores
+// IPv4 VIP prefixes as IPv4-mapped IPv6 prefixes and dumps the mapped prefix length.
+func vipFromVpp(vppVip lb_types.LbVip) *lb.VIP {
+	pfx := ip_types.Prefix(vppVip.Pfx)
+	if pfx.Address.Af == ip_types.ADDRESS_IP4 && pfx.Len > 32 {
+		pfx.Len -= 96
+	}
+	return &lb.VIP{
+		Prefix:   pfx.String(),
+		Protocol: protocolFromVpp(uint8(vppVip.Protocol)),
+		Port:     uint32(vppVip.Port),
+	}
+}
+
+// encapFromVipType converts the VIP type (dumped by VPP instead of the encapsulation)
+// into the encapsulation.
+func encapFromVipType(vipType lb_types.LbVipType) lb.VIP_Encap {
+	switch vipType {
+	case lb_types.LB_API_VIP_TYPE_IP6_GRE6, lb_types.LB_API_VIP_TYPE_IP4_GRE6:
+		return lb.VIP_GRE6
+	case lb_types.LB_API_VIP_TYPE_IP6_GRE4, lb_types.LB_API_VIP_TYPE_IP4_GRE4:
+		return lb.VIP_GRE4
+	case lb_types.LB_API_VIP_TYPE_IP4_L3DSR:
+		return lb.VIP_L3DSR
+	case lb_types.LB_API_VIP_TYPE_IP4_NAT4:
+		return lb.VIP_NAT4
+	default:
+		return lb.VIP_NAT6
+	}
+}
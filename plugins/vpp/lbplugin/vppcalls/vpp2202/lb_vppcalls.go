@@ -0,0 +1,158 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	vpp_lb "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lb"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lb_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+// protocolAny is the VIP protocol matching all protocols and ports.
+const protocolAny = ^uint8(0)
+
+// SetLbConf sets the global configuration of the load-balancer. Zero values
+// are replaced with VPP defaults.
+func (h *LbVppHandler) SetLbConf(conf *lb.GlobalConfig) error {
+	req := &vpp_lb.LbConf{
+		StickyBucketsPerCore: conf.GetStickyBucketsPerCore(),
+		FlowTimeout:          conf.GetFlowTimeout(),
+	}
+	if req.StickyBucketsPerCore == 0 {
+		req.StickyBucketsPerCore = vppcalls.DefaultStickyBucketsPerCore
+	}
+	if req.FlowTimeout == 0 {
+		req.FlowTimeout = vppcalls.DefaultFlowTimeout
+	}
+	if conf.GetIp4SrcAddress() != "" {
+		addr, err := ip_types.ParseIP4Address(conf.GetIp4SrcAddress())
+		if err != nil {
+			return errors.Wrapf(err, "invalid IPv4 source address %s", conf.GetIp4SrcAddress())
+		}
+		req.IP4SrcAddress = addr
+	}
+	if conf.GetIp6SrcAddress() != "" {
+		addr, err := ip_types.ParseIP6Address(conf.GetIp6SrcAddress())
+		if err != nil {
+			return errors.Wrapf(err, "invalid IPv6 source address %s", conf.GetIp6SrcAddress())
+		}
+		req.IP6SrcAddress = addr
+	}
+	reply := &vpp_lb.LbConfReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddLbVip adds new VIP (without application servers).
+func (h *LbVppHandler) AddLbVip(vip *lb.VIP) error {
+	return h.handleLbVip(vip, false)
+}
+
+// DelLbVip removes VIP. All application servers have to be removed before.
+func (h *LbVppHandler) DelLbVip(vip *lb.VIP) error {
+	return h.handleLbVip(vip, true)
+}
+
+// AddLbAs adds application server to the VIP.
+func (h *LbVppHandler) AddLbAs(vip *lb.VIP, address string) error {
+	return h.handleLbAs(vip, address, false)
+}
+
+// DelLbAs removes application server from the VIP. Existing flows are not flushed,
+// they are kept until they time out.
+func (h *LbVppHandler) DelLbAs(vip *lb.VIP, address string) error {
+	return h.handleLbAs(vip, address, true)
+}
+
+func (h *LbVppHandler) handleLbVip(vip *lb.VIP, isDel bool) error {
+	pfx, err := ip_types.ParseAddressWithPrefix(vip.Prefix)
+	if err != nil {
+		return errors.Wrapf(err, "invalid VIP prefix %s", vip.Prefix)
+	}
+	req := &vpp_lb.LbAddDelVip{
+		Pfx:                 pfx,
+		Protocol:            protocolToVpp(vip.Protocol),
+		Port:                uint16(vip.Port),
+		Encap:               lb_types.LbEncapType(vip.Encap),
+		Dscp:                uint8(vip.Dscp),
+		Type:                lb_types.LbSrvType(vip.ServiceType),
+		TargetPort:          uint16(vip.TargetPort),
+		NodePort:            uint16(vip.NodePort),
+		NewFlowsTableLength: vip.NewFlowsTableLength,
+		IsDel:               isDel,
+	}
+	if req.NewFlowsTableLength == 0 {
+		req.NewFlowsTableLength = vppcalls.DefaultNewFlowsTableLength
+	}
+	reply := &vpp_lb.LbAddDelVipReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *LbVppHandler) handleLbAs(vip *lb.VIP, address string, isDel bool) error {
+	pfx, err := ip_types.ParseAddressWithPrefix(vip.Prefix)
+	if err != nil {
+		return errors.Wrapf(err, "invalid VIP prefix %s", vip.Prefix)
+	}
+	addr, err := ip_types.ParseAddress(address)
+	if err != nil {
+		return errors.Wrapf(err, "invalid application server address %s", address)
+	}
+	req := &vpp_lb.LbAddDelAs{
+		Pfx:       pfx,
+		Protocol:  protocolToVpp(vip.Protocol),
+		Port:      uint16(vip.Port),
+		AsAddress: addr,
+		IsDel:     isDel,
+	}
+	reply := &vpp_lb.LbAddDelAsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+func protocolToVpp(protocol lb.VIP_Protocol) uint8 {
+	switch protocol {
+	case lb.VIP_TCP:
+		return uint8(ip_types.IP_API_PROTO_TCP)
+	case lb.VIP_UDP:
+		return uint8(ip_types.IP_API_PROTO_UDP)
+	default:
+		return protocolAny
+	}
+}
+
+func protocolFromVpp(protocol uint8) lb.VIP_Protocol {
+	switch protocol {
+	case uint8(ip_types.IP_API_PROTO_TCP):
+		return lb.VIP_TCP
+	case uint8(ip_types.IP_API_PROTO_UDP):
+		return lb.VIP_UDP
+	default:
+		return lb.VIP_ANY
+	}
+}
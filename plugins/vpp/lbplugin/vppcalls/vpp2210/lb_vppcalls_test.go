@@ -0,0 +1,218 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	vpp_lb "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/lb"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/lb_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/lbplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+func TestSetLbConf(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lb.LbConfReply{})
+	err := lbHandler.SetLbConf(&lb.GlobalConfig{
+		Ip4SrcAddress: "10.0.0.1",
+		Ip6SrcAddress: "2001:db8::1",
+		FlowTimeout:   60,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_lb.LbConf)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IP4SrcAddress.String()).To(Equal("10.0.0.1"))
+	Expect(msg.IP6SrcAddress.String()).To(Equal("2001:db8::1"))
+	Expect(msg.StickyBucketsPerCore).To(BeEquivalentTo(vppcalls.DefaultStickyBucketsPerCore))
+	Expect(msg.FlowTimeout).To(BeEquivalentTo(60))
+
+	// invalid address
+	err = lbHandler.SetLbConf(&lb.GlobalConfig{Ip4SrcAddress: "2001:db8::1"})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddLbVip(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lb.LbAddDelVipReply{})
+	err := lbHandler.AddLbVip(&lb.VIP{
+		Prefix:      "10.96.0.10/32",
+		Protocol:    lb.VIP_TCP,
+		Port:        80,
+		Encap:       lb.VIP_NAT4,
+		ServiceType: lb.VIP_NODEPORT,
+		TargetPort:  8080,
+		NodePort:    30080,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_lb.LbAddDelVip)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Pfx.String()).To(Equal("10.96.0.10/32"))
+	Expect(msg.Protocol).To(BeEquivalentTo(ip_types.IP_API_PROTO_TCP))
+	Expect(msg.Port).To(BeEquivalentTo(80))
+	Expect(msg.Encap).To(Equal(lb_types.LB_API_ENCAP_TYPE_NAT4))
+	Expect(msg.Type).To(Equal(lb_types.LB_API_SRV_TYPE_NODEPORT))
+	Expect(msg.TargetPort).To(BeEquivalentTo(8080))
+	Expect(msg.NodePort).To(BeEquivalentTo(30080))
+	Expect(msg.NewFlowsTableLength).To(BeEquivalentTo(vppcalls.DefaultNewFlowsTableLength))
+	Expect(msg.IsDel).To(BeFalse())
+}
+
+func TestDelLbVip(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lb.LbAddDelVipReply{})
+	err := lbHandler.DelLbVip(&lb.VIP{
+		Prefix: "2001:db8::/64",
+		Encap:  lb.VIP_GRE6,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_lb.LbAddDelVip)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Pfx.String()).To(Equal("2001:db8::/64"))
+	Expect(msg.Protocol).To(BeEquivalentTo(255))
+	Expect(msg.IsDel).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_lb.LbAddDelVipReply{Retval: -1})
+	err = lbHandler.DelLbVip(&lb.VIP{Prefix: "2001:db8::/64"})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddDelLbAs(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	vip := &lb.VIP{
+		Prefix:   "10.96.0.10/32",
+		Protocol: lb.VIP_UDP,
+		Port:     53,
+	}
+	ctx.MockVpp.MockReply(&vpp_lb.LbAddDelAsReply{})
+	err := lbHandler.AddLbAs(vip, "192.168.1.1")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_lb.LbAddDelAs)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Pfx.String()).To(Equal("10.96.0.10/32"))
+	Expect(msg.Protocol).To(BeEquivalentTo(ip_types.IP_API_PROTO_UDP))
+	Expect(msg.Port).To(BeEquivalentTo(53))
+	Expect(msg.AsAddress.String()).To(Equal("192.168.1.1"))
+	Expect(msg.IsDel).To(BeFalse())
+
+	ctx.MockVpp.MockReply(&vpp_lb.LbAddDelAsReply{})
+	err = lbHandler.DelLbAs(vip, "192.168.1.1")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_lb.LbAddDelAs)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsDel).To(BeTrue())
+	Expect(msg.IsFlush).To(BeFalse())
+
+	// invalid address
+	err = lbHandler.AddLbAs(vip, "invalid")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpLbVips(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	vipAddr, _ := ip_types.ParseAddress("10.96.0.10")
+	as1, _ := ip_types.ParseAddress("192.168.1.1")
+	as2, _ := ip_types.ParseAddress("192.168.1.2")
+	vppVip := lb_types.LbVip{
+		Pfx:      ip_types.AddressWithPrefix{Address: vipAddr, Len: 128},
+		Protocol: ip_types.IP_API_PROTO_TCP,
+		Port:     80,
+	}
+	ctx.MockVpp.MockReply(&vpp_lb.LbVipDetails{
+		Vip:             vppVip,
+		Encap:           lb_types.LbEncapType(lb_types.LB_API_VIP_TYPE_IP4_GRE4),
+		FlowTableLength: 1024,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	ctx.MockVpp.MockReply(
+		&vpp_lb.LbAsDetails{
+			Vip:    vppVip,
+			AppSrv: as1,
+			Flags:  1,
+		},
+		&vpp_lb.LbAsDetails{
+			Vip:        vppVip,
+			AppSrv:     as2,
+			InUseSince: 100,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	vips, err := lbHandler.DumpLbVips()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(vips).To(HaveLen(1))
+	Expect(vips[0].Prefix).To(Equal("10.96.0.10/32"))
+	Expect(vips[0].Protocol).To(Equal(lb.VIP_TCP))
+	Expect(vips[0].Port).To(BeEquivalentTo(80))
+	Expect(vips[0].Encap).To(Equal(lb.VIP_GRE4))
+	Expect(vips[0].NewFlowsTableLength).To(BeEquivalentTo(1024))
+	// removed application server is not returned
+	Expect(vips[0].ApplicationServers).To(HaveLen(1))
+	Expect(vips[0].ApplicationServers[0].Address).To(Equal("192.168.1.1"))
+}
+
+func TestDumpLbAppServers(t *testing.T) {
+	ctx, lbHandler := lbTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	vipAddr, _ := ip_types.ParseAddress("2001:db8::")
+	as, _ := ip_types.ParseAddress("2001:db8:1::1")
+	ctx.MockVpp.MockReply(&vpp_lb.LbAsDetails{
+		Vip: lb_types.LbVip{
+			Pfx:      ip_types.AddressWithPrefix{Address: vipAddr, Len: 64},
+			Protocol: 255,
+		},
+		AppSrv:     as,
+		InUseSince: 100,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	appServers, err := lbHandler.DumpLbAppServers()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(appServers).To(HaveLen(1))
+	Expect(appServers[0].VIP.Prefix).To(Equal("2001:db8::/64"))
+	Expect(appServers[0].VIP.Protocol).To(Equal(lb.VIP_ANY))
+	Expect(appServers[0].Address).To(Equal("2001:db8:1::1"))
+	Expect(appServers[0].InUse).To(BeFalse())
+	Expect(appServers[0].InUseSince).To(BeEquivalentTo(100))
+}
+
+func lbTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.LbVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	lbHandler := vpp2210.NewLbVppHandler(ctx.MockChannel, log)
+	return ctx, lbHandler
+}
@@ -0,0 +1,147 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64AddressPoolDescriptorName is the name of the descriptor for NAT64 IP address pools.
+	NAT64AddressPoolDescriptorName = "vpp-nat64-address-pool"
+)
+
+// NAT64AddressPoolDescriptor teaches KVScheduler how to add/remove VPP NAT64 IP addresses pools.
+type NAT64AddressPoolDescriptor struct {
+	log             logging.Logger
+	nat64Handler    vppcalls.Nat64VppAPI
+	nat64GlobalDesc *NAT64GlobalDescriptor
+}
+
+// NewNAT64AddressPoolDescriptor creates a new instance of the NAT64AddressPoolDescriptor.
+func NewNAT64AddressPoolDescriptor(nat64GlobalDesc *NAT64GlobalDescriptor,
+	nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64AddressPoolDescriptor{
+		nat64GlobalDesc: nat64GlobalDesc,
+		nat64Handler:    nat64Handler,
+		log:             log.NewLogger("nat64-address-pool-descriptor"),
+	}
+	typedDescr := &adapter.NAT64AddressPoolDescriptor{
+		Name:                 NAT64AddressPoolDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64AddressPool.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64AddressPool.ProtoName(),
+		KeySelector:          nat.ModelNat64AddressPool.IsKeyValid,
+		KeyLabel:             nat.ModelNat64AddressPool.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64AddressPoolDescriptor(typedDescr)
+}
+
+// Validate validates configuration for NAT64 IP addresses pool.
+func (d *NAT64AddressPoolDescriptor) Validate(key string, natPool *nat.Nat64AddressPool) error {
+	firstIP := net.ParseIP(natPool.FirstIp).To4()
+	if firstIP == nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "first_ip")
+	}
+	if natPool.LastIp != "" {
+		lastIP := net.ParseIP(natPool.LastIp).To4()
+		if lastIP == nil {
+			return kvs.NewInvalidValueError(errInvalidIPAddress, "last_ip")
+		}
+		if bytes.Compare(firstIP, lastIP) > 0 {
+			// last IP should be empty or higher than first IP
+			return kvs.NewInvalidValueError(errInvalidLastPoolAddress, "last_ip")
+		}
+	}
+	return nil
+}
+
+// Create adds IP address pool into VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Create(key string, natPool *nat.Nat64AddressPool) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64AddressPool(natPool.VrfId, natPool.FirstIp, natPool.LastIp)
+}
+
+// Delete removes IP address pool from VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Delete(key string, natPool *nat.Nat64AddressPool, metadata interface{}) error {
+	return d.nat64Handler.DelNat64AddressPool(natPool.VrfId, natPool.FirstIp, natPool.LastIp)
+}
+
+// Retrieve returns NAT64 IP address pools configured on VPP.
+func (d *NAT64AddressPoolDescriptor) Retrieve(correlate []adapter.NAT64AddressPoolKVWithMetadata) (
+	retrieved []adapter.NAT64AddressPoolKVWithMetadata, err error) {
+	if !d.nat64GlobalDesc.Enabled {
+		return nil, nil // nothing to retrieve while NAT64 plugin is disabled
+	}
+	natPools, err := d.nat64Handler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, sbPool := range natPools {
+		// SB pool is built from subsequent addresses, use NB pool with the same range instead
+		// (NB may define the same range using different form of IP addresses or as multiple pools)
+		pool := sbPool
+		for _, nbPool := range correlate {
+			if nbPool.Value.VrfId == sbPool.VrfId &&
+				equivalentIPv4(nbPool.Value.FirstIp, sbPool.FirstIp) &&
+				equivalentIPv4(lastNat64PoolIP(nbPool.Value), lastNat64PoolIP(sbPool)) {
+				pool = nbPool.Value
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.NAT64AddressPoolKVWithMetadata{
+			Key:    models.Key(pool),
+			Value:  pool,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the NAT64 global configuration and non-zero/non-all-ones VRF as dependencies.
+func (d *NAT64AddressPoolDescriptor) Dependencies(key string, natPool *nat.Nat64AddressPool) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if natPool.VrfId != 0 && natPool.VrfId != ^uint32(0) {
+		deps = append(deps, kvs.Dependency{
+			Label: nat64VrfDep,
+			Key:   l3.VrfTableKey(natPool.VrfId, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
+
+func lastNat64PoolIP(natPool *nat.Nat64AddressPool) string {
+	if natPool.LastIp == "" {
+		return natPool.FirstIp
+	}
+	return natPool.LastIp
+}
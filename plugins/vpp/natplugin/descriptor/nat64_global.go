@@ -0,0 +1,96 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64GlobalDescriptorName is the name of the descriptor for VPP NAT64 global
+	// configuration.
+	NAT64GlobalDescriptorName = "vpp-nat64-global"
+
+	// dependency labels
+	nat64GlobalCfgDep = "nat64-global-config-exists"
+)
+
+// NAT64GlobalDescriptor teaches KVScheduler how to enable/disable VPP NAT64 plugin.
+type NAT64GlobalDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+
+	// Enabled tracks whether NAT64 plugin is enabled.
+	// Used by other NAT64 descriptors to skip Retrieve while the plugin is disabled.
+	Enabled bool
+}
+
+// NewNAT64GlobalDescriptor creates a new instance of the NAT64Global descriptor.
+func NewNAT64GlobalDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) (*NAT64GlobalDescriptor, *kvs.KVDescriptor) {
+	ctx := &NAT64GlobalDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-global-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64GlobalDescriptor{
+		Name:          NAT64GlobalDescriptorName,
+		NBKeyPrefix:   nat.ModelNat64Global.KeyPrefix(),
+		ValueTypeName: nat.ModelNat64Global.ProtoName(),
+		KeySelector:   nat.ModelNat64Global.IsKeyValid,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	return ctx, adapter.NewNAT64GlobalDescriptor(typedDescr)
+}
+
+// Create enables NAT64 plugin.
+func (d *NAT64GlobalDescriptor) Create(key string, globalCfg *nat.Nat64Global) (metadata interface{}, err error) {
+	if err = d.nat64Handler.EnableNat64Plugin(globalCfg); err != nil {
+		return nil, err
+	}
+	d.Enabled = true
+	return nil, nil
+}
+
+// Delete disables NAT64 plugin.
+func (d *NAT64GlobalDescriptor) Delete(key string, globalCfg *nat.Nat64Global, metadata interface{}) error {
+	if err := d.nat64Handler.DisableNat64Plugin(); err != nil {
+		return err
+	}
+	d.Enabled = false
+	return nil
+}
+
+// Retrieve returns the expected NAT64 global configuration.
+func (d *NAT64GlobalDescriptor) Retrieve(correlate []adapter.NAT64GlobalKVWithMetadata) (
+	retrieved []adapter.NAT64GlobalKVWithMetadata, err error) {
+	// It is not possible to find out if the NAT64 plugin is enabled or disabled
+	// (and with which table sizes). Assume that the plugin state is as expected.
+	d.Enabled = len(correlate) > 0
+	for _, g := range correlate {
+		retrieved = append(retrieved, adapter.NAT64GlobalKVWithMetadata{
+			Key:    g.Key,
+			Value:  g.Value,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
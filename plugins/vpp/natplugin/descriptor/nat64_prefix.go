@@ -0,0 +1,150 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64IPv6PrefixDescriptorName is the name of the descriptor for NAT64 prefixes.
+	NAT64IPv6PrefixDescriptorName = "vpp-nat64-prefix"
+
+	// dependency labels
+	nat64VrfDep = "vrf-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// errInvalidNAT64Prefix is returned when NAT64 prefix cannot be parsed or is not IPv6.
+	errInvalidNAT64Prefix = errors.New("invalid IPv6 prefix")
+	// errInvalidNAT64PrefixLen is returned when length of NAT64 prefix is not one of allowed by RFC 6052.
+	errInvalidNAT64PrefixLen = errors.New("NAT64 prefix length has to be one of 32, 40, 48, 56, 64 or 96")
+)
+
+// NAT64IPv6PrefixDescriptor teaches KVScheduler how to add/remove VPP NAT64 prefixes.
+type NAT64IPv6PrefixDescriptor struct {
+	log             logging.Logger
+	nat64Handler    vppcalls.Nat64VppAPI
+	nat64GlobalDesc *NAT64GlobalDescriptor
+}
+
+// NewNAT64IPv6PrefixDescriptor creates a new instance of the NAT64IPv6Prefix descriptor.
+func NewNAT64IPv6PrefixDescriptor(nat64GlobalDesc *NAT64GlobalDescriptor,
+	nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64IPv6PrefixDescriptor{
+		nat64GlobalDesc: nat64GlobalDesc,
+		nat64Handler:    nat64Handler,
+		log:             log.NewLogger("nat64-prefix-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64IPv6PrefixDescriptor{
+		Name:                 NAT64IPv6PrefixDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64IPv6Prefix.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64IPv6Prefix.ProtoName(),
+		KeySelector:          nat.ModelNat64IPv6Prefix.IsKeyValid,
+		KeyLabel:             nat.ModelNat64IPv6Prefix.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentNAT64IPv6Prefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64IPv6PrefixDescriptor(typedDescr)
+}
+
+// EquivalentNAT64IPv6Prefix compares prefixes by their value rather than string form.
+func (d *NAT64IPv6PrefixDescriptor) EquivalentNAT64IPv6Prefix(key string, oldPrefix, newPrefix *nat.Nat64IPv6Prefix) bool {
+	if oldPrefix.VrfId != newPrefix.VrfId {
+		return false
+	}
+	_, oldNet, err1 := net.ParseCIDR(oldPrefix.Prefix)
+	_, newNet, err2 := net.ParseCIDR(newPrefix.Prefix)
+	if err1 != nil || err2 != nil {
+		return equivalentTrimmedLowered(oldPrefix.Prefix, newPrefix.Prefix)
+	}
+	return oldNet.String() == newNet.String()
+}
+
+// Validate validates NAT64 prefix configuration.
+func (d *NAT64IPv6PrefixDescriptor) Validate(key string, prefix *nat.Nat64IPv6Prefix) error {
+	ip, ipNet, err := net.ParseCIDR(prefix.Prefix)
+	if err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidNAT64Prefix, "prefix")
+	}
+	switch ones, _ := ipNet.Mask.Size(); ones {
+	case 32, 40, 48, 56, 64, 96:
+	default:
+		return kvs.NewInvalidValueError(errInvalidNAT64PrefixLen, "prefix")
+	}
+	return nil
+}
+
+// Create adds NAT64 prefix.
+func (d *NAT64IPv6PrefixDescriptor) Create(key string, prefix *nat.Nat64IPv6Prefix) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64IPv6Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Delete removes NAT64 prefix.
+func (d *NAT64IPv6PrefixDescriptor) Delete(key string, prefix *nat.Nat64IPv6Prefix, metadata interface{}) error {
+	return d.nat64Handler.DelNat64IPv6Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Retrieve returns NAT64 prefixes configured on VPP.
+func (d *NAT64IPv6PrefixDescriptor) Retrieve(correlate []adapter.NAT64IPv6PrefixKVWithMetadata) (
+	retrieved []adapter.NAT64IPv6PrefixKVWithMetadata, err error) {
+	if !d.nat64GlobalDesc.Enabled {
+		return nil, nil // nothing to retrieve while NAT64 plugin is disabled
+	}
+	prefixes, err := d.nat64Handler.Nat64IPv6PrefixDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, prefix := range prefixes {
+		retrieved = append(retrieved, adapter.NAT64IPv6PrefixKVWithMetadata{
+			Key:    models.Key(prefix),
+			Value:  prefix,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the NAT64 global configuration and non-zero VRF as dependencies.
+func (d *NAT64IPv6PrefixDescriptor) Dependencies(key string, prefix *nat.Nat64IPv6Prefix) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if prefix.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: nat64VrfDep,
+			Key:   l3.VrfTableKey(prefix.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
@@ -18,6 +18,10 @@
 //go:generate descriptor-adapter --descriptor-name DNAT44 --value-type *vpp_nat.DNat44 --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44Interface --value-type *vpp_nat.Nat44Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44AddressPool --value-type *vpp_nat.Nat44AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Global --value-type *vpp_nat.Nat64Global --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64IPv6Prefix --value-type *vpp_nat.Nat64IPv6Prefix --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Interface --value-type *vpp_nat.Nat64Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64AddressPool --value-type *vpp_nat.Nat64AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 
 package natplugin
 
@@ -36,6 +40,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2210"
 )
 
 // NATPlugin configures VPP NAT.
@@ -43,7 +48,8 @@ type NATPlugin struct {
 	Deps
 
 	// handlers
-	natHandler vppcalls.NatVppAPI
+	natHandler   vppcalls.NatVppAPI
+	nat64Handler vppcalls.Nat64VppAPI
 }
 
 // Deps lists dependencies of the NAT plugin.
@@ -57,11 +63,26 @@ type Deps struct {
 
 // Init registers NAT-related descriptors.
 func (p *NATPlugin) Init() (err error) {
-	if !p.VPP.IsPluginLoaded("nat") {
+	if p.VPP.IsPluginLoaded("nat") {
+		if err = p.initNAT44(); err != nil {
+			return err
+		}
+	} else {
 		p.Log.Warnf("VPP plugin NAT was disabled by VPP")
-		return nil
 	}
 
+	if p.VPP.IsPluginLoaded("nat64") {
+		if err = p.initNAT64(); err != nil {
+			return err
+		}
+	} else {
+		p.Log.Warnf("VPP plugin NAT64 was disabled by VPP")
+	}
+
+	return nil
+}
+
+func (p *NATPlugin) initNAT44() (err error) {
 	// init handlers
 	p.natHandler = vppcalls.CompatibleNatVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.IfPlugin.GetDHCPIndex(), p.Log)
 	if p.natHandler == nil {
@@ -76,7 +97,7 @@ func (p *NATPlugin) Init() (err error) {
 	nat44IfaceDescriptor := descriptor.NewNAT44InterfaceDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
 	nat44AddrPoolDescriptor := descriptor.NewNAT44AddressPoolDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
 
-	err = p.KVScheduler.RegisterKVDescriptor(
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler,
 		nat44GlobalDescriptor,
 		nat44GlobalIfaceDescriptor, // deprecated, kept for backward compatibility
 		nat44GlobalAddrDescriptor,  // deprecated, kept for backward compatibility
@@ -91,6 +112,33 @@ func (p *NATPlugin) Init() (err error) {
 	return nil
 }
 
+func (p *NATPlugin) initNAT64() (err error) {
+	// init handlers
+	p.nat64Handler = vppcalls.CompatibleNat64VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.nat64Handler == nil {
+		p.Log.Warnf("NAT64 is not supported by the VPP version")
+		return nil
+	}
+
+	// init and register descriptors
+	nat64GlobalCtx, nat64GlobalDescriptor := descriptor.NewNAT64GlobalDescriptor(p.nat64Handler, p.Log)
+	nat64PrefixDescriptor := descriptor.NewNAT64IPv6PrefixDescriptor(nat64GlobalCtx, p.nat64Handler, p.Log)
+	nat64IfaceDescriptor := descriptor.NewNAT64InterfaceDescriptor(nat64GlobalCtx, p.nat64Handler, p.Log)
+	nat64AddrPoolDescriptor := descriptor.NewNAT64AddressPoolDescriptor(nat64GlobalCtx, p.nat64Handler, p.Log)
+
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler,
+		nat64GlobalDescriptor,
+		nat64PrefixDescriptor,
+		nat64IfaceDescriptor,
+		nat64AddrPoolDescriptor,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AfterInit registers plugin with StatusCheck.
 func (p *NATPlugin) AfterInit() error {
 	if p.StatusCheck != nil {
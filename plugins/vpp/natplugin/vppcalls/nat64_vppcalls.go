@@ -0,0 +1,87 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Nat64VppAPI provides methods for managing VPP NAT64 configuration.
+type Nat64VppAPI interface {
+	Nat64VppRead
+
+	// EnableNat64Plugin enables NAT64 plugin with the given table sizes.
+	EnableNat64Plugin(globalCfg *nat.Nat64Global) error
+	// DisableNat64Plugin disables NAT64 plugin.
+	DisableNat64Plugin() error
+	// AddNat64IPv6Prefix configures NAT64 prefix for the given VRF.
+	AddNat64IPv6Prefix(vrf uint32, prefix string) error
+	// DelNat64IPv6Prefix removes NAT64 prefix of the given VRF.
+	DelNat64IPv6Prefix(vrf uint32, prefix string) error
+	// EnableNat64Interface enables NAT64 feature for provided interface.
+	EnableNat64Interface(iface string, isInside bool) error
+	// DisableNat64Interface disables NAT64 feature for provided interface.
+	DisableNat64Interface(iface string, isInside bool) error
+	// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+	AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+	DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+}
+
+// Nat64VppRead provides read methods for VPP NAT64 configuration.
+type Nat64VppRead interface {
+	// Nat64IPv6PrefixDump dumps all configured NAT64 prefixes.
+	Nat64IPv6PrefixDump() ([]*nat.Nat64IPv6Prefix, error)
+	// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+	Nat64InterfacesDump() ([]*nat.Nat64Interface, error)
+	// Nat64AddressPoolsDump dumps all configured NAT64 address pools
+	// (every continuous range of addresses is returned as a single pool).
+	Nat64AddressPoolsDump() ([]*nat.Nat64AddressPool, error)
+}
+
+var nat64Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "nat64",
+	HandlerAPI: (*Nat64VppAPI)(nil),
+})
+
+func AddNat64HandlerVersion(version vpp.Version, msgs []govppapi.Message,
+	h func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI,
+) {
+	nat64Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleNat64VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI {
+	if v := nat64Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(Nat64VppAPI)
+	}
+	return nil
+}
@@ -54,6 +54,9 @@ type NatVppAPI interface {
 	AddNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
 	// DelNat44StaticMapping removes existing NAT44 static mapping entry.
 	DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
+	// DelNat44Session removes NAT44 session identified by its inside address, port, protocol
+	// and VRF (and by the external host in the endpoint-dependent mode).
+	DelNat44Session(session *nat.Nat44Session) error
 }
 
 // NatVppRead provides read methods for VPP NAT configuration.
@@ -72,6 +75,10 @@ type NatVppRead interface {
 	Nat44InterfacesDump() ([]*nat.Nat44Interface, error)
 	// Nat44AddressPoolsDump dumps all configured NAT44 address pools.
 	Nat44AddressPoolsDump() ([]*nat.Nat44AddressPool, error)
+	// Nat44UsersDump dumps all inside addresses with active NAT44 sessions.
+	Nat44UsersDump() ([]*nat.Nat44User, error)
+	// Nat44SessionsDump dumps all active NAT44 sessions.
+	Nat44SessionsDump() ([]*nat.Nat44Session, error)
 }
 
 // Previously these options were configured for NAT44 plugin via the startup configuration file.
@@ -226,6 +226,93 @@ func (h *NatVppHandler) Nat44AddressPoolsDump() (natPools []*nat.Nat44AddressPoo
 	return
 }
 
+// Nat44UsersDump dumps all inside addresses with active NAT44 sessions.
+func (h *NatVppHandler) Nat44UsersDump() (users []*nat.Nat44User, err error) {
+	req := &vpp_nat.Nat44UserDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat.Nat44UserDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT44 users: %v", err)
+		}
+		if stop {
+			break
+		}
+		users = append(users, &nat.Nat44User{
+			IpAddress:      net.IP(msg.IPAddress[:]).String(),
+			VrfId:          msg.VrfID,
+			Sessions:       msg.Nsessions,
+			StaticSessions: msg.Nstaticsessions,
+		})
+	}
+	return
+}
+
+// Nat44SessionsDump dumps all active NAT44 sessions (sessions are dumped per user).
+func (h *NatVppHandler) Nat44SessionsDump() (sessions []*nat.Nat44Session, err error) {
+	users, err := h.Nat44UsersDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		userSessions, err := h.nat44UserSessionsDump(user)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, userSessions...)
+	}
+	return
+}
+
+func (h *NatVppHandler) nat44UserSessionsDump(user *nat.Nat44User) (sessions []*nat.Nat44Session, err error) {
+	userAddr, err := ipTo4Address(user.IpAddress)
+	if err != nil {
+		return nil, err
+	}
+	req := &vpp_nat.Nat44UserSessionDump{
+		IPAddress: userAddr,
+		VrfID:     user.VrfId,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat.Nat44UserSessionDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT44 sessions of user %s: %v", user.IpAddress, err)
+		}
+		if stop {
+			break
+		}
+		flags := getNat44Flags(msg.Flags)
+		session := &nat.Nat44Session{
+			InsideIpAddress:  net.IP(msg.InsideIPAddress[:]).String(),
+			InsidePort:       uint32(msg.InsidePort),
+			OutsideIpAddress: net.IP(msg.OutsideIPAddress[:]).String(),
+			OutsidePort:      uint32(msg.OutsidePort),
+			Protocol:         h.protocolNumberToNBValue(uint8(msg.Protocol)),
+			VrfId:            user.VrfId,
+			IsStatic:         flags.isStatic,
+			IsTwiceNat:       flags.isTwiceNat,
+			LastHeard:        msg.LastHeard,
+			TotalBytes:       msg.TotalBytes,
+			TotalPackets:     msg.TotalPkts,
+		}
+		if flags.isExtHostValid {
+			session.ExtHostAddress = net.IP(msg.ExtHostAddress[:]).String()
+			session.ExtHostPort = uint32(msg.ExtHostPort)
+		}
+		if flags.isTwiceNat {
+			session.ExtHostNatAddress = net.IP(msg.ExtHostNatAddress[:]).String()
+			session.ExtHostNatPort = uint32(msg.ExtHostNatPort)
+		}
+		sessions = append(sessions, session)
+	}
+	return
+}
+
 // nat44AddressDump returns NAT44 address pool configured in the VPP.
 // Deprecated. Functionality moved to Nat44AddressPoolsDump. Kept for backward compatibility.
 func (h *NatVppHandler) nat44AddressDump() (addressPool []*nat.Nat44Global_Address, err error) {
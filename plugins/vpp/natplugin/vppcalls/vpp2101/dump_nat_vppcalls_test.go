@@ -215,6 +215,63 @@ func TestNat44AddressPoolsDump(t *testing.T) {
 	Expect(pools[1].VrfId).To(BeEquivalentTo(2))
 }
 
+func TestNat44SessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// users
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44UserDetails{
+		IPAddress:       ipTo4Address("10.0.0.1"),
+		VrfID:           1,
+		Nsessions:       1,
+		Nstaticsessions: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	// sessions of the user
+	ctx.MockVpp.MockReply(
+		&vpp_nat.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.0.0.1"),
+			InsidePort:       8080,
+			OutsideIPAddress: ipTo4Address("192.168.10.1"),
+			OutsidePort:      1024,
+			Protocol:         6,
+			Flags:            nat_types.NAT_IS_EXT_HOST_VALID,
+			ExtHostAddress:   ipTo4Address("8.8.8.8"),
+			ExtHostPort:      443,
+			TotalPkts:        10,
+		},
+		&vpp_nat.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.0.0.1"),
+			InsidePort:       53,
+			OutsideIPAddress: ipTo4Address("192.168.10.1"),
+			OutsidePort:      53,
+			Protocol:         17,
+			Flags:            nat_types.NAT_IS_STATIC,
+		})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+
+	Expect(sessions).To(HaveLen(2))
+
+	Expect(sessions[0].InsideIpAddress).To(Equal("10.0.0.1"))
+	Expect(sessions[0].InsidePort).To(BeEquivalentTo(8080))
+	Expect(sessions[0].OutsideIpAddress).To(Equal("192.168.10.1"))
+	Expect(sessions[0].OutsidePort).To(BeEquivalentTo(1024))
+	Expect(sessions[0].Protocol).To(Equal(nat.DNat44_TCP))
+	Expect(sessions[0].VrfId).To(BeEquivalentTo(1))
+	Expect(sessions[0].ExtHostAddress).To(Equal("8.8.8.8"))
+	Expect(sessions[0].ExtHostPort).To(BeEquivalentTo(443))
+	Expect(sessions[0].IsStatic).To(BeFalse())
+	Expect(sessions[0].TotalPackets).To(BeEquivalentTo(10))
+
+	Expect(sessions[1].Protocol).To(Equal(nat.DNat44_UDP))
+	Expect(sessions[1].ExtHostAddress).To(BeEmpty())
+	Expect(sessions[1].IsStatic).To(BeTrue())
+}
+
 func TestDNATDump(t *testing.T) {
 	ctx, natHandler, swIfIndexes, dhcpIndexes := natTestSetup(t)
 	defer ctx.TeardownTestCtx()
@@ -174,6 +174,37 @@ func (h *NatVppHandler) DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping,
 	return h.handleNat44StaticMappingLb(mapping, dnatLabel, false)
 }
 
+// DelNat44Session removes NAT44 session identified by its inside address, port, protocol
+// and VRF (and by the external host in the endpoint-dependent mode).
+func (h *NatVppHandler) DelNat44Session(session *nat.Nat44Session) error {
+	addr, err := ipTo4Address(session.InsideIpAddress)
+	if err != nil {
+		return errors.Errorf("unable to parse inside address of NAT44 session: %v", err)
+	}
+	req := &vpp_nat.Nat44DelSession{
+		Address:  addr,
+		Protocol: h.protocolNBValueToNumber(session.Protocol),
+		Port:     uint16(session.InsidePort),
+		VrfID:    session.VrfId,
+	}
+	flags := &nat44Flags{isInside: true}
+	if session.ExtHostAddress != "" {
+		if req.ExtHostAddress, err = ipTo4Address(session.ExtHostAddress); err != nil {
+			return errors.Errorf("unable to parse external host address of NAT44 session: %v", err)
+		}
+		req.ExtHostPort = uint16(session.ExtHostPort)
+		flags.isExtHostValid = true
+	}
+	req.Flags = setNat44Flags(flags)
+	reply := &vpp_nat.Nat44DelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Calls VPP binary API to set/unset interface NAT44 feature.
 func (h *NatVppHandler) handleNat44Interface(iface string, isInside, isAdd bool) error {
 	// get interface metadata
@@ -865,6 +865,74 @@ func TestDelNat44EiAddressPool(t *testing.T) {
 	Expect(msg.VrfID).To(BeEquivalentTo(0))
 }
 
+func TestDelNat44EdSession(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat_ed.Nat44EdPluginEnableDisableReply{})
+	err := natHandler.EnableNAT44Plugin(vppcalls.Nat44InitOpts{EndpointDependent: true})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_nat_ed.Nat44DelSessionReply{})
+	err = natHandler.DelNat44Session(&nat.Nat44Session{
+		InsideIpAddress: "10.0.0.1",
+		InsidePort:      8080,
+		Protocol:        nat.DNat44_UDP,
+		VrfId:           1,
+		ExtHostAddress:  "8.8.8.8",
+		ExtHostPort:     53,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat_ed.Nat44DelSession)
+	Expect(ok).To(BeTrue())
+	Expect(addressTo4IP(msg.Address)).To(Equal("10.0.0.1"))
+	Expect(msg.Port).To(BeEquivalentTo(8080))
+	Expect(msg.Protocol).To(BeEquivalentTo(vpp2106.UDP))
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(addressTo4IP(msg.ExtHostAddress)).To(Equal("8.8.8.8"))
+	Expect(msg.ExtHostPort).To(BeEquivalentTo(53))
+	Expect(msg.Flags).To(BeEquivalentTo(nat_types.NAT_IS_INSIDE | nat_types.NAT_IS_EXT_HOST_VALID))
+}
+
+func TestDelNat44EiSession(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat_ei.Nat44EiPluginEnableDisableReply{})
+	err := natHandler.EnableNAT44Plugin(vppcalls.Nat44InitOpts{EndpointDependent: false})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_nat_ei.Nat44EiDelSessionReply{})
+	err = natHandler.DelNat44Session(&nat.Nat44Session{
+		InsideIpAddress: "10.0.0.1",
+		InsidePort:      1000,
+		Protocol:        nat.DNat44_TCP,
+	})
+
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat_ei.Nat44EiDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(addressTo4IP(msg.Address)).To(Equal("10.0.0.1"))
+	Expect(msg.Port).To(BeEquivalentTo(1000))
+	Expect(msg.Protocol).To(BeEquivalentTo(vpp2106.TCP))
+	Expect(msg.VrfID).To(BeEquivalentTo(0))
+	Expect(msg.Flags).To(BeEquivalentTo(vpp_nat_ei.NAT44_EI_IF_INSIDE))
+}
+
+func TestDelNat44SessionInvalidAddress(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := natHandler.DelNat44Session(&nat.Nat44Session{
+		InsideIpAddress: "no-ip",
+	})
+
+	Expect(err).Should(HaveOccurred())
+}
+
 /* DEPRECATED
 
 func TestSetNat44VirtualReassemblyIPv4(t *testing.T) {
@@ -0,0 +1,113 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"fmt"
+	"net"
+
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Nat64IPv6PrefixDump dumps all configured NAT64 prefixes.
+func (h *Nat64VppHandler) Nat64IPv6PrefixDump() (prefixes []*nat.Nat64IPv6Prefix, err error) {
+	req := &vpp_nat64.Nat64PrefixDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PrefixDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 prefixes: %v", err)
+		}
+		if stop {
+			break
+		}
+		prefixes = append(prefixes, &nat.Nat64IPv6Prefix{
+			VrfId:  msg.VrfID,
+			Prefix: msg.Prefix.String(),
+		})
+	}
+	return
+}
+
+// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+func (h *Nat64VppHandler) Nat64InterfacesDump() (natIfs []*nat.Nat64Interface, err error) {
+	req := &vpp_nat64.Nat64InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 interfaces: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIf := &nat.Nat64Interface{
+			Name: ifName,
+			Type: nat.Nat64Interface_IPV4_OUTSIDE,
+		}
+		if msg.Flags&nat_types.NAT_IS_INSIDE != 0 {
+			natIf.Type = nat.Nat64Interface_IPV6_INSIDE
+		}
+		natIfs = append(natIfs, natIf)
+	}
+	return
+}
+
+// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+func (h *Nat64VppHandler) Nat64AddressPoolsDump() (natPools []*nat.Nat64AddressPool, err error) {
+	var curPool *nat.Nat64AddressPool
+	var lastIP net.IP
+
+	req := &vpp_nat64.Nat64PoolAddrDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PoolAddrDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 address pool: %v", err)
+		}
+		if stop {
+			break
+		}
+		ip := net.IP(msg.Address[:])
+		// merge subsequent IPs into a single pool
+		if curPool != nil && curPool.VrfId == msg.VrfID && ip.Equal(incIP(lastIP)) {
+			// update current pool
+			curPool.LastIp = ip.String()
+		} else {
+			// start a new pool
+			pool := &nat.Nat64AddressPool{
+				FirstIp: ip.String(),
+				VrfId:   msg.VrfID,
+			}
+			curPool = pool
+			natPools = append(natPools, pool)
+		}
+		lastIP = ip
+	}
+	return
+}
@@ -323,6 +323,128 @@ func TestNat44EiAddressPoolsDump(t *testing.T) {
 	Expect(pools[1].VrfId).To(BeEquivalentTo(2))
 }
 
+func TestNat44EdSessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat_ed.Nat44EdPluginEnableDisableReply{})
+	err := natHandler.EnableNAT44Plugin(vppcalls.Nat44InitOpts{EndpointDependent: true})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// users
+	ctx.MockVpp.MockReply(&vpp_nat_ed.Nat44UserDetails{
+		IPAddress:       ipTo4Address("10.0.0.1"),
+		VrfID:           1,
+		Nsessions:       1,
+		Nstaticsessions: 1,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	// sessions of the user
+	ctx.MockVpp.MockReply(
+		&vpp_nat_ed.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.0.0.1"),
+			InsidePort:       8080,
+			OutsideIPAddress: ipTo4Address("192.168.10.1"),
+			OutsidePort:      1024,
+			Protocol:         6,
+			Flags:            nat_types.NAT_IS_EXT_HOST_VALID,
+			ExtHostAddress:   ipTo4Address("8.8.8.8"),
+			ExtHostPort:      443,
+			TotalPkts:        10,
+		},
+		&vpp_nat_ed.Nat44UserSessionDetails{
+			InsideIPAddress:  ipTo4Address("10.0.0.1"),
+			InsidePort:       53,
+			OutsideIPAddress: ipTo4Address("192.168.10.1"),
+			OutsidePort:      53,
+			Protocol:         17,
+			Flags:            nat_types.NAT_IS_STATIC,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+
+	Expect(sessions).To(HaveLen(2))
+
+	Expect(sessions[0].InsideIpAddress).To(Equal("10.0.0.1"))
+	Expect(sessions[0].InsidePort).To(BeEquivalentTo(8080))
+	Expect(sessions[0].OutsideIpAddress).To(Equal("192.168.10.1"))
+	Expect(sessions[0].OutsidePort).To(BeEquivalentTo(1024))
+	Expect(sessions[0].Protocol).To(Equal(nat.DNat44_TCP))
+	Expect(sessions[0].VrfId).To(BeEquivalentTo(1))
+	Expect(sessions[0].ExtHostAddress).To(Equal("8.8.8.8"))
+	Expect(sessions[0].ExtHostPort).To(BeEquivalentTo(443))
+	Expect(sessions[0].IsStatic).To(BeFalse())
+	Expect(sessions[0].TotalPackets).To(BeEquivalentTo(10))
+
+	Expect(sessions[1].Protocol).To(Equal(nat.DNat44_UDP))
+	Expect(sessions[1].ExtHostAddress).To(BeEmpty())
+	Expect(sessions[1].IsStatic).To(BeTrue())
+}
+
+func TestNat44EiSessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat_ei.Nat44EiPluginEnableDisableReply{})
+	err := natHandler.EnableNAT44Plugin(vppcalls.Nat44InitOpts{EndpointDependent: false})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// users
+	ctx.MockVpp.MockReply(
+		&vpp_nat_ei.Nat44EiUserDetails{
+			IPAddress: ipTo4Address("10.0.0.1"),
+			Nsessions: 1,
+		},
+		&vpp_nat_ei.Nat44EiUserDetails{
+			IPAddress: ipTo4Address("10.0.0.2"),
+			VrfID:     2,
+			Nsessions: 1,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	// sessions of the first user
+	ctx.MockVpp.MockReply(&vpp_nat_ei.Nat44EiUserSessionDetails{
+		InsideIPAddress:  ipTo4Address("10.0.0.1"),
+		InsidePort:       1000,
+		OutsideIPAddress: ipTo4Address("192.168.10.1"),
+		OutsidePort:      2000,
+		Protocol:         1,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	// sessions of the second user
+	ctx.MockVpp.MockReply(&vpp_nat_ei.Nat44EiUserSessionDetails{
+		InsideIPAddress:  ipTo4Address("10.0.0.2"),
+		InsidePort:       80,
+		OutsideIPAddress: ipTo4Address("192.168.10.2"),
+		OutsidePort:      80,
+		Protocol:         6,
+		Flags:            vpp_nat_ei.NAT44_EI_STATIC_MAPPING,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+
+	Expect(sessions).To(HaveLen(2))
+
+	Expect(sessions[0].InsideIpAddress).To(Equal("10.0.0.1"))
+	Expect(sessions[0].InsidePort).To(BeEquivalentTo(1000))
+	Expect(sessions[0].OutsidePort).To(BeEquivalentTo(2000))
+	Expect(sessions[0].Protocol).To(Equal(nat.DNat44_ICMP))
+	Expect(sessions[0].VrfId).To(BeEquivalentTo(0))
+	Expect(sessions[0].IsStatic).To(BeFalse())
+
+	Expect(sessions[1].InsideIpAddress).To(Equal("10.0.0.2"))
+	Expect(sessions[1].OutsideIpAddress).To(Equal("192.168.10.2"))
+	Expect(sessions[1].Protocol).To(Equal(nat.DNat44_TCP))
+	Expect(sessions[1].VrfId).To(BeEquivalentTo(2))
+	Expect(sessions[1].IsStatic).To(BeTrue())
+}
+
 func TestDNATDump(t *testing.T) {
 	ctx, natHandler, swIfIndexes, dhcpIndexes := natTestSetup(t)
 	defer ctx.TeardownTestCtx()
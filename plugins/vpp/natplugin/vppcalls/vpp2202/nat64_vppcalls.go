@@ -0,0 +1,158 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableNat64Plugin enables NAT64 plugin with the given table sizes.
+func (h *Nat64VppHandler) EnableNat64Plugin(globalCfg *nat.Nat64Global) error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		BibBuckets:    globalCfg.GetBibBuckets(),
+		BibMemorySize: globalCfg.GetBibMemorySize(),
+		StBuckets:     globalCfg.GetStBuckets(),
+		StMemorySize:  globalCfg.GetStMemorySize(),
+		Enable:        true,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DisableNat64Plugin disables NAT64 plugin.
+func (h *Nat64VppHandler) DisableNat64Plugin() error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		Enable: false,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddNat64IPv6Prefix configures NAT64 prefix for the given VRF.
+func (h *Nat64VppHandler) AddNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64IPv6Prefix(vrf, prefix, true)
+}
+
+// DelNat64IPv6Prefix removes NAT64 prefix of the given VRF.
+func (h *Nat64VppHandler) DelNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64IPv6Prefix(vrf, prefix, false)
+}
+
+// EnableNat64Interface enables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) EnableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, true)
+}
+
+// DisableNat64Interface disables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) DisableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, false)
+}
+
+// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+func (h *Nat64VppHandler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, true)
+}
+
+// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+func (h *Nat64VppHandler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, false)
+}
+
+// Calls VPP binary API to add/remove NAT64 prefix.
+func (h *Nat64VppHandler) handleNat64IPv6Prefix(vrf uint32, prefix string, isAdd bool) error {
+	ip6Prefix, err := ip_types.ParseIP6Prefix(prefix)
+	if err != nil {
+		return errors.Errorf("unable to parse NAT64 prefix %s: %v", prefix, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelPrefix{
+		Prefix: ip6Prefix,
+		VrfID:  vrf,
+		IsAdd:  isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPrefixReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to set/unset interface NAT64 feature.
+func (h *Nat64VppHandler) handleNat64Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	flags := nat_types.NAT_IS_OUTSIDE
+	if isInside {
+		flags = nat_types.NAT_IS_INSIDE
+	}
+	req := &vpp_nat64.Nat64AddDelInterface{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		Flags:     flags,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove NAT64 address pool.
+func (h *Nat64VppHandler) handleNat64AddressPool(vrf uint32, firstIP, lastIP string, isAdd bool) error {
+	firstAddr, err := ipTo4Address(firstIP)
+	if err != nil {
+		return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", firstIP, err)
+	}
+	lastAddr := firstAddr
+	if lastIP != "" {
+		lastAddr, err = ipTo4Address(lastIP)
+		if err != nil {
+			return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", lastIP, err)
+		}
+	}
+
+	req := &vpp_nat64.Nat64AddDelPoolAddrRange{
+		StartAddr: firstAddr,
+		EndAddr:   lastAddr,
+		VrfID:     vrf,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPoolAddrRangeReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
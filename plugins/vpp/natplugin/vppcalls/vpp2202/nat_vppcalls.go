@@ -270,6 +270,66 @@ func (h *NatVppHandler) DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping,
 	return h.handleNat44StaticMappingLb(mapping, dnatLabel, false)
 }
 
+func (h *NatVppHandler) delNat44EdSession(session *nat.Nat44Session) error {
+	addr, err := ipTo4Address(session.InsideIpAddress)
+	if err != nil {
+		return errors.Errorf("unable to parse inside address of NAT44 session: %v", err)
+	}
+	req := &vpp_nat_ed.Nat44DelSession{
+		Address:  addr,
+		Protocol: h.protocolNBValueToNumber(session.Protocol),
+		Port:     uint16(session.InsidePort),
+		VrfID:    session.VrfId,
+	}
+	flags := &nat44EdFlags{isInside: true}
+	if session.ExtHostAddress != "" {
+		if req.ExtHostAddress, err = ipTo4Address(session.ExtHostAddress); err != nil {
+			return errors.Errorf("unable to parse external host address of NAT44 session: %v", err)
+		}
+		req.ExtHostPort = uint16(session.ExtHostPort)
+		flags.isExtHostValid = true
+	}
+	req.Flags = setNat44EdFlags(flags)
+	reply := &vpp_nat_ed.Nat44DelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (h *NatVppHandler) delNat44EiSession(session *nat.Nat44Session) error {
+	addr, err := ipTo4Address(session.InsideIpAddress)
+	if err != nil {
+		return errors.Errorf("unable to parse inside address of NAT44 session: %v", err)
+	}
+	req := &vpp_nat_ei.Nat44EiDelSession{
+		Address:  addr,
+		Protocol: h.protocolNBValueToNumber(session.Protocol),
+		Port:     uint16(session.InsidePort),
+		VrfID:    session.VrfId,
+		Flags:    setNat44EiFlags(&nat44EiFlags{eiIfInside: true}),
+	}
+	reply := &vpp_nat_ei.Nat44EiDelSessionReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DelNat44Session removes NAT44 session identified by its inside address, port, protocol
+// and VRF (and by the external host in the endpoint-dependent mode).
+func (h *NatVppHandler) DelNat44Session(session *nat.Nat44Session) error {
+	if h.ed {
+		return h.delNat44EdSession(session)
+	} else {
+		return h.delNat44EiSession(session)
+	}
+}
+
 func (h *NatVppHandler) handleNatEd44Interface(iface string, isInside, isAdd bool) error {
 	// get interface metadata
 	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
@@ -0,0 +1,254 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableNat64Plugin(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	err := nat64Handler.EnableNat64Plugin(&nat.Nat64Global{
+		BibBuckets: 1024,
+		StBuckets:  2048,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.BibBuckets).To(BeEquivalentTo(1024))
+	Expect(msg.StBuckets).To(BeEquivalentTo(2048))
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	err = nat64Handler.DisableNat64Plugin()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeFalse())
+}
+
+func TestAddNat64IPv6Prefix(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err := nat64Handler.AddNat64IPv6Prefix(1, "2001:db8::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.Prefix.String()).To(Equal("2001:db8::/96"))
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err = nat64Handler.DelNat64IPv6Prefix(1, "2001:db8::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+}
+
+func TestAddNat64IPv6PrefixError(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// invalid prefix
+	err := nat64Handler.AddNat64IPv6Prefix(0, "2001:db8::")
+	Expect(err).Should(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{
+		Retval: 1,
+	})
+	err = nat64Handler.AddNat64IPv6Prefix(0, "2001:db8::/96")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestEnableNat64Interface(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err := nat64Handler.EnableNat64Interface("if0", true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(msg.Flags).To(Equal(nat_types.NAT_IS_INSIDE))
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err = nat64Handler.DisableNat64Interface("if1", false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(msg.Flags).To(Equal(nat_types.NAT_IS_OUTSIDE))
+
+	// missing interface
+	err = nat64Handler.EnableNat64Interface("if2", true)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat64AddressPool(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// first IP only
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPoolAddrRangeReply{})
+	err := nat64Handler.AddNat64AddressPool(0, "10.0.0.1", "")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPoolAddrRange)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(addressTo4IP(msg.StartAddr)).To(Equal("10.0.0.1"))
+	Expect(addressTo4IP(msg.EndAddr)).To(Equal("10.0.0.1"))
+
+	// first IP + last IP
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPoolAddrRangeReply{})
+	err = nat64Handler.DelNat64AddressPool(2, "10.0.0.1", "10.0.0.10")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPoolAddrRange)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.VrfID).To(BeEquivalentTo(2))
+	Expect(addressTo4IP(msg.StartAddr)).To(Equal("10.0.0.1"))
+	Expect(addressTo4IP(msg.EndAddr)).To(Equal("10.0.0.10"))
+
+	// invalid IP
+	err = nat64Handler.AddNat64AddressPool(0, "2001:db8::1", "")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestNat64IPv6PrefixDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	prefix, err := ip_types.ParseIP6Prefix("64:ff9b::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PrefixDetails{
+		Prefix: prefix,
+		VrfID:  1,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	prefixes, err := nat64Handler.Nat64IPv6PrefixDump()
+	Expect(err).To(Succeed())
+	Expect(prefixes).To(HaveLen(1))
+	Expect(prefixes[0].VrfId).To(BeEquivalentTo(1))
+	Expect(prefixes[0].Prefix).To(Equal("64:ff9b::/96"))
+}
+
+func TestNat64InterfacesDump(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: interface_types.InterfaceIndex(1),
+			Flags:     nat_types.NAT_IS_INSIDE,
+		},
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: interface_types.InterfaceIndex(2),
+			Flags:     nat_types.NAT_IS_OUTSIDE,
+		},
+		&vpp_nat64.Nat64InterfaceDetails{
+			SwIfIndex: interface_types.InterfaceIndex(3), // not in the mapping
+			Flags:     nat_types.NAT_IS_OUTSIDE,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	ifaces, err := nat64Handler.Nat64InterfacesDump()
+	Expect(err).To(Succeed())
+	Expect(ifaces).To(HaveLen(2))
+	Expect(ifaces[0].Name).To(Equal("if0"))
+	Expect(ifaces[0].Type).To(Equal(nat.Nat64Interface_IPV6_INSIDE))
+	Expect(ifaces[1].Name).To(Equal("if1"))
+	Expect(ifaces[1].Type).To(Equal(nat.Nat64Interface_IPV4_OUTSIDE))
+}
+
+func TestNat64AddressPoolsDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("10.0.0.1"),
+		},
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("10.0.0.2"),
+		},
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("10.0.0.3"),
+			VrfID:   1,
+		},
+		&vpp_nat64.Nat64PoolAddrDetails{
+			Address: ipTo4Address("10.0.0.10"),
+			VrfID:   1,
+		})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	pools, err := nat64Handler.Nat64AddressPoolsDump()
+	Expect(err).To(Succeed())
+	Expect(pools).To(HaveLen(3))
+	Expect(pools[0].VrfId).To(BeEquivalentTo(0))
+	Expect(pools[0].FirstIp).To(Equal("10.0.0.1"))
+	Expect(pools[0].LastIp).To(Equal("10.0.0.2"))
+	Expect(pools[1].VrfId).To(BeEquivalentTo(1))
+	Expect(pools[1].FirstIp).To(Equal("10.0.0.3"))
+	Expect(pools[1].LastIp).To(BeEmpty())
+	Expect(pools[2].VrfId).To(BeEquivalentTo(1))
+	Expect(pools[2].FirstIp).To(Equal("10.0.0.10"))
+}
+
+func nat64TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.Nat64VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	swIfIndexes := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-sw_if_indexes")
+	nat64Handler := vpp2210.NewNat64VppHandler(ctx.MockVPPClient, swIfIndexes, log)
+	return ctx, nat64Handler, swIfIndexes
+}
@@ -0,0 +1,80 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210"
+	vpp_nat_ed "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ed"
+	vpp_nat_ei "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ei"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_nat_ed.AllMessages()...)
+	msgs = append(msgs, vpp_nat_ei.AllMessages()...)
+
+	vppcalls.AddNatHandlerVersion(vpp2210.Version, msgs, NewNatVppHandler)
+	vppcalls.AddNat64HandlerVersion(vpp2210.Version, vpp_nat64.AllMessages(), NewNat64VppHandler)
+}
+
+// NatVppHandler is accessor for NAT-related vppcalls methods.
+type NatVppHandler struct {
+	callsChannel govppapi.Channel
+	conn         govppapi.Connection
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	dhcpIndex    idxmap.NamedMapping
+	log          logging.Logger
+	ed           bool
+}
+
+// NewNatVppHandler creates new instance of NAT vppcalls handler.
+func NewNatVppHandler(c vpp.Client,
+	ifIndexes ifaceidx.IfaceMetadataIndex, dhcpIndex idxmap.NamedMapping, log logging.Logger,
+) vppcalls.NatVppAPI {
+	callsChan, _ := c.NewAPIChannel()
+	return &NatVppHandler{
+		callsChannel: callsChan,
+		conn:         c,
+		ifIndexes:    ifIndexes,
+		dhcpIndex:    dhcpIndex,
+		log:          log,
+		ed:           true,
+	}
+}
+
+// Nat64VppHandler is accessor for NAT64-related vppcalls methods.
+type Nat64VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewNat64VppHandler creates new instance of NAT64 vppcalls handler.
+func NewNat64VppHandler(c vpp.Client, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) vppcalls.Nat64VppAPI {
+	callsChan, _ := c.NewAPIChannel()
+	return &Nat64VppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
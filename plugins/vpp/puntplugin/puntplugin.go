@@ -37,6 +37,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls/vpp2210"
 )
 
 // PuntPlugin configures VPP punt to host or unix domain socket entries and IP redirect entries using GoVPP.
@@ -70,7 +71,7 @@ func (p *PuntPlugin) Init() (err error) {
 	// init and register IP punt redirect
 	p.ipRedirectDescriptor = descriptor.NewIPRedirectDescriptor(p.puntHandler, p.Log)
 	ipRedirectDescriptor := adapter.NewIPPuntRedirectDescriptor(p.ipRedirectDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(ipRedirectDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, ipRedirectDescriptor)
 	if err != nil {
 		return err
 	}
@@ -78,7 +79,7 @@ func (p *PuntPlugin) Init() (err error) {
 	// init and register punt descriptor
 	p.toHostDescriptor = descriptor.NewPuntToHostDescriptor(p.puntHandler, p.Log)
 	toHostDescriptor := adapter.NewPuntToHostDescriptor(p.toHostDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(toHostDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, toHostDescriptor)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (p *PuntPlugin) Init() (err error) {
 	// init and register punt exception descriptor
 	p.puntExceptionDescriptor = descriptor.NewPuntExceptionDescriptor(p.puntHandler, p.Log)
 	exceptionDescriptor := adapter.NewPuntExceptionDescriptor(p.puntExceptionDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(exceptionDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, exceptionDescriptor)
 	if err != nil {
 		return err
 	}
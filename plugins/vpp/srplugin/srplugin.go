@@ -32,6 +32,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin/vppcalls/vpp2210"
 )
 
 // SRPlugin configures segment routing.
@@ -68,7 +69,7 @@ func (p *SRPlugin) Init() error {
 	steeringDescriptor := descriptor.NewSteeringDescriptor(p.srHandler, p.Log)
 	encapSourceAddressDescriptor := descriptor.NewSRv6GlobalDescriptor(p.srHandler, p.Log)
 
-	err = p.Deps.Scheduler.RegisterKVDescriptor(
+	err = p.VPP.RegisterKVDescriptor(p.Deps.Scheduler,
 		localSIDDescriptor,
 		policyDescriptor,
 		steeringDescriptor,
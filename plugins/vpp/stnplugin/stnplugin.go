@@ -30,6 +30,7 @@ import (
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/vppcalls/vpp2101"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin/vppcalls/vpp2210"
 )
 
 // STNPlugin configures VPP STN rules using GoVPP.
@@ -65,7 +66,7 @@ func (p *STNPlugin) Init() (err error) {
 	// init and register STN descriptor
 	p.stnDescriptor = descriptor.NewSTNDescriptor(p.stnHandler, p.Log)
 	stnDescriptor := adapter.NewSTNDescriptor(p.stnDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(stnDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, stnDescriptor)
 	if err != nil {
 		return err
 	}
@@ -97,6 +97,7 @@ func (d *WgPeerDescriptor) GetDescriptor() *adapter.PeerDescriptor {
 		ValueComparator:      d.EquivalentWgPeers,
 		Validate:             d.Validate,
 		Create:               d.Create,
+		Update:               d.Update,
 		Delete:               d.Delete,
 		Retrieve:             d.Retrieve,
 		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName},
@@ -148,11 +149,42 @@ func (d *WgPeerDescriptor) Create(key string, peer *wg.Peer) (metadata *wgidx.Wg
 	}
 
 	metadata = &wgidx.WgMetadata{
-		Index: vppWgIndex,
+		Index:     vppWgIndex,
+		PublicKey: peer.PublicKey,
 	}
 	return metadata, err
 }
 
+// Update replaces the wireguard peer. If the public key of the peer has changed
+// (key rotation), the peer with the new key is added before the old one is
+// removed (make-before-break) so that the tunnel stays up during the rotation.
+func (d *WgPeerDescriptor) Update(key string, oldPeer, newPeer *wg.Peer, oldMetadata *wgidx.WgMetadata) (
+	newMetadata *wgidx.WgMetadata, err error) {
+	if oldMetadata == nil {
+		return nil, fmt.Errorf("failed to update peer - metadata is nil")
+	}
+	if oldPeer.PublicKey == newPeer.PublicKey {
+		// VPP does not allow two peers with the same public key
+		if err = d.Delete(key, oldPeer, oldMetadata); err != nil {
+			return nil, err
+		}
+		return d.Create(key, newPeer)
+	}
+
+	if newMetadata, err = d.Create(key, newPeer); err != nil {
+		return nil, err
+	}
+	if err = d.wgHandler.RemovePeer(oldMetadata.Index); err != nil {
+		d.log.Errorf("failed to remove peer with the rotated key: %v", err)
+		// revert to keep the old peer as the only one
+		if rmErr := d.wgHandler.RemovePeer(newMetadata.Index); rmErr != nil {
+			d.log.Error(rmErr)
+		}
+		return nil, err
+	}
+	return newMetadata, nil
+}
+
 // Delete removes VPP wg peers.
 func (d *WgPeerDescriptor) Delete(key string, peer *wg.Peer, metadata *wgidx.WgMetadata) error {
 	if metadata == nil {
@@ -167,18 +199,42 @@ func (d *WgPeerDescriptor) Delete(key string, peer *wg.Peer, metadata *wgidx.WgM
 
 // Retrieve returns all wg peers.
 func (d *WgPeerDescriptor) Retrieve(correlate []adapter.PeerKVWithMetadata) (dump []adapter.PeerKVWithMetadata, err error) {
-	peers, err := d.wgHandler.DumpWgPeers()
+	peers, err := d.wgHandler.DumpWgPeerDetails()
 	if err != nil {
 		d.log.Error(err)
 		return dump, err
 	}
-	for _, peer := range peers {
+	for _, details := range peers {
+		var metadata *wgidx.WgMetadata
+		if details.Meta != nil {
+			metadata = &wgidx.WgMetadata{
+				Index:     details.Meta.PeerIndex,
+				PublicKey: details.Peer.PublicKey,
+			}
+		} else {
+			// peer index not reported by this VPP version, keep the metadata
+			// of the same peer known from the previous run (if any)
+			metadata = correlatedPeerMetadata(correlate, details.Peer)
+		}
 		dump = append(dump, adapter.PeerKVWithMetadata{
-			Key:    models.Key(peer),
-			Value:  peer,
-			Origin: kvs.FromNB,
+			Key:      models.Key(details.Peer),
+			Value:    details.Peer,
+			Metadata: metadata,
+			Origin:   kvs.FromNB,
 		})
 	}
 
 	return dump, nil
 }
+
+// correlatedPeerMetadata returns metadata of the correlated peer with the same
+// public key and interface as the given peer, or nil if there is none.
+func correlatedPeerMetadata(correlate []adapter.PeerKVWithMetadata, peer *wg.Peer) *wgidx.WgMetadata {
+	for _, kv := range correlate {
+		if kv.Value.GetPublicKey() == peer.GetPublicKey() &&
+			kv.Value.GetWgIfName() == peer.GetWgIfName() {
+			return kv.Metadata
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/wgidx"
+	wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
+)
+
+const (
+	testKey1 = "lVIrE2JVz8g9cpMMOzzIqH5m9BCSSuYuFYkDUZmOsGI="
+	testKey2 = "q4SUJBqTHmFR0eJK9e0/1QxN+0ZMmnrYGJmvhqc03zw="
+)
+
+// mockWgHandler simulates peers configured in VPP.
+type mockWgHandler struct {
+	nextIndex  uint32
+	peers      map[uint32]*wg.Peer
+	removeErr  map[uint32]error
+	withoutIdx bool
+	ops        []string
+}
+
+func newMockWgHandler() *mockWgHandler {
+	return &mockWgHandler{
+		peers:     make(map[uint32]*wg.Peer),
+		removeErr: make(map[uint32]error),
+	}
+}
+
+func (h *mockWgHandler) AddPeer(peer *wg.Peer) (uint32, error) {
+	for _, p := range h.peers {
+		if p.PublicKey == peer.PublicKey {
+			return 0, errors.New("peer with the same public key already exists")
+		}
+	}
+	idx := h.nextIndex
+	h.nextIndex++
+	h.peers[idx] = peer
+	h.ops = append(h.ops, "add "+peer.PublicKey)
+	return idx, nil
+}
+
+func (h *mockWgHandler) RemovePeer(idx uint32) error {
+	if err := h.removeErr[idx]; err != nil {
+		return err
+	}
+	peer, ok := h.peers[idx]
+	if !ok {
+		return errors.New("peer does not exist")
+	}
+	delete(h.peers, idx)
+	h.ops = append(h.ops, "remove "+peer.PublicKey)
+	return nil
+}
+
+func (h *mockWgHandler) DumpWgPeers() (peerList []*wg.Peer, err error) {
+	for _, peer := range h.peers {
+		peerList = append(peerList, peer)
+	}
+	return peerList, nil
+}
+
+func (h *mockWgHandler) DumpWgPeerDetails() (peerList []*vppcalls.WgPeerDetails, err error) {
+	for idx, peer := range h.peers {
+		details := &vppcalls.WgPeerDetails{Peer: peer}
+		if !h.withoutIdx {
+			details.Meta = &vppcalls.WgPeerMeta{PeerIndex: idx}
+		}
+		peerList = append(peerList, details)
+	}
+	return peerList, nil
+}
+
+func testPeer(publicKey string, port uint32) *wg.Peer {
+	return &wg.Peer{
+		PublicKey:  publicKey,
+		WgIfName:   "wg1",
+		Endpoint:   "10.10.2.1",
+		Port:       port,
+		AllowedIps: []string{"10.10.0.0/24"},
+	}
+}
+
+func TestUpdatePeer(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := newMockWgHandler()
+	d := NewWgPeerDescriptor(handler, logging.ForPlugin("test-log"))
+	oldPeer := testPeer(testKey1, 12345)
+	key := models.Key(oldPeer)
+
+	meta, err := d.Create(key, oldPeer)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(meta).To(Equal(&wgidx.WgMetadata{Index: 0, PublicKey: testKey1}))
+
+	// the same public key - the peer is re-created
+	newPeer := testPeer(testKey1, 12346)
+	meta, err = d.Update(key, oldPeer, newPeer, meta)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(meta).To(Equal(&wgidx.WgMetadata{Index: 1, PublicKey: testKey1}))
+	Expect(handler.ops).To(Equal([]string{"add " + testKey1, "remove " + testKey1, "add " + testKey1}))
+	Expect(handler.peers).To(HaveLen(1))
+
+	// key rotation - the new peer is added before the old one is removed
+	handler.ops = nil
+	rotatedPeer := testPeer(testKey2, 12346)
+	meta, err = d.Update(key, newPeer, rotatedPeer, meta)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(meta).To(Equal(&wgidx.WgMetadata{Index: 2, PublicKey: testKey2}))
+	Expect(handler.ops).To(Equal([]string{"add " + testKey2, "remove " + testKey1}))
+	Expect(handler.peers).To(HaveKeyWithValue(uint32(2), rotatedPeer))
+	Expect(handler.peers).To(HaveLen(1))
+
+	_, err = d.Update(key, newPeer, rotatedPeer, nil)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestUpdatePeerRotationFailure(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := newMockWgHandler()
+	d := NewWgPeerDescriptor(handler, logging.ForPlugin("test-log"))
+	oldPeer := testPeer(testKey1, 12345)
+	key := models.Key(oldPeer)
+
+	meta, err := d.Create(key, oldPeer)
+	Expect(err).ToNot(HaveOccurred())
+
+	// the old peer cannot be removed - the new peer is reverted
+	handler.removeErr[meta.Index] = errors.New("remove failed")
+	newMeta, err := d.Update(key, oldPeer, testPeer(testKey2, 12345), meta)
+	Expect(err).To(HaveOccurred())
+	Expect(newMeta).To(BeNil())
+	Expect(handler.peers).To(HaveLen(1))
+	Expect(handler.peers).To(HaveKeyWithValue(meta.Index, oldPeer))
+}
+
+func TestRetrievePeers(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := newMockWgHandler()
+	d := NewWgPeerDescriptor(handler, logging.ForPlugin("test-log"))
+	handler.nextIndex = 5
+	peer := testPeer(testKey1, 12345)
+	_, err := handler.AddPeer(peer)
+	Expect(err).ToNot(HaveOccurred())
+
+	// metadata is rebuilt from the dump
+	dump, err := d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dump).To(HaveLen(1))
+	Expect(dump[0].Key).To(Equal(models.Key(peer)))
+	Expect(dump[0].Metadata).To(Equal(&wgidx.WgMetadata{Index: 5, PublicKey: testKey1}))
+
+	// the retrieved peer can be removed
+	Expect(d.Delete(dump[0].Key, dump[0].Value, dump[0].Metadata)).To(Succeed())
+	Expect(handler.peers).To(BeEmpty())
+
+	// VPP not reporting the peer index and nothing to correlate with
+	handler.withoutIdx = true
+	_, err = handler.AddPeer(peer)
+	Expect(err).ToNot(HaveOccurred())
+	dump, err = d.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dump).To(HaveLen(1))
+	Expect(dump[0].Metadata).To(BeNil())
+}
+
+func TestRetrievePeersWithoutIndex(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := newMockWgHandler()
+	handler.withoutIdx = true
+	d := NewWgPeerDescriptor(handler, logging.ForPlugin("test-log"))
+	peer := testPeer(testKey1, 12345)
+	key := models.Key(peer)
+
+	meta, err := d.Create(key, peer)
+	Expect(err).ToNot(HaveOccurred())
+
+	// metadata is taken over from the correlated peer
+	otherIf := testPeer(testKey1, 12345)
+	otherIf.WgIfName = "wg2"
+	correlate := []adapter.PeerKVWithMetadata{
+		{Key: models.Key(otherIf), Value: otherIf, Metadata: &wgidx.WgMetadata{Index: 7, PublicKey: testKey1}},
+		{Key: key, Value: peer, Metadata: meta},
+	}
+	dump, err := d.Retrieve(correlate)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dump).To(HaveLen(1))
+	Expect(dump[0].Metadata).To(Equal(meta))
+
+	// the retrieved peer can be updated and removed
+	newPeer := testPeer(testKey1, 12346)
+	meta, err = d.Update(key, peer, newPeer, dump[0].Metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(d.Delete(key, newPeer, meta)).To(Succeed())
+	Expect(handler.peers).To(BeEmpty())
+}
@@ -0,0 +1,196 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package wireguardplugin
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/datasync"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/wgidx"
+	wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
+)
+
+// PeerStatePollPeriod is the period between dumps of the wireguard peer state.
+var PeerStatePollPeriod = time.Second * 10
+
+// peer flags as defined by the VPP wireguard plugin
+const (
+	peerStatusDead  = 0x1
+	peerEstablished = 0x2
+)
+
+// peerStateTracker remembers the last published state.
+type peerStateTracker struct {
+	mu sync.Mutex
+	// last published state (by peer name)
+	published map[string]*wg.PeerState
+}
+
+func newPeerStateTracker() *peerStateTracker {
+	return &peerStateTracker{
+		published: make(map[string]*wg.PeerState),
+	}
+}
+
+// GetPeerStates returns the runtime state of all configured wireguard peers.
+func (p *WgPlugin) GetPeerStates() ([]*wg.PeerState, error) {
+	if p.WgHandler == nil {
+		return nil, errors.New("VPP plugin wireguard is not available")
+	}
+	peers, err := p.WgHandler.DumpWgPeerDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	// peer names by their VPP indexes and public keys (unique in VPP)
+	byIndex := make(map[uint32]string)
+	byKey := make(map[string]string)
+	peerIndex := p.KVScheduler.GetMetadataMap(descriptor.PeerDescriptorName)
+	for _, name := range peerIndex.ListAllNames() {
+		meta, exists := peerIndex.GetValue(name)
+		if !exists {
+			continue
+		}
+		if peerMeta, ok := meta.(*wgidx.WgMetadata); ok && peerMeta != nil {
+			byIndex[peerMeta.Index] = name
+			byKey[peerMeta.PublicKey] = name
+		}
+	}
+
+	peersPerIf := make(map[string]int)
+	for _, details := range peers {
+		peersPerIf[details.Peer.WgIfName]++
+	}
+	ifCounters := p.getInterfaceCounters()
+
+	var states []*wg.PeerState
+	for _, details := range peers {
+		peer := details.Peer
+		var name string
+		var configured bool
+		if details.Meta != nil {
+			name, configured = byIndex[details.Meta.PeerIndex]
+		} else {
+			// older VPP versions do not report the peer index
+			name, configured = byKey[peer.PublicKey]
+		}
+		if !configured {
+			continue
+		}
+		state := &wg.PeerState{
+			Name:        name,
+			WgIfName:    peer.WgIfName,
+			PublicKey:   peer.PublicKey,
+			Endpoint:    peer.Endpoint,
+			Port:        peer.Port,
+			Established: peer.Flags&peerEstablished != 0,
+			Dead:        peer.Flags&peerStatusDead != 0,
+		}
+		if peersPerIf[peer.WgIfName] == 1 {
+			if counters, ok := ifCounters[peer.WgIfName]; ok {
+				state.RxBytes = counters.Rx.Bytes
+				state.TxBytes = counters.Tx.Bytes
+			}
+		}
+		states = append(states, state)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Name < states[j].Name
+	})
+	return states, nil
+}
+
+// getInterfaceCounters returns counters of the wireguard interfaces by their names.
+func (p *WgPlugin) getInterfaceCounters() map[string]govppapi.InterfaceCounters {
+	counters := make(map[string]govppapi.InterfaceCounters)
+	stats := p.VPP.Stats()
+	if stats == nil {
+		return counters
+	}
+	ifStats := &govppapi.InterfaceStats{}
+	if err := stats.GetInterfaceStats(ifStats); err != nil {
+		p.Log.Debugf("interface stats not available: %v", err)
+		return counters
+	}
+	ifIndex := p.IfPlugin.GetInterfaceIndex()
+	for _, iface := range ifStats.Interfaces {
+		if name, _, exists := ifIndex.LookupBySwIfIndex(iface.InterfaceIndex); exists {
+			counters[name] = iface
+		}
+	}
+	return counters
+}
+
+// watchPeerStates periodically publishes the state of the peers until the context is cancelled.
+func (p *WgPlugin) watchPeerStates(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(PeerStatePollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.publishPeerStates(); err != nil {
+				p.Log.Warnf("failed to publish wireguard peer state: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// publishPeerStates publishes the peer state that has changed since the last publishing.
+func (p *WgPlugin) publishPeerStates() error {
+	states, err := p.GetPeerStates()
+	if err != nil {
+		return err
+	}
+
+	p.peerStates.mu.Lock()
+	defer p.peerStates.mu.Unlock()
+
+	current := make(map[string]struct{})
+	for _, state := range states {
+		current[state.Name] = struct{}{}
+		if proto.Equal(p.peerStates.published[state.Name], state) {
+			continue
+		}
+		err := p.PublishState.Put(wg.PeerStateKey(state.Name), state, datasync.WithClientLifetimeTTL())
+		if err != nil {
+			return err
+		}
+		p.peerStates.published[state.Name] = state
+	}
+	for name := range p.peerStates.published {
+		if _, exists := current[name]; exists {
+			continue
+		}
+		if err := p.PublishState.Put(wg.PeerStateKey(name), nil); err != nil {
+			return err
+		}
+		delete(p.peerStates.published, name)
+	}
+	return nil
+}
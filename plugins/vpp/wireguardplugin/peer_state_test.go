@@ -0,0 +1,220 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package wireguardplugin
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/datasync"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	idxmap_mem "go.ligato.io/cn-infra/v2/idxmap/mem"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/wgidx"
+	wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
+)
+
+type mockWgHandler struct {
+	vppcalls.WgVppAPI
+	peers []*vppcalls.WgPeerDetails
+}
+
+func (h *mockWgHandler) DumpWgPeerDetails() ([]*vppcalls.WgPeerDetails, error) {
+	return h.peers, nil
+}
+
+type mockScheduler struct {
+	kvs.KVScheduler
+	peerIndex idxmap.NamedMappingRW
+}
+
+func (s *mockScheduler) GetMetadataMap(descriptorName string) idxmap.NamedMapping {
+	if descriptorName == descriptor.PeerDescriptorName {
+		return s.peerIndex
+	}
+	return nil
+}
+
+type mockVPP struct {
+	govppmux.API
+	stats *mockStats
+}
+
+func (v *mockVPP) Stats() govppapi.StatsProvider {
+	return v.stats
+}
+
+type mockStats struct {
+	govppapi.StatsProvider
+	interfaces []govppapi.InterfaceCounters
+}
+
+func (s *mockStats) GetInterfaceStats(stats *govppapi.InterfaceStats) error {
+	stats.Interfaces = s.interfaces
+	return nil
+}
+
+type mockIfPlugin struct {
+	ifplugin.API
+	ifIndex ifaceidx.IfaceMetadataIndexRW
+}
+
+func (p *mockIfPlugin) GetInterfaceIndex() ifaceidx.IfaceMetadataIndex {
+	return p.ifIndex
+}
+
+type mockPublisher struct {
+	published map[string]proto.Message
+	puts      int
+}
+
+func (p *mockPublisher) Put(key string, data proto.Message, opts ...datasync.PutOption) error {
+	p.puts++
+	if data == nil {
+		delete(p.published, key)
+		return nil
+	}
+	p.published[key] = data
+	return nil
+}
+
+func wgPeerDetails(index uint32, ifName, publicKey string, flags uint32) *vppcalls.WgPeerDetails {
+	return &vppcalls.WgPeerDetails{
+		Peer: &wg.Peer{
+			PublicKey: publicKey,
+			WgIfName:  ifName,
+			Endpoint:  "10.10.2.1",
+			Port:      12345,
+			Flags:     flags,
+		},
+		Meta: &vppcalls.WgPeerMeta{PeerIndex: index},
+	}
+}
+
+func testWgPlugin() (*WgPlugin, *mockWgHandler, *mockPublisher) {
+	peerIndex := idxmap_mem.NewNamedMapping(logrus.DefaultLogger(), "test-wg-peers", nil)
+	peerIndex.Put("wg0/endpoint/10.10.2.1/12345", &wgidx.WgMetadata{Index: 0, PublicKey: "key-a"})
+	peerIndex.Put("wg1/endpoint/10.10.2.1/12345", &wgidx.WgMetadata{Index: 1, PublicKey: "key-b"})
+
+	ifIndex := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-ifaces")
+	ifIndex.Put("wg0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndex.Put("wg1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	handler := &mockWgHandler{}
+	publisher := &mockPublisher{published: make(map[string]proto.Message)}
+	p := &WgPlugin{
+		Deps: Deps{
+			KVScheduler: &mockScheduler{peerIndex: peerIndex},
+			VPP: &mockVPP{stats: &mockStats{interfaces: []govppapi.InterfaceCounters{
+				{InterfaceIndex: 1, Rx: govppapi.InterfaceCounterCombined{Bytes: 100}, Tx: govppapi.InterfaceCounterCombined{Bytes: 200}},
+				{InterfaceIndex: 2, Rx: govppapi.InterfaceCounterCombined{Bytes: 300}, Tx: govppapi.InterfaceCounterCombined{Bytes: 400}},
+			}}},
+			IfPlugin:     &mockIfPlugin{ifIndex: ifIndex},
+			PublishState: publisher,
+		},
+		WgHandler:  handler,
+		peerStates: newPeerStateTracker(),
+	}
+	return p, handler, publisher
+}
+
+func TestGetPeerStates(t *testing.T) {
+	RegisterTestingT(t)
+
+	p, handler, _ := testWgPlugin()
+	handler.peers = []*vppcalls.WgPeerDetails{
+		wgPeerDetails(0, "wg0", "key-a", peerEstablished),
+		wgPeerDetails(1, "wg1", "key-b", peerStatusDead),
+		wgPeerDetails(2, "wg1", "key-c", 0), // not configured by the agent
+	}
+
+	states, err := p.GetPeerStates()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(states).To(HaveLen(2))
+
+	Expect(states[0].Name).To(Equal("wg0/endpoint/10.10.2.1/12345"))
+	Expect(states[0].PublicKey).To(Equal("key-a"))
+	Expect(states[0].Established).To(BeTrue())
+	Expect(states[0].Dead).To(BeFalse())
+	// the only peer of the interface gets the interface counters
+	Expect(states[0].RxBytes).To(BeEquivalentTo(100))
+	Expect(states[0].TxBytes).To(BeEquivalentTo(200))
+
+	Expect(states[1].Name).To(Equal("wg1/endpoint/10.10.2.1/12345"))
+	Expect(states[1].Established).To(BeFalse())
+	Expect(states[1].Dead).To(BeTrue())
+	// interface shared by multiple peers
+	Expect(states[1].RxBytes).To(BeZero())
+	Expect(states[1].TxBytes).To(BeZero())
+}
+
+func TestGetPeerStatesMatching(t *testing.T) {
+	RegisterTestingT(t)
+
+	p, handler, _ := testWgPlugin()
+
+	// the peer index reported by VPP has precedence over the public key
+	handler.peers = []*vppcalls.WgPeerDetails{wgPeerDetails(1, "wg0", "key-a", 0)}
+	states, err := p.GetPeerStates()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(states).To(HaveLen(1))
+	Expect(states[0].Name).To(Equal("wg1/endpoint/10.10.2.1/12345"))
+
+	// older VPP versions do not report the peer index
+	peer := wgPeerDetails(0, "wg0", "key-b", 0)
+	peer.Meta = nil
+	handler.peers = []*vppcalls.WgPeerDetails{peer}
+	states, err = p.GetPeerStates()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(states).To(HaveLen(1))
+	Expect(states[0].Name).To(Equal("wg1/endpoint/10.10.2.1/12345"))
+}
+
+func TestPublishPeerStates(t *testing.T) {
+	RegisterTestingT(t)
+
+	p, handler, publisher := testWgPlugin()
+	handler.peers = []*vppcalls.WgPeerDetails{
+		wgPeerDetails(0, "wg0", "key-a", 0),
+		wgPeerDetails(1, "wg1", "key-b", 0),
+	}
+	keyA := wg.PeerStateKey("wg0/endpoint/10.10.2.1/12345")
+	keyB := wg.PeerStateKey("wg1/endpoint/10.10.2.1/12345")
+
+	Expect(p.publishPeerStates()).To(Succeed())
+	Expect(publisher.published).To(HaveKey(keyA))
+	Expect(publisher.published).To(HaveKey(keyB))
+	Expect(publisher.puts).To(Equal(2))
+
+	// unchanged state is not published again
+	Expect(p.publishPeerStates()).To(Succeed())
+	Expect(publisher.puts).To(Equal(2))
+
+	// changed state is published, state of removed peer is deleted
+	handler.peers = []*vppcalls.WgPeerDetails{wgPeerDetails(0, "wg0", "key-a", peerEstablished)}
+	Expect(p.publishPeerStates()).To(Succeed())
+	Expect(publisher.puts).To(Equal(4))
+	Expect(publisher.published).To(HaveLen(1))
+	Expect(publisher.published[keyA].(*wg.PeerState).Established).To(BeTrue())
+}
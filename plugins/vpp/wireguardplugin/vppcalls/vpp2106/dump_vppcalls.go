@@ -17,11 +17,24 @@ package vpp2106
 import (
 	"encoding/base64"
 	vpp_wg "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/wireguard"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 	wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
 )
 
 // DumpWgPeers implements wg handler.
 func (h *WgVppHandler) DumpWgPeers() (peerList []*wg.Peer, err error) {
+	details, err := h.DumpWgPeerDetails()
+	if err != nil {
+		return nil, err
+	}
+	for _, peerDetails := range details {
+		peerList = append(peerList, peerDetails.Peer)
+	}
+	return peerList, nil
+}
+
+// DumpWgPeerDetails implements wg handler.
+func (h *WgVppHandler) DumpWgPeerDetails() (peerList []*vppcalls.WgPeerDetails, err error) {
 	req := &vpp_wg.WireguardPeersDump{}
 	requestCtx := h.callsChannel.SendMultiRequest(req)
 
@@ -57,14 +70,16 @@ func (h *WgVppHandler) DumpWgPeers() (peerList []*wg.Peer, err error) {
 			continue
 		}
 
-		peerDetails.WgIfName = ifName;
+		peerDetails.WgIfName = ifName
 
 		endpointAddr := vppPeerDetails.Peer.Endpoint.ToIP()
 		if !endpointAddr.IsUnspecified() {
 			peerDetails.Endpoint = endpointAddr.String()
 		}
 
-		peerList = append(peerList, peerDetails)
+		peerList = append(peerList, &vppcalls.WgPeerDetails{
+			Peer: peerDetails,
+		})
 	}
 
 	return
@@ -37,6 +37,21 @@ type WgVppAPI interface {
 type WgVppRead interface {
 	// DumpWgPeer returns a peers state
 	DumpWgPeers() (peerList []*wg.Peer, err error)
+	// DumpWgPeerDetails returns peers together with their VPP-specific metadata
+	DumpWgPeerDetails() (peerList []*WgPeerDetails, err error)
+}
+
+// WgPeerDetails contains wireguard peer with VPP-specific metadata
+type WgPeerDetails struct {
+	Peer *wg.Peer
+	// Meta is nil if the VPP version does not report the peer index in the dump,
+	// the descriptor then keeps the metadata of the correlated peer
+	Meta *WgPeerMeta
+}
+
+// WgPeerMeta contains VPP-specific metadata of wireguard peer
+type WgPeerMeta struct {
+	PeerIndex uint32
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
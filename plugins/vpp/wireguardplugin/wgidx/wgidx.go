@@ -16,5 +16,6 @@ package wgidx
 
 // WgMetadata represents metadata for wireguard
 type WgMetadata struct {
-	Index uint32
+	Index     uint32
+	PublicKey string
 }
@@ -17,7 +17,11 @@
 package wireguardplugin
 
 import (
+	"context"
+	"sync"
+
 	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/datasync"
 	"go.ligato.io/cn-infra/v2/health/statuscheck"
 	"go.ligato.io/cn-infra/v2/infra"
 
@@ -30,6 +34,7 @@ import (
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls/vpp2106"
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls/vpp2210"
 )
 
 type WgPlugin struct {
@@ -38,14 +43,22 @@ type WgPlugin struct {
 	WgHandler vppcalls.WgVppAPI
 
 	peerDescriptor *descriptor.WgPeerDescriptor
+
+	// runtime state of the peers
+	peerStates *peerStateTracker
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 type Deps struct {
 	infra.PluginDeps
-	KVScheduler kvs.KVScheduler
-	VPP         govppmux.API
-	IfPlugin    ifplugin.API
-	StatusCheck statuscheck.PluginStatusWriter // optional
+	KVScheduler  kvs.KVScheduler
+	VPP          govppmux.API
+	IfPlugin     ifplugin.API
+	PublishState datasync.KeyProtoValWriter     // optional
+	StatusCheck  statuscheck.PluginStatusWriter // optional
 }
 
 func (p *WgPlugin) Init() (err error) {
@@ -60,9 +73,11 @@ func (p *WgPlugin) Init() (err error) {
 		return errors.New("Wireguard handler is not available")
 	}
 
+	p.peerStates = newPeerStateTracker()
+
 	p.peerDescriptor = descriptor.NewWgPeerDescriptor(p.WgHandler, p.Log)
 	peerDescriptor := adapter.NewPeerDescriptor(p.peerDescriptor.GetDescriptor())
-	err = p.KVScheduler.RegisterKVDescriptor(peerDescriptor)
+	err = p.VPP.RegisterKVDescriptor(p.KVScheduler, peerDescriptor)
 	if err != nil {
 		return err
 	}
@@ -75,5 +90,21 @@ func (p *WgPlugin) AfterInit() error {
 	if p.StatusCheck != nil {
 		p.StatusCheck.Register(p.PluginName, nil)
 	}
+
+	// publish runtime state of the peers
+	if p.WgHandler != nil && p.PublishState != nil {
+		p.ctx, p.cancel = context.WithCancel(context.Background())
+		p.wg.Add(1)
+		go p.watchPeerStates(p.ctx)
+	}
+	return nil
+}
+
+// Close stops publishing of the peer state.
+func (p *WgPlugin) Close() error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
 	return nil
 }
@@ -0,0 +1,374 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.1.0
+// - protoc             v3.17.3
+// source: ligato/kvscheduler/remote_descriptor.proto
+
+package kvscheduler
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RemoteRegistryServiceClient is the client API for RemoteRegistryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoteRegistryServiceClient interface {
+	// RegisterDescriptor registers model together with the remote descriptor handling it.
+	RegisterDescriptor(ctx context.Context, in *RegisterDescriptorRequest, opts ...grpc.CallOption) (*RegisterDescriptorResponse, error)
+}
+
+type remoteRegistryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteRegistryServiceClient(cc grpc.ClientConnInterface) RemoteRegistryServiceClient {
+	return &remoteRegistryServiceClient{cc}
+}
+
+func (c *remoteRegistryServiceClient) RegisterDescriptor(ctx context.Context, in *RegisterDescriptorRequest, opts ...grpc.CallOption) (*RegisterDescriptorResponse, error) {
+	out := new(RegisterDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteRegistryService/RegisterDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteRegistryServiceServer is the server API for RemoteRegistryService service.
+// All implementations must embed UnimplementedRemoteRegistryServiceServer
+// for forward compatibility
+type RemoteRegistryServiceServer interface {
+	// RegisterDescriptor registers model together with the remote descriptor handling it.
+	RegisterDescriptor(context.Context, *RegisterDescriptorRequest) (*RegisterDescriptorResponse, error)
+	mustEmbedUnimplementedRemoteRegistryServiceServer()
+}
+
+// UnimplementedRemoteRegistryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRemoteRegistryServiceServer struct {
+}
+
+func (UnimplementedRemoteRegistryServiceServer) RegisterDescriptor(context.Context, *RegisterDescriptorRequest) (*RegisterDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDescriptor not implemented")
+}
+func (UnimplementedRemoteRegistryServiceServer) mustEmbedUnimplementedRemoteRegistryServiceServer() {}
+
+// UnsafeRemoteRegistryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteRegistryServiceServer will
+// result in compilation errors.
+type UnsafeRemoteRegistryServiceServer interface {
+	mustEmbedUnimplementedRemoteRegistryServiceServer()
+}
+
+func RegisterRemoteRegistryServiceServer(s grpc.ServiceRegistrar, srv RemoteRegistryServiceServer) {
+	s.RegisterService(&RemoteRegistryService_ServiceDesc, srv)
+}
+
+func _RemoteRegistryService_RegisterDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteRegistryServiceServer).RegisterDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteRegistryService/RegisterDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteRegistryServiceServer).RegisterDescriptor(ctx, req.(*RegisterDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteRegistryService_ServiceDesc is the grpc.ServiceDesc for RemoteRegistryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteRegistryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ligato.kvscheduler.RemoteRegistryService",
+	HandlerType: (*RemoteRegistryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDescriptor",
+			Handler:    _RemoteRegistryService_RegisterDescriptor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ligato/kvscheduler/remote_descriptor.proto",
+}
+
+// RemoteDescriptorServiceClient is the client API for RemoteDescriptorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemoteDescriptorServiceClient interface {
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	Dependencies(ctx context.Context, in *DependenciesRequest, opts ...grpc.CallOption) (*DependenciesResponse, error)
+}
+
+type remoteDescriptorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemoteDescriptorServiceClient(cc grpc.ClientConnInterface) RemoteDescriptorServiceClient {
+	return &remoteDescriptorServiceClient{cc}
+}
+
+func (c *remoteDescriptorServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteDescriptorServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
+	out := new(CreateResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteDescriptorServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteDescriptorServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteDescriptorServiceClient) Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error) {
+	out := new(RetrieveResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Retrieve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteDescriptorServiceClient) Dependencies(ctx context.Context, in *DependenciesRequest, opts ...grpc.CallOption) (*DependenciesResponse, error) {
+	out := new(DependenciesResponse)
+	err := c.cc.Invoke(ctx, "/ligato.kvscheduler.RemoteDescriptorService/Dependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteDescriptorServiceServer is the server API for RemoteDescriptorService service.
+// All implementations must embed UnimplementedRemoteDescriptorServiceServer
+// for forward compatibility
+type RemoteDescriptorServiceServer interface {
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	Dependencies(context.Context, *DependenciesRequest) (*DependenciesResponse, error)
+	mustEmbedUnimplementedRemoteDescriptorServiceServer()
+}
+
+// UnimplementedRemoteDescriptorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRemoteDescriptorServiceServer struct {
+}
+
+func (UnimplementedRemoteDescriptorServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retrieve not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) Dependencies(context.Context, *DependenciesRequest) (*DependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dependencies not implemented")
+}
+func (UnimplementedRemoteDescriptorServiceServer) mustEmbedUnimplementedRemoteDescriptorServiceServer() {
+}
+
+// UnsafeRemoteDescriptorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemoteDescriptorServiceServer will
+// result in compilation errors.
+type UnsafeRemoteDescriptorServiceServer interface {
+	mustEmbedUnimplementedRemoteDescriptorServiceServer()
+}
+
+func RegisterRemoteDescriptorServiceServer(s grpc.ServiceRegistrar, srv RemoteDescriptorServiceServer) {
+	s.RegisterService(&RemoteDescriptorService_ServiceDesc, srv)
+}
+
+func _RemoteDescriptorService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteDescriptorService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteDescriptorService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteDescriptorService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteDescriptorService_Retrieve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Retrieve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Retrieve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Retrieve(ctx, req.(*RetrieveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteDescriptorService_Dependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteDescriptorServiceServer).Dependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.kvscheduler.RemoteDescriptorService/Dependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteDescriptorServiceServer).Dependencies(ctx, req.(*DependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemoteDescriptorService_ServiceDesc is the grpc.ServiceDesc for RemoteDescriptorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemoteDescriptorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ligato.kvscheduler.RemoteDescriptorService",
+	HandlerType: (*RemoteDescriptorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validate",
+			Handler:    _RemoteDescriptorService_Validate_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _RemoteDescriptorService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _RemoteDescriptorService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RemoteDescriptorService_Delete_Handler,
+		},
+		{
+			MethodName: "Retrieve",
+			Handler:    _RemoteDescriptorService_Retrieve_Handler,
+		},
+		{
+			MethodName: "Dependencies",
+			Handler:    _RemoteDescriptorService_Dependencies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ligato/kvscheduler/remote_descriptor.proto",
+}
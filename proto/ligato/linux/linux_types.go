@@ -18,6 +18,8 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 )
 
 type (
@@ -30,4 +32,12 @@ type (
 
 	// IP tables
 	IPTablesRuleChain = linux_iptables.RuleChain
+
+	// Traffic control
+	TcQdisc  = linux_tc.Qdisc
+	TcClass  = linux_tc.Class
+	TcFilter = linux_tc.Filter
+
+	// Kernel parameters
+	Sysctl = linux_sysctl.Sysctl
 )
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_sysctl
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.sysctl"
+
+var (
+	ModelSysctl = models.Register(&Sysctl{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "setting",
+	}, models.WithNameTemplate(
+		`{{if .Interface}}interface/{{.Interface}}`+
+			`{{else}}{{with .Namespace}}{{if .Type}}ns/{{.Type}}/{{.Reference}}{{else}}ns/default{{end}}`+
+			`{{else}}ns/default{{end}}{{end}}`+
+			`/{{.Path}}`,
+	))
+)
+
+// SysctlKey returns the key used in KV database to store kernel parameter
+// set in the given namespace.
+func SysctlKey(namespace *linux_namespace.NetNamespace, path string) string {
+	return models.Key(&Sysctl{
+		Namespace: namespace,
+		Path:      path,
+	})
+}
+
+// InterfaceSysctlKey returns the key used in KV database to store per-interface
+// kernel parameter.
+func InterfaceSysctlKey(iface, path string) string {
+	return models.Key(&Sysctl{
+		Interface: iface,
+		Path:      path,
+	})
+}
+
+// SysctlMetadata stores the value of the kernel parameter from before
+// the setting was applied.
+type SysctlMetadata struct {
+	OriginalValue string
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_sysctl
+
+import (
+	"testing"
+
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func TestSysctlKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		namespace   *linux_namespace.NetNamespace
+		path        string
+		expectedKey string
+	}{
+		{
+			name:        "default namespace",
+			path:        "net.ipv4.ip_forward",
+			expectedKey: "config/linux/sysctl/v2/setting/ns/default/net.ipv4.ip_forward",
+		},
+		{
+			name:        "undefined namespace",
+			namespace:   &linux_namespace.NetNamespace{},
+			path:        "net.ipv4.ip_forward",
+			expectedKey: "config/linux/sysctl/v2/setting/ns/default/net.ipv4.ip_forward",
+		},
+		{
+			name: "microservice namespace",
+			namespace: &linux_namespace.NetNamespace{
+				Type:      linux_namespace.NetNamespace_MICROSERVICE,
+				Reference: "microservice1",
+			},
+			path:        "net.ipv6.conf.all.forwarding",
+			expectedKey: "config/linux/sysctl/v2/setting/ns/MICROSERVICE/microservice1/net.ipv6.conf.all.forwarding",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := SysctlKey(test.namespace, test.path)
+			if key != test.expectedKey {
+				t.Errorf("failed for: namespace=%v path=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.namespace, test.path, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestInterfaceSysctlKey(t *testing.T) {
+	key := InterfaceSysctlKey("tap1", "net.ipv4.conf.rp_filter")
+	if expected := "config/linux/sysctl/v2/setting/interface/tap1/net.ipv4.conf.rp_filter"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/linux/sysctl/sysctl.proto
+
+package linux_sysctl
+
+import (
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Sysctl is a kernel parameter set inside a network namespace or for a single
+// Linux interface. The original value of the parameter is restored when the
+// setting is removed.
+type Sysctl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace *namespace.NetNamespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Interface string                  `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"` // logical name of the Linux interface for per-interface parameters
+	Path      string                  `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Value     string                  `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"` // value to set (multiple values are separated with whitespace)
+}
+
+func (x *Sysctl) Reset() {
+	*x = Sysctl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_sysctl_sysctl_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sysctl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sysctl) ProtoMessage() {}
+
+func (x *Sysctl) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_sysctl_sysctl_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sysctl.ProtoReflect.Descriptor instead.
+func (*Sysctl) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_sysctl_sysctl_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Sysctl) GetNamespace() *namespace.NetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *Sysctl) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Sysctl) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Sysctl) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_ligato_linux_sysctl_sysctl_proto protoreflect.FileDescriptor
+
+var file_ligato_linux_sysctl_sysctl_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x73,
+	0x79, 0x73, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2e, 0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x1a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x94, 0x01, 0x0a, 0x06, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x3b, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x5f, 0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ligato_linux_sysctl_sysctl_proto_rawDescOnce sync.Once
+	file_ligato_linux_sysctl_sysctl_proto_rawDescData = file_ligato_linux_sysctl_sysctl_proto_rawDesc
+)
+
+func file_ligato_linux_sysctl_sysctl_proto_rawDescGZIP() []byte {
+	file_ligato_linux_sysctl_sysctl_proto_rawDescOnce.Do(func() {
+		file_ligato_linux_sysctl_sysctl_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_sysctl_sysctl_proto_rawDescData)
+	})
+	return file_ligato_linux_sysctl_sysctl_proto_rawDescData
+}
+
+var file_ligato_linux_sysctl_sysctl_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_linux_sysctl_sysctl_proto_goTypes = []interface{}{
+	(*Sysctl)(nil),                 // 0: ligato.linux.sysctl.Sysctl
+	(*namespace.NetNamespace)(nil), // 1: ligato.linux.namespace.NetNamespace
+}
+var file_ligato_linux_sysctl_sysctl_proto_depIdxs = []int32{
+	1, // 0: ligato.linux.sysctl.Sysctl.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_linux_sysctl_sysctl_proto_init() }
+func file_ligato_linux_sysctl_sysctl_proto_init() {
+	if File_ligato_linux_sysctl_sysctl_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_linux_sysctl_sysctl_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sysctl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_linux_sysctl_sysctl_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_linux_sysctl_sysctl_proto_goTypes,
+		DependencyIndexes: file_ligato_linux_sysctl_sysctl_proto_depIdxs,
+		MessageInfos:      file_ligato_linux_sysctl_sysctl_proto_msgTypes,
+	}.Build()
+	File_ligato_linux_sysctl_sysctl_proto = out.File
+	file_ligato_linux_sysctl_sysctl_proto_rawDesc = nil
+	file_ligato_linux_sysctl_sysctl_proto_goTypes = nil
+	file_ligato_linux_sysctl_sysctl_proto_depIdxs = nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_tc
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.tc"
+
+// IngressHandle is the handle of the ingress qdisc (displayed as "ffff:" by tc).
+const IngressHandle = 0xffff
+
+var (
+	ModelQdisc = models.Register(&Qdisc{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "qdisc",
+	}, models.WithNameTemplate("{{.Interface}}/{{.Handle}}"))
+
+	ModelClass = models.Register(&Class{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "class",
+	}, models.WithNameTemplate("{{.Interface}}/{{.Qdisc}}/{{.ClassId}}"))
+
+	ModelFilter = models.Register(&Filter{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "filter",
+	}, models.WithNameTemplate("{{.Interface}}/{{.Qdisc}}/{{.Priority}}"))
+)
+
+// QdiscKey returns the key used in KV database to store configuration of a qdisc
+// with the given handle attached to the given Linux interface.
+func QdiscKey(iface string, handle uint32) string {
+	return models.Key(&Qdisc{
+		Interface: iface,
+		Handle:    handle,
+	})
+}
+
+// ClassKey returns the key used in KV database to store configuration of a traffic
+// class of the given qdisc.
+func ClassKey(iface string, qdisc, classID uint32) string {
+	return models.Key(&Class{
+		Interface: iface,
+		Qdisc:     qdisc,
+		ClassId:   classID,
+	})
+}
+
+// FilterKey returns the key used in KV database to store configuration of a filter
+// attached to the given qdisc with the given priority.
+func FilterKey(iface string, qdisc, priority uint32) string {
+	return models.Key(&Filter{
+		Interface: iface,
+		Qdisc:     qdisc,
+		Priority:  priority,
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_tc
+
+import (
+	"testing"
+)
+
+func TestQdiscKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		handle      uint32
+		expectedKey string
+	}{
+		{
+			name:        "root qdisc",
+			iface:       "tap1",
+			handle:      1,
+			expectedKey: "config/linux/tc/v2/qdisc/tap1/1",
+		},
+		{
+			name:        "ingress qdisc",
+			iface:       "veth1",
+			handle:      IngressHandle,
+			expectedKey: "config/linux/tc/v2/qdisc/veth1/65535",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := QdiscKey(test.iface, test.handle)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s handle=%d\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.handle, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestClassKey(t *testing.T) {
+	key := ClassKey("tap1", 1, 10)
+	if expected := "config/linux/tc/v2/class/tap1/1/10"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestFilterKey(t *testing.T) {
+	key := FilterKey("tap1", 1, 100)
+	if expected := "config/linux/tc/v2/filter/tap1/1/100"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
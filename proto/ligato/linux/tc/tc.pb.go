@@ -0,0 +1,1493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/linux/tc/tc.proto
+
+package linux_tc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Qdisc_Parent int32
+
+const (
+	Qdisc_ROOT    Qdisc_Parent = 0 // root egress qdisc of the interface
+	Qdisc_INGRESS Qdisc_Parent = 1 // ingress qdisc, only filters can be attached to it
+	Qdisc_CLASS   Qdisc_Parent = 2 // child qdisc attached to a class of another qdisc
+)
+
+// Enum value maps for Qdisc_Parent.
+var (
+	Qdisc_Parent_name = map[int32]string{
+		0: "ROOT",
+		1: "INGRESS",
+		2: "CLASS",
+	}
+	Qdisc_Parent_value = map[string]int32{
+		"ROOT":    0,
+		"INGRESS": 1,
+		"CLASS":   2,
+	}
+)
+
+func (x Qdisc_Parent) Enum() *Qdisc_Parent {
+	p := new(Qdisc_Parent)
+	*p = x
+	return p
+}
+
+func (x Qdisc_Parent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Qdisc_Parent) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_tc_tc_proto_enumTypes[0].Descriptor()
+}
+
+func (Qdisc_Parent) Type() protoreflect.EnumType {
+	return &file_ligato_linux_tc_tc_proto_enumTypes[0]
+}
+
+func (x Qdisc_Parent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Qdisc_Parent.Descriptor instead.
+func (Qdisc_Parent) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Filter_Protocol int32
+
+const (
+	Filter_ALL  Filter_Protocol = 0
+	Filter_IPV4 Filter_Protocol = 1
+	Filter_IPV6 Filter_Protocol = 2
+	Filter_ARP  Filter_Protocol = 3
+	Filter_VLAN Filter_Protocol = 4
+)
+
+// Enum value maps for Filter_Protocol.
+var (
+	Filter_Protocol_name = map[int32]string{
+		0: "ALL",
+		1: "IPV4",
+		2: "IPV6",
+		3: "ARP",
+		4: "VLAN",
+	}
+	Filter_Protocol_value = map[string]int32{
+		"ALL":  0,
+		"IPV4": 1,
+		"IPV6": 2,
+		"ARP":  3,
+		"VLAN": 4,
+	}
+)
+
+func (x Filter_Protocol) Enum() *Filter_Protocol {
+	p := new(Filter_Protocol)
+	*p = x
+	return p
+}
+
+func (x Filter_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Filter_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_tc_tc_proto_enumTypes[1].Descriptor()
+}
+
+func (Filter_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_linux_tc_tc_proto_enumTypes[1]
+}
+
+func (x Filter_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Filter_Protocol.Descriptor instead.
+func (Filter_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Filter_Action int32
+
+const (
+	Filter_NONE Filter_Action = 0 // no action, packets are only classified
+	Filter_DROP Filter_Action = 1 // matching packets are dropped
+	Filter_PASS Filter_Action = 2 // matching packets are accepted (typically used with ingress qdisc)
+)
+
+// Enum value maps for Filter_Action.
+var (
+	Filter_Action_name = map[int32]string{
+		0: "NONE",
+		1: "DROP",
+		2: "PASS",
+	}
+	Filter_Action_value = map[string]int32{
+		"NONE": 0,
+		"DROP": 1,
+		"PASS": 2,
+	}
+)
+
+func (x Filter_Action) Enum() *Filter_Action {
+	p := new(Filter_Action)
+	*p = x
+	return p
+}
+
+func (x Filter_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Filter_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_tc_tc_proto_enumTypes[2].Descriptor()
+}
+
+func (Filter_Action) Type() protoreflect.EnumType {
+	return &file_ligato_linux_tc_tc_proto_enumTypes[2]
+}
+
+func (x Filter_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Filter_Action.Descriptor instead.
+func (Filter_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2, 1}
+}
+
+// Qdisc is a queueing discipline attached to a Linux interface managed by the agent.
+// Handles and class IDs are plain numbers, note that the tc tool prints them
+// in hexadecimal (e.g. handle 16 is displayed as "10:").
+type Qdisc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface   string       `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"` // logical name of the Linux interface the qdisc is attached to (mandatory)
+	Handle      uint32       `protobuf:"varint,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Parent      Qdisc_Parent `protobuf:"varint,3,opt,name=parent,proto3,enum=ligato.linux.tc.Qdisc_Parent" json:"parent,omitempty"`
+	ParentQdisc uint32       `protobuf:"varint,4,opt,name=parent_qdisc,json=parentQdisc,proto3" json:"parent_qdisc,omitempty"` // handle of the qdisc with the parent class (used only with CLASS parent)
+	ParentClass uint32       `protobuf:"varint,5,opt,name=parent_class,json=parentClass,proto3" json:"parent_class,omitempty"` // minor number of the parent class (used only with CLASS parent)
+	// Types that are assignable to QdiscType:
+	//	*Qdisc_Htb
+	//	*Qdisc_FqCodel
+	//	*Qdisc_Tbf
+	//	*Qdisc_Netem
+	QdiscType isQdisc_QdiscType `protobuf_oneof:"qdisc_type"`
+}
+
+func (x *Qdisc) Reset() {
+	*x = Qdisc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Qdisc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qdisc) ProtoMessage() {}
+
+func (x *Qdisc) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qdisc.ProtoReflect.Descriptor instead.
+func (*Qdisc) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Qdisc) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Qdisc) GetHandle() uint32 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+func (x *Qdisc) GetParent() Qdisc_Parent {
+	if x != nil {
+		return x.Parent
+	}
+	return Qdisc_ROOT
+}
+
+func (x *Qdisc) GetParentQdisc() uint32 {
+	if x != nil {
+		return x.ParentQdisc
+	}
+	return 0
+}
+
+func (x *Qdisc) GetParentClass() uint32 {
+	if x != nil {
+		return x.ParentClass
+	}
+	return 0
+}
+
+func (m *Qdisc) GetQdiscType() isQdisc_QdiscType {
+	if m != nil {
+		return m.QdiscType
+	}
+	return nil
+}
+
+func (x *Qdisc) GetHtb() *Qdisc_HtbParams {
+	if x, ok := x.GetQdiscType().(*Qdisc_Htb); ok {
+		return x.Htb
+	}
+	return nil
+}
+
+func (x *Qdisc) GetFqCodel() *Qdisc_FqCodelParams {
+	if x, ok := x.GetQdiscType().(*Qdisc_FqCodel); ok {
+		return x.FqCodel
+	}
+	return nil
+}
+
+func (x *Qdisc) GetTbf() *Qdisc_TbfParams {
+	if x, ok := x.GetQdiscType().(*Qdisc_Tbf); ok {
+		return x.Tbf
+	}
+	return nil
+}
+
+func (x *Qdisc) GetNetem() *Qdisc_NetemParams {
+	if x, ok := x.GetQdiscType().(*Qdisc_Netem); ok {
+		return x.Netem
+	}
+	return nil
+}
+
+type isQdisc_QdiscType interface {
+	isQdisc_QdiscType()
+}
+
+type Qdisc_Htb struct {
+	Htb *Qdisc_HtbParams `protobuf:"bytes,10,opt,name=htb,proto3,oneof"`
+}
+
+type Qdisc_FqCodel struct {
+	FqCodel *Qdisc_FqCodelParams `protobuf:"bytes,11,opt,name=fq_codel,json=fqCodel,proto3,oneof"`
+}
+
+type Qdisc_Tbf struct {
+	Tbf *Qdisc_TbfParams `protobuf:"bytes,12,opt,name=tbf,proto3,oneof"`
+}
+
+type Qdisc_Netem struct {
+	Netem *Qdisc_NetemParams `protobuf:"bytes,13,opt,name=netem,proto3,oneof"`
+}
+
+func (*Qdisc_Htb) isQdisc_QdiscType() {}
+
+func (*Qdisc_FqCodel) isQdisc_QdiscType() {}
+
+func (*Qdisc_Tbf) isQdisc_QdiscType() {}
+
+func (*Qdisc_Netem) isQdisc_QdiscType() {}
+
+// Class is a traffic class of a classful qdisc.
+type Class struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface   string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`             // logical name of the Linux interface (mandatory)
+	Qdisc       uint32 `protobuf:"varint,2,opt,name=qdisc,proto3" json:"qdisc,omitempty"`                    // handle of the classful qdisc the class belongs to (mandatory)
+	ClassId     uint32 `protobuf:"varint,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"` // minor number of the class ID, unique per qdisc (mandatory, non-zero)
+	ParentClass uint32 `protobuf:"varint,4,opt,name=parent_class,json=parentClass,proto3" json:"parent_class,omitempty"`
+	// Types that are assignable to ClassType:
+	//	*Class_Htb
+	ClassType isClass_ClassType `protobuf_oneof:"class_type"`
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Class) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Class) GetQdisc() uint32 {
+	if x != nil {
+		return x.Qdisc
+	}
+	return 0
+}
+
+func (x *Class) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *Class) GetParentClass() uint32 {
+	if x != nil {
+		return x.ParentClass
+	}
+	return 0
+}
+
+func (m *Class) GetClassType() isClass_ClassType {
+	if m != nil {
+		return m.ClassType
+	}
+	return nil
+}
+
+func (x *Class) GetHtb() *Class_HtbParams {
+	if x, ok := x.GetClassType().(*Class_Htb); ok {
+		return x.Htb
+	}
+	return nil
+}
+
+type isClass_ClassType interface {
+	isClass_ClassType()
+}
+
+type Class_Htb struct {
+	Htb *Class_HtbParams `protobuf:"bytes,10,opt,name=htb,proto3,oneof"`
+}
+
+func (*Class_Htb) isClass_ClassType() {}
+
+// Filter classifies packets of a qdisc into its classes or applies an action on them.
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string          `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`                                     // logical name of the Linux interface (mandatory)
+	Qdisc     uint32          `protobuf:"varint,2,opt,name=qdisc,proto3" json:"qdisc,omitempty"`                                            // handle of the qdisc the filter is attached to (mandatory)
+	Priority  uint32          `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`                                      // filter priority, unique per qdisc (mandatory, lower value is evaluated first)
+	Protocol  Filter_Protocol `protobuf:"varint,4,opt,name=protocol,proto3,enum=ligato.linux.tc.Filter_Protocol" json:"protocol,omitempty"` // L3 protocol the filter applies to
+	ClassId   uint32          `protobuf:"varint,5,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Action    Filter_Action   `protobuf:"varint,6,opt,name=action,proto3,enum=ligato.linux.tc.Filter_Action" json:"action,omitempty"`
+	// Types that are assignable to FilterType:
+	//	*Filter_U32
+	//	*Filter_Flower
+	FilterType isFilter_FilterType `protobuf_oneof:"filter_type"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Filter) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Filter) GetQdisc() uint32 {
+	if x != nil {
+		return x.Qdisc
+	}
+	return 0
+}
+
+func (x *Filter) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Filter) GetProtocol() Filter_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Filter_ALL
+}
+
+func (x *Filter) GetClassId() uint32 {
+	if x != nil {
+		return x.ClassId
+	}
+	return 0
+}
+
+func (x *Filter) GetAction() Filter_Action {
+	if x != nil {
+		return x.Action
+	}
+	return Filter_NONE
+}
+
+func (m *Filter) GetFilterType() isFilter_FilterType {
+	if m != nil {
+		return m.FilterType
+	}
+	return nil
+}
+
+func (x *Filter) GetU32() *Filter_U32Match {
+	if x, ok := x.GetFilterType().(*Filter_U32); ok {
+		return x.U32
+	}
+	return nil
+}
+
+func (x *Filter) GetFlower() *Filter_FlowerMatch {
+	if x, ok := x.GetFilterType().(*Filter_Flower); ok {
+		return x.Flower
+	}
+	return nil
+}
+
+type isFilter_FilterType interface {
+	isFilter_FilterType()
+}
+
+type Filter_U32 struct {
+	U32 *Filter_U32Match `protobuf:"bytes,10,opt,name=u32,proto3,oneof"`
+}
+
+type Filter_Flower struct {
+	Flower *Filter_FlowerMatch `protobuf:"bytes,11,opt,name=flower,proto3,oneof"`
+}
+
+func (*Filter_U32) isFilter_FilterType() {}
+
+func (*Filter_Flower) isFilter_FilterType() {}
+
+// HtbParams are parameters of HTB, a classful qdisc shaping traffic into a hierarchy of classes.
+type Qdisc_HtbParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DefaultClass uint32 `protobuf:"varint,1,opt,name=default_class,json=defaultClass,proto3" json:"default_class,omitempty"` // minor number of the class for unclassified traffic
+	Rate2Quantum uint32 `protobuf:"varint,2,opt,name=rate2quantum,proto3" json:"rate2quantum,omitempty"`                     // divisor used to compute class quantum from its rate (default 10)
+}
+
+func (x *Qdisc_HtbParams) Reset() {
+	*x = Qdisc_HtbParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Qdisc_HtbParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qdisc_HtbParams) ProtoMessage() {}
+
+func (x *Qdisc_HtbParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qdisc_HtbParams.ProtoReflect.Descriptor instead.
+func (*Qdisc_HtbParams) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Qdisc_HtbParams) GetDefaultClass() uint32 {
+	if x != nil {
+		return x.DefaultClass
+	}
+	return 0
+}
+
+func (x *Qdisc_HtbParams) GetRate2Quantum() uint32 {
+	if x != nil {
+		return x.Rate2Quantum
+	}
+	return 0
+}
+
+// FqCodelParams are parameters of FQ-CoDel, a classless fair queueing with controlled delay.
+type Qdisc_FqCodelParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit      uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                             // hard limit on the queue size in packets
+	Flows      uint32 `protobuf:"varint,2,opt,name=flows,proto3" json:"flows,omitempty"`                             // number of flows into which the incoming packets are classified
+	IntervalUs uint32 `protobuf:"varint,3,opt,name=interval_us,json=intervalUs,proto3" json:"interval_us,omitempty"` // interval in microseconds used to measure the minimum delay
+	Quantum    uint32 `protobuf:"varint,4,opt,name=quantum,proto3" json:"quantum,omitempty"`                         // number of bytes used as deficit in the fair queuing algorithm
+	Ecn        bool   `protobuf:"varint,5,opt,name=ecn,proto3" json:"ecn,omitempty"`                                 // mark packets with ECN instead of dropping them
+}
+
+func (x *Qdisc_FqCodelParams) Reset() {
+	*x = Qdisc_FqCodelParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Qdisc_FqCodelParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qdisc_FqCodelParams) ProtoMessage() {}
+
+func (x *Qdisc_FqCodelParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qdisc_FqCodelParams.ProtoReflect.Descriptor instead.
+func (*Qdisc_FqCodelParams) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Qdisc_FqCodelParams) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Qdisc_FqCodelParams) GetFlows() uint32 {
+	if x != nil {
+		return x.Flows
+	}
+	return 0
+}
+
+func (x *Qdisc_FqCodelParams) GetIntervalUs() uint32 {
+	if x != nil {
+		return x.IntervalUs
+	}
+	return 0
+}
+
+func (x *Qdisc_FqCodelParams) GetQuantum() uint32 {
+	if x != nil {
+		return x.Quantum
+	}
+	return 0
+}
+
+func (x *Qdisc_FqCodelParams) GetEcn() bool {
+	if x != nil {
+		return x.Ecn
+	}
+	return false
+}
+
+// TbfParams are parameters of TBF, a classless token bucket filter.
+type Qdisc_TbfParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate     uint64 `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`                         // rate in bytes per second (mandatory)
+	Burst    uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`                       // size of the bucket in bytes (mandatory)
+	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // number of bytes that can be queued waiting for tokens (mandatory)
+	PeakRate uint64 `protobuf:"varint,4,opt,name=peak_rate,json=peakRate,proto3" json:"peak_rate,omitempty"` // maximum depletion rate of the bucket in bytes per second
+	MinBurst uint32 `protobuf:"varint,5,opt,name=min_burst,json=minBurst,proto3" json:"min_burst,omitempty"` // size of the peak rate bucket in bytes
+}
+
+func (x *Qdisc_TbfParams) Reset() {
+	*x = Qdisc_TbfParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Qdisc_TbfParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qdisc_TbfParams) ProtoMessage() {}
+
+func (x *Qdisc_TbfParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qdisc_TbfParams.ProtoReflect.Descriptor instead.
+func (*Qdisc_TbfParams) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Qdisc_TbfParams) GetRate() uint64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Qdisc_TbfParams) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *Qdisc_TbfParams) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Qdisc_TbfParams) GetPeakRate() uint64 {
+	if x != nil {
+		return x.PeakRate
+	}
+	return 0
+}
+
+func (x *Qdisc_TbfParams) GetMinBurst() uint32 {
+	if x != nil {
+		return x.MinBurst
+	}
+	return 0
+}
+
+// NetemParams are parameters of netem, a qdisc emulating network properties like delay, loss or reordering.
+type Qdisc_NetemParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LatencyUs uint32  `protobuf:"varint,1,opt,name=latency_us,json=latencyUs,proto3" json:"latency_us,omitempty"` // added delay in microseconds
+	JitterUs  uint32  `protobuf:"varint,2,opt,name=jitter_us,json=jitterUs,proto3" json:"jitter_us,omitempty"`    // delay variation in microseconds
+	Loss      float32 `protobuf:"fixed32,3,opt,name=loss,proto3" json:"loss,omitempty"`                           // packet loss in percents
+	Duplicate float32 `protobuf:"fixed32,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"`                 // packet duplication in percents
+	Reorder   float32 `protobuf:"fixed32,5,opt,name=reorder,proto3" json:"reorder,omitempty"`                     // packet reordering probability in percents
+	Corrupt   float32 `protobuf:"fixed32,6,opt,name=corrupt,proto3" json:"corrupt,omitempty"`                     // packet corruption probability in percents
+	Limit     uint32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                          // maximum number of packets the qdisc may hold queued
+	Gap       uint32  `protobuf:"varint,8,opt,name=gap,proto3" json:"gap,omitempty"`                              // reordering gap in packets
+}
+
+func (x *Qdisc_NetemParams) Reset() {
+	*x = Qdisc_NetemParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Qdisc_NetemParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Qdisc_NetemParams) ProtoMessage() {}
+
+func (x *Qdisc_NetemParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Qdisc_NetemParams.ProtoReflect.Descriptor instead.
+func (*Qdisc_NetemParams) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Qdisc_NetemParams) GetLatencyUs() uint32 {
+	if x != nil {
+		return x.LatencyUs
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetJitterUs() uint32 {
+	if x != nil {
+		return x.JitterUs
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetLoss() float32 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetDuplicate() float32 {
+	if x != nil {
+		return x.Duplicate
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetReorder() float32 {
+	if x != nil {
+		return x.Reorder
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetCorrupt() float32 {
+	if x != nil {
+		return x.Corrupt
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Qdisc_NetemParams) GetGap() uint32 {
+	if x != nil {
+		return x.Gap
+	}
+	return 0
+}
+
+// HtbParams are parameters of an HTB class.
+type Class_HtbParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rate    uint64 `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`       // guaranteed rate in bytes per second (mandatory)
+	Ceil    uint64 `protobuf:"varint,2,opt,name=ceil,proto3" json:"ceil,omitempty"`       // maximum rate in bytes per second (defaults to rate)
+	Burst   uint32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`     // amount of bytes that can be burst at ceil speed
+	Cburst  uint32 `protobuf:"varint,4,opt,name=cburst,proto3" json:"cburst,omitempty"`   // amount of bytes that can be burst at "infinite" speed
+	Prio    uint32 `protobuf:"varint,5,opt,name=prio,proto3" json:"prio,omitempty"`       // priority of the class, lower value means higher priority
+	Quantum uint32 `protobuf:"varint,6,opt,name=quantum,proto3" json:"quantum,omitempty"` // number of bytes served from the class before moving to the next one
+}
+
+func (x *Class_HtbParams) Reset() {
+	*x = Class_HtbParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Class_HtbParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class_HtbParams) ProtoMessage() {}
+
+func (x *Class_HtbParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class_HtbParams.ProtoReflect.Descriptor instead.
+func (*Class_HtbParams) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Class_HtbParams) GetRate() uint64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Class_HtbParams) GetCeil() uint64 {
+	if x != nil {
+		return x.Ceil
+	}
+	return 0
+}
+
+func (x *Class_HtbParams) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *Class_HtbParams) GetCburst() uint32 {
+	if x != nil {
+		return x.Cburst
+	}
+	return 0
+}
+
+func (x *Class_HtbParams) GetPrio() uint32 {
+	if x != nil {
+		return x.Prio
+	}
+	return 0
+}
+
+func (x *Class_HtbParams) GetQuantum() uint32 {
+	if x != nil {
+		return x.Quantum
+	}
+	return 0
+}
+
+// U32Match configures u32 filter matching arbitrary 32-bit words of the packet.
+type Filter_U32Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*Filter_U32Match_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // all keys must match for the filter to apply
+}
+
+func (x *Filter_U32Match) Reset() {
+	*x = Filter_U32Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter_U32Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter_U32Match) ProtoMessage() {}
+
+func (x *Filter_U32Match) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter_U32Match.ProtoReflect.Descriptor instead.
+func (*Filter_U32Match) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Filter_U32Match) GetKeys() []*Filter_U32Match_Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// FlowerMatch configures flower filter matching on packet headers.
+type Filter_FlowerMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcNetwork string `protobuf:"bytes,1,opt,name=src_network,json=srcNetwork,proto3" json:"src_network,omitempty"` // source IP network in CIDR notation
+	DstNetwork string `protobuf:"bytes,2,opt,name=dst_network,json=dstNetwork,proto3" json:"dst_network,omitempty"` // destination IP network in CIDR notation
+}
+
+func (x *Filter_FlowerMatch) Reset() {
+	*x = Filter_FlowerMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter_FlowerMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter_FlowerMatch) ProtoMessage() {}
+
+func (x *Filter_FlowerMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter_FlowerMatch.ProtoReflect.Descriptor instead.
+func (*Filter_FlowerMatch) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Filter_FlowerMatch) GetSrcNetwork() string {
+	if x != nil {
+		return x.SrcNetwork
+	}
+	return ""
+}
+
+func (x *Filter_FlowerMatch) GetDstNetwork() string {
+	if x != nil {
+		return x.DstNetwork
+	}
+	return ""
+}
+
+type Filter_U32Match_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  uint32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`   // value to match (in host byte order)
+	Mask   uint32 `protobuf:"varint,2,opt,name=mask,proto3" json:"mask,omitempty"`     // mask applied before the comparison
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // offset of the matched word from the beginning of the L3 header
+}
+
+func (x *Filter_U32Match_Key) Reset() {
+	*x = Filter_U32Match_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_tc_tc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter_U32Match_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter_U32Match_Key) ProtoMessage() {}
+
+func (x *Filter_U32Match_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_tc_tc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter_U32Match_Key.ProtoReflect.Descriptor instead.
+func (*Filter_U32Match_Key) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_tc_tc_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
+func (x *Filter_U32Match_Key) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Filter_U32Match_Key) GetMask() uint32 {
+	if x != nil {
+		return x.Mask
+	}
+	return 0
+}
+
+func (x *Filter_U32Match_Key) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+var File_ligato_linux_tc_tc_proto protoreflect.FileDescriptor
+
+var file_ligato_linux_tc_tc_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x74,
+	0x63, 0x2f, 0x74, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x22, 0xa2, 0x08, 0x0a, 0x05,
+	0x51, 0x64, 0x69, 0x73, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x51, 0x64,
+	0x69, 0x73, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x64, 0x69,
+	0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x51, 0x64, 0x69, 0x73, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x68, 0x74, 0x62, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x2e, 0x48, 0x74,
+	0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x03, 0x68, 0x74, 0x62, 0x12, 0x41,
+	0x0a, 0x08, 0x66, 0x71, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e,
+	0x74, 0x63, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x2e, 0x46, 0x71, 0x43, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x71, 0x43, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x34, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63,
+	0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x2e, 0x54, 0x62, 0x66, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x48, 0x00, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x3a, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x51, 0x64, 0x69, 0x73, 0x63, 0x2e, 0x4e,
+	0x65, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65,
+	0x74, 0x65, 0x6d, 0x1a, 0x54, 0x0a, 0x09, 0x48, 0x74, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x32, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x61, 0x74,
+	0x65, 0x32, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x1a, 0x88, 0x01, 0x0a, 0x0d, 0x46, 0x71,
+	0x43, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x55, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x65, 0x63, 0x6e, 0x1a, 0x85, 0x01, 0x0a, 0x09, 0x54, 0x62, 0x66, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x61, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x1a, 0xd7, 0x01, 0x0a,
+	0x0b, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x72, 0x65, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x67, 0x61, 0x70, 0x22, 0x2a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x10, 0x02, 0x42, 0x0c, 0x0a, 0x0a, 0x71, 0x64, 0x69, 0x73, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0xcf, 0x02, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x64, 0x69, 0x73,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x64, 0x69, 0x73, 0x63, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x03,
+	0x68, 0x74, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x2e, 0x48, 0x74, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x03, 0x68,
+	0x74, 0x62, 0x1a, 0x8f, 0x01, 0x0a, 0x09, 0x48, 0x74, 0x62, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x63, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x69, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x72, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x75, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0xb2, 0x05, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x64, 0x69, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x64, 0x69, 0x73,
+	0x63, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3c, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74,
+	0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x03, 0x75, 0x33, 0x32, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x33, 0x32, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52,
+	0x03, 0x75, 0x33, 0x32, 0x12, 0x3d, 0x0a, 0x06, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x66, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x55, 0x33, 0x32, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x38, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x74, 0x63,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x33, 0x32, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0x47, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x1a, 0x4f, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x22, 0x3a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56,
+	0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x52, 0x50, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x04,
+	0x22, 0x26, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x50, 0x41, 0x53, 0x53, 0x10, 0x02, 0x42, 0x0d, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x74, 0x63, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x5f, 0x74, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_linux_tc_tc_proto_rawDescOnce sync.Once
+	file_ligato_linux_tc_tc_proto_rawDescData = file_ligato_linux_tc_tc_proto_rawDesc
+)
+
+func file_ligato_linux_tc_tc_proto_rawDescGZIP() []byte {
+	file_ligato_linux_tc_tc_proto_rawDescOnce.Do(func() {
+		file_ligato_linux_tc_tc_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_tc_tc_proto_rawDescData)
+	})
+	return file_ligato_linux_tc_tc_proto_rawDescData
+}
+
+var file_ligato_linux_tc_tc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_linux_tc_tc_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ligato_linux_tc_tc_proto_goTypes = []interface{}{
+	(Qdisc_Parent)(0),           // 0: ligato.linux.tc.Qdisc.Parent
+	(Filter_Protocol)(0),        // 1: ligato.linux.tc.Filter.Protocol
+	(Filter_Action)(0),          // 2: ligato.linux.tc.Filter.Action
+	(*Qdisc)(nil),               // 3: ligato.linux.tc.Qdisc
+	(*Class)(nil),               // 4: ligato.linux.tc.Class
+	(*Filter)(nil),              // 5: ligato.linux.tc.Filter
+	(*Qdisc_HtbParams)(nil),     // 6: ligato.linux.tc.Qdisc.HtbParams
+	(*Qdisc_FqCodelParams)(nil), // 7: ligato.linux.tc.Qdisc.FqCodelParams
+	(*Qdisc_TbfParams)(nil),     // 8: ligato.linux.tc.Qdisc.TbfParams
+	(*Qdisc_NetemParams)(nil),   // 9: ligato.linux.tc.Qdisc.NetemParams
+	(*Class_HtbParams)(nil),     // 10: ligato.linux.tc.Class.HtbParams
+	(*Filter_U32Match)(nil),     // 11: ligato.linux.tc.Filter.U32Match
+	(*Filter_FlowerMatch)(nil),  // 12: ligato.linux.tc.Filter.FlowerMatch
+	(*Filter_U32Match_Key)(nil), // 13: ligato.linux.tc.Filter.U32Match.Key
+}
+var file_ligato_linux_tc_tc_proto_depIdxs = []int32{
+	0,  // 0: ligato.linux.tc.Qdisc.parent:type_name -> ligato.linux.tc.Qdisc.Parent
+	6,  // 1: ligato.linux.tc.Qdisc.htb:type_name -> ligato.linux.tc.Qdisc.HtbParams
+	7,  // 2: ligato.linux.tc.Qdisc.fq_codel:type_name -> ligato.linux.tc.Qdisc.FqCodelParams
+	8,  // 3: ligato.linux.tc.Qdisc.tbf:type_name -> ligato.linux.tc.Qdisc.TbfParams
+	9,  // 4: ligato.linux.tc.Qdisc.netem:type_name -> ligato.linux.tc.Qdisc.NetemParams
+	10, // 5: ligato.linux.tc.Class.htb:type_name -> ligato.linux.tc.Class.HtbParams
+	1,  // 6: ligato.linux.tc.Filter.protocol:type_name -> ligato.linux.tc.Filter.Protocol
+	2,  // 7: ligato.linux.tc.Filter.action:type_name -> ligato.linux.tc.Filter.Action
+	11, // 8: ligato.linux.tc.Filter.u32:type_name -> ligato.linux.tc.Filter.U32Match
+	12, // 9: ligato.linux.tc.Filter.flower:type_name -> ligato.linux.tc.Filter.FlowerMatch
+	13, // 10: ligato.linux.tc.Filter.U32Match.keys:type_name -> ligato.linux.tc.Filter.U32Match.Key
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_ligato_linux_tc_tc_proto_init() }
+func file_ligato_linux_tc_tc_proto_init() {
+	if File_ligato_linux_tc_tc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_linux_tc_tc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qdisc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Class); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qdisc_HtbParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qdisc_FqCodelParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qdisc_TbfParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qdisc_NetemParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Class_HtbParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_U32Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_FlowerMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_tc_tc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter_U32Match_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ligato_linux_tc_tc_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Qdisc_Htb)(nil),
+		(*Qdisc_FqCodel)(nil),
+		(*Qdisc_Tbf)(nil),
+		(*Qdisc_Netem)(nil),
+	}
+	file_ligato_linux_tc_tc_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Class_Htb)(nil),
+	}
+	file_ligato_linux_tc_tc_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*Filter_U32)(nil),
+		(*Filter_Flower)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_linux_tc_tc_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_linux_tc_tc_proto_goTypes,
+		DependencyIndexes: file_ligato_linux_tc_tc_proto_depIdxs,
+		EnumInfos:         file_ligato_linux_tc_tc_proto_enumTypes,
+		MessageInfos:      file_ligato_linux_tc_tc_proto_msgTypes,
+	}.Build()
+	File_ligato_linux_tc_tc_proto = out.File
+	file_ligato_linux_tc_tc_proto_rawDesc = nil
+	file_ligato_linux_tc_tc_proto_goTypes = nil
+	file_ligato_linux_tc_tc_proto_depIdxs = nil
+}
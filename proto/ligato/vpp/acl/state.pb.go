@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/acl/state.proto
+
+package vpp_acl
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ACLStats contains hit counters of rules of an ACL (L3/L4) collected by VPP.
+type ACLStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // name of the ACL
+	Rules []*ACLStats_RuleStats `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ACLStats) Reset() {
+	*x = ACLStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_acl_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACLStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLStats) ProtoMessage() {}
+
+func (x *ACLStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_acl_state_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLStats.ProtoReflect.Descriptor instead.
+func (*ACLStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_acl_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ACLStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ACLStats) GetRules() []*ACLStats_RuleStats {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ACLStats_RuleStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleIndex uint32 `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"` // index of the rule in the ACL rule list
+	Packets   uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`                      // number of packets matched by the rule
+	Bytes     uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`                          // number of bytes matched by the rule
+}
+
+func (x *ACLStats_RuleStats) Reset() {
+	*x = ACLStats_RuleStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_acl_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ACLStats_RuleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLStats_RuleStats) ProtoMessage() {}
+
+func (x *ACLStats_RuleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_acl_state_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLStats_RuleStats.ProtoReflect.Descriptor instead.
+func (*ACLStats_RuleStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_acl_state_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ACLStats_RuleStats) GetRuleIndex() uint32 {
+	if x != nil {
+		return x.RuleIndex
+	}
+	return 0
+}
+
+func (x *ACLStats_RuleStats) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *ACLStats_RuleStats) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+var File_ligato_vpp_acl_state_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_acl_state_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x22, 0xb4, 0x01, 0x0a,
+	0x08, 0x41, 0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43,
+	0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2f, 0x61, 0x63, 0x6c, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x61, 0x63, 0x6c, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_acl_state_proto_rawDescOnce sync.Once
+	file_ligato_vpp_acl_state_proto_rawDescData = file_ligato_vpp_acl_state_proto_rawDesc
+)
+
+func file_ligato_vpp_acl_state_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_acl_state_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_acl_state_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_acl_state_proto_rawDescData)
+	})
+	return file_ligato_vpp_acl_state_proto_rawDescData
+}
+
+var file_ligato_vpp_acl_state_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ligato_vpp_acl_state_proto_goTypes = []interface{}{
+	(*ACLStats)(nil),           // 0: ligato.vpp.acl.ACLStats
+	(*ACLStats_RuleStats)(nil), // 1: ligato.vpp.acl.ACLStats.RuleStats
+}
+var file_ligato_vpp_acl_state_proto_depIdxs = []int32{
+	1, // 0: ligato.vpp.acl.ACLStats.rules:type_name -> ligato.vpp.acl.ACLStats.RuleStats
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_acl_state_proto_init() }
+func file_ligato_vpp_acl_state_proto_init() {
+	if File_ligato_vpp_acl_state_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_acl_state_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_acl_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ACLStats_RuleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_acl_state_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_acl_state_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_acl_state_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_acl_state_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_acl_state_proto = out.File
+	file_ligato_vpp_acl_state_proto_rawDesc = nil
+	file_ligato_vpp_acl_state_proto_goTypes = nil
+	file_ligato_vpp_acl_state_proto_depIdxs = nil
+}
@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/classify/classify.proto
+
+package vpp_classify
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Packet data the mask is applied to.
+type Table_CurrentData int32
+
+const (
+	Table_PACKET_START Table_CurrentData = 0 // start of the packet header (L2 for L2 tables, L3 for IP tables)
+	Table_CURRENT_DATA Table_CurrentData = 1 // current position in the buffer moved by current_data_offset
+)
+
+// Enum value maps for Table_CurrentData.
+var (
+	Table_CurrentData_name = map[int32]string{
+		0: "PACKET_START",
+		1: "CURRENT_DATA",
+	}
+	Table_CurrentData_value = map[string]int32{
+		"PACKET_START": 0,
+		"CURRENT_DATA": 1,
+	}
+)
+
+func (x Table_CurrentData) Enum() *Table_CurrentData {
+	p := new(Table_CurrentData)
+	*p = x
+	return p
+}
+
+func (x Table_CurrentData) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Table_CurrentData) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classify_classify_proto_enumTypes[0].Descriptor()
+}
+
+func (Table_CurrentData) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classify_classify_proto_enumTypes[0]
+}
+
+func (x Table_CurrentData) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Table_CurrentData.Descriptor instead.
+func (Table_CurrentData) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Table_Interface_Type int32
+
+const (
+	Table_Interface_INPUT_ACL  Table_Interface_Type = 0 // input ACL
+	Table_Interface_OUTPUT_ACL Table_Interface_Type = 1 // output ACL
+	Table_Interface_POLICER    Table_Interface_Type = 2 // policer classification
+)
+
+// Enum value maps for Table_Interface_Type.
+var (
+	Table_Interface_Type_name = map[int32]string{
+		0: "INPUT_ACL",
+		1: "OUTPUT_ACL",
+		2: "POLICER",
+	}
+	Table_Interface_Type_value = map[string]int32{
+		"INPUT_ACL":  0,
+		"OUTPUT_ACL": 1,
+		"POLICER":    2,
+	}
+)
+
+func (x Table_Interface_Type) Enum() *Table_Interface_Type {
+	p := new(Table_Interface_Type)
+	*p = x
+	return p
+}
+
+func (x Table_Interface_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Table_Interface_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classify_classify_proto_enumTypes[1].Descriptor()
+}
+
+func (Table_Interface_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classify_classify_proto_enumTypes[1]
+}
+
+func (x Table_Interface_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Table_Interface_Type.Descriptor instead.
+func (Table_Interface_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+type Table_Interface_Protocol int32
+
+const (
+	Table_Interface_IP4 Table_Interface_Protocol = 0
+	Table_Interface_IP6 Table_Interface_Protocol = 1
+	Table_Interface_L2  Table_Interface_Protocol = 2
+)
+
+// Enum value maps for Table_Interface_Protocol.
+var (
+	Table_Interface_Protocol_name = map[int32]string{
+		0: "IP4",
+		1: "IP6",
+		2: "L2",
+	}
+	Table_Interface_Protocol_value = map[string]int32{
+		"IP4": 0,
+		"IP6": 1,
+		"L2":  2,
+	}
+)
+
+func (x Table_Interface_Protocol) Enum() *Table_Interface_Protocol {
+	p := new(Table_Interface_Protocol)
+	*p = x
+	return p
+}
+
+func (x Table_Interface_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Table_Interface_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classify_classify_proto_enumTypes[2].Descriptor()
+}
+
+func (Table_Interface_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classify_classify_proto_enumTypes[2]
+}
+
+func (x Table_Interface_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Table_Interface_Protocol.Descriptor instead.
+func (Table_Interface_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+type Session_Action int32
+
+const (
+	Session_NONE              Session_Action = 0
+	Session_SET_IP4_FIB_INDEX Session_Action = 1 // metadata is IPv4 VRF index
+	Session_SET_IP6_FIB_INDEX Session_Action = 2 // metadata is IPv6 VRF index
+	Session_SET_METADATA      Session_Action = 3 // metadata is stored into the packet
+)
+
+// Enum value maps for Session_Action.
+var (
+	Session_Action_name = map[int32]string{
+		0: "NONE",
+		1: "SET_IP4_FIB_INDEX",
+		2: "SET_IP6_FIB_INDEX",
+		3: "SET_METADATA",
+	}
+	Session_Action_value = map[string]int32{
+		"NONE":              0,
+		"SET_IP4_FIB_INDEX": 1,
+		"SET_IP6_FIB_INDEX": 2,
+		"SET_METADATA":      3,
+	}
+)
+
+func (x Session_Action) Enum() *Session_Action {
+	p := new(Session_Action)
+	*p = x
+	return p
+}
+
+func (x Session_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Session_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classify_classify_proto_enumTypes[3].Descriptor()
+}
+
+func (Session_Action) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classify_classify_proto_enumTypes[3]
+}
+
+func (x Session_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Session_Action.Descriptor instead.
+func (Session_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Table is a VPP classifier table. Packets are matched against sessions of the table
+// after applying the mask. If no session matches, the packet is passed to the next
+// table of the chain or to the miss next node.
+type Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the table.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Mask applied to the packet data (hexadecimal string, e.g. "000000000000ffffffffffff").
+	// The mask is padded with zeros to a multiple of 16 bytes (match vectors).
+	Mask string `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
+	// Number of 16-byte vectors skipped from the start of the packet data
+	// before the mask is applied.
+	SkipNVectors uint32 `protobuf:"varint,3,opt,name=skip_n_vectors,json=skipNVectors,proto3" json:"skip_n_vectors,omitempty"`
+	// Number of buckets of the hash table (VPP default is used if zero).
+	Nbuckets uint32 `protobuf:"varint,4,opt,name=nbuckets,proto3" json:"nbuckets,omitempty"`
+	// Memory size of the table in bytes (VPP default is used if zero).
+	MemorySize uint32 `protobuf:"varint,5,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	// Name of the table consulted if no session of this table matches the packet.
+	NextTable string `protobuf:"bytes,6,opt,name=next_table,json=nextTable,proto3" json:"next_table,omitempty"`
+	// Index of the next node for packets not matched by any session of the chain.
+	// For ACL tables 0 denies the packet and 4294967295 (~0) permits it.
+	MissNextIndex uint32            `protobuf:"varint,7,opt,name=miss_next_index,json=missNextIndex,proto3" json:"miss_next_index,omitempty"`
+	CurrentData   Table_CurrentData `protobuf:"varint,8,opt,name=current_data,json=currentData,proto3,enum=ligato.vpp.classify.Table_CurrentData" json:"current_data,omitempty"`
+	// Offset to the current data (used with CURRENT_DATA).
+	CurrentDataOffset int32 `protobuf:"varint,9,opt,name=current_data_offset,json=currentDataOffset,proto3" json:"current_data_offset,omitempty"`
+	// Interfaces with the table attached.
+	// Only one table can be attached to an interface for each type and protocol.
+	Interfaces []*Table_Interface `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classify_classify_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classify_classify_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Table) GetMask() string {
+	if x != nil {
+		return x.Mask
+	}
+	return ""
+}
+
+func (x *Table) GetSkipNVectors() uint32 {
+	if x != nil {
+		return x.SkipNVectors
+	}
+	return 0
+}
+
+func (x *Table) GetNbuckets() uint32 {
+	if x != nil {
+		return x.Nbuckets
+	}
+	return 0
+}
+
+func (x *Table) GetMemorySize() uint32 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *Table) GetNextTable() string {
+	if x != nil {
+		return x.NextTable
+	}
+	return ""
+}
+
+func (x *Table) GetMissNextIndex() uint32 {
+	if x != nil {
+		return x.MissNextIndex
+	}
+	return 0
+}
+
+func (x *Table) GetCurrentData() Table_CurrentData {
+	if x != nil {
+		return x.CurrentData
+	}
+	return Table_PACKET_START
+}
+
+func (x *Table) GetCurrentDataOffset() int32 {
+	if x != nil {
+		return x.CurrentDataOffset
+	}
+	return 0
+}
+
+func (x *Table) GetInterfaces() []*Table_Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// Session is an entry of the classifier table.
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the table the session belongs to.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// Match value (hexadecimal string) including the skipped vectors of the table.
+	// Only the bytes covered by the table mask are significant.
+	Match string `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	// Index of the next node for matching packets.
+	// For ACL tables 0 denies the packet and 4294967295 (~0) permits it.
+	HitNextIndex uint32 `protobuf:"varint,3,opt,name=hit_next_index,json=hitNextIndex,proto3" json:"hit_next_index,omitempty"`
+	// Opaque value stored into packet metadata of matching packets.
+	OpaqueIndex uint32 `protobuf:"varint,4,opt,name=opaque_index,json=opaqueIndex,proto3" json:"opaque_index,omitempty"`
+	// Number of bytes the current data of matching packets is advanced by.
+	Advance  int32          `protobuf:"varint,5,opt,name=advance,proto3" json:"advance,omitempty"`
+	Action   Session_Action `protobuf:"varint,6,opt,name=action,proto3,enum=ligato.vpp.classify.Session_Action" json:"action,omitempty"`
+	Metadata uint32         `protobuf:"varint,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classify_classify_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classify_classify_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Session) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *Session) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *Session) GetHitNextIndex() uint32 {
+	if x != nil {
+		return x.HitNextIndex
+	}
+	return 0
+}
+
+func (x *Session) GetOpaqueIndex() uint32 {
+	if x != nil {
+		return x.OpaqueIndex
+	}
+	return 0
+}
+
+func (x *Session) GetAdvance() int32 {
+	if x != nil {
+		return x.Advance
+	}
+	return 0
+}
+
+func (x *Session) GetAction() Session_Action {
+	if x != nil {
+		return x.Action
+	}
+	return Session_NONE
+}
+
+func (x *Session) GetMetadata() uint32 {
+	if x != nil {
+		return x.Metadata
+	}
+	return 0
+}
+
+// Interface attaches the table to an interface.
+type Table_Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name (logical).
+	Name     string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     Table_Interface_Type     `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.vpp.classify.Table_Interface_Type" json:"type,omitempty"`
+	Protocol Table_Interface_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=ligato.vpp.classify.Table_Interface_Protocol" json:"protocol,omitempty"`
+}
+
+func (x *Table_Interface) Reset() {
+	*x = Table_Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classify_classify_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Table_Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table_Interface) ProtoMessage() {}
+
+func (x *Table_Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classify_classify_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table_Interface.ProtoReflect.Descriptor instead.
+func (*Table_Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classify_classify_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Table_Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Table_Interface) GetType() Table_Interface_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Table_Interface_INPUT_ACL
+}
+
+func (x *Table_Interface) GetProtocol() Table_Interface_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Table_Interface_IP4
+}
+
+var File_ligato_vpp_classify_classify_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_classify_classify_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x79, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x22, 0xd3, 0x05, 0x0a, 0x05, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x6e, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x49, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x79, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x44, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x83, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x22, 0x32, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e,
+	0x50, 0x55, 0x54, 0x5f, 0x41, 0x43, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x55, 0x54,
+	0x50, 0x55, 0x54, 0x5f, 0x41, 0x43, 0x4c, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x45, 0x52, 0x10, 0x02, 0x22, 0x24, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49,
+	0x50, 0x36, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x02, 0x22, 0x31, 0x0a, 0x0b,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x22,
+	0xc5, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x69, 0x74, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x68, 0x69, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x54, 0x5f, 0x49,
+	0x50, 0x34, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x45, 0x54, 0x5f, 0x49, 0x50, 0x36, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x49, 0x4e,
+	0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x54, 0x5f, 0x4d, 0x45, 0x54,
+	0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x03, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x3b, 0x76,
+	0x70, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_classify_classify_proto_rawDescOnce sync.Once
+	file_ligato_vpp_classify_classify_proto_rawDescData = file_ligato_vpp_classify_classify_proto_rawDesc
+)
+
+func file_ligato_vpp_classify_classify_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_classify_classify_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_classify_classify_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_classify_classify_proto_rawDescData)
+	})
+	return file_ligato_vpp_classify_classify_proto_rawDescData
+}
+
+var file_ligato_vpp_classify_classify_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ligato_vpp_classify_classify_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_classify_classify_proto_goTypes = []interface{}{
+	(Table_CurrentData)(0),        // 0: ligato.vpp.classify.Table.CurrentData
+	(Table_Interface_Type)(0),     // 1: ligato.vpp.classify.Table.Interface.Type
+	(Table_Interface_Protocol)(0), // 2: ligato.vpp.classify.Table.Interface.Protocol
+	(Session_Action)(0),           // 3: ligato.vpp.classify.Session.Action
+	(*Table)(nil),                 // 4: ligato.vpp.classify.Table
+	(*Session)(nil),               // 5: ligato.vpp.classify.Session
+	(*Table_Interface)(nil),       // 6: ligato.vpp.classify.Table.Interface
+}
+var file_ligato_vpp_classify_classify_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.classify.Table.current_data:type_name -> ligato.vpp.classify.Table.CurrentData
+	6, // 1: ligato.vpp.classify.Table.interfaces:type_name -> ligato.vpp.classify.Table.Interface
+	3, // 2: ligato.vpp.classify.Session.action:type_name -> ligato.vpp.classify.Session.Action
+	1, // 3: ligato.vpp.classify.Table.Interface.type:type_name -> ligato.vpp.classify.Table.Interface.Type
+	2, // 4: ligato.vpp.classify.Table.Interface.protocol:type_name -> ligato.vpp.classify.Table.Interface.Protocol
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_classify_classify_proto_init() }
+func file_ligato_vpp_classify_classify_proto_init() {
+	if File_ligato_vpp_classify_classify_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_classify_classify_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_classify_classify_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_classify_classify_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Table_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_classify_classify_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_classify_classify_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_classify_classify_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_classify_classify_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_classify_classify_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_classify_classify_proto = out.File
+	file_ligato_vpp_classify_classify_proto_rawDesc = nil
+	file_ligato_vpp_classify_classify_proto_goTypes = nil
+	file_ligato_vpp_classify_classify_proto_depIdxs = nil
+}
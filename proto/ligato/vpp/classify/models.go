@@ -0,0 +1,108 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_classify
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.classify"
+
+var (
+	ModelTable = models.Register(&Table{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "table",
+		Version: "v1",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelSession = models.Register(&Session{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "session",
+		Version: "v1",
+	}, models.WithNameTemplate("{{.Table}}/match/{{.Match}}"))
+)
+
+// TableKey returns the key used in NB DB to store the configuration of the
+// given classifier table.
+func TableKey(name string) string {
+	return models.Key(&Table{
+		Name: name,
+	})
+}
+
+// SessionKey returns the key used in NB DB to store the configuration of the
+// given classifier session.
+func SessionKey(table, match string) string {
+	return models.Key(&Session{
+		Table: table,
+		Match: match,
+	})
+}
+
+const (
+	// tableInterfaceTemplate is a template for key representing table attached
+	// to an interface (derived value).
+	tableInterfaceTemplate = "vpp/classify/table/{table}/{type}/{protocol}/interface/{iface}"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// TableInterfaceKey returns key representing the table attached to the interface.
+func TableInterfaceKey(table string, iface *Table_Interface) string {
+	if table == "" {
+		table = InvalidKeyPart
+	}
+	ifName := iface.GetName()
+	if ifName == "" {
+		ifName = InvalidKeyPart
+	}
+	key := tableInterfaceTemplate
+	key = strings.Replace(key, "{table}", table, 1)
+	key = strings.Replace(key, "{type}", strings.ToLower(iface.GetType().String()), 1)
+	key = strings.Replace(key, "{protocol}", strings.ToLower(iface.GetProtocol().String()), 1)
+	key = strings.Replace(key, "{iface}", ifName, 1)
+	return key
+}
+
+// ParseTableInterfaceKey parses key representing the table attached to the interface.
+func ParseTableInterfaceKey(key string) (table string, iface *Table_Interface, isTableInterfaceKey bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 7 || parts[0] != "vpp" || parts[1] != "classify" || parts[2] != "table" ||
+		parts[6] != "interface" {
+		return "", nil, false
+	}
+	ifType, ok := Table_Interface_Type_value[strings.ToUpper(parts[4])]
+	if !ok {
+		return "", nil, false
+	}
+	protocol, ok := Table_Interface_Protocol_value[strings.ToUpper(parts[5])]
+	if !ok {
+		return "", nil, false
+	}
+	table = parts[3]
+	ifName := strings.Join(parts[7:], "/")
+	if table == "" || ifName == "" {
+		return "", nil, false
+	}
+	return table, &Table_Interface{
+		Name:     ifName,
+		Type:     Table_Interface_Type(ifType),
+		Protocol: Table_Interface_Protocol(protocol),
+	}, true
+}
@@ -0,0 +1,139 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_classify_test
+
+import (
+	"testing"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+)
+
+func TestTableKey(t *testing.T) {
+	key := vpp_classify.TableKey("acl-table")
+	if expected := "config/vpp/classify/v1/table/acl-table"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestSessionKey(t *testing.T) {
+	key := vpp_classify.SessionKey("acl-table", "0a0000010a000002")
+	if expected := "config/vpp/classify/v1/session/acl-table/match/0a0000010a000002"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestTableInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		table       string
+		iface       *vpp_classify.Table_Interface
+		expectedKey string
+	}{
+		{
+			name:  "input IPv4",
+			table: "t1",
+			iface: &vpp_classify.Table_Interface{
+				Name: "tap0",
+			},
+			expectedKey: "vpp/classify/table/t1/input_acl/ip4/interface/tap0",
+		},
+		{
+			name:  "policer L2 with slash in interface name",
+			table: "t2",
+			iface: &vpp_classify.Table_Interface{
+				Name:     "memif0/1",
+				Type:     vpp_classify.Table_Interface_POLICER,
+				Protocol: vpp_classify.Table_Interface_L2,
+			},
+			expectedKey: "vpp/classify/table/t2/policer/l2/interface/memif0/1",
+		},
+		{
+			name:        "empty interface",
+			table:       "t3",
+			iface:       &vpp_classify.Table_Interface{Type: vpp_classify.Table_Interface_OUTPUT_ACL},
+			expectedKey: "vpp/classify/table/t3/output_acl/ip4/interface/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_classify.TableInterfaceKey(test.table, test.iface)
+			if key != test.expectedKey {
+				t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseTableInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		expectedTable string
+		expectedIface *vpp_classify.Table_Interface
+		expectedIsKey bool
+	}{
+		{
+			name:          "output IPv6",
+			key:           "vpp/classify/table/t1/output_acl/ip6/interface/tap0",
+			expectedTable: "t1",
+			expectedIface: &vpp_classify.Table_Interface{
+				Name:     "tap0",
+				Type:     vpp_classify.Table_Interface_OUTPUT_ACL,
+				Protocol: vpp_classify.Table_Interface_IP6,
+			},
+			expectedIsKey: true,
+		},
+		{
+			name:          "interface name with slash",
+			key:           "vpp/classify/table/t2/input_acl/l2/interface/memif0/1",
+			expectedTable: "t2",
+			expectedIface: &vpp_classify.Table_Interface{
+				Name:     "memif0/1",
+				Protocol: vpp_classify.Table_Interface_L2,
+			},
+			expectedIsKey: true,
+		},
+		{
+			name: "invalid type",
+			key:  "vpp/classify/table/t1/unknown/ip4/interface/tap0",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/classify/table/t1/input_acl/ip4/interface/",
+		},
+		{
+			name: "table key",
+			key:  "config/vpp/classify/v1/table/t1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table, iface, isKey := vpp_classify.ParseTableInterfaceKey(test.key)
+			if isKey != test.expectedIsKey {
+				t.Fatalf("expected isKey: %v\tgot: %v", test.expectedIsKey, isKey)
+			}
+			if !isKey {
+				return
+			}
+			if table != test.expectedTable {
+				t.Errorf("expected table: %s\tgot: %s", test.expectedTable, table)
+			}
+			if iface.Name != test.expectedIface.Name || iface.Type != test.expectedIface.Type ||
+				iface.Protocol != test.expectedIface.Protocol {
+				t.Errorf("expected interface: %v\tgot: %v", test.expectedIface, iface)
+			}
+		})
+	}
+}
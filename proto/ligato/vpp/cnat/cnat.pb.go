@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/cnat/cnat.proto
+
+package vpp_cnat
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Translation_Protocol int32
+
+const (
+	Translation_TCP Translation_Protocol = 0
+	Translation_UDP Translation_Protocol = 1
+)
+
+// Enum value maps for Translation_Protocol.
+var (
+	Translation_Protocol_name = map[int32]string{
+		0: "TCP",
+		1: "UDP",
+	}
+	Translation_Protocol_value = map[string]int32{
+		"TCP": 0,
+		"UDP": 1,
+	}
+)
+
+func (x Translation_Protocol) Enum() *Translation_Protocol {
+	p := new(Translation_Protocol)
+	*p = x
+	return p
+}
+
+func (x Translation_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Translation_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_cnat_cnat_proto_enumTypes[0].Descriptor()
+}
+
+func (Translation_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_cnat_cnat_proto_enumTypes[0]
+}
+
+func (x Translation_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Translation_Protocol.Descriptor instead.
+func (Translation_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Translation_LbType int32
+
+const (
+	Translation_DEFAULT Translation_LbType = 0
+	Translation_MAGLEV  Translation_LbType = 1
+)
+
+// Enum value maps for Translation_LbType.
+var (
+	Translation_LbType_name = map[int32]string{
+		0: "DEFAULT",
+		1: "MAGLEV",
+	}
+	Translation_LbType_value = map[string]int32{
+		"DEFAULT": 0,
+		"MAGLEV":  1,
+	}
+)
+
+func (x Translation_LbType) Enum() *Translation_LbType {
+	p := new(Translation_LbType)
+	*p = x
+	return p
+}
+
+func (x Translation_LbType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Translation_LbType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_cnat_cnat_proto_enumTypes[1].Descriptor()
+}
+
+func (Translation_LbType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_cnat_cnat_proto_enumTypes[1]
+}
+
+func (x Translation_LbType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Translation_LbType.Descriptor instead.
+func (Translation_LbType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type SnatPolicy_Policy int32
+
+const (
+	SnatPolicy_NONE   SnatPolicy_Policy = 0 // source-NAT every packet
+	SnatPolicy_IF_PFX SnatPolicy_Policy = 1 // source-NAT packets not destined to prefixes of included interfaces
+	SnatPolicy_K8S    SnatPolicy_Policy = 2 // source-NAT packets leaving pod interfaces
+)
+
+// Enum value maps for SnatPolicy_Policy.
+var (
+	SnatPolicy_Policy_name = map[int32]string{
+		0: "NONE",
+		1: "IF_PFX",
+		2: "K8S",
+	}
+	SnatPolicy_Policy_value = map[string]int32{
+		"NONE":   0,
+		"IF_PFX": 1,
+		"K8S":    2,
+	}
+)
+
+func (x SnatPolicy_Policy) Enum() *SnatPolicy_Policy {
+	p := new(SnatPolicy_Policy)
+	*p = x
+	return p
+}
+
+func (x SnatPolicy_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnatPolicy_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_cnat_cnat_proto_enumTypes[2].Descriptor()
+}
+
+func (SnatPolicy_Policy) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_cnat_cnat_proto_enumTypes[2]
+}
+
+func (x SnatPolicy_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnatPolicy_Policy.Descriptor instead.
+func (SnatPolicy_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type SnatPolicyInterface_Table int32
+
+const (
+	SnatPolicyInterface_INCLUDE_V4 SnatPolicyInterface_Table = 0 // IPv4 prefixes of the interface are not source-NATed (IF_PFX policy)
+	SnatPolicyInterface_INCLUDE_V6 SnatPolicyInterface_Table = 1 // IPv6 prefixes of the interface are not source-NATed (IF_PFX policy)
+	SnatPolicyInterface_POD        SnatPolicyInterface_Table = 2 // interface is connected to a pod (K8S policy)
+)
+
+// Enum value maps for SnatPolicyInterface_Table.
+var (
+	SnatPolicyInterface_Table_name = map[int32]string{
+		0: "INCLUDE_V4",
+		1: "INCLUDE_V6",
+		2: "POD",
+	}
+	SnatPolicyInterface_Table_value = map[string]int32{
+		"INCLUDE_V4": 0,
+		"INCLUDE_V6": 1,
+		"POD":        2,
+	}
+)
+
+func (x SnatPolicyInterface_Table) Enum() *SnatPolicyInterface_Table {
+	p := new(SnatPolicyInterface_Table)
+	*p = x
+	return p
+}
+
+func (x SnatPolicyInterface_Table) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnatPolicyInterface_Table) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_cnat_cnat_proto_enumTypes[3].Descriptor()
+}
+
+func (SnatPolicyInterface_Table) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_cnat_cnat_proto_enumTypes[3]
+}
+
+func (x SnatPolicyInterface_Table) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnatPolicyInterface_Table.Descriptor instead.
+func (SnatPolicyInterface_Table) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// Endpoint is an address and port used by CNAT translations.
+// The address can be given either explicitly or as an interface whose address is used.
+type Endpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP address of the endpoint (ignored when interface is set).
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Interface (logical name) with the address used as the endpoint address.
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Use IPv6 address of the interface (IPv4 is used by default).
+	InterfaceIpv6 bool `protobuf:"varint,3,opt,name=interface_ipv6,json=interfaceIpv6,proto3" json:"interface_ipv6,omitempty"`
+	// Port of the endpoint (0 matches any port).
+	Port uint32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Endpoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Endpoint) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Endpoint) GetInterfaceIpv6() bool {
+	if x != nil {
+		return x.InterfaceIpv6
+	}
+	return false
+}
+
+func (x *Endpoint) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Translation defines CNAT translation of a virtual IP (VIP) into a set of backends.
+// VPP identifies a translation by VIP and protocol, therefore only one translation
+// can be defined for a given VIP and protocol.
+type Translation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the translation.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Virtual IP and port (service address).
+	Vip      *Endpoint            `protobuf:"bytes,2,opt,name=vip,proto3" json:"vip,omitempty"`
+	Protocol Translation_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=ligato.vpp.cnat.Translation_Protocol" json:"protocol,omitempty"`
+	// Translate source of the packets into the real IP of the client.
+	IsRealIp bool `protobuf:"varint,4,opt,name=is_real_ip,json=isRealIp,proto3" json:"is_real_ip,omitempty"`
+	// Allocate source port for the translated sessions.
+	AllocPort bool `protobuf:"varint,5,opt,name=alloc_port,json=allocPort,proto3" json:"alloc_port,omitempty"`
+	// Load-balancing method used to select the backend.
+	LbType Translation_LbType  `protobuf:"varint,6,opt,name=lb_type,json=lbType,proto3,enum=ligato.vpp.cnat.Translation_LbType" json:"lb_type,omitempty"`
+	Paths  []*Translation_Path `protobuf:"bytes,7,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation) ProtoMessage() {}
+
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Translation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Translation) GetVip() *Endpoint {
+	if x != nil {
+		return x.Vip
+	}
+	return nil
+}
+
+func (x *Translation) GetProtocol() Translation_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Translation_TCP
+}
+
+func (x *Translation) GetIsRealIp() bool {
+	if x != nil {
+		return x.IsRealIp
+	}
+	return false
+}
+
+func (x *Translation) GetAllocPort() bool {
+	if x != nil {
+		return x.AllocPort
+	}
+	return false
+}
+
+func (x *Translation) GetLbType() Translation_LbType {
+	if x != nil {
+		return x.LbType
+	}
+	return Translation_DEFAULT
+}
+
+func (x *Translation) GetPaths() []*Translation_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// SnatPolicy defines global source-NAT configuration of CNAT.
+type SnatPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy SnatPolicy_Policy `protobuf:"varint,1,opt,name=policy,proto3,enum=ligato.vpp.cnat.SnatPolicy_Policy" json:"policy,omitempty"`
+	// IPv4 address used to source-NAT packets.
+	SnatIpv4 string `protobuf:"bytes,2,opt,name=snat_ipv4,json=snatIpv4,proto3" json:"snat_ipv4,omitempty"`
+	// IPv6 address used to source-NAT packets.
+	SnatIpv6 string `protobuf:"bytes,3,opt,name=snat_ipv6,json=snatIpv6,proto3" json:"snat_ipv6,omitempty"`
+	// Interface (logical name) whose addresses are used to source-NAT packets
+	// (overrides snat_ipv4/snat_ipv6).
+	SnatInterface string `protobuf:"bytes,4,opt,name=snat_interface,json=snatInterface,proto3" json:"snat_interface,omitempty"`
+	// Destination prefixes excluded from source-NAT.
+	ExcludePrefixes []string `protobuf:"bytes,5,rep,name=exclude_prefixes,json=excludePrefixes,proto3" json:"exclude_prefixes,omitempty"`
+}
+
+func (x *SnatPolicy) Reset() {
+	*x = SnatPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnatPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnatPolicy) ProtoMessage() {}
+
+func (x *SnatPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnatPolicy.ProtoReflect.Descriptor instead.
+func (*SnatPolicy) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnatPolicy) GetPolicy() SnatPolicy_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return SnatPolicy_NONE
+}
+
+func (x *SnatPolicy) GetSnatIpv4() string {
+	if x != nil {
+		return x.SnatIpv4
+	}
+	return ""
+}
+
+func (x *SnatPolicy) GetSnatIpv6() string {
+	if x != nil {
+		return x.SnatIpv6
+	}
+	return ""
+}
+
+func (x *SnatPolicy) GetSnatInterface() string {
+	if x != nil {
+		return x.SnatInterface
+	}
+	return ""
+}
+
+func (x *SnatPolicy) GetExcludePrefixes() []string {
+	if x != nil {
+		return x.ExcludePrefixes
+	}
+	return nil
+}
+
+// SnatPolicyInterface adds interface into a table used by the source-NAT policy.
+type SnatPolicyInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name (logical).
+	Interface string                    `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Table     SnatPolicyInterface_Table `protobuf:"varint,2,opt,name=table,proto3,enum=ligato.vpp.cnat.SnatPolicyInterface_Table" json:"table,omitempty"`
+}
+
+func (x *SnatPolicyInterface) Reset() {
+	*x = SnatPolicyInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnatPolicyInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnatPolicyInterface) ProtoMessage() {}
+
+func (x *SnatPolicyInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnatPolicyInterface.ProtoReflect.Descriptor instead.
+func (*SnatPolicyInterface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SnatPolicyInterface) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *SnatPolicyInterface) GetTable() SnatPolicyInterface_Table {
+	if x != nil {
+		return x.Table
+	}
+	return SnatPolicyInterface_INCLUDE_V4
+}
+
+// Path is a backend of the translation.
+type Translation_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Destination endpoint (backend address).
+	Dst *Endpoint `protobuf:"bytes,1,opt,name=dst,proto3" json:"dst,omitempty"`
+	// Source endpoint (source address of the translated packets, unset means unchanged).
+	Src *Endpoint `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	// Do not translate packets towards this backend.
+	NoNat bool `protobuf:"varint,3,opt,name=no_nat,json=noNat,proto3" json:"no_nat,omitempty"`
+}
+
+func (x *Translation_Path) Reset() {
+	*x = Translation_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Translation_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation_Path) ProtoMessage() {}
+
+func (x *Translation_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_cnat_cnat_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation_Path.ProtoReflect.Descriptor instead.
+func (*Translation_Path) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_cnat_cnat_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Translation_Path) GetDst() *Endpoint {
+	if x != nil {
+		return x.Dst
+	}
+	return nil
+}
+
+func (x *Translation_Path) GetSrc() *Endpoint {
+	if x != nil {
+		return x.Src
+	}
+	return nil
+}
+
+func (x *Translation_Path) GetNoNat() bool {
+	if x != nil {
+		return x.NoNat
+	}
+	return false
+}
+
+var File_ligato_vpp_cnat_cnat_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_cnat_cnat_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6e, 0x61,
+	0x74, 0x2f, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x1a, 0x18, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x70, 0x76, 0x36, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10,
+	0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xff, 0x03, 0x0a, 0x0b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x03, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1c, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x6c, 0x62,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x6c, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x1a, 0x77, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x03, 0x64, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x03,
+	0x73, 0x72, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x5f, 0x6e, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x4e, 0x61, 0x74, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x01, 0x22, 0x21, 0x0a, 0x06, 0x4c, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x47, 0x4c, 0x45, 0x56, 0x10, 0x01, 0x22, 0x92, 0x02, 0x0a, 0x0a,
+	0x53, 0x6e, 0x61, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x53, 0x6e, 0x61,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x74, 0x5f, 0x69,
+	0x70, 0x76, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x02,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x74, 0x49, 0x70, 0x76, 0x34, 0x12, 0x22, 0x0a, 0x09, 0x73, 0x6e,
+	0x61, 0x74, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x03, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x74, 0x49, 0x70, 0x76, 0x36, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x49,
+	0x46, 0x5f, 0x50, 0x46, 0x58, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x38, 0x53, 0x10, 0x02,
+	0x22, 0xa7, 0x01, 0x0a, 0x13, 0x53, 0x6e, 0x61, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x53, 0x6e, 0x61, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x56, 0x34, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x56, 0x36, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x02, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70,
+	0x70, 0x5f, 0x63, 0x6e, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_cnat_cnat_proto_rawDescOnce sync.Once
+	file_ligato_vpp_cnat_cnat_proto_rawDescData = file_ligato_vpp_cnat_cnat_proto_rawDesc
+)
+
+func file_ligato_vpp_cnat_cnat_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_cnat_cnat_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_cnat_cnat_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_cnat_cnat_proto_rawDescData)
+	})
+	return file_ligato_vpp_cnat_cnat_proto_rawDescData
+}
+
+var file_ligato_vpp_cnat_cnat_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ligato_vpp_cnat_cnat_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ligato_vpp_cnat_cnat_proto_goTypes = []interface{}{
+	(Translation_Protocol)(0),      // 0: ligato.vpp.cnat.Translation.Protocol
+	(Translation_LbType)(0),        // 1: ligato.vpp.cnat.Translation.LbType
+	(SnatPolicy_Policy)(0),         // 2: ligato.vpp.cnat.SnatPolicy.Policy
+	(SnatPolicyInterface_Table)(0), // 3: ligato.vpp.cnat.SnatPolicyInterface.Table
+	(*Endpoint)(nil),               // 4: ligato.vpp.cnat.Endpoint
+	(*Translation)(nil),            // 5: ligato.vpp.cnat.Translation
+	(*SnatPolicy)(nil),             // 6: ligato.vpp.cnat.SnatPolicy
+	(*SnatPolicyInterface)(nil),    // 7: ligato.vpp.cnat.SnatPolicyInterface
+	(*Translation_Path)(nil),       // 8: ligato.vpp.cnat.Translation.Path
+}
+var file_ligato_vpp_cnat_cnat_proto_depIdxs = []int32{
+	4, // 0: ligato.vpp.cnat.Translation.vip:type_name -> ligato.vpp.cnat.Endpoint
+	0, // 1: ligato.vpp.cnat.Translation.protocol:type_name -> ligato.vpp.cnat.Translation.Protocol
+	1, // 2: ligato.vpp.cnat.Translation.lb_type:type_name -> ligato.vpp.cnat.Translation.LbType
+	8, // 3: ligato.vpp.cnat.Translation.paths:type_name -> ligato.vpp.cnat.Translation.Path
+	2, // 4: ligato.vpp.cnat.SnatPolicy.policy:type_name -> ligato.vpp.cnat.SnatPolicy.Policy
+	3, // 5: ligato.vpp.cnat.SnatPolicyInterface.table:type_name -> ligato.vpp.cnat.SnatPolicyInterface.Table
+	4, // 6: ligato.vpp.cnat.Translation.Path.dst:type_name -> ligato.vpp.cnat.Endpoint
+	4, // 7: ligato.vpp.cnat.Translation.Path.src:type_name -> ligato.vpp.cnat.Endpoint
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_cnat_cnat_proto_init() }
+func file_ligato_vpp_cnat_cnat_proto_init() {
+	if File_ligato_vpp_cnat_cnat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_cnat_cnat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Endpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_cnat_cnat_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Translation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_cnat_cnat_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnatPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_cnat_cnat_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnatPolicyInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_cnat_cnat_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Translation_Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_cnat_cnat_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_cnat_cnat_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_cnat_cnat_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_cnat_cnat_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_cnat_cnat_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_cnat_cnat_proto = out.File
+	file_ligato_vpp_cnat_cnat_proto_rawDesc = nil
+	file_ligato_vpp_cnat_cnat_proto_goTypes = nil
+	file_ligato_vpp_cnat_cnat_proto_depIdxs = nil
+}
@@ -0,0 +1,55 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_cnat
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.cnat"
+
+var (
+	ModelTranslation = models.Register(&Translation{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "translation",
+		Version: "v1",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelSnatPolicy = models.Register(&SnatPolicy{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "snat-policy",
+		Version: "v1",
+	})
+
+	ModelSnatPolicyInterface = models.Register(&SnatPolicyInterface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "snat-policy-interface",
+		Version: "v1",
+	}, models.WithNameTemplate("{{.Interface}}/table/{{.Table}}"))
+)
+
+// TranslationKey returns the key used in NB DB to store the configuration of the
+// given CNAT translation.
+func TranslationKey(name string) string {
+	return models.Key(&Translation{
+		Name: name,
+	})
+}
+
+// SnatPolicyKey returns key for SnatPolicy.
+func SnatPolicyKey() string {
+	return models.Key(&SnatPolicy{})
+}
@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/igmp/igmp.proto
+
+package vpp_igmp
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Interface_Mode int32
+
+const (
+	Interface_HOST   Interface_Mode = 0 // interface joins multicast groups (e.g. upstream of the proxy device)
+	Interface_ROUTER Interface_Mode = 1 // interface sends queries and tracks listeners (e.g. towards subscribers)
+)
+
+// Enum value maps for Interface_Mode.
+var (
+	Interface_Mode_name = map[int32]string{
+		0: "HOST",
+		1: "ROUTER",
+	}
+	Interface_Mode_value = map[string]int32{
+		"HOST":   0,
+		"ROUTER": 1,
+	}
+)
+
+func (x Interface_Mode) Enum() *Interface_Mode {
+	p := new(Interface_Mode)
+	*p = x
+	return p
+}
+
+func (x Interface_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Interface_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_igmp_igmp_proto_enumTypes[0].Descriptor()
+}
+
+func (Interface_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_igmp_igmp_proto_enumTypes[0]
+}
+
+func (x Interface_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Interface_Mode.Descriptor instead.
+func (Interface_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_igmp_igmp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Interface enables IGMP (v3) on the VPP interface in the host or router mode.
+// VPP does not allow to dump the configuration.
+type Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface.
+	Interface string         `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Mode      Interface_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.vpp.igmp.Interface_Mode" json:"mode,omitempty"`
+	// Groups joined by the interface (allowed only in the host mode).
+	Groups []*Interface_Group `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *Interface) Reset() {
+	*x = Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface) ProtoMessage() {}
+
+func (x *Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
+func (*Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_igmp_igmp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Interface) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Interface) GetMode() Interface_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Interface_HOST
+}
+
+func (x *Interface) GetGroups() []*Interface_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// ProxyDevice forwards IGMP reports received on the downstream (router mode)
+// interfaces to the upstream (host mode) interface, which allows to build
+// multicast routes for groups requested by the downstream listeners.
+// There can be only one proxy device per VRF.
+type ProxyDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// Upstream interface (IGMP has to be enabled on it in the host mode).
+	UpstreamInterface string `protobuf:"bytes,2,opt,name=upstream_interface,json=upstreamInterface,proto3" json:"upstream_interface,omitempty"`
+	// Downstream interfaces (IGMP has to be enabled on them in the router mode).
+	DownstreamInterfaces []string `protobuf:"bytes,3,rep,name=downstream_interfaces,json=downstreamInterfaces,proto3" json:"downstream_interfaces,omitempty"`
+}
+
+func (x *ProxyDevice) Reset() {
+	*x = ProxyDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyDevice) ProtoMessage() {}
+
+func (x *ProxyDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyDevice.ProtoReflect.Descriptor instead.
+func (*ProxyDevice) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_igmp_igmp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProxyDevice) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *ProxyDevice) GetUpstreamInterface() string {
+	if x != nil {
+		return x.UpstreamInterface
+	}
+	return ""
+}
+
+func (x *ProxyDevice) GetDownstreamInterfaces() []string {
+	if x != nil {
+		return x.DownstreamInterfaces
+	}
+	return nil
+}
+
+// Group is a source-specific multicast group joined by the interface.
+// VPP supports only joins in INCLUDE mode, therefore at least one source
+// address has to be defined.
+type Interface_Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupAddress    string   `protobuf:"bytes,1,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	SourceAddresses []string `protobuf:"bytes,2,rep,name=source_addresses,json=sourceAddresses,proto3" json:"source_addresses,omitempty"`
+}
+
+func (x *Interface_Group) Reset() {
+	*x = Interface_Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_Group) ProtoMessage() {}
+
+func (x *Interface_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_igmp_igmp_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_Group.ProtoReflect.Descriptor instead.
+func (*Interface_Group) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_igmp_igmp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Interface_Group) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+func (x *Interface_Group) GetSourceAddresses() []string {
+	if x != nil {
+		return x.SourceAddresses
+	}
+	return nil
+}
+
+var File_ligato_vpp_igmp_igmp_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_igmp_igmp_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x67, 0x6d,
+	0x70, 0x2f, 0x69, 0x67, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x67, 0x6d, 0x70, 0x1a, 0x18, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x67, 0x6d, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x67, 0x6d, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x1a, 0x65, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x0d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x02, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x02, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52,
+	0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x33, 0x0a,
+	0x15, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x69, 0x67, 0x6d, 0x70, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x67, 0x6d, 0x70, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_igmp_igmp_proto_rawDescOnce sync.Once
+	file_ligato_vpp_igmp_igmp_proto_rawDescData = file_ligato_vpp_igmp_igmp_proto_rawDesc
+)
+
+func file_ligato_vpp_igmp_igmp_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_igmp_igmp_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_igmp_igmp_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_igmp_igmp_proto_rawDescData)
+	})
+	return file_ligato_vpp_igmp_igmp_proto_rawDescData
+}
+
+var file_ligato_vpp_igmp_igmp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_vpp_igmp_igmp_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_igmp_igmp_proto_goTypes = []interface{}{
+	(Interface_Mode)(0),     // 0: ligato.vpp.igmp.Interface.Mode
+	(*Interface)(nil),       // 1: ligato.vpp.igmp.Interface
+	(*ProxyDevice)(nil),     // 2: ligato.vpp.igmp.ProxyDevice
+	(*Interface_Group)(nil), // 3: ligato.vpp.igmp.Interface.Group
+}
+var file_ligato_vpp_igmp_igmp_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.igmp.Interface.mode:type_name -> ligato.vpp.igmp.Interface.Mode
+	3, // 1: ligato.vpp.igmp.Interface.groups:type_name -> ligato.vpp.igmp.Interface.Group
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_igmp_igmp_proto_init() }
+func file_ligato_vpp_igmp_igmp_proto_init() {
+	if File_ligato_vpp_igmp_igmp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_igmp_igmp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_igmp_igmp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_igmp_igmp_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_igmp_igmp_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_igmp_igmp_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_igmp_igmp_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_igmp_igmp_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_igmp_igmp_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_igmp_igmp_proto = out.File
+	file_ligato_vpp_igmp_igmp_proto_rawDesc = nil
+	file_ligato_vpp_igmp_igmp_proto_goTypes = nil
+	file_ligato_vpp_igmp_igmp_proto_depIdxs = nil
+}
@@ -0,0 +1,93 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_igmp
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.igmp"
+
+var (
+	ModelInterface = models.Register(&Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "interface",
+		Version: "v1",
+	}, models.WithNameTemplate("{{.Interface}}"))
+
+	ModelProxyDevice = models.Register(&ProxyDevice{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "proxy-device",
+		Version: "v1",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}"))
+)
+
+// InterfaceKey returns the key used in NB DB to store the IGMP configuration
+// of the given interface.
+func InterfaceKey(iface string) string {
+	return models.Key(&Interface{
+		Interface: iface,
+	})
+}
+
+// ProxyDeviceKey returns the key used in NB DB to store the configuration
+// of the IGMP proxy device for the given VRF.
+func ProxyDeviceKey(vrf uint32) string {
+	return models.Key(&ProxyDevice{
+		VrfId: vrf,
+	})
+}
+
+const (
+	// interfaceModeKeyTemplate is a template for key representing the mode in which
+	// IGMP is enabled on the interface (derived value).
+	interfaceModeKeyTemplate = "vpp/igmp/interface/{iface}/mode/{mode}"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// InterfaceModeKey returns key representing IGMP enabled on the interface
+// in the given mode.
+func InterfaceModeKey(iface string, mode Interface_Mode) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	key := strings.Replace(interfaceModeKeyTemplate, "{iface}", iface, 1)
+	key = strings.Replace(key, "{mode}", strings.ToLower(mode.String()), 1)
+	return key
+}
+
+// ParseInterfaceModeKey parses key representing IGMP enabled on the interface
+// in the given mode.
+func ParseInterfaceModeKey(key string) (iface string, mode Interface_Mode, isInterfaceModeKey bool) {
+	const prefix = "vpp/igmp/interface/"
+	if !strings.HasPrefix(key, prefix) {
+		return "", 0, false
+	}
+	modeIdx := strings.LastIndex(key, "/mode/")
+	if modeIdx <= len(prefix) {
+		return "", 0, false
+	}
+	iface = key[len(prefix):modeIdx]
+	modeVal, ok := Interface_Mode_value[strings.ToUpper(key[modeIdx+len("/mode/"):])]
+	if !ok || iface == InvalidKeyPart {
+		return "", 0, false
+	}
+	return iface, Interface_Mode(modeVal), true
+}
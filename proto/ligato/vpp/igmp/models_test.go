@@ -0,0 +1,104 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_igmp_test
+
+import (
+	"testing"
+
+	vpp_igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+)
+
+func TestInterfaceKey(t *testing.T) {
+	key := vpp_igmp.InterfaceKey("tap0")
+	if expected := "config/vpp/igmp/v1/interface/tap0"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestProxyDeviceKey(t *testing.T) {
+	key := vpp_igmp.ProxyDeviceKey(2)
+	if expected := "config/vpp/igmp/v1/proxy-device/vrf/2"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestInterfaceModeKey(t *testing.T) {
+	key := vpp_igmp.InterfaceModeKey("tap0", vpp_igmp.Interface_ROUTER)
+	if expected := "vpp/igmp/interface/tap0/mode/router"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+	key = vpp_igmp.InterfaceModeKey("", vpp_igmp.Interface_HOST)
+	if expected := "vpp/igmp/interface/<invalid>/mode/host"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestParseInterfaceModeKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		expectedIface string
+		expectedMode  vpp_igmp.Interface_Mode
+		expectedIsKey bool
+	}{
+		{
+			name:          "host mode",
+			key:           "vpp/igmp/interface/tap0/mode/host",
+			expectedIface: "tap0",
+			expectedMode:  vpp_igmp.Interface_HOST,
+			expectedIsKey: true,
+		},
+		{
+			name:          "router mode with slash in interface name",
+			key:           "vpp/igmp/interface/Gbe0/8/0/mode/router",
+			expectedIface: "Gbe0/8/0",
+			expectedMode:  vpp_igmp.Interface_ROUTER,
+			expectedIsKey: true,
+		},
+		{
+			name: "invalid mode",
+			key:  "vpp/igmp/interface/tap0/mode/proxy",
+		},
+		{
+			name: "invalid interface",
+			key:  "vpp/igmp/interface/<invalid>/mode/host",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/igmp/interface/mode/host",
+		},
+		{
+			name: "interface key",
+			key:  "config/vpp/igmp/v1/interface/tap0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, mode, isKey := vpp_igmp.ParseInterfaceModeKey(test.key)
+			if isKey != test.expectedIsKey {
+				t.Fatalf("expected isKey: %v\tgot: %v", test.expectedIsKey, isKey)
+			}
+			if !isKey {
+				return
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected interface: %s\tgot: %s", test.expectedIface, iface)
+			}
+			if mode != test.expectedMode {
+				t.Errorf("expected mode: %v\tgot: %v", test.expectedMode, mode)
+			}
+		})
+	}
+}
@@ -1750,8 +1750,8 @@ func (x *IPSecLink) GetEnableUdpEncap() bool {
 
 // VmxNet3Link defines configuration for interface type: VMXNET3_INTERFACE
 // PCI address (unsigned 32bit int) is derived from vmxnet3 interface name. It is expected that the interface
-// name is in format "vmxnet3-<d>/<b>/<s>/<f>", where 'd' stands for domain (max ffff), 'b' is bus (max ff),
-// 's' is slot (max 1f) and 'f is function' (max 7). All values are base 16
+// name is in format `vmxnet3-<d>/<b>/<s>/<f>`, where `d` stands for domain (max ffff), `b` is bus (max ff),
+// `s` is slot (max 1f) and `f` is function (max 7). All values are base 16
 type VmxNet3Link struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2216,7 +2216,7 @@ func (x *WireguardLink) GetSrcAddr() string {
 	return ""
 }
 
-// https://github.com/FDio/vpp/blob/master/src/plugins/rdma/rdma_doc.md
+// https://github.com/FDio/vpp/blob/master/src/plugins/rdma/rdma_doc.rst
 type RDMALink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2305,7 +2305,8 @@ func (x *RDMALink) GetTxqSize() uint32 {
 }
 
 // Ip6Nd is used to enable/disable IPv6 ND address autoconfiguration
-// and setting up default routes
+// and setting up default routes, and to configure router advertisements
+// sent on the interface (requires IPv6 enabled on the interface).
 type Interface_IP6ND struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2314,7 +2315,9 @@ type Interface_IP6ND struct {
 	// Enable IPv6 ND address autoconfiguration.
 	AddressAutoconfig bool `protobuf:"varint,1,opt,name=address_autoconfig,json=addressAutoconfig,proto3" json:"address_autoconfig,omitempty"`
 	// Enable installing default routes.
-	InstallDefaultRoutes bool `protobuf:"varint,2,opt,name=install_default_routes,json=installDefaultRoutes,proto3" json:"install_default_routes,omitempty"`
+	InstallDefaultRoutes bool                                 `protobuf:"varint,2,opt,name=install_default_routes,json=installDefaultRoutes,proto3" json:"install_default_routes,omitempty"`
+	RouterAdvertisement  *Interface_IP6ND_RouterAdvertisement `protobuf:"bytes,3,opt,name=router_advertisement,json=routerAdvertisement,proto3" json:"router_advertisement,omitempty"`
+	Prefixes             []*Interface_IP6ND_Prefix            `protobuf:"bytes,4,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
 }
 
 func (x *Interface_IP6ND) Reset() {
@@ -2363,6 +2366,20 @@ func (x *Interface_IP6ND) GetInstallDefaultRoutes() bool {
 	return false
 }
 
+func (x *Interface_IP6ND) GetRouterAdvertisement() *Interface_IP6ND_RouterAdvertisement {
+	if x != nil {
+		return x.RouterAdvertisement
+	}
+	return nil
+}
+
+func (x *Interface_IP6ND) GetPrefixes() []*Interface_IP6ND_Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
 // Unnumbered is used for inheriting IP address from another interface.
 type Interface_Unnumbered struct {
 	state         protoimpl.MessageState
@@ -2544,6 +2561,251 @@ func (x *Interface_RxPlacement) GetMainThread() bool {
 	return false
 }
 
+// RouterAdvertisement configures router advertisements sent on the interface.
+// Zero values of intervals and lifetime mean VPP defaults.
+type Interface_IP6ND_RouterAdvertisement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Do not send router advertisements on the interface.
+	Suppress bool `protobuf:"varint,1,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	// Set managed address configuration (M) flag.
+	Managed bool `protobuf:"varint,2,opt,name=managed,proto3" json:"managed,omitempty"`
+	// Set other configuration (O) flag.
+	Other bool `protobuf:"varint,3,opt,name=other,proto3" json:"other,omitempty"`
+	// Omit source link-layer address option.
+	SuppressLlOption bool `protobuf:"varint,4,opt,name=suppress_ll_option,json=suppressLlOption,proto3" json:"suppress_ll_option,omitempty"`
+	// Respond to router solicitations with unicast advertisements.
+	SendUnicast bool `protobuf:"varint,5,opt,name=send_unicast,json=sendUnicast,proto3" json:"send_unicast,omitempty"`
+	// Send advertisements with zero router lifetime before ceasing to act as a router.
+	Cease bool `protobuf:"varint,6,opt,name=cease,proto3" json:"cease,omitempty"`
+	// Advertise zero router lifetime, i.e. the router is not a default router.
+	NoDefaultRouter bool `protobuf:"varint,7,opt,name=no_default_router,json=noDefaultRouter,proto3" json:"no_default_router,omitempty"`
+	// Router lifetime in seconds (must be greater than max_interval,
+	// default is 3*max_interval).
+	RouterLifetime uint32 `protobuf:"varint,8,opt,name=router_lifetime,json=routerLifetime,proto3" json:"router_lifetime,omitempty"`
+	// Max interval between unsolicited advertisements in seconds (4-1800).
+	MaxInterval uint32 `protobuf:"varint,9,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	// Min interval between unsolicited advertisements in seconds
+	// (3 - 0.75*max_interval, default is 0.75*max_interval).
+	MinInterval uint32 `protobuf:"varint,10,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	// Number of initial advertisements (max 3).
+	InitialCount uint32 `protobuf:"varint,11,opt,name=initial_count,json=initialCount,proto3" json:"initial_count,omitempty"`
+	// Interval between initial advertisements in seconds (max 16).
+	InitialInterval uint32 `protobuf:"varint,12,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) Reset() {
+	*x = Interface_IP6ND_RouterAdvertisement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_IP6ND_RouterAdvertisement) ProtoMessage() {}
+
+func (x *Interface_IP6ND_RouterAdvertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_IP6ND_RouterAdvertisement.ProtoReflect.Descriptor instead.
+func (*Interface_IP6ND_RouterAdvertisement) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSuppress() bool {
+	if x != nil {
+		return x.Suppress
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetOther() bool {
+	if x != nil {
+		return x.Other
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSuppressLlOption() bool {
+	if x != nil {
+		return x.SuppressLlOption
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetSendUnicast() bool {
+	if x != nil {
+		return x.SendUnicast
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetCease() bool {
+	if x != nil {
+		return x.Cease
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetNoDefaultRouter() bool {
+	if x != nil {
+		return x.NoDefaultRouter
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetRouterLifetime() uint32 {
+	if x != nil {
+		return x.RouterLifetime
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetMaxInterval() uint32 {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetMinInterval() uint32 {
+	if x != nil {
+		return x.MinInterval
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetInitialCount() uint32 {
+	if x != nil {
+		return x.InitialCount
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_RouterAdvertisement) GetInitialInterval() uint32 {
+	if x != nil {
+		return x.InitialInterval
+	}
+	return 0
+}
+
+// Prefix is advertised in router advertisements (prefix information option).
+type Interface_IP6ND_Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IPv6 prefix in the CIDR notation.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Valid lifetime in seconds (both lifetimes zero for VPP defaults).
+	ValidLifetime uint32 `protobuf:"varint,2,opt,name=valid_lifetime,json=validLifetime,proto3" json:"valid_lifetime,omitempty"`
+	// Preferred lifetime in seconds (must not exceed valid lifetime).
+	PreferredLifetime uint32 `protobuf:"varint,3,opt,name=preferred_lifetime,json=preferredLifetime,proto3" json:"preferred_lifetime,omitempty"`
+	// Do not advertise the prefix.
+	NoAdvertise bool `protobuf:"varint,4,opt,name=no_advertise,json=noAdvertise,proto3" json:"no_advertise,omitempty"`
+	// Clear on-link (L) flag.
+	OffLink bool `protobuf:"varint,5,opt,name=off_link,json=offLink,proto3" json:"off_link,omitempty"`
+	// Clear autonomous address-configuration (A) flag, i.e. disable SLAAC for the prefix.
+	NoAutoconfig bool `protobuf:"varint,6,opt,name=no_autoconfig,json=noAutoconfig,proto3" json:"no_autoconfig,omitempty"`
+}
+
+func (x *Interface_IP6ND_Prefix) Reset() {
+	*x = Interface_IP6ND_Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface_IP6ND_Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface_IP6ND_Prefix) ProtoMessage() {}
+
+func (x *Interface_IP6ND_Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interface_IP6ND_Prefix.ProtoReflect.Descriptor instead.
+func (*Interface_IP6ND_Prefix) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *Interface_IP6ND_Prefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Interface_IP6ND_Prefix) GetValidLifetime() uint32 {
+	if x != nil {
+		return x.ValidLifetime
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_Prefix) GetPreferredLifetime() uint32 {
+	if x != nil {
+		return x.PreferredLifetime
+	}
+	return 0
+}
+
+func (x *Interface_IP6ND_Prefix) GetNoAdvertise() bool {
+	if x != nil {
+		return x.NoAdvertise
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_Prefix) GetOffLink() bool {
+	if x != nil {
+		return x.OffLink
+	}
+	return false
+}
+
+func (x *Interface_IP6ND_Prefix) GetNoAutoconfig() bool {
+	if x != nil {
+		return x.NoAutoconfig
+	}
+	return false
+}
+
 // Gpe (Generic Protocol Extension) allows encapsulating not only Ethernet frame payload.
 type VxlanLink_Gpe struct {
 	state         protoimpl.MessageState
@@ -2558,7 +2820,7 @@ type VxlanLink_Gpe struct {
 func (x *VxlanLink_Gpe) Reset() {
 	*x = VxlanLink_Gpe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2571,7 +2833,7 @@ func (x *VxlanLink_Gpe) String() string {
 func (*VxlanLink_Gpe) ProtoMessage() {}
 
 func (x *VxlanLink_Gpe) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2876,7 @@ type BondLink_BondedInterface struct {
 func (x *BondLink_BondedInterface) Reset() {
 	*x = BondLink_BondedInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2627,7 +2889,7 @@ func (x *BondLink_BondedInterface) String() string {
 func (*BondLink_BondedInterface) ProtoMessage() {}
 
 func (x *BondLink_BondedInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +2936,7 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63,
 	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x10, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x16, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
@@ -2757,306 +3019,358 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x18, 0x70, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x1a, 0x6c, 0x0a, 0x05,
-	0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
-	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45,
-	0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
-	0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04,
-	0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41,
-	0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50,
-	0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
-	0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
-	0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b,
-	0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a,
-	0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
-	0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x42, 0x06, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59,
-	0x0a, 0x0d, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75,
-	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61,
-	0x67, 0x52, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73,
-	0x68, 0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
-	0x75, 0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32,
-	0x22, 0x8f, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x50, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10,
-	0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32,
-	0x10, 0x08, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x22, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x49, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64,
-	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03,
-	0x47, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70,
-	0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34,
-	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45,
-	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48,
-	0x10, 0x04, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x1a, 0xb8, 0x07, 0x0a,
+	0x05, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x14, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03,
-	0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65,
-	0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74,
-	0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02,
-	0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x70, 0x12, 0x1f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53,
-	0x70, 0x69, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f,
-	0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41,
-	0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67,
-	0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f,
-	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a,
-	0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42,
-	0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69,
-	0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
-	0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f,
-	0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a,
-	0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12,
-	0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22,
-	0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66,
-	0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c,
-	0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70,
-	0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07,
-	0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e,
+	0x44, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49,
+	0x50, 0x36, 0x4e, 0x44, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x1a, 0xb3, 0x03, 0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x70,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73,
+	0x65, 0x6e, 0x64, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x6f, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0xd9, 0x01, 0x0a, 0x06,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x25,
+	0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x5f, 0x61, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x41, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x41, 0x75, 0x74,
+	0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x70, 0x1a, 0xcf, 0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x41, 0x50,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x04, 0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x22, 0x96, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e,
+	0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10,
+	0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x4c, 0x4f,
+	0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x50, 0x44, 0x4b,
+	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04, 0x12, 0x07, 0x0a,
+	0x03, 0x54, 0x41, 0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x54,
+	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50, 0x53, 0x45, 0x43,
+	0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x56, 0x4d, 0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
+	0x43, 0x45, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x52, 0x45, 0x5f,
+	0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x54, 0x50, 0x55,
+	0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x49,
+	0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49,
+	0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0e,
+	0x12, 0x08, 0x0a, 0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x0d, 0x74,
+	0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61, 0x67, 0x52, 0x77,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64,
+	0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68,
+	0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32, 0x22, 0x8f, 0x01,
+	0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x31, 0x10,
+	0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32, 0x10, 0x08, 0x22,
+	0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x3e, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x65,
+	0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x32,
+	0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x45,
+	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x50, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54,
+	0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x72,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76,
+	0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
+	0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03, 0x47, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c,
+	0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x40, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x54, 0x48, 0x45,
+	0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48, 0x10, 0x04, 0x22,
+	0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x07, 0x54,
+	0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72,
+	0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
+	0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03, 0x65, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65, 0x73, 0x6e, 0x12,
+	0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74, 0x69, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1f,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x12,
+	0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41,
+	0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79,
+	0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79,
+	0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x2a, 0x0a,
+	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x64, 0x70,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a, 0x0b, 0x56, 0x6d,
+	0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78,
+	0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78,
+	0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52,
+	0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x58, 0x4f, 0x52,
+	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c,
+	0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02,
+	0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22, 0x86, 0x02, 0x0a,
+	0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x46,
+	0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x33, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x53,
+	0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63,
+	0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64,
+	0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61,
+	0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50,
+	0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50,
+	0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c,
-	0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b,
-	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64,
-	0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a,
-	0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
-	0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08,
-	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
-	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
-	0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10,
-	0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01,
+	0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72,
+	0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10, 0x02, 0x42, 0x46,
+	0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3072,42 +3386,44 @@ func file_ligato_vpp_interfaces_interface_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
-	(Interface_Type)(0),                 // 0: ligato.vpp.interfaces.Interface.Type
-	(Interface_RxMode_Type)(0),          // 1: ligato.vpp.interfaces.Interface.RxMode.Type
-	(SubInterface_TagRewriteOptions)(0), // 2: ligato.vpp.interfaces.SubInterface.TagRewriteOptions
-	(MemifLink_MemifMode)(0),            // 3: ligato.vpp.interfaces.MemifLink.MemifMode
-	(VxlanLink_Gpe_Protocol)(0),         // 4: ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	(IPSecLink_Mode)(0),                 // 5: ligato.vpp.interfaces.IPSecLink.Mode
-	(BondLink_Mode)(0),                  // 6: ligato.vpp.interfaces.BondLink.Mode
-	(BondLink_LoadBalance)(0),           // 7: ligato.vpp.interfaces.BondLink.LoadBalance
-	(GreLink_Type)(0),                   // 8: ligato.vpp.interfaces.GreLink.Type
-	(GtpuLink_NextNode)(0),              // 9: ligato.vpp.interfaces.GtpuLink.NextNode
-	(IPIPLink_Mode)(0),                  // 10: ligato.vpp.interfaces.IPIPLink.Mode
-	(RDMALink_Mode)(0),                  // 11: ligato.vpp.interfaces.RDMALink.Mode
-	(*Interface)(nil),                   // 12: ligato.vpp.interfaces.Interface
-	(*SubInterface)(nil),                // 13: ligato.vpp.interfaces.SubInterface
-	(*MemifLink)(nil),                   // 14: ligato.vpp.interfaces.MemifLink
-	(*VxlanLink)(nil),                   // 15: ligato.vpp.interfaces.VxlanLink
-	(*AfpacketLink)(nil),                // 16: ligato.vpp.interfaces.AfpacketLink
-	(*TapLink)(nil),                     // 17: ligato.vpp.interfaces.TapLink
-	(*IPSecLink)(nil),                   // 18: ligato.vpp.interfaces.IPSecLink
-	(*VmxNet3Link)(nil),                 // 19: ligato.vpp.interfaces.VmxNet3Link
-	(*BondLink)(nil),                    // 20: ligato.vpp.interfaces.BondLink
-	(*GreLink)(nil),                     // 21: ligato.vpp.interfaces.GreLink
-	(*GtpuLink)(nil),                    // 22: ligato.vpp.interfaces.GtpuLink
-	(*IPIPLink)(nil),                    // 23: ligato.vpp.interfaces.IPIPLink
-	(*WireguardLink)(nil),               // 24: ligato.vpp.interfaces.WireguardLink
-	(*RDMALink)(nil),                    // 25: ligato.vpp.interfaces.RDMALink
-	(*Interface_IP6ND)(nil),             // 26: ligato.vpp.interfaces.Interface.IP6ND
-	(*Interface_Unnumbered)(nil),        // 27: ligato.vpp.interfaces.Interface.Unnumbered
-	(*Interface_RxMode)(nil),            // 28: ligato.vpp.interfaces.Interface.RxMode
-	(*Interface_RxPlacement)(nil),       // 29: ligato.vpp.interfaces.Interface.RxPlacement
-	(*VxlanLink_Gpe)(nil),               // 30: ligato.vpp.interfaces.VxlanLink.Gpe
-	(*BondLink_BondedInterface)(nil),    // 31: ligato.vpp.interfaces.BondLink.BondedInterface
-	(ipsec.CryptoAlg)(0),                // 32: ligato.vpp.ipsec.CryptoAlg
-	(ipsec.IntegAlg)(0),                 // 33: ligato.vpp.ipsec.IntegAlg
+	(Interface_Type)(0),                         // 0: ligato.vpp.interfaces.Interface.Type
+	(Interface_RxMode_Type)(0),                  // 1: ligato.vpp.interfaces.Interface.RxMode.Type
+	(SubInterface_TagRewriteOptions)(0),         // 2: ligato.vpp.interfaces.SubInterface.TagRewriteOptions
+	(MemifLink_MemifMode)(0),                    // 3: ligato.vpp.interfaces.MemifLink.MemifMode
+	(VxlanLink_Gpe_Protocol)(0),                 // 4: ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	(IPSecLink_Mode)(0),                         // 5: ligato.vpp.interfaces.IPSecLink.Mode
+	(BondLink_Mode)(0),                          // 6: ligato.vpp.interfaces.BondLink.Mode
+	(BondLink_LoadBalance)(0),                   // 7: ligato.vpp.interfaces.BondLink.LoadBalance
+	(GreLink_Type)(0),                           // 8: ligato.vpp.interfaces.GreLink.Type
+	(GtpuLink_NextNode)(0),                      // 9: ligato.vpp.interfaces.GtpuLink.NextNode
+	(IPIPLink_Mode)(0),                          // 10: ligato.vpp.interfaces.IPIPLink.Mode
+	(RDMALink_Mode)(0),                          // 11: ligato.vpp.interfaces.RDMALink.Mode
+	(*Interface)(nil),                           // 12: ligato.vpp.interfaces.Interface
+	(*SubInterface)(nil),                        // 13: ligato.vpp.interfaces.SubInterface
+	(*MemifLink)(nil),                           // 14: ligato.vpp.interfaces.MemifLink
+	(*VxlanLink)(nil),                           // 15: ligato.vpp.interfaces.VxlanLink
+	(*AfpacketLink)(nil),                        // 16: ligato.vpp.interfaces.AfpacketLink
+	(*TapLink)(nil),                             // 17: ligato.vpp.interfaces.TapLink
+	(*IPSecLink)(nil),                           // 18: ligato.vpp.interfaces.IPSecLink
+	(*VmxNet3Link)(nil),                         // 19: ligato.vpp.interfaces.VmxNet3Link
+	(*BondLink)(nil),                            // 20: ligato.vpp.interfaces.BondLink
+	(*GreLink)(nil),                             // 21: ligato.vpp.interfaces.GreLink
+	(*GtpuLink)(nil),                            // 22: ligato.vpp.interfaces.GtpuLink
+	(*IPIPLink)(nil),                            // 23: ligato.vpp.interfaces.IPIPLink
+	(*WireguardLink)(nil),                       // 24: ligato.vpp.interfaces.WireguardLink
+	(*RDMALink)(nil),                            // 25: ligato.vpp.interfaces.RDMALink
+	(*Interface_IP6ND)(nil),                     // 26: ligato.vpp.interfaces.Interface.IP6ND
+	(*Interface_Unnumbered)(nil),                // 27: ligato.vpp.interfaces.Interface.Unnumbered
+	(*Interface_RxMode)(nil),                    // 28: ligato.vpp.interfaces.Interface.RxMode
+	(*Interface_RxPlacement)(nil),               // 29: ligato.vpp.interfaces.Interface.RxPlacement
+	(*Interface_IP6ND_RouterAdvertisement)(nil), // 30: ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement
+	(*Interface_IP6ND_Prefix)(nil),              // 31: ligato.vpp.interfaces.Interface.IP6ND.Prefix
+	(*VxlanLink_Gpe)(nil),                       // 32: ligato.vpp.interfaces.VxlanLink.Gpe
+	(*BondLink_BondedInterface)(nil),            // 33: ligato.vpp.interfaces.BondLink.BondedInterface
+	(ipsec.CryptoAlg)(0),                        // 34: ligato.vpp.ipsec.CryptoAlg
+	(ipsec.IntegAlg)(0),                         // 35: ligato.vpp.ipsec.IntegAlg
 }
 var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	0,  // 0: ligato.vpp.interfaces.Interface.type:type_name -> ligato.vpp.interfaces.Interface.Type
@@ -3130,24 +3446,26 @@ var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	25, // 17: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
 	2,  // 18: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
 	3,  // 19: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
-	30, // 20: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
+	32, // 20: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
 	5,  // 21: ligato.vpp.interfaces.IPSecLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPSecLink.Mode
-	32, // 22: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
-	33, // 23: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	34, // 22: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	35, // 23: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
 	6,  // 24: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
 	7,  // 25: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
-	31, // 26: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
+	33, // 26: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
 	8,  // 27: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
 	9,  // 28: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
 	10, // 29: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
 	11, // 30: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
-	1,  // 31: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
-	4,  // 32: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	30, // 31: ligato.vpp.interfaces.Interface.IP6ND.router_advertisement:type_name -> ligato.vpp.interfaces.Interface.IP6ND.RouterAdvertisement
+	31, // 32: ligato.vpp.interfaces.Interface.IP6ND.prefixes:type_name -> ligato.vpp.interfaces.Interface.IP6ND.Prefix
+	1,  // 33: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
+	4,  // 34: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_interfaces_interface_proto_init() }
@@ -3373,7 +3691,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VxlanLink_Gpe); i {
+			switch v := v.(*Interface_IP6ND_RouterAdvertisement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,6 +3703,30 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_IP6ND_Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink_Gpe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BondLink_BondedInterface); i {
 			case 0:
 				return &v.state
@@ -3418,7 +3760,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_interfaces_interface_proto_rawDesc,
 			NumEnums:      12,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
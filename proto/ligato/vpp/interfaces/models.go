@@ -15,6 +15,7 @@
 package vpp_interfaces
 
 import (
+	"net"
 	"strconv"
 	"strings"
 
@@ -118,6 +119,10 @@ const (
 	// IP6NDKeyPrefix is used as a common prefix for keys derived from
 	// interfaces to represent enabled IP6 ND.
 	IP6NDKeyPrefix = "vpp/interface/ip6nd/"
+
+	// ip6ndPrefixKeyTemplate is a template for (derived) key representing
+	// prefix advertised in IPv6 router advertisements sent on the interface.
+	ip6ndPrefixKeyTemplate = "vpp/interface/{iface}/ip6nd-prefix/{prefix}"
 )
 
 /* DHCP (client - derived, lease - notification) */
@@ -465,6 +470,51 @@ func ParseNameFromIP6NDKey(key string) (iface string, isIP6NDKey bool) {
 	return
 }
 
+// IP6NDPrefixKey returns a (derived) key used to represent prefix advertised
+// in IPv6 router advertisements sent on the interface.
+func IP6NDPrefixKey(iface, prefix string) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if prefix == "" {
+		prefix = InvalidKeyPart
+	}
+	key := strings.Replace(ip6ndPrefixKeyTemplate, "{iface}", iface, 1)
+	key = strings.Replace(key, "{prefix}", prefix, 1)
+	return key
+}
+
+// ParseIP6NDPrefixKey parses interface name and advertised prefix from key
+// derived by IP6NDPrefixKey().
+func ParseIP6NDPrefixKey(key string) (iface, prefix string, isIP6NDPrefixKey bool) {
+	if suffix := strings.TrimPrefix(key, "vpp/interface/"); suffix != key {
+		parts := strings.Split(suffix, "/")
+		ip6ndPrefix := -1
+		for i, part := range parts {
+			if part == "ip6nd-prefix" {
+				ip6ndPrefix = i
+				break
+			}
+		}
+		// IPv6 prefix is always given with prefix length (address/length)
+		if ip6ndPrefix < 1 || ip6ndPrefix != len(parts)-3 {
+			return
+		}
+		prefix = strings.Join(parts[ip6ndPrefix+1:], "/")
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return "", "", false
+		}
+
+		// beware: interface name may contain forward slashes
+		iface = strings.Join(parts[:ip6ndPrefix], "/")
+		if iface == InvalidKeyPart {
+			return "", "", false
+		}
+		return iface, prefix, true
+	}
+	return "", "", false
+}
+
 /* DHCP (client - derived, lease - notification) */
 
 // DHCPClientKey returns a (derived) key used to represent enabled DHCP lease.
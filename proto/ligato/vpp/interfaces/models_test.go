@@ -1306,3 +1306,112 @@ func TestParseInterfaceWithIPKey(t *testing.T) {
 		})
 	}
 }
+
+func TestIP6NDPrefixKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		prefix      string
+		expectedKey string
+	}{
+		{
+			name:        "valid prefix",
+			iface:       "memif0",
+			prefix:      "2001:db8::/64",
+			expectedKey: "vpp/interface/memif0/ip6nd-prefix/2001:db8::/64",
+		},
+		{
+			name:        "invalid interface name",
+			iface:       "",
+			prefix:      "2001:db8::/64",
+			expectedKey: "vpp/interface/<invalid>/ip6nd-prefix/2001:db8::/64",
+		},
+		{
+			name:        "invalid prefix",
+			iface:       "memif0",
+			prefix:      "",
+			expectedKey: "vpp/interface/memif0/ip6nd-prefix/<invalid>",
+		},
+		{
+			name:        "Gbe interface",
+			iface:       "GigabitEthernet0/8/0",
+			prefix:      "2001:db8:1::/48",
+			expectedKey: "vpp/interface/GigabitEthernet0/8/0/ip6nd-prefix/2001:db8:1::/48",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := IP6NDPrefixKey(test.iface, test.prefix)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s prefix=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.prefix, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseIP6NDPrefixKey(t *testing.T) {
+	tests := []struct {
+		name                     string
+		key                      string
+		expectedIface            string
+		expectedPrefix           string
+		expectedIsIP6NDPrefixKey bool
+	}{
+		{
+			name:                     "valid prefix",
+			key:                      "vpp/interface/memif0/ip6nd-prefix/2001:db8::/64",
+			expectedIface:            "memif0",
+			expectedPrefix:           "2001:db8::/64",
+			expectedIsIP6NDPrefixKey: true,
+		},
+		{
+			name:                     "Gbe interface",
+			key:                      "vpp/interface/GigabitEthernet0/8/0/ip6nd-prefix/2001:db8:1::/48",
+			expectedIface:            "GigabitEthernet0/8/0",
+			expectedPrefix:           "2001:db8:1::/48",
+			expectedIsIP6NDPrefixKey: true,
+		},
+		{
+			name:                     "invalid interface name",
+			key:                      "vpp/interface/<invalid>/ip6nd-prefix/2001:db8::/64",
+			expectedIsIP6NDPrefixKey: false,
+		},
+		{
+			name:                     "invalid prefix",
+			key:                      "vpp/interface/memif0/ip6nd-prefix/<invalid>",
+			expectedIsIP6NDPrefixKey: false,
+		},
+		{
+			name:                     "missing prefix length",
+			key:                      "vpp/interface/memif0/ip6nd-prefix/2001:db8::",
+			expectedIsIP6NDPrefixKey: false,
+		},
+		{
+			name:                     "missing interface",
+			key:                      "vpp/interface/ip6nd-prefix/2001:db8::/64",
+			expectedIsIP6NDPrefixKey: false,
+		},
+		{
+			name:                     "not IPv6 ND prefix key",
+			key:                      "vpp/interface/memif0/link-state/DOWN",
+			expectedIsIP6NDPrefixKey: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, prefix, isIP6NDPrefixKey := ParseIP6NDPrefixKey(test.key)
+			if isIP6NDPrefixKey != test.expectedIsIP6NDPrefixKey {
+				t.Errorf("expected isIP6NDPrefixKey: %v\tgot: %v",
+					test.expectedIsIP6NDPrefixKey, isIP6NDPrefixKey)
+			}
+			if prefix != test.expectedPrefix {
+				t.Errorf("expected prefix: %s\tgot: %s", test.expectedPrefix, prefix)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected iface: %s\tgot: %s", test.expectedIface, iface)
+			}
+		})
+	}
+}
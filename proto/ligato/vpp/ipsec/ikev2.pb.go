@@ -0,0 +1,1392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/ipsec/ikev2.proto
+
+package vpp_ipsec
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IKEv2Profile_Authentication_Method int32
+
+const (
+	IKEv2Profile_Authentication_SHARED_KEY IKEv2Profile_Authentication_Method = 0 // Pre-shared key
+	IKEv2Profile_Authentication_RSA_SIG    IKEv2Profile_Authentication_Method = 1 // RSA signature, data is a path to the peer certificate file
+)
+
+// Enum value maps for IKEv2Profile_Authentication_Method.
+var (
+	IKEv2Profile_Authentication_Method_name = map[int32]string{
+		0: "SHARED_KEY",
+		1: "RSA_SIG",
+	}
+	IKEv2Profile_Authentication_Method_value = map[string]int32{
+		"SHARED_KEY": 0,
+		"RSA_SIG":    1,
+	}
+)
+
+func (x IKEv2Profile_Authentication_Method) Enum() *IKEv2Profile_Authentication_Method {
+	p := new(IKEv2Profile_Authentication_Method)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Authentication_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Authentication_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ikev2_proto_enumTypes[0].Descriptor()
+}
+
+func (IKEv2Profile_Authentication_Method) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ikev2_proto_enumTypes[0]
+}
+
+func (x IKEv2Profile_Authentication_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Authentication_Method.Descriptor instead.
+func (IKEv2Profile_Authentication_Method) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+type IKEv2Profile_Identity_Type int32
+
+const (
+	IKEv2Profile_Identity_FQDN        IKEv2Profile_Identity_Type = 0
+	IKEv2Profile_Identity_IPV4_ADDR   IKEv2Profile_Identity_Type = 1
+	IKEv2Profile_Identity_IPV6_ADDR   IKEv2Profile_Identity_Type = 2
+	IKEv2Profile_Identity_RFC822_ADDR IKEv2Profile_Identity_Type = 3 // e-mail address
+	IKEv2Profile_Identity_KEY_ID      IKEv2Profile_Identity_Type = 4
+)
+
+// Enum value maps for IKEv2Profile_Identity_Type.
+var (
+	IKEv2Profile_Identity_Type_name = map[int32]string{
+		0: "FQDN",
+		1: "IPV4_ADDR",
+		2: "IPV6_ADDR",
+		3: "RFC822_ADDR",
+		4: "KEY_ID",
+	}
+	IKEv2Profile_Identity_Type_value = map[string]int32{
+		"FQDN":        0,
+		"IPV4_ADDR":   1,
+		"IPV6_ADDR":   2,
+		"RFC822_ADDR": 3,
+		"KEY_ID":      4,
+	}
+)
+
+func (x IKEv2Profile_Identity_Type) Enum() *IKEv2Profile_Identity_Type {
+	p := new(IKEv2Profile_Identity_Type)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Identity_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Identity_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ikev2_proto_enumTypes[1].Descriptor()
+}
+
+func (IKEv2Profile_Identity_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ikev2_proto_enumTypes[1]
+}
+
+func (x IKEv2Profile_Identity_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Identity_Type.Descriptor instead.
+func (IKEv2Profile_Identity_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+type IKEv2Profile_Transforms_EncryptionAlg int32
+
+const (
+	IKEv2Profile_Transforms_ENCR_AES_CBC    IKEv2Profile_Transforms_EncryptionAlg = 0
+	IKEv2Profile_Transforms_ENCR_AES_CTR    IKEv2Profile_Transforms_EncryptionAlg = 1
+	IKEv2Profile_Transforms_ENCR_AES_GCM_16 IKEv2Profile_Transforms_EncryptionAlg = 2
+)
+
+// Enum value maps for IKEv2Profile_Transforms_EncryptionAlg.
+var (
+	IKEv2Profile_Transforms_EncryptionAlg_name = map[int32]string{
+		0: "ENCR_AES_CBC",
+		1: "ENCR_AES_CTR",
+		2: "ENCR_AES_GCM_16",
+	}
+	IKEv2Profile_Transforms_EncryptionAlg_value = map[string]int32{
+		"ENCR_AES_CBC":    0,
+		"ENCR_AES_CTR":    1,
+		"ENCR_AES_GCM_16": 2,
+	}
+)
+
+func (x IKEv2Profile_Transforms_EncryptionAlg) Enum() *IKEv2Profile_Transforms_EncryptionAlg {
+	p := new(IKEv2Profile_Transforms_EncryptionAlg)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Transforms_EncryptionAlg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Transforms_EncryptionAlg) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ikev2_proto_enumTypes[2].Descriptor()
+}
+
+func (IKEv2Profile_Transforms_EncryptionAlg) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ikev2_proto_enumTypes[2]
+}
+
+func (x IKEv2Profile_Transforms_EncryptionAlg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Transforms_EncryptionAlg.Descriptor instead.
+func (IKEv2Profile_Transforms_EncryptionAlg) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+type IKEv2Profile_Transforms_IntegrityAlg int32
+
+const (
+	IKEv2Profile_Transforms_AUTH_NONE              IKEv2Profile_Transforms_IntegrityAlg = 0 // Only valid with AES-GCM
+	IKEv2Profile_Transforms_AUTH_HMAC_SHA1_96      IKEv2Profile_Transforms_IntegrityAlg = 1
+	IKEv2Profile_Transforms_AUTH_HMAC_SHA2_256_128 IKEv2Profile_Transforms_IntegrityAlg = 2
+	IKEv2Profile_Transforms_AUTH_HMAC_SHA2_384_192 IKEv2Profile_Transforms_IntegrityAlg = 3
+	IKEv2Profile_Transforms_AUTH_HMAC_SHA2_512_256 IKEv2Profile_Transforms_IntegrityAlg = 4
+)
+
+// Enum value maps for IKEv2Profile_Transforms_IntegrityAlg.
+var (
+	IKEv2Profile_Transforms_IntegrityAlg_name = map[int32]string{
+		0: "AUTH_NONE",
+		1: "AUTH_HMAC_SHA1_96",
+		2: "AUTH_HMAC_SHA2_256_128",
+		3: "AUTH_HMAC_SHA2_384_192",
+		4: "AUTH_HMAC_SHA2_512_256",
+	}
+	IKEv2Profile_Transforms_IntegrityAlg_value = map[string]int32{
+		"AUTH_NONE":              0,
+		"AUTH_HMAC_SHA1_96":      1,
+		"AUTH_HMAC_SHA2_256_128": 2,
+		"AUTH_HMAC_SHA2_384_192": 3,
+		"AUTH_HMAC_SHA2_512_256": 4,
+	}
+)
+
+func (x IKEv2Profile_Transforms_IntegrityAlg) Enum() *IKEv2Profile_Transforms_IntegrityAlg {
+	p := new(IKEv2Profile_Transforms_IntegrityAlg)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Transforms_IntegrityAlg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Transforms_IntegrityAlg) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ikev2_proto_enumTypes[3].Descriptor()
+}
+
+func (IKEv2Profile_Transforms_IntegrityAlg) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ikev2_proto_enumTypes[3]
+}
+
+func (x IKEv2Profile_Transforms_IntegrityAlg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Transforms_IntegrityAlg.Descriptor instead.
+func (IKEv2Profile_Transforms_IntegrityAlg) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 4, 1}
+}
+
+type IKEv2Profile_Transforms_DhGroup int32
+
+const (
+	IKEv2Profile_Transforms_DH_NONE   IKEv2Profile_Transforms_DhGroup = 0
+	IKEv2Profile_Transforms_MODP_1024 IKEv2Profile_Transforms_DhGroup = 1
+	IKEv2Profile_Transforms_MODP_1536 IKEv2Profile_Transforms_DhGroup = 2
+	IKEv2Profile_Transforms_MODP_2048 IKEv2Profile_Transforms_DhGroup = 3
+	IKEv2Profile_Transforms_MODP_3072 IKEv2Profile_Transforms_DhGroup = 4
+	IKEv2Profile_Transforms_MODP_4096 IKEv2Profile_Transforms_DhGroup = 5
+	IKEv2Profile_Transforms_ECP_256   IKEv2Profile_Transforms_DhGroup = 6
+	IKEv2Profile_Transforms_ECP_384   IKEv2Profile_Transforms_DhGroup = 7
+	IKEv2Profile_Transforms_ECP_521   IKEv2Profile_Transforms_DhGroup = 8
+)
+
+// Enum value maps for IKEv2Profile_Transforms_DhGroup.
+var (
+	IKEv2Profile_Transforms_DhGroup_name = map[int32]string{
+		0: "DH_NONE",
+		1: "MODP_1024",
+		2: "MODP_1536",
+		3: "MODP_2048",
+		4: "MODP_3072",
+		5: "MODP_4096",
+		6: "ECP_256",
+		7: "ECP_384",
+		8: "ECP_521",
+	}
+	IKEv2Profile_Transforms_DhGroup_value = map[string]int32{
+		"DH_NONE":   0,
+		"MODP_1024": 1,
+		"MODP_1536": 2,
+		"MODP_2048": 3,
+		"MODP_3072": 4,
+		"MODP_4096": 5,
+		"ECP_256":   6,
+		"ECP_384":   7,
+		"ECP_521":   8,
+	}
+)
+
+func (x IKEv2Profile_Transforms_DhGroup) Enum() *IKEv2Profile_Transforms_DhGroup {
+	p := new(IKEv2Profile_Transforms_DhGroup)
+	*p = x
+	return p
+}
+
+func (x IKEv2Profile_Transforms_DhGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IKEv2Profile_Transforms_DhGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_ipsec_ikev2_proto_enumTypes[4].Descriptor()
+}
+
+func (IKEv2Profile_Transforms_DhGroup) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_ipsec_ikev2_proto_enumTypes[4]
+}
+
+func (x IKEv2Profile_Transforms_DhGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IKEv2Profile_Transforms_DhGroup.Descriptor instead.
+func (IKEv2Profile_Transforms_DhGroup) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 4, 2}
+}
+
+// IKEv2Profile configures automatic negotiation of IPSec security associations
+// using the Internet Key Exchange protocol (version 2).
+type IKEv2Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Unique name of the profile
+	Auth            *IKEv2Profile_Authentication  `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	LocalId         *IKEv2Profile_Identity        `protobuf:"bytes,3,opt,name=local_id,json=localId,proto3" json:"local_id,omitempty"`
+	RemoteId        *IKEv2Profile_Identity        `protobuf:"bytes,4,opt,name=remote_id,json=remoteId,proto3" json:"remote_id,omitempty"`
+	LocalTs         *IKEv2Profile_TrafficSelector `protobuf:"bytes,5,opt,name=local_ts,json=localTs,proto3" json:"local_ts,omitempty"`
+	RemoteTs        *IKEv2Profile_TrafficSelector `protobuf:"bytes,6,opt,name=remote_ts,json=remoteTs,proto3" json:"remote_ts,omitempty"`
+	Responder       *IKEv2Profile_Responder       `protobuf:"bytes,7,opt,name=responder,proto3" json:"responder,omitempty"`
+	IkeTransforms   *IKEv2Profile_Transforms      `protobuf:"bytes,8,opt,name=ike_transforms,json=ikeTransforms,proto3" json:"ike_transforms,omitempty"`
+	EspTransforms   *IKEv2Profile_Transforms      `protobuf:"bytes,9,opt,name=esp_transforms,json=espTransforms,proto3" json:"esp_transforms,omitempty"`
+	Lifetime        uint64                        `protobuf:"varint,10,opt,name=lifetime,proto3" json:"lifetime,omitempty"`                                      // Lifetime of child SAs in seconds, rekeyed before expiration
+	LifetimeJitter  uint32                        `protobuf:"varint,11,opt,name=lifetime_jitter,json=lifetimeJitter,proto3" json:"lifetime_jitter,omitempty"`    // Random jitter (in seconds) added to the lifetime
+	Handover        uint32                        `protobuf:"varint,12,opt,name=handover,proto3" json:"handover,omitempty"`                                      // Time (in seconds) the old child SA is kept after rekey
+	LifetimeMaxdata uint64                        `protobuf:"varint,13,opt,name=lifetime_maxdata,json=lifetimeMaxdata,proto3" json:"lifetime_maxdata,omitempty"` // Rekey after the given amount of bytes, 0 means no limit
+	TunnelInterface string                        `protobuf:"bytes,14,opt,name=tunnel_interface,json=tunnelInterface,proto3" json:"tunnel_interface,omitempty"`  // IPIP tunnel interface protected by the negotiated child SAs
+	UdpEncap        bool                          `protobuf:"varint,15,opt,name=udp_encap,json=udpEncap,proto3" json:"udp_encap,omitempty"`                      // Encapsulate ESP packets in UDP
+	Initiate        bool                          `protobuf:"varint,16,opt,name=initiate,proto3" json:"initiate,omitempty"`                                      // Initiate the IKE negotiation towards the responder
+}
+
+func (x *IKEv2Profile) Reset() {
+	*x = IKEv2Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile) ProtoMessage() {}
+
+func (x *IKEv2Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IKEv2Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IKEv2Profile) GetAuth() *IKEv2Profile_Authentication {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetLocalId() *IKEv2Profile_Identity {
+	if x != nil {
+		return x.LocalId
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetRemoteId() *IKEv2Profile_Identity {
+	if x != nil {
+		return x.RemoteId
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetLocalTs() *IKEv2Profile_TrafficSelector {
+	if x != nil {
+		return x.LocalTs
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetRemoteTs() *IKEv2Profile_TrafficSelector {
+	if x != nil {
+		return x.RemoteTs
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetResponder() *IKEv2Profile_Responder {
+	if x != nil {
+		return x.Responder
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetIkeTransforms() *IKEv2Profile_Transforms {
+	if x != nil {
+		return x.IkeTransforms
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetEspTransforms() *IKEv2Profile_Transforms {
+	if x != nil {
+		return x.EspTransforms
+	}
+	return nil
+}
+
+func (x *IKEv2Profile) GetLifetime() uint64 {
+	if x != nil {
+		return x.Lifetime
+	}
+	return 0
+}
+
+func (x *IKEv2Profile) GetLifetimeJitter() uint32 {
+	if x != nil {
+		return x.LifetimeJitter
+	}
+	return 0
+}
+
+func (x *IKEv2Profile) GetHandover() uint32 {
+	if x != nil {
+		return x.Handover
+	}
+	return 0
+}
+
+func (x *IKEv2Profile) GetLifetimeMaxdata() uint64 {
+	if x != nil {
+		return x.LifetimeMaxdata
+	}
+	return 0
+}
+
+func (x *IKEv2Profile) GetTunnelInterface() string {
+	if x != nil {
+		return x.TunnelInterface
+	}
+	return ""
+}
+
+func (x *IKEv2Profile) GetUdpEncap() bool {
+	if x != nil {
+		return x.UdpEncap
+	}
+	return false
+}
+
+func (x *IKEv2Profile) GetInitiate() bool {
+	if x != nil {
+		return x.Initiate
+	}
+	return false
+}
+
+// IKEv2ProfileState is the state of IKE security associations negotiated using the profile.
+type IKEv2ProfileState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the profile
+	Sas  []*IKEv2ProfileState_SA `protobuf:"bytes,2,rep,name=sas,proto3" json:"sas,omitempty"`
+}
+
+func (x *IKEv2ProfileState) Reset() {
+	*x = IKEv2ProfileState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2ProfileState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2ProfileState) ProtoMessage() {}
+
+func (x *IKEv2ProfileState) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2ProfileState.ProtoReflect.Descriptor instead.
+func (*IKEv2ProfileState) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IKEv2ProfileState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IKEv2ProfileState) GetSas() []*IKEv2ProfileState_SA {
+	if x != nil {
+		return x.Sas
+	}
+	return nil
+}
+
+type IKEv2Profile_Authentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method IKEv2Profile_Authentication_Method `protobuf:"varint,1,opt,name=method,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Authentication_Method" json:"method,omitempty"`
+	Data   string                             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                 // Pre-shared key or path to the certificate file
+	IsHex  bool                               `protobuf:"varint,3,opt,name=is_hex,json=isHex,proto3" json:"is_hex,omitempty"` // Pre-shared key is given in hexadecimal format
+}
+
+func (x *IKEv2Profile_Authentication) Reset() {
+	*x = IKEv2Profile_Authentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Authentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Authentication) ProtoMessage() {}
+
+func (x *IKEv2Profile_Authentication) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Authentication.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Authentication) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *IKEv2Profile_Authentication) GetMethod() IKEv2Profile_Authentication_Method {
+	if x != nil {
+		return x.Method
+	}
+	return IKEv2Profile_Authentication_SHARED_KEY
+}
+
+func (x *IKEv2Profile_Authentication) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_Authentication) GetIsHex() bool {
+	if x != nil {
+		return x.IsHex
+	}
+	return false
+}
+
+type IKEv2Profile_Identity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type IKEv2Profile_Identity_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Identity_Type" json:"type,omitempty"`
+	Data string                     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *IKEv2Profile_Identity) Reset() {
+	*x = IKEv2Profile_Identity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Identity) ProtoMessage() {}
+
+func (x *IKEv2Profile_Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Identity.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Identity) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *IKEv2Profile_Identity) GetType() IKEv2Profile_Identity_Type {
+	if x != nil {
+		return x.Type
+	}
+	return IKEv2Profile_Identity_FQDN
+}
+
+func (x *IKEv2Profile_Identity) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type IKEv2Profile_TrafficSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol  uint32 `protobuf:"varint,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // IP protocol number, 0 matches any protocol
+	StartPort uint32 `protobuf:"varint,2,opt,name=start_port,json=startPort,proto3" json:"start_port,omitempty"`
+	EndPort   uint32 `protobuf:"varint,3,opt,name=end_port,json=endPort,proto3" json:"end_port,omitempty"`
+	StartAddr string `protobuf:"bytes,4,opt,name=start_addr,json=startAddr,proto3" json:"start_addr,omitempty"`
+	EndAddr   string `protobuf:"bytes,5,opt,name=end_addr,json=endAddr,proto3" json:"end_addr,omitempty"`
+}
+
+func (x *IKEv2Profile_TrafficSelector) Reset() {
+	*x = IKEv2Profile_TrafficSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_TrafficSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_TrafficSelector) ProtoMessage() {}
+
+func (x *IKEv2Profile_TrafficSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_TrafficSelector.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_TrafficSelector) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetStartPort() uint32 {
+	if x != nil {
+		return x.StartPort
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetEndPort() uint32 {
+	if x != nil {
+		return x.EndPort
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetStartAddr() string {
+	if x != nil {
+		return x.StartAddr
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_TrafficSelector) GetEndAddr() string {
+	if x != nil {
+		return x.EndAddr
+	}
+	return ""
+}
+
+// Responder is the peer to which the IKE negotiation is initiated.
+type IKEv2Profile_Responder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"` // Interface used to reach the responder
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *IKEv2Profile_Responder) Reset() {
+	*x = IKEv2Profile_Responder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Responder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Responder) ProtoMessage() {}
+
+func (x *IKEv2Profile_Responder) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Responder.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Responder) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *IKEv2Profile_Responder) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *IKEv2Profile_Responder) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type IKEv2Profile_Transforms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CryptoAlg     IKEv2Profile_Transforms_EncryptionAlg `protobuf:"varint,1,opt,name=crypto_alg,json=cryptoAlg,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Transforms_EncryptionAlg" json:"crypto_alg,omitempty"`
+	CryptoKeySize uint32                                `protobuf:"varint,2,opt,name=crypto_key_size,json=cryptoKeySize,proto3" json:"crypto_key_size,omitempty"` // Key size in bits (128, 192 or 256)
+	IntegAlg      IKEv2Profile_Transforms_IntegrityAlg  `protobuf:"varint,3,opt,name=integ_alg,json=integAlg,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Transforms_IntegrityAlg" json:"integ_alg,omitempty"`
+	DhGroup       IKEv2Profile_Transforms_DhGroup       `protobuf:"varint,4,opt,name=dh_group,json=dhGroup,proto3,enum=ligato.vpp.ipsec.IKEv2Profile_Transforms_DhGroup" json:"dh_group,omitempty"` // Diffie-Hellman group (only used for IKE transforms)
+}
+
+func (x *IKEv2Profile_Transforms) Reset() {
+	*x = IKEv2Profile_Transforms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2Profile_Transforms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2Profile_Transforms) ProtoMessage() {}
+
+func (x *IKEv2Profile_Transforms) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2Profile_Transforms.ProtoReflect.Descriptor instead.
+func (*IKEv2Profile_Transforms) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *IKEv2Profile_Transforms) GetCryptoAlg() IKEv2Profile_Transforms_EncryptionAlg {
+	if x != nil {
+		return x.CryptoAlg
+	}
+	return IKEv2Profile_Transforms_ENCR_AES_CBC
+}
+
+func (x *IKEv2Profile_Transforms) GetCryptoKeySize() uint32 {
+	if x != nil {
+		return x.CryptoKeySize
+	}
+	return 0
+}
+
+func (x *IKEv2Profile_Transforms) GetIntegAlg() IKEv2Profile_Transforms_IntegrityAlg {
+	if x != nil {
+		return x.IntegAlg
+	}
+	return IKEv2Profile_Transforms_AUTH_NONE
+}
+
+func (x *IKEv2Profile_Transforms) GetDhGroup() IKEv2Profile_Transforms_DhGroup {
+	if x != nil {
+		return x.DhGroup
+	}
+	return IKEv2Profile_Transforms_DH_NONE
+}
+
+type IKEv2ProfileState_ChildSA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index             uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	InitiatorSpi      uint32 `protobuf:"varint,2,opt,name=initiator_spi,json=initiatorSpi,proto3" json:"initiator_spi,omitempty"`                // SPI of the initiator's inbound ESP SA
+	ResponderSpi      uint32 `protobuf:"varint,3,opt,name=responder_spi,json=responderSpi,proto3" json:"responder_spi,omitempty"`                // SPI of the responder's inbound ESP SA
+	LifetimeRemaining uint64 `protobuf:"varint,4,opt,name=lifetime_remaining,json=lifetimeRemaining,proto3" json:"lifetime_remaining,omitempty"` // Approximate time (in seconds) until rekey, 0 if unknown
+}
+
+func (x *IKEv2ProfileState_ChildSA) Reset() {
+	*x = IKEv2ProfileState_ChildSA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2ProfileState_ChildSA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2ProfileState_ChildSA) ProtoMessage() {}
+
+func (x *IKEv2ProfileState_ChildSA) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2ProfileState_ChildSA.ProtoReflect.Descriptor instead.
+func (*IKEv2ProfileState_ChildSA) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *IKEv2ProfileState_ChildSA) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_ChildSA) GetInitiatorSpi() uint32 {
+	if x != nil {
+		return x.InitiatorSpi
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_ChildSA) GetResponderSpi() uint32 {
+	if x != nil {
+		return x.ResponderSpi
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_ChildSA) GetLifetimeRemaining() uint64 {
+	if x != nil {
+		return x.LifetimeRemaining
+	}
+	return 0
+}
+
+type IKEv2ProfileState_SA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         uint32                       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	InitiatorSpi  uint64                       `protobuf:"varint,2,opt,name=initiator_spi,json=initiatorSpi,proto3" json:"initiator_spi,omitempty"`
+	ResponderSpi  uint64                       `protobuf:"varint,3,opt,name=responder_spi,json=responderSpi,proto3" json:"responder_spi,omitempty"`
+	InitiatorAddr string                       `protobuf:"bytes,4,opt,name=initiator_addr,json=initiatorAddr,proto3" json:"initiator_addr,omitempty"`
+	ResponderAddr string                       `protobuf:"bytes,5,opt,name=responder_addr,json=responderAddr,proto3" json:"responder_addr,omitempty"`
+	RekeyRequests uint32                       `protobuf:"varint,6,opt,name=rekey_requests,json=rekeyRequests,proto3" json:"rekey_requests,omitempty"`
+	Retransmits   uint32                       `protobuf:"varint,7,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	ChildSas      []*IKEv2ProfileState_ChildSA `protobuf:"bytes,8,rep,name=child_sas,json=childSas,proto3" json:"child_sas,omitempty"`
+}
+
+func (x *IKEv2ProfileState_SA) Reset() {
+	*x = IKEv2ProfileState_SA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IKEv2ProfileState_SA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IKEv2ProfileState_SA) ProtoMessage() {}
+
+func (x *IKEv2ProfileState_SA) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_ipsec_ikev2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IKEv2ProfileState_SA.ProtoReflect.Descriptor instead.
+func (*IKEv2ProfileState_SA) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *IKEv2ProfileState_SA) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_SA) GetInitiatorSpi() uint64 {
+	if x != nil {
+		return x.InitiatorSpi
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_SA) GetResponderSpi() uint64 {
+	if x != nil {
+		return x.ResponderSpi
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_SA) GetInitiatorAddr() string {
+	if x != nil {
+		return x.InitiatorAddr
+	}
+	return ""
+}
+
+func (x *IKEv2ProfileState_SA) GetResponderAddr() string {
+	if x != nil {
+		return x.ResponderAddr
+	}
+	return ""
+}
+
+func (x *IKEv2ProfileState_SA) GetRekeyRequests() uint32 {
+	if x != nil {
+		return x.RekeyRequests
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_SA) GetRetransmits() uint32 {
+	if x != nil {
+		return x.Retransmits
+	}
+	return 0
+}
+
+func (x *IKEv2ProfileState_SA) GetChildSas() []*IKEv2ProfileState_ChildSA {
+	if x != nil {
+		return x.ChildSas
+	}
+	return nil
+}
+
+var File_ligato_vpp_ipsec_ikev2_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_ipsec_ikev2_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2f, 0x69, 0x6b, 0x65, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x10, 0x0a, 0x0c, 0x49,
+	0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x41, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x12, 0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x07, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45,
+	0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65,
+	0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b,
+	0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x54, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0e,
+	0x69, 0x6b, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x52,
+	0x0d, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x50,
+	0x0a, 0x0e, 0x65, 0x73, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x52, 0x0d, 0x65, 0x73, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x61,
+	0x78, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x61, 0x78, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x5f, 0x65,
+	0x6e, 0x63, 0x61, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x64, 0x70, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x1a, 0xb0, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x48, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x06,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x53, 0x41, 0x5f, 0x53, 0x49,
+	0x47, 0x10, 0x01, 0x1a, 0xad, 0x01, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65,
+	0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x51, 0x44, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x50, 0x56, 0x34,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x50, 0x56, 0x36, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x46, 0x43, 0x38, 0x32, 0x32,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x45, 0x59, 0x5f, 0x49,
+	0x44, 0x10, 0x04, 0x1a, 0xc5, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff,
+	0xff, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x4a, 0x0a, 0x09, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x8f, 0x05, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x5f, 0x61, 0x6c, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b,
+	0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6c, 0x67, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x26,
+	0x0a, 0x0f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b,
+	0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x53, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f,
+	0x61, 0x6c, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45,
+	0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x41, 0x6c,
+	0x67, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x4c, 0x0a, 0x08, 0x64,
+	0x68, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x44, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x07, 0x64, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x48, 0x0a, 0x0d, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x67, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e,
+	0x43, 0x52, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x43, 0x42, 0x43, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x45, 0x4e, 0x43, 0x52, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x43, 0x54, 0x52, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x45, 0x4e, 0x43, 0x52, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x47, 0x43, 0x4d, 0x5f, 0x31,
+	0x36, 0x10, 0x02, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x41, 0x6c, 0x67, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48, 0x4d, 0x41, 0x43,
+	0x5f, 0x53, 0x48, 0x41, 0x31, 0x5f, 0x39, 0x36, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x5f, 0x32, 0x35, 0x36,
+	0x5f, 0x31, 0x32, 0x38, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48,
+	0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x5f, 0x33, 0x38, 0x34, 0x5f, 0x31, 0x39, 0x32,
+	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f,
+	0x53, 0x48, 0x41, 0x32, 0x5f, 0x35, 0x31, 0x32, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x04, 0x22, 0x88,
+	0x01, 0x0a, 0x07, 0x44, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x48,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f,
+	0x31, 0x30, 0x32, 0x34, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x31,
+	0x35, 0x33, 0x36, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x32, 0x30,
+	0x34, 0x38, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x33, 0x30, 0x37,
+	0x32, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x50, 0x5f, 0x34, 0x30, 0x39, 0x36,
+	0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x43, 0x50, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x06, 0x12,
+	0x0b, 0x0a, 0x07, 0x45, 0x43, 0x50, 0x5f, 0x33, 0x38, 0x34, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x43, 0x50, 0x5f, 0x35, 0x32, 0x31, 0x10, 0x08, 0x22, 0xc4, 0x04, 0x0a, 0x11, 0x49, 0x4b,
+	0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x03, 0x73, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x41, 0x52, 0x03, 0x73, 0x61, 0x73, 0x1a, 0x98, 0x01,
+	0x0a, 0x07, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x41, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x70, 0x69,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x70, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x69, 0x12, 0x2d, 0x0a, 0x12, 0x6c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0xc5, 0x02, 0x0a, 0x02, 0x53, 0x41, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x70, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x70, 0x69, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x73, 0x61, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45,
+	0x76, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x53, 0x41, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x61, 0x73,
+	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69,
+	0x70, 0x73, 0x65, 0x63, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_ipsec_ikev2_proto_rawDescOnce sync.Once
+	file_ligato_vpp_ipsec_ikev2_proto_rawDescData = file_ligato_vpp_ipsec_ikev2_proto_rawDesc
+)
+
+func file_ligato_vpp_ipsec_ikev2_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_ipsec_ikev2_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_ipsec_ikev2_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_ipsec_ikev2_proto_rawDescData)
+	})
+	return file_ligato_vpp_ipsec_ikev2_proto_rawDescData
+}
+
+var file_ligato_vpp_ipsec_ikev2_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_ligato_vpp_ipsec_ikev2_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ligato_vpp_ipsec_ikev2_proto_goTypes = []interface{}{
+	(IKEv2Profile_Authentication_Method)(0),    // 0: ligato.vpp.ipsec.IKEv2Profile.Authentication.Method
+	(IKEv2Profile_Identity_Type)(0),            // 1: ligato.vpp.ipsec.IKEv2Profile.Identity.Type
+	(IKEv2Profile_Transforms_EncryptionAlg)(0), // 2: ligato.vpp.ipsec.IKEv2Profile.Transforms.EncryptionAlg
+	(IKEv2Profile_Transforms_IntegrityAlg)(0),  // 3: ligato.vpp.ipsec.IKEv2Profile.Transforms.IntegrityAlg
+	(IKEv2Profile_Transforms_DhGroup)(0),       // 4: ligato.vpp.ipsec.IKEv2Profile.Transforms.DhGroup
+	(*IKEv2Profile)(nil),                       // 5: ligato.vpp.ipsec.IKEv2Profile
+	(*IKEv2ProfileState)(nil),                  // 6: ligato.vpp.ipsec.IKEv2ProfileState
+	(*IKEv2Profile_Authentication)(nil),        // 7: ligato.vpp.ipsec.IKEv2Profile.Authentication
+	(*IKEv2Profile_Identity)(nil),              // 8: ligato.vpp.ipsec.IKEv2Profile.Identity
+	(*IKEv2Profile_TrafficSelector)(nil),       // 9: ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	(*IKEv2Profile_Responder)(nil),             // 10: ligato.vpp.ipsec.IKEv2Profile.Responder
+	(*IKEv2Profile_Transforms)(nil),            // 11: ligato.vpp.ipsec.IKEv2Profile.Transforms
+	(*IKEv2ProfileState_ChildSA)(nil),          // 12: ligato.vpp.ipsec.IKEv2ProfileState.ChildSA
+	(*IKEv2ProfileState_SA)(nil),               // 13: ligato.vpp.ipsec.IKEv2ProfileState.SA
+}
+var file_ligato_vpp_ipsec_ikev2_proto_depIdxs = []int32{
+	7,  // 0: ligato.vpp.ipsec.IKEv2Profile.auth:type_name -> ligato.vpp.ipsec.IKEv2Profile.Authentication
+	8,  // 1: ligato.vpp.ipsec.IKEv2Profile.local_id:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity
+	8,  // 2: ligato.vpp.ipsec.IKEv2Profile.remote_id:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity
+	9,  // 3: ligato.vpp.ipsec.IKEv2Profile.local_ts:type_name -> ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	9,  // 4: ligato.vpp.ipsec.IKEv2Profile.remote_ts:type_name -> ligato.vpp.ipsec.IKEv2Profile.TrafficSelector
+	10, // 5: ligato.vpp.ipsec.IKEv2Profile.responder:type_name -> ligato.vpp.ipsec.IKEv2Profile.Responder
+	11, // 6: ligato.vpp.ipsec.IKEv2Profile.ike_transforms:type_name -> ligato.vpp.ipsec.IKEv2Profile.Transforms
+	11, // 7: ligato.vpp.ipsec.IKEv2Profile.esp_transforms:type_name -> ligato.vpp.ipsec.IKEv2Profile.Transforms
+	13, // 8: ligato.vpp.ipsec.IKEv2ProfileState.sas:type_name -> ligato.vpp.ipsec.IKEv2ProfileState.SA
+	0,  // 9: ligato.vpp.ipsec.IKEv2Profile.Authentication.method:type_name -> ligato.vpp.ipsec.IKEv2Profile.Authentication.Method
+	1,  // 10: ligato.vpp.ipsec.IKEv2Profile.Identity.type:type_name -> ligato.vpp.ipsec.IKEv2Profile.Identity.Type
+	2,  // 11: ligato.vpp.ipsec.IKEv2Profile.Transforms.crypto_alg:type_name -> ligato.vpp.ipsec.IKEv2Profile.Transforms.EncryptionAlg
+	3,  // 12: ligato.vpp.ipsec.IKEv2Profile.Transforms.integ_alg:type_name -> ligato.vpp.ipsec.IKEv2Profile.Transforms.IntegrityAlg
+	4,  // 13: ligato.vpp.ipsec.IKEv2Profile.Transforms.dh_group:type_name -> ligato.vpp.ipsec.IKEv2Profile.Transforms.DhGroup
+	12, // 14: ligato.vpp.ipsec.IKEv2ProfileState.SA.child_sas:type_name -> ligato.vpp.ipsec.IKEv2ProfileState.ChildSA
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_ipsec_ikev2_proto_init() }
+func file_ligato_vpp_ipsec_ikev2_proto_init() {
+	if File_ligato_vpp_ipsec_ikev2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2ProfileState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Authentication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Identity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_TrafficSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Responder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2Profile_Transforms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2ProfileState_ChildSA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_ipsec_ikev2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IKEv2ProfileState_SA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_ipsec_ikev2_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_ipsec_ikev2_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_ipsec_ikev2_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_ipsec_ikev2_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_ipsec_ikev2_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_ipsec_ikev2_proto = out.File
+	file_ligato_vpp_ipsec_ikev2_proto_rawDesc = nil
+	file_ligato_vpp_ipsec_ikev2_proto_goTypes = nil
+	file_ligato_vpp_ipsec_ikev2_proto_depIdxs = nil
+}
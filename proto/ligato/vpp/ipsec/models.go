@@ -56,6 +56,12 @@ var (
 		`{{.Interface}}`+
 			`{{if .NextHopAddr}}/nh/{{.NextHopAddr}}{{end}}`,
 	))
+
+	ModelIKEv2Profile = models.Register(&IKEv2Profile{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "ikev2-profile",
+	}, models.WithNameTemplate("{{.Name}}"))
 )
 
 // SPDKey returns the key used in NB DB to store the configuration of the
@@ -74,6 +80,20 @@ func SAKey(index uint32) string {
 	})
 }
 
+// IKEv2ProfileKey returns the key used in NB DB to store the configuration of the
+// given IKEv2 profile.
+func IKEv2ProfileKey(name string) string {
+	return models.Key(&IKEv2Profile{
+		Name: name,
+	})
+}
+
+// IKEv2ProfileStateKey returns the key used to publish the state of security
+// associations negotiated using the given IKEv2 profile.
+func IKEv2ProfileStateKey(name string) string {
+	return strings.Replace(IKEv2ProfileKey(name), "config/", "status/", 1)
+}
+
 /* SPD <-> interface binding (derived) */
 const (
 	// spdInterfaceKeyTemplate is a template for (derived) key representing binding
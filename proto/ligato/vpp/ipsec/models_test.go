@@ -280,3 +280,31 @@ func TestParseIPSecSANameFromKey(t *testing.T) {
 		})
 	}
 }*/
+
+func TestIKEv2ProfileStateKey(t *testing.T) {
+	tests := []struct {
+		name             string
+		profile          string
+		expectedKey      string
+		expectedStateKey string
+	}{
+		{
+			name:             "valid profile name",
+			profile:          "site-a",
+			expectedKey:      "config/vpp/ipsec/v2/ikev2-profile/site-a",
+			expectedStateKey: "status/vpp/ipsec/v2/ikev2-profile/site-a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := ipsec.IKEv2ProfileKey(test.profile)
+			if key != test.expectedKey {
+				t.Errorf("expected key: %s\tgot: %s", test.expectedKey, key)
+			}
+			stateKey := ipsec.IKEv2ProfileStateKey(test.profile)
+			if stateKey != test.expectedStateKey {
+				t.Errorf("expected state key: %s\tgot: %s", test.expectedStateKey, stateKey)
+			}
+		})
+	}
+}
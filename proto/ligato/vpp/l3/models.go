@@ -45,6 +45,16 @@ var (
 			`{{if .NextHopAddr}}gw/{{.NextHopAddr}}{{end}}`,
 	))
 
+	ModelMRoute = models.Register(&MRoute{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mroute",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`vrf/{{.VrfId}}/`+
+			`{{with ipnet .Group}}{{printf "grp/%s/%d/" .IP .MaskSize}}{{end}}`+
+			`src/{{if .SourceAddress}}{{ip .SourceAddress}}{{else}}any{{end}}`,
+	))
+
 	ModelProxyARP = models.Register(&ProxyARP{}, models.Spec{
 		Module:  ModuleName,
 		Type:    "proxyarp-global",
@@ -118,6 +128,15 @@ func RouteKey(iface string, vrf uint32, dstNet string, nextHopAddr string) strin
 	})
 }
 
+// MRouteKey returns the key used to store VPP multicast route.
+func MRouteKey(vrf uint32, group string, sourceAddr string) string {
+	return models.Key(&MRoute{
+		VrfId:         vrf,
+		Group:         group,
+		SourceAddress: sourceAddr,
+	})
+}
+
 // ArpEntryKey returns the key to store ARP entry
 func ArpEntryKey(iface, ipAddr string) string {
 	return models.Key(&ARPEntry{
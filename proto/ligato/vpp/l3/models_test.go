@@ -194,3 +194,76 @@ func TestParseRouteKey(t *testing.T) {
 		})
 	}
 }
+
+func TestMRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		mroute      MRoute
+		expectedKey string
+	}{
+		{
+			"mroute-ipv4-any-source",
+			MRoute{
+				VrfId: 0,
+				Group: "232.1.1.1/32",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/232.1.1.1/32/src/any",
+		},
+		{
+			"mroute-ipv4-source",
+			MRoute{
+				VrfId:         2,
+				Group:         "232.1.1.1/32",
+				SourceAddress: "10.0.0.1",
+			},
+			"config/vpp/v2/mroute/vrf/2/grp/232.1.1.1/32/src/10.0.0.1",
+		},
+		{
+			"mroute-ipv4-group-prefix",
+			MRoute{
+				VrfId: 0,
+				Group: "232.1.1.1/8",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/232.0.0.0/8/src/any",
+		},
+		{
+			"mroute-ipv6-source",
+			MRoute{
+				VrfId:         0,
+				Group:         "FF0E::0001/128",
+				SourceAddress: "2001:DB8::0001",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/ff0e::1/128/src/2001:db8::1",
+		},
+		{
+			"mroute-invalid-group",
+			MRoute{
+				VrfId: 0,
+				Group: "INVALID",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/<invalid>/0/src/any",
+		},
+		{
+			"mroute-invalid-source",
+			MRoute{
+				VrfId:         0,
+				Group:         "232.1.1.1/32",
+				SourceAddress: "INVALID",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/232.1.1.1/32/src/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(&test.mroute)
+			if key != test.expectedKey {
+				t.Errorf("failed key for mroute: %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.mroute, test.expectedKey, key)
+			}
+			if mrouteKey := MRouteKey(test.mroute.VrfId, test.mroute.Group, test.mroute.SourceAddress); mrouteKey != key {
+				t.Errorf("MRouteKey returned different key: %q", mrouteKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/l3/mroute.proto
+
+package vpp_l3
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MRoute is a multicast route, i.e. an entry of the multicast FIB (mfib)
+// matching either (S,G) or (*,G).
+type MRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF identifier. Non-zero VRF has to be explicitly created
+	// (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// Multicast group defined by IP address and prefix (format: <address>/<prefix>),
+	// e.g. 232.1.1.1/32 for a single group.
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// Source address of the (S,G) route, leave empty for (*,G) route.
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// Signal packets matching the route to the control plane.
+	Signal bool `protobuf:"varint,4,opt,name=signal,proto3" json:"signal,omitempty"`
+	// Drop all packets matching the route.
+	Drop bool `protobuf:"varint,5,opt,name=drop,proto3" json:"drop,omitempty"`
+	// Signal only packets received from directly connected sources.
+	Connected bool `protobuf:"varint,6,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Accept packets received on any interface (disables RPF check).
+	AcceptAllInterfaces bool `protobuf:"varint,7,opt,name=accept_all_interfaces,json=acceptAllInterfaces,proto3" json:"accept_all_interfaces,omitempty"`
+	// RPF ID to check instead of the accepting interface (e.g. for packets
+	// received from multicast tunnels), zero if not used.
+	RpfId uint32         `protobuf:"varint,8,opt,name=rpf_id,json=rpfId,proto3" json:"rpf_id,omitempty"`
+	Paths []*MRoute_Path `protobuf:"bytes,9,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *MRoute) Reset() {
+	*x = MRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_mroute_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MRoute) ProtoMessage() {}
+
+func (x *MRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_mroute_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MRoute.ProtoReflect.Descriptor instead.
+func (*MRoute) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_mroute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MRoute) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *MRoute) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *MRoute) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *MRoute) GetSignal() bool {
+	if x != nil {
+		return x.Signal
+	}
+	return false
+}
+
+func (x *MRoute) GetDrop() bool {
+	if x != nil {
+		return x.Drop
+	}
+	return false
+}
+
+func (x *MRoute) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *MRoute) GetAcceptAllInterfaces() bool {
+	if x != nil {
+		return x.AcceptAllInterfaces
+	}
+	return false
+}
+
+func (x *MRoute) GetRpfId() uint32 {
+	if x != nil {
+		return x.RpfId
+	}
+	return 0
+}
+
+func (x *MRoute) GetPaths() []*MRoute_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// Path describes how the route treats the given interface.
+// At least one of the flags has to be set.
+type MRoute_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Accept packets of the route received on the interface (RPF interface).
+	Accept bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	// Forward packets of the route out of the interface.
+	Forward bool `protobuf:"varint,3,opt,name=forward,proto3" json:"forward,omitempty"`
+	// Signal packets received on the interface to the control plane.
+	SignalPresent bool `protobuf:"varint,4,opt,name=signal_present,json=signalPresent,proto3" json:"signal_present,omitempty"`
+	// Invert signal flag of the route for the interface.
+	NegateSignal bool `protobuf:"varint,5,opt,name=negate_signal,json=negateSignal,proto3" json:"negate_signal,omitempty"`
+}
+
+func (x *MRoute_Path) Reset() {
+	*x = MRoute_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_mroute_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MRoute_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MRoute_Path) ProtoMessage() {}
+
+func (x *MRoute_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_mroute_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MRoute_Path.ProtoReflect.Descriptor instead.
+func (*MRoute_Path) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_mroute_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MRoute_Path) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *MRoute_Path) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *MRoute_Path) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+func (x *MRoute_Path) GetSignalPresent() bool {
+	if x != nil {
+		return x.SignalPresent
+	}
+	return false
+}
+
+func (x *MRoute_Path) GetNegateSignal() bool {
+	if x != nil {
+		return x.NegateSignal
+	}
+	return false
+}
+
+var File_ligato_vpp_l3_mroute_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_l3_mroute_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f,
+	0x6d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x1a, 0x18, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x03, 0x0a, 0x06, 0x4d, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
+	0x02, 0x08, 0x01, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x70, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x72, 0x70, 0x66, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x1a, 0xa2, 0x01, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42, 0x36,
+	0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b,
+	0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_l3_mroute_proto_rawDescOnce sync.Once
+	file_ligato_vpp_l3_mroute_proto_rawDescData = file_ligato_vpp_l3_mroute_proto_rawDesc
+)
+
+func file_ligato_vpp_l3_mroute_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_l3_mroute_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_l3_mroute_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_l3_mroute_proto_rawDescData)
+	})
+	return file_ligato_vpp_l3_mroute_proto_rawDescData
+}
+
+var file_ligato_vpp_l3_mroute_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ligato_vpp_l3_mroute_proto_goTypes = []interface{}{
+	(*MRoute)(nil),      // 0: ligato.vpp.l3.MRoute
+	(*MRoute_Path)(nil), // 1: ligato.vpp.l3.MRoute.Path
+}
+var file_ligato_vpp_l3_mroute_proto_depIdxs = []int32{
+	1, // 0: ligato.vpp.l3.MRoute.paths:type_name -> ligato.vpp.l3.MRoute.Path
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_l3_mroute_proto_init() }
+func file_ligato_vpp_l3_mroute_proto_init() {
+	if File_ligato_vpp_l3_mroute_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_l3_mroute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_l3_mroute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MRoute_Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_l3_mroute_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_l3_mroute_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_l3_mroute_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_l3_mroute_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_l3_mroute_proto = out.File
+	file_ligato_vpp_l3_mroute_proto_rawDesc = nil
+	file_ligato_vpp_l3_mroute_proto_goTypes = nil
+	file_ligato_vpp_l3_mroute_proto_depIdxs = nil
+}
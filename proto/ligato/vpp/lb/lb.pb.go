@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/lb/lb.proto
+
+package vpp_lb
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VIP_Protocol int32
+
+const (
+	VIP_ANY VIP_Protocol = 0 // all protocols and ports (port has to be zero)
+	VIP_TCP VIP_Protocol = 1
+	VIP_UDP VIP_Protocol = 2
+)
+
+// Enum value maps for VIP_Protocol.
+var (
+	VIP_Protocol_name = map[int32]string{
+		0: "ANY",
+		1: "TCP",
+		2: "UDP",
+	}
+	VIP_Protocol_value = map[string]int32{
+		"ANY": 0,
+		"TCP": 1,
+		"UDP": 2,
+	}
+)
+
+func (x VIP_Protocol) Enum() *VIP_Protocol {
+	p := new(VIP_Protocol)
+	*p = x
+	return p
+}
+
+func (x VIP_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VIP_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_lb_lb_proto_enumTypes[0].Descriptor()
+}
+
+func (VIP_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_lb_lb_proto_enumTypes[0]
+}
+
+func (x VIP_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VIP_Protocol.Descriptor instead.
+func (VIP_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type VIP_Encap int32
+
+const (
+	VIP_GRE4  VIP_Encap = 0
+	VIP_GRE6  VIP_Encap = 1
+	VIP_L3DSR VIP_Encap = 2
+	VIP_NAT4  VIP_Encap = 3
+	VIP_NAT6  VIP_Encap = 4
+)
+
+// Enum value maps for VIP_Encap.
+var (
+	VIP_Encap_name = map[int32]string{
+		0: "GRE4",
+		1: "GRE6",
+		2: "L3DSR",
+		3: "NAT4",
+		4: "NAT6",
+	}
+	VIP_Encap_value = map[string]int32{
+		"GRE4":  0,
+		"GRE6":  1,
+		"L3DSR": 2,
+		"NAT4":  3,
+		"NAT6":  4,
+	}
+)
+
+func (x VIP_Encap) Enum() *VIP_Encap {
+	p := new(VIP_Encap)
+	*p = x
+	return p
+}
+
+func (x VIP_Encap) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VIP_Encap) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_lb_lb_proto_enumTypes[1].Descriptor()
+}
+
+func (VIP_Encap) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_lb_lb_proto_enumTypes[1]
+}
+
+func (x VIP_Encap) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VIP_Encap.Descriptor instead.
+func (VIP_Encap) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type VIP_ServiceType int32
+
+const (
+	VIP_CLUSTERIP VIP_ServiceType = 0
+	VIP_NODEPORT  VIP_ServiceType = 1
+)
+
+// Enum value maps for VIP_ServiceType.
+var (
+	VIP_ServiceType_name = map[int32]string{
+		0: "CLUSTERIP",
+		1: "NODEPORT",
+	}
+	VIP_ServiceType_value = map[string]int32{
+		"CLUSTERIP": 0,
+		"NODEPORT":  1,
+	}
+)
+
+func (x VIP_ServiceType) Enum() *VIP_ServiceType {
+	p := new(VIP_ServiceType)
+	*p = x
+	return p
+}
+
+func (x VIP_ServiceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VIP_ServiceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_lb_lb_proto_enumTypes[2].Descriptor()
+}
+
+func (VIP_ServiceType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_lb_lb_proto_enumTypes[2]
+}
+
+func (x VIP_ServiceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VIP_ServiceType.Descriptor instead.
+func (VIP_ServiceType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{1, 2}
+}
+
+// GlobalConfig is the global configuration of the VPP load-balancer.
+// VPP does not allow to dump the configuration.
+type GlobalConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source address of the GRE4 encapsulated packets.
+	Ip4SrcAddress string `protobuf:"bytes,1,opt,name=ip4_src_address,json=ip4SrcAddress,proto3" json:"ip4_src_address,omitempty"`
+	// Source address of the GRE6 encapsulated packets.
+	Ip6SrcAddress string `protobuf:"bytes,2,opt,name=ip6_src_address,json=ip6SrcAddress,proto3" json:"ip6_src_address,omitempty"`
+	// Number of sticky buckets per core (power of 2, VPP default is used if zero).
+	StickyBucketsPerCore uint32 `protobuf:"varint,3,opt,name=sticky_buckets_per_core,json=stickyBucketsPerCore,proto3" json:"sticky_buckets_per_core,omitempty"`
+	// Time in seconds after which an idle flow is removed from the sticky table
+	// (VPP default is used if zero).
+	FlowTimeout uint32 `protobuf:"varint,4,opt,name=flow_timeout,json=flowTimeout,proto3" json:"flow_timeout,omitempty"`
+}
+
+func (x *GlobalConfig) Reset() {
+	*x = GlobalConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_lb_lb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GlobalConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalConfig) ProtoMessage() {}
+
+func (x *GlobalConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_lb_lb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalConfig.ProtoReflect.Descriptor instead.
+func (*GlobalConfig) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GlobalConfig) GetIp4SrcAddress() string {
+	if x != nil {
+		return x.Ip4SrcAddress
+	}
+	return ""
+}
+
+func (x *GlobalConfig) GetIp6SrcAddress() string {
+	if x != nil {
+		return x.Ip6SrcAddress
+	}
+	return ""
+}
+
+func (x *GlobalConfig) GetStickyBucketsPerCore() uint32 {
+	if x != nil {
+		return x.StickyBucketsPerCore
+	}
+	return 0
+}
+
+func (x *GlobalConfig) GetFlowTimeout() uint32 {
+	if x != nil {
+		return x.FlowTimeout
+	}
+	return 0
+}
+
+// VIP is a virtual IP (prefix) load-balanced across a set of application servers
+// using Maglev consistent hashing. VPP identifies a VIP by prefix, protocol and port.
+type VIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Virtual IP prefix (e.g. "10.96.0.10/32").
+	Prefix   string       `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Protocol VIP_Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=ligato.vpp.lb.VIP_Protocol" json:"protocol,omitempty"`
+	// Destination port of the VIP (used with TCP and UDP).
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Encapsulation of the packets forwarded to application servers.
+	Encap VIP_Encap `protobuf:"varint,4,opt,name=encap,proto3,enum=ligato.vpp.lb.VIP_Encap" json:"encap,omitempty"`
+	// DSCP bits used to mark the packets (L3DSR only).
+	Dscp uint32 `protobuf:"varint,5,opt,name=dscp,proto3" json:"dscp,omitempty"`
+	// Type of the service (NAT4 and NAT6 only).
+	ServiceType VIP_ServiceType `protobuf:"varint,6,opt,name=service_type,json=serviceType,proto3,enum=ligato.vpp.lb.VIP_ServiceType" json:"service_type,omitempty"`
+	// Port of the application servers (NAT4 and NAT6 only).
+	TargetPort uint32 `protobuf:"varint,7,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	// Node port of the service (NAT4 and NAT6 with NODEPORT only).
+	NodePort uint32 `protobuf:"varint,8,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	// Length of the new flows table (power of 2, VPP default is used if zero).
+	NewFlowsTableLength uint32 `protobuf:"varint,9,opt,name=new_flows_table_length,json=newFlowsTableLength,proto3" json:"new_flows_table_length,omitempty"`
+	// Application servers are configured as derived values, therefore adding or removing
+	// a server does not re-create the VIP.
+	ApplicationServers []*VIP_ApplicationServer `protobuf:"bytes,10,rep,name=application_servers,json=applicationServers,proto3" json:"application_servers,omitempty"`
+}
+
+func (x *VIP) Reset() {
+	*x = VIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_lb_lb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VIP) ProtoMessage() {}
+
+func (x *VIP) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_lb_lb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VIP.ProtoReflect.Descriptor instead.
+func (*VIP) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VIP) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *VIP) GetProtocol() VIP_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return VIP_ANY
+}
+
+func (x *VIP) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *VIP) GetEncap() VIP_Encap {
+	if x != nil {
+		return x.Encap
+	}
+	return VIP_GRE4
+}
+
+func (x *VIP) GetDscp() uint32 {
+	if x != nil {
+		return x.Dscp
+	}
+	return 0
+}
+
+func (x *VIP) GetServiceType() VIP_ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return VIP_CLUSTERIP
+}
+
+func (x *VIP) GetTargetPort() uint32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *VIP) GetNodePort() uint32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+func (x *VIP) GetNewFlowsTableLength() uint32 {
+	if x != nil {
+		return x.NewFlowsTableLength
+	}
+	return 0
+}
+
+func (x *VIP) GetApplicationServers() []*VIP_ApplicationServer {
+	if x != nil {
+		return x.ApplicationServers
+	}
+	return nil
+}
+
+// ApplicationServer is a backend of the VIP.
+type VIP_ApplicationServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP address of the application server.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *VIP_ApplicationServer) Reset() {
+	*x = VIP_ApplicationServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_lb_lb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VIP_ApplicationServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VIP_ApplicationServer) ProtoMessage() {}
+
+func (x *VIP_ApplicationServer) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_lb_lb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VIP_ApplicationServer.ProtoReflect.Descriptor instead.
+func (*VIP_ApplicationServer) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_lb_lb_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *VIP_ApplicationServer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+var File_ligato_vpp_lb_lb_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_lb_lb_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x62, 0x2f,
+	0x6c, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xc6, 0x01, 0x0a, 0x0c, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2d, 0x0a, 0x0f, 0x69, 0x70, 0x34, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x02, 0x52, 0x0d, 0x69, 0x70, 0x34, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2d, 0x0a, 0x0f, 0x69, 0x70, 0x36, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x03, 0x52, 0x0d, 0x69, 0x70, 0x36, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x35, 0x0a, 0x17, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x14, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66,
+	0x6c, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xb1, 0x05, 0x0a, 0x03, 0x56,
+	0x49, 0x50, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x6c, 0x62, 0x2e, 0x56, 0x49, 0x50, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10,
+	0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x63,
+	0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x2e, 0x56, 0x49, 0x50, 0x2e, 0x45, 0x6e, 0x63,
+	0x61, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x73, 0x63,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x82, 0x7d, 0x04, 0x12, 0x02, 0x10, 0x3f,
+	0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x2e, 0x56, 0x49, 0x50,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
+	0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10,
+	0xff, 0xff, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a,
+	0x16, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e,
+	0x65, 0x77, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x55, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x2e,
+	0x56, 0x49, 0x50, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x1a, 0x34, 0x0a, 0x11, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x25, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x4e, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x22, 0x3a, 0x0a, 0x05, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x12,
+	0x08, 0x0a, 0x04, 0x47, 0x52, 0x45, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52, 0x45,
+	0x36, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x33, 0x44, 0x53, 0x52, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x41, 0x54, 0x34, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x41, 0x54, 0x36,
+	0x10, 0x04, 0x22, 0x2a, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x49, 0x50, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x44, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x42, 0x36,
+	0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x62, 0x3b,
+	0x76, 0x70, 0x70, 0x5f, 0x6c, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_lb_lb_proto_rawDescOnce sync.Once
+	file_ligato_vpp_lb_lb_proto_rawDescData = file_ligato_vpp_lb_lb_proto_rawDesc
+)
+
+func file_ligato_vpp_lb_lb_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_lb_lb_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_lb_lb_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_lb_lb_proto_rawDescData)
+	})
+	return file_ligato_vpp_lb_lb_proto_rawDescData
+}
+
+var file_ligato_vpp_lb_lb_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_vpp_lb_lb_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_lb_lb_proto_goTypes = []interface{}{
+	(VIP_Protocol)(0),             // 0: ligato.vpp.lb.VIP.Protocol
+	(VIP_Encap)(0),                // 1: ligato.vpp.lb.VIP.Encap
+	(VIP_ServiceType)(0),          // 2: ligato.vpp.lb.VIP.ServiceType
+	(*GlobalConfig)(nil),          // 3: ligato.vpp.lb.GlobalConfig
+	(*VIP)(nil),                   // 4: ligato.vpp.lb.VIP
+	(*VIP_ApplicationServer)(nil), // 5: ligato.vpp.lb.VIP.ApplicationServer
+}
+var file_ligato_vpp_lb_lb_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.lb.VIP.protocol:type_name -> ligato.vpp.lb.VIP.Protocol
+	1, // 1: ligato.vpp.lb.VIP.encap:type_name -> ligato.vpp.lb.VIP.Encap
+	2, // 2: ligato.vpp.lb.VIP.service_type:type_name -> ligato.vpp.lb.VIP.ServiceType
+	5, // 3: ligato.vpp.lb.VIP.application_servers:type_name -> ligato.vpp.lb.VIP.ApplicationServer
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_lb_lb_proto_init() }
+func file_ligato_vpp_lb_lb_proto_init() {
+	if File_ligato_vpp_lb_lb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_lb_lb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GlobalConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_lb_lb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_lb_lb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VIP_ApplicationServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_lb_lb_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_lb_lb_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_lb_lb_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_lb_lb_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_lb_lb_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_lb_lb_proto = out.File
+	file_ligato_vpp_lb_lb_proto_rawDesc = nil
+	file_ligato_vpp_lb_lb_proto_goTypes = nil
+	file_ligato_vpp_lb_lb_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_lb
+
+import (
+	"strconv"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.lb"
+
+var (
+	ModelGlobalConfig = models.Register(&GlobalConfig{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "global",
+		Version: "v1",
+	})
+
+	ModelVIP = models.Register(&VIP{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "vip",
+		Version: "v1",
+	}, models.WithNameTemplate(
+		`{{with ipnet .Prefix}}{{printf "%s/%d" .IP .MaskSize}}{{else}}{{.Prefix}}{{end}}`+
+			`/protocol/{{.Protocol}}/port/{{.Port}}`,
+	))
+)
+
+// GlobalConfigKey returns the key used in NB DB to store the global configuration
+// of the load-balancer.
+func GlobalConfigKey() string {
+	return models.Key(&GlobalConfig{})
+}
+
+// VIPKey returns the key used in NB DB to store the configuration of the given VIP.
+func VIPKey(prefix string, protocol VIP_Protocol, port uint32) string {
+	return models.Key(&VIP{
+		Prefix:   prefix,
+		Protocol: protocol,
+		Port:     port,
+	})
+}
+
+const (
+	// vipApplicationServerTemplate is a template for key representing application
+	// server of the VIP (derived value).
+	vipApplicationServerTemplate = "vpp/lb/vip/{vip}/as/{address}"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// VIPApplicationServerKey returns key representing application server of the VIP.
+func VIPApplicationServerKey(vip *VIP, address string) string {
+	if address == "" {
+		address = InvalidKeyPart
+	}
+	key := vipApplicationServerTemplate
+	key = strings.Replace(key, "{vip}", models.Name(vip), 1)
+	key = strings.Replace(key, "{address}", address, 1)
+	return key
+}
+
+// ParseVIPApplicationServerKey parses key representing application server of the VIP.
+// Returned VIP has only prefix, protocol and port defined.
+func ParseVIPApplicationServerKey(key string) (vip *VIP, address string, isVIPApplicationServerKey bool) {
+	const prefix = "vpp/lb/vip/"
+	if !strings.HasPrefix(key, prefix) {
+		return nil, "", false
+	}
+	asIdx := strings.LastIndex(key, "/as/")
+	if asIdx < len(prefix) {
+		return nil, "", false
+	}
+	address = key[asIdx+len("/as/"):]
+	if address == "" || strings.Contains(address, "/") {
+		return nil, "", false
+	}
+	// VIP name: <ip>/<mask>/protocol/<protocol>/port/<port>
+	parts := strings.Split(key[len(prefix):asIdx], "/")
+	if len(parts) != 6 || parts[2] != "protocol" || parts[4] != "port" {
+		return nil, "", false
+	}
+	protocol, ok := VIP_Protocol_value[parts[3]]
+	if !ok {
+		return nil, "", false
+	}
+	port, err := strconv.ParseUint(parts[5], 10, 16)
+	if err != nil {
+		return nil, "", false
+	}
+	return &VIP{
+		Prefix:   parts[0] + "/" + parts[1],
+		Protocol: VIP_Protocol(protocol),
+		Port:     uint32(port),
+	}, address, true
+}
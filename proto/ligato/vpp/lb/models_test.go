@@ -0,0 +1,147 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp_lb_test
+
+import (
+	"testing"
+
+	vpp_lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+func TestGlobalConfigKey(t *testing.T) {
+	key := vpp_lb.GlobalConfigKey()
+	if expected := "config/vpp/lb/v1/global"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestVIPKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		protocol    vpp_lb.VIP_Protocol
+		port        uint32
+		expectedKey string
+	}{
+		{
+			name:        "IPv4 TCP",
+			prefix:      "10.96.0.10/32",
+			protocol:    vpp_lb.VIP_TCP,
+			port:        80,
+			expectedKey: "config/vpp/lb/v1/vip/10.96.0.10/32/protocol/TCP/port/80",
+		},
+		{
+			name:        "IPv6 any protocol",
+			prefix:      "2001:db8::/64",
+			expectedKey: "config/vpp/lb/v1/vip/2001:db8::/64/protocol/ANY/port/0",
+		},
+		{
+			name:        "invalid prefix",
+			prefix:      "invalid",
+			protocol:    vpp_lb.VIP_UDP,
+			port:        53,
+			expectedKey: "config/vpp/lb/v1/vip/<invalid>/0/protocol/UDP/port/53",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := vpp_lb.VIPKey(test.prefix, test.protocol, test.port)
+			if key != test.expectedKey {
+				t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestVIPApplicationServerKey(t *testing.T) {
+	vip := &vpp_lb.VIP{
+		Prefix:   "10.96.0.10/32",
+		Protocol: vpp_lb.VIP_TCP,
+		Port:     80,
+	}
+	key := vpp_lb.VIPApplicationServerKey(vip, "10.1.1.1")
+	if expected := "vpp/lb/vip/10.96.0.10/32/protocol/TCP/port/80/as/10.1.1.1"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+	key = vpp_lb.VIPApplicationServerKey(vip, "")
+	if expected := "vpp/lb/vip/10.96.0.10/32/protocol/TCP/port/80/as/<invalid>"; key != expected {
+		t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", expected, key)
+	}
+}
+
+func TestParseVIPApplicationServerKey(t *testing.T) {
+	tests := []struct {
+		name            string
+		key             string
+		expectedVIP     *vpp_lb.VIP
+		expectedAddress string
+		expectedIsKey   bool
+	}{
+		{
+			name: "IPv4",
+			key:  "vpp/lb/vip/10.96.0.10/32/protocol/TCP/port/80/as/10.1.1.1",
+			expectedVIP: &vpp_lb.VIP{
+				Prefix:   "10.96.0.10/32",
+				Protocol: vpp_lb.VIP_TCP,
+				Port:     80,
+			},
+			expectedAddress: "10.1.1.1",
+			expectedIsKey:   true,
+		},
+		{
+			name: "IPv6",
+			key:  "vpp/lb/vip/2001:db8::/64/protocol/ANY/port/0/as/2001:db8:1::1",
+			expectedVIP: &vpp_lb.VIP{
+				Prefix: "2001:db8::/64",
+			},
+			expectedAddress: "2001:db8:1::1",
+			expectedIsKey:   true,
+		},
+		{
+			name: "invalid protocol",
+			key:  "vpp/lb/vip/10.96.0.10/32/protocol/SCTP/port/80/as/10.1.1.1",
+		},
+		{
+			name: "invalid port",
+			key:  "vpp/lb/vip/10.96.0.10/32/protocol/TCP/port/x/as/10.1.1.1",
+		},
+		{
+			name: "missing address",
+			key:  "vpp/lb/vip/10.96.0.10/32/protocol/TCP/port/80/as/",
+		},
+		{
+			name: "VIP key",
+			key:  "config/vpp/lb/v1/vip/10.96.0.10/32/protocol/TCP/port/80",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vip, address, isKey := vpp_lb.ParseVIPApplicationServerKey(test.key)
+			if isKey != test.expectedIsKey {
+				t.Fatalf("expected isKey: %v\tgot: %v", test.expectedIsKey, isKey)
+			}
+			if !isKey {
+				return
+			}
+			if address != test.expectedAddress {
+				t.Errorf("expected address: %s\tgot: %s", test.expectedAddress, address)
+			}
+			if vip.Prefix != test.expectedVIP.Prefix || vip.Protocol != test.expectedVIP.Protocol ||
+				vip.Port != test.expectedVIP.Port {
+				t.Errorf("expected VIP: %v\tgot: %v", test.expectedVIP, vip)
+			}
+		})
+	}
+}
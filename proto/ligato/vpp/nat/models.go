@@ -57,6 +57,34 @@ var (
 			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}"+
 			"{{end}}",
 	))
+
+	ModelNat64Global = models.Register(&Nat64Global{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-global",
+		Version: "v2",
+	})
+
+	ModelNat64IPv6Prefix = models.Register(&Nat64IPv6Prefix{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-prefix",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}"))
+
+	ModelNat64Interface = models.Register(&Nat64Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat64AddressPool = models.Register(&Nat64AddressPool{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-pool",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}"+
+			"/address/{{.FirstIp}}"+
+			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}",
+	))
 )
 
 // GlobalNAT44Key returns key for Nat44Global.
@@ -80,6 +108,19 @@ func Nat44InterfaceKey(name string) string {
 	})
 }
 
+// GlobalNAT64Key returns key for Nat64Global.
+func GlobalNAT64Key() string {
+	return models.Key(&Nat64Global{})
+}
+
+// Nat64InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT64 interface.
+func Nat64InterfaceKey(name string) string {
+	return models.Key(&Nat64Interface{
+		Name: name,
+	})
+}
+
 /* NAT44 mode (derived) */
 
 const (
@@ -0,0 +1,946 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/nat/state.proto
+
+package vpp_nat
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Nat44User is an inside address with active NAT44 sessions.
+type Nat44User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress      string `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                 // inside IPv4 address of the user
+	VrfId          uint32 `protobuf:"varint,2,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`                            // inside VRF of the user
+	Sessions       uint32 `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`                                   // number of dynamic sessions
+	StaticSessions uint32 `protobuf:"varint,4,opt,name=static_sessions,json=staticSessions,proto3" json:"static_sessions,omitempty"` // number of sessions created for static mappings
+}
+
+func (x *Nat44User) Reset() {
+	*x = Nat44User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat44User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat44User) ProtoMessage() {}
+
+func (x *Nat44User) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat44User.ProtoReflect.Descriptor instead.
+func (*Nat44User) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Nat44User) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Nat44User) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat44User) GetSessions() uint32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *Nat44User) GetStaticSessions() uint32 {
+	if x != nil {
+		return x.StaticSessions
+	}
+	return 0
+}
+
+// Nat44Session is an active NAT44 session.
+type Nat44Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InsideIpAddress   string          `protobuf:"bytes,1,opt,name=inside_ip_address,json=insideIpAddress,proto3" json:"inside_ip_address,omitempty"`
+	InsidePort        uint32          `protobuf:"varint,2,opt,name=inside_port,json=insidePort,proto3" json:"inside_port,omitempty"` // port (or ICMP identifier) on the inside
+	OutsideIpAddress  string          `protobuf:"bytes,3,opt,name=outside_ip_address,json=outsideIpAddress,proto3" json:"outside_ip_address,omitempty"`
+	OutsidePort       uint32          `protobuf:"varint,4,opt,name=outside_port,json=outsidePort,proto3" json:"outside_port,omitempty"` // port (or ICMP identifier) on the outside
+	Protocol          DNat44_Protocol `protobuf:"varint,5,opt,name=protocol,proto3,enum=ligato.vpp.nat.DNat44_Protocol" json:"protocol,omitempty"`
+	VrfId             uint32          `protobuf:"varint,6,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`                             // inside VRF of the session
+	ExtHostAddress    string          `protobuf:"bytes,7,opt,name=ext_host_address,json=extHostAddress,proto3" json:"ext_host_address,omitempty"` // address of the external host (endpoint-dependent mode only)
+	ExtHostPort       uint32          `protobuf:"varint,8,opt,name=ext_host_port,json=extHostPort,proto3" json:"ext_host_port,omitempty"`
+	ExtHostNatAddress string          `protobuf:"bytes,9,opt,name=ext_host_nat_address,json=extHostNatAddress,proto3" json:"ext_host_nat_address,omitempty"` // translated address of the external host (twice-NAT only)
+	ExtHostNatPort    uint32          `protobuf:"varint,10,opt,name=ext_host_nat_port,json=extHostNatPort,proto3" json:"ext_host_nat_port,omitempty"`
+	IsStatic          bool            `protobuf:"varint,11,opt,name=is_static,json=isStatic,proto3" json:"is_static,omitempty"` // session created for a static mapping
+	IsTwiceNat        bool            `protobuf:"varint,12,opt,name=is_twice_nat,json=isTwiceNat,proto3" json:"is_twice_nat,omitempty"`
+	LastHeard         uint64          `protobuf:"varint,13,opt,name=last_heard,json=lastHeard,proto3" json:"last_heard,omitempty"` // time of the last packet (seconds since VPP start)
+	TotalBytes        uint64          `protobuf:"varint,14,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	TotalPackets      uint32          `protobuf:"varint,15,opt,name=total_packets,json=totalPackets,proto3" json:"total_packets,omitempty"`
+}
+
+func (x *Nat44Session) Reset() {
+	*x = Nat44Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat44Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat44Session) ProtoMessage() {}
+
+func (x *Nat44Session) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat44Session.ProtoReflect.Descriptor instead.
+func (*Nat44Session) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Nat44Session) GetInsideIpAddress() string {
+	if x != nil {
+		return x.InsideIpAddress
+	}
+	return ""
+}
+
+func (x *Nat44Session) GetInsidePort() uint32 {
+	if x != nil {
+		return x.InsidePort
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetOutsideIpAddress() string {
+	if x != nil {
+		return x.OutsideIpAddress
+	}
+	return ""
+}
+
+func (x *Nat44Session) GetOutsidePort() uint32 {
+	if x != nil {
+		return x.OutsidePort
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetProtocol() DNat44_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return DNat44_TCP
+}
+
+func (x *Nat44Session) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetExtHostAddress() string {
+	if x != nil {
+		return x.ExtHostAddress
+	}
+	return ""
+}
+
+func (x *Nat44Session) GetExtHostPort() uint32 {
+	if x != nil {
+		return x.ExtHostPort
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetExtHostNatAddress() string {
+	if x != nil {
+		return x.ExtHostNatAddress
+	}
+	return ""
+}
+
+func (x *Nat44Session) GetExtHostNatPort() uint32 {
+	if x != nil {
+		return x.ExtHostNatPort
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetIsStatic() bool {
+	if x != nil {
+		return x.IsStatic
+	}
+	return false
+}
+
+func (x *Nat44Session) GetIsTwiceNat() bool {
+	if x != nil {
+		return x.IsTwiceNat
+	}
+	return false
+}
+
+func (x *Nat44Session) GetLastHeard() uint64 {
+	if x != nil {
+		return x.LastHeard
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *Nat44Session) GetTotalPackets() uint32 {
+	if x != nil {
+		return x.TotalPackets
+	}
+	return 0
+}
+
+// Nat44SessionFilter selects NAT44 sessions, unset fields match any value.
+type Nat44SessionFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InsideIpAddress  string          `protobuf:"bytes,1,opt,name=inside_ip_address,json=insideIpAddress,proto3" json:"inside_ip_address,omitempty"`
+	InsidePort       uint32          `protobuf:"varint,2,opt,name=inside_port,json=insidePort,proto3" json:"inside_port,omitempty"`
+	OutsideIpAddress string          `protobuf:"bytes,3,opt,name=outside_ip_address,json=outsideIpAddress,proto3" json:"outside_ip_address,omitempty"`
+	OutsidePort      uint32          `protobuf:"varint,4,opt,name=outside_port,json=outsidePort,proto3" json:"outside_port,omitempty"`
+	Protocol         DNat44_Protocol `protobuf:"varint,5,opt,name=protocol,proto3,enum=ligato.vpp.nat.DNat44_Protocol" json:"protocol,omitempty"`
+	MatchProtocol    bool            `protobuf:"varint,6,opt,name=match_protocol,json=matchProtocol,proto3" json:"match_protocol,omitempty"` // protocol is matched only if enabled (TCP is the zero value)
+	VrfId            uint32          `protobuf:"varint,7,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	MatchVrf         bool            `protobuf:"varint,8,opt,name=match_vrf,json=matchVrf,proto3" json:"match_vrf,omitempty"` // VRF is matched only if enabled (0 is the default VRF)
+}
+
+func (x *Nat44SessionFilter) Reset() {
+	*x = Nat44SessionFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat44SessionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat44SessionFilter) ProtoMessage() {}
+
+func (x *Nat44SessionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat44SessionFilter.ProtoReflect.Descriptor instead.
+func (*Nat44SessionFilter) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Nat44SessionFilter) GetInsideIpAddress() string {
+	if x != nil {
+		return x.InsideIpAddress
+	}
+	return ""
+}
+
+func (x *Nat44SessionFilter) GetInsidePort() uint32 {
+	if x != nil {
+		return x.InsidePort
+	}
+	return 0
+}
+
+func (x *Nat44SessionFilter) GetOutsideIpAddress() string {
+	if x != nil {
+		return x.OutsideIpAddress
+	}
+	return ""
+}
+
+func (x *Nat44SessionFilter) GetOutsidePort() uint32 {
+	if x != nil {
+		return x.OutsidePort
+	}
+	return 0
+}
+
+func (x *Nat44SessionFilter) GetProtocol() DNat44_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return DNat44_TCP
+}
+
+func (x *Nat44SessionFilter) GetMatchProtocol() bool {
+	if x != nil {
+		return x.MatchProtocol
+	}
+	return false
+}
+
+func (x *Nat44SessionFilter) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat44SessionFilter) GetMatchVrf() bool {
+	if x != nil {
+		return x.MatchVrf
+	}
+	return false
+}
+
+type DumpUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DumpUsersRequest) Reset() {
+	*x = DumpUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpUsersRequest) ProtoMessage() {}
+
+func (x *DumpUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpUsersRequest.ProtoReflect.Descriptor instead.
+func (*DumpUsersRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{3}
+}
+
+type DumpUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*Nat44User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *DumpUsersResponse) Reset() {
+	*x = DumpUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpUsersResponse) ProtoMessage() {}
+
+func (x *DumpUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpUsersResponse.ProtoReflect.Descriptor instead.
+func (*DumpUsersResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DumpUsersResponse) GetUsers() []*Nat44User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DumpSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Nat44SessionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *DumpSessionsRequest) Reset() {
+	*x = DumpSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpSessionsRequest) ProtoMessage() {}
+
+func (x *DumpSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpSessionsRequest.ProtoReflect.Descriptor instead.
+func (*DumpSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DumpSessionsRequest) GetFilter() *Nat44SessionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type DumpSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Nat44Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *DumpSessionsResponse) Reset() {
+	*x = DumpSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DumpSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DumpSessionsResponse) ProtoMessage() {}
+
+func (x *DumpSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DumpSessionsResponse.ProtoReflect.Descriptor instead.
+func (*DumpSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DumpSessionsResponse) GetSessions() []*Nat44Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type DeleteSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *Nat44SessionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	All    bool                `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // delete all sessions (required if the filter is empty)
+}
+
+func (x *DeleteSessionsRequest) Reset() {
+	*x = DeleteSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionsRequest) ProtoMessage() {}
+
+func (x *DeleteSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteSessionsRequest) GetFilter() *Nat44SessionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DeleteSessionsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type DeleteSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted []*Nat44Session `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"` // sessions deleted by the request
+}
+
+func (x *DeleteSessionsResponse) Reset() {
+	*x = DeleteSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_state_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionsResponse) ProtoMessage() {}
+
+func (x *DeleteSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_state_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteSessionsResponse) GetDeleted() []*Nat44Session {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+var File_ligato_vpp_nat_state_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_nat_state_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x1a, 0x18, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x01, 0x0a, 0x09, 0x4e, 0x61, 0x74, 0x34, 0x34,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xce, 0x04, 0x0a, 0x0c, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x73,
+	0x69, 0x64, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x73, 0x69,
+	0x64, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3b,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61,
+	0x74, 0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x76,
+	0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x2f, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x65, 0x78, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x29, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f,
+	0x74, 0x77, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x54, 0x77, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0xca, 0x02, 0x0a, 0x12, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f,
+	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x76, 0x72, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x56, 0x72, 0x66, 0x22, 0x12, 0x0a,
+	0x10, 0x44, 0x75, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x75, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x44, 0x75, 0x6d, 0x70, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
+	0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x14, 0x44, 0x75,
+	0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x65, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
+	0x4e, 0x61, 0x74, 0x34, 0x34, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x32, 0xa1, 0x02, 0x0a, 0x11, 0x4e, 0x61, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x44,
+	0x75, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x75, 0x6d, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a,
+	0x0c, 0x44, 0x75, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44,
+	0x75, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6e, 0x61, 0x74, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e,
+	0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70, 0x70, 0x5f,
+	0x6e, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_nat_state_proto_rawDescOnce sync.Once
+	file_ligato_vpp_nat_state_proto_rawDescData = file_ligato_vpp_nat_state_proto_rawDesc
+)
+
+func file_ligato_vpp_nat_state_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_nat_state_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_nat_state_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_nat_state_proto_rawDescData)
+	})
+	return file_ligato_vpp_nat_state_proto_rawDescData
+}
+
+var file_ligato_vpp_nat_state_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ligato_vpp_nat_state_proto_goTypes = []interface{}{
+	(*Nat44User)(nil),              // 0: ligato.vpp.nat.Nat44User
+	(*Nat44Session)(nil),           // 1: ligato.vpp.nat.Nat44Session
+	(*Nat44SessionFilter)(nil),     // 2: ligato.vpp.nat.Nat44SessionFilter
+	(*DumpUsersRequest)(nil),       // 3: ligato.vpp.nat.DumpUsersRequest
+	(*DumpUsersResponse)(nil),      // 4: ligato.vpp.nat.DumpUsersResponse
+	(*DumpSessionsRequest)(nil),    // 5: ligato.vpp.nat.DumpSessionsRequest
+	(*DumpSessionsResponse)(nil),   // 6: ligato.vpp.nat.DumpSessionsResponse
+	(*DeleteSessionsRequest)(nil),  // 7: ligato.vpp.nat.DeleteSessionsRequest
+	(*DeleteSessionsResponse)(nil), // 8: ligato.vpp.nat.DeleteSessionsResponse
+	(DNat44_Protocol)(0),           // 9: ligato.vpp.nat.DNat44.Protocol
+}
+var file_ligato_vpp_nat_state_proto_depIdxs = []int32{
+	9,  // 0: ligato.vpp.nat.Nat44Session.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	9,  // 1: ligato.vpp.nat.Nat44SessionFilter.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	0,  // 2: ligato.vpp.nat.DumpUsersResponse.users:type_name -> ligato.vpp.nat.Nat44User
+	2,  // 3: ligato.vpp.nat.DumpSessionsRequest.filter:type_name -> ligato.vpp.nat.Nat44SessionFilter
+	1,  // 4: ligato.vpp.nat.DumpSessionsResponse.sessions:type_name -> ligato.vpp.nat.Nat44Session
+	2,  // 5: ligato.vpp.nat.DeleteSessionsRequest.filter:type_name -> ligato.vpp.nat.Nat44SessionFilter
+	1,  // 6: ligato.vpp.nat.DeleteSessionsResponse.deleted:type_name -> ligato.vpp.nat.Nat44Session
+	3,  // 7: ligato.vpp.nat.NatSessionService.DumpUsers:input_type -> ligato.vpp.nat.DumpUsersRequest
+	5,  // 8: ligato.vpp.nat.NatSessionService.DumpSessions:input_type -> ligato.vpp.nat.DumpSessionsRequest
+	7,  // 9: ligato.vpp.nat.NatSessionService.DeleteSessions:input_type -> ligato.vpp.nat.DeleteSessionsRequest
+	4,  // 10: ligato.vpp.nat.NatSessionService.DumpUsers:output_type -> ligato.vpp.nat.DumpUsersResponse
+	6,  // 11: ligato.vpp.nat.NatSessionService.DumpSessions:output_type -> ligato.vpp.nat.DumpSessionsResponse
+	8,  // 12: ligato.vpp.nat.NatSessionService.DeleteSessions:output_type -> ligato.vpp.nat.DeleteSessionsResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_nat_state_proto_init() }
+func file_ligato_vpp_nat_state_proto_init() {
+	if File_ligato_vpp_nat_state_proto != nil {
+		return
+	}
+	file_ligato_vpp_nat_nat_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_nat_state_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat44User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat44Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat44SessionFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DumpSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_nat_state_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ligato_vpp_nat_state_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_nat_state_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_nat_state_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_nat_state_proto = out.File
+	file_ligato_vpp_nat_state_proto_rawDesc = nil
+	file_ligato_vpp_nat_state_proto_goTypes = nil
+	file_ligato_vpp_nat_state_proto_depIdxs = nil
+}
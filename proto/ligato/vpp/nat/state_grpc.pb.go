@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.1.0
+// - protoc             v3.17.3
+// source: ligato/vpp/nat/state.proto
+
+package vpp_nat
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NatSessionServiceClient is the client API for NatSessionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NatSessionServiceClient interface {
+	// DumpUsers returns NAT44 users.
+	DumpUsers(ctx context.Context, in *DumpUsersRequest, opts ...grpc.CallOption) (*DumpUsersResponse, error)
+	// DumpSessions returns NAT44 sessions matching the filter.
+	DumpSessions(ctx context.Context, in *DumpSessionsRequest, opts ...grpc.CallOption) (*DumpSessionsResponse, error)
+	// DeleteSessions deletes NAT44 sessions matching the filter
+	// (e.g. to flush sessions of an inside address after a mapping change).
+	DeleteSessions(ctx context.Context, in *DeleteSessionsRequest, opts ...grpc.CallOption) (*DeleteSessionsResponse, error)
+}
+
+type natSessionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNatSessionServiceClient(cc grpc.ClientConnInterface) NatSessionServiceClient {
+	return &natSessionServiceClient{cc}
+}
+
+func (c *natSessionServiceClient) DumpUsers(ctx context.Context, in *DumpUsersRequest, opts ...grpc.CallOption) (*DumpUsersResponse, error) {
+	out := new(DumpUsersResponse)
+	err := c.cc.Invoke(ctx, "/ligato.vpp.nat.NatSessionService/DumpUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natSessionServiceClient) DumpSessions(ctx context.Context, in *DumpSessionsRequest, opts ...grpc.CallOption) (*DumpSessionsResponse, error) {
+	out := new(DumpSessionsResponse)
+	err := c.cc.Invoke(ctx, "/ligato.vpp.nat.NatSessionService/DumpSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natSessionServiceClient) DeleteSessions(ctx context.Context, in *DeleteSessionsRequest, opts ...grpc.CallOption) (*DeleteSessionsResponse, error) {
+	out := new(DeleteSessionsResponse)
+	err := c.cc.Invoke(ctx, "/ligato.vpp.nat.NatSessionService/DeleteSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NatSessionServiceServer is the server API for NatSessionService service.
+// All implementations must embed UnimplementedNatSessionServiceServer
+// for forward compatibility
+type NatSessionServiceServer interface {
+	// DumpUsers returns NAT44 users.
+	DumpUsers(context.Context, *DumpUsersRequest) (*DumpUsersResponse, error)
+	// DumpSessions returns NAT44 sessions matching the filter.
+	DumpSessions(context.Context, *DumpSessionsRequest) (*DumpSessionsResponse, error)
+	// DeleteSessions deletes NAT44 sessions matching the filter
+	// (e.g. to flush sessions of an inside address after a mapping change).
+	DeleteSessions(context.Context, *DeleteSessionsRequest) (*DeleteSessionsResponse, error)
+	mustEmbedUnimplementedNatSessionServiceServer()
+}
+
+// UnimplementedNatSessionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNatSessionServiceServer struct {
+}
+
+func (UnimplementedNatSessionServiceServer) DumpUsers(context.Context, *DumpUsersRequest) (*DumpUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpUsers not implemented")
+}
+func (UnimplementedNatSessionServiceServer) DumpSessions(context.Context, *DumpSessionsRequest) (*DumpSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpSessions not implemented")
+}
+func (UnimplementedNatSessionServiceServer) DeleteSessions(context.Context, *DeleteSessionsRequest) (*DeleteSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessions not implemented")
+}
+func (UnimplementedNatSessionServiceServer) mustEmbedUnimplementedNatSessionServiceServer() {}
+
+// UnsafeNatSessionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NatSessionServiceServer will
+// result in compilation errors.
+type UnsafeNatSessionServiceServer interface {
+	mustEmbedUnimplementedNatSessionServiceServer()
+}
+
+func RegisterNatSessionServiceServer(s grpc.ServiceRegistrar, srv NatSessionServiceServer) {
+	s.RegisterService(&NatSessionService_ServiceDesc, srv)
+}
+
+func _NatSessionService_DumpUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatSessionServiceServer).DumpUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.vpp.nat.NatSessionService/DumpUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatSessionServiceServer).DumpUsers(ctx, req.(*DumpUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatSessionService_DumpSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatSessionServiceServer).DumpSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.vpp.nat.NatSessionService/DumpSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatSessionServiceServer).DumpSessions(ctx, req.(*DumpSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatSessionService_DeleteSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatSessionServiceServer).DeleteSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.vpp.nat.NatSessionService/DeleteSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatSessionServiceServer).DeleteSessions(ctx, req.(*DeleteSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NatSessionService_ServiceDesc is the grpc.ServiceDesc for NatSessionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NatSessionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ligato.vpp.nat.NatSessionService",
+	HandlerType: (*NatSessionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DumpUsers",
+			Handler:    _NatSessionService_DumpUsers_Handler,
+		},
+		{
+			MethodName: "DumpSessions",
+			Handler:    _NatSessionService_DumpSessions_Handler,
+		},
+		{
+			MethodName: "DeleteSessions",
+			Handler:    _NatSessionService_DeleteSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ligato/vpp/nat/state.proto",
+}
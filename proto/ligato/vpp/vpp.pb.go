@@ -9,12 +9,16 @@ package vpp
 import (
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+	cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
 	dns "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/dns"
+	igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	ipfix "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipfix"
 	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 	punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
 	srv6 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/srv6"
@@ -38,41 +42,56 @@ type ConfigData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interfaces             []*interfaces.Interface         `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	Spans                  []*interfaces.Span              `protobuf:"bytes,11,rep,name=spans,proto3" json:"spans,omitempty"`
-	Acls                   []*acl.ACL                      `protobuf:"bytes,20,rep,name=acls,proto3" json:"acls,omitempty"`
-	Abfs                   []*abf.ABF                      `protobuf:"bytes,21,rep,name=abfs,proto3" json:"abfs,omitempty"`
-	BridgeDomains          []*l2.BridgeDomain              `protobuf:"bytes,30,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
-	Fibs                   []*l2.FIBEntry                  `protobuf:"bytes,31,rep,name=fibs,proto3" json:"fibs,omitempty"`
-	XconnectPairs          []*l2.XConnectPair              `protobuf:"bytes,32,rep,name=xconnect_pairs,json=xconnectPairs,proto3" json:"xconnect_pairs,omitempty"`
-	Routes                 []*l3.Route                     `protobuf:"bytes,40,rep,name=routes,proto3" json:"routes,omitempty"`
-	Arps                   []*l3.ARPEntry                  `protobuf:"bytes,41,rep,name=arps,proto3" json:"arps,omitempty"`
-	ProxyArp               *l3.ProxyARP                    `protobuf:"bytes,42,opt,name=proxy_arp,json=proxyArp,proto3" json:"proxy_arp,omitempty"`
-	IpscanNeighbor         *l3.IPScanNeighbor              `protobuf:"bytes,43,opt,name=ipscan_neighbor,json=ipscanNeighbor,proto3" json:"ipscan_neighbor,omitempty"`
-	Vrfs                   []*l3.VrfTable                  `protobuf:"bytes,44,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
-	L3Xconnects            []*l3.L3XConnect                `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
-	DhcpProxies            []*l3.DHCPProxy                 `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
-	TeibEntries            []*l3.TeibEntry                 `protobuf:"bytes,47,rep,name=teib_entries,json=teibEntries,proto3" json:"teib_entries,omitempty"`
-	Nat44Global            *nat.Nat44Global                `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
-	Dnat44S                []*nat.DNat44                   `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
-	Nat44Interfaces        []*nat.Nat44Interface           `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
-	Nat44Pools             []*nat.Nat44AddressPool         `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
-	IpsecSpds              []*ipsec.SecurityPolicyDatabase `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
-	IpsecSas               []*ipsec.SecurityAssociation    `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
-	IpsecTunnelProtections []*ipsec.TunnelProtection       `protobuf:"bytes,62,rep,name=ipsec_tunnel_protections,json=ipsecTunnelProtections,proto3" json:"ipsec_tunnel_protections,omitempty"`
-	IpsecSps               []*ipsec.SecurityPolicy         `protobuf:"bytes,63,rep,name=ipsec_sps,json=ipsecSps,proto3" json:"ipsec_sps,omitempty"`
-	PuntIpredirects        []*punt.IPRedirect              `protobuf:"bytes,70,rep,name=punt_ipredirects,json=puntIpredirects,proto3" json:"punt_ipredirects,omitempty"`
-	PuntTohosts            []*punt.ToHost                  `protobuf:"bytes,71,rep,name=punt_tohosts,json=puntTohosts,proto3" json:"punt_tohosts,omitempty"`
-	PuntExceptions         []*punt.Exception               `protobuf:"bytes,72,rep,name=punt_exceptions,json=puntExceptions,proto3" json:"punt_exceptions,omitempty"`
-	Srv6Global             *srv6.SRv6Global                `protobuf:"bytes,83,opt,name=srv6_global,json=srv6Global,proto3" json:"srv6_global,omitempty"`
-	Srv6Localsids          []*srv6.LocalSID                `protobuf:"bytes,80,rep,name=srv6_localsids,json=srv6Localsids,proto3" json:"srv6_localsids,omitempty"`
-	Srv6Policies           []*srv6.Policy                  `protobuf:"bytes,81,rep,name=srv6_policies,json=srv6Policies,proto3" json:"srv6_policies,omitempty"`
-	Srv6Steerings          []*srv6.Steering                `protobuf:"bytes,82,rep,name=srv6_steerings,json=srv6Steerings,proto3" json:"srv6_steerings,omitempty"`
-	IpfixGlobal            *ipfix.IPFIX                    `protobuf:"bytes,90,opt,name=ipfix_global,json=ipfixGlobal,proto3" json:"ipfix_global,omitempty"`
-	IpfixFlowprobeParams   *ipfix.FlowProbeParams          `protobuf:"bytes,91,opt,name=ipfix_flowprobe_params,json=ipfixFlowprobeParams,proto3" json:"ipfix_flowprobe_params,omitempty"`
-	IpfixFlowprobes        []*ipfix.FlowProbeFeature       `protobuf:"bytes,92,rep,name=ipfix_flowprobes,json=ipfixFlowprobes,proto3" json:"ipfix_flowprobes,omitempty"`
-	WgPeers                []*wireguard.Peer               `protobuf:"bytes,93,rep,name=wg_peers,json=wgPeers,proto3" json:"wg_peers,omitempty"`
-	DnsCache               *dns.DNSCache                   `protobuf:"bytes,100,opt,name=dns_cache,json=dnsCache,proto3" json:"dns_cache,omitempty"`
+	Interfaces               []*interfaces.Interface         `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Spans                    []*interfaces.Span              `protobuf:"bytes,11,rep,name=spans,proto3" json:"spans,omitempty"`
+	Acls                     []*acl.ACL                      `protobuf:"bytes,20,rep,name=acls,proto3" json:"acls,omitempty"`
+	Abfs                     []*abf.ABF                      `protobuf:"bytes,21,rep,name=abfs,proto3" json:"abfs,omitempty"`
+	BridgeDomains            []*l2.BridgeDomain              `protobuf:"bytes,30,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
+	Fibs                     []*l2.FIBEntry                  `protobuf:"bytes,31,rep,name=fibs,proto3" json:"fibs,omitempty"`
+	XconnectPairs            []*l2.XConnectPair              `protobuf:"bytes,32,rep,name=xconnect_pairs,json=xconnectPairs,proto3" json:"xconnect_pairs,omitempty"`
+	Routes                   []*l3.Route                     `protobuf:"bytes,40,rep,name=routes,proto3" json:"routes,omitempty"`
+	Arps                     []*l3.ARPEntry                  `protobuf:"bytes,41,rep,name=arps,proto3" json:"arps,omitempty"`
+	ProxyArp                 *l3.ProxyARP                    `protobuf:"bytes,42,opt,name=proxy_arp,json=proxyArp,proto3" json:"proxy_arp,omitempty"`
+	IpscanNeighbor           *l3.IPScanNeighbor              `protobuf:"bytes,43,opt,name=ipscan_neighbor,json=ipscanNeighbor,proto3" json:"ipscan_neighbor,omitempty"`
+	Vrfs                     []*l3.VrfTable                  `protobuf:"bytes,44,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+	L3Xconnects              []*l3.L3XConnect                `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
+	DhcpProxies              []*l3.DHCPProxy                 `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
+	TeibEntries              []*l3.TeibEntry                 `protobuf:"bytes,47,rep,name=teib_entries,json=teibEntries,proto3" json:"teib_entries,omitempty"`
+	Mroutes                  []*l3.MRoute                    `protobuf:"bytes,48,rep,name=mroutes,proto3" json:"mroutes,omitempty"`
+	Nat44Global              *nat.Nat44Global                `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
+	Dnat44S                  []*nat.DNat44                   `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
+	Nat44Interfaces          []*nat.Nat44Interface           `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
+	Nat44Pools               []*nat.Nat44AddressPool         `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
+	Nat64Global              *nat.Nat64Global                `protobuf:"bytes,54,opt,name=nat64_global,json=nat64Global,proto3" json:"nat64_global,omitempty"`
+	Nat64Prefixes            []*nat.Nat64IPv6Prefix          `protobuf:"bytes,55,rep,name=nat64_prefixes,json=nat64Prefixes,proto3" json:"nat64_prefixes,omitempty"`
+	Nat64Interfaces          []*nat.Nat64Interface           `protobuf:"bytes,56,rep,name=nat64_interfaces,json=nat64Interfaces,proto3" json:"nat64_interfaces,omitempty"`
+	Nat64Pools               []*nat.Nat64AddressPool         `protobuf:"bytes,57,rep,name=nat64_pools,json=nat64Pools,proto3" json:"nat64_pools,omitempty"`
+	IpsecSpds                []*ipsec.SecurityPolicyDatabase `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
+	IpsecSas                 []*ipsec.SecurityAssociation    `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
+	IpsecTunnelProtections   []*ipsec.TunnelProtection       `protobuf:"bytes,62,rep,name=ipsec_tunnel_protections,json=ipsecTunnelProtections,proto3" json:"ipsec_tunnel_protections,omitempty"`
+	IpsecSps                 []*ipsec.SecurityPolicy         `protobuf:"bytes,63,rep,name=ipsec_sps,json=ipsecSps,proto3" json:"ipsec_sps,omitempty"`
+	IpsecIkev2Profiles       []*ipsec.IKEv2Profile           `protobuf:"bytes,64,rep,name=ipsec_ikev2_profiles,json=ipsecIkev2Profiles,proto3" json:"ipsec_ikev2_profiles,omitempty"`
+	PuntIpredirects          []*punt.IPRedirect              `protobuf:"bytes,70,rep,name=punt_ipredirects,json=puntIpredirects,proto3" json:"punt_ipredirects,omitempty"`
+	PuntTohosts              []*punt.ToHost                  `protobuf:"bytes,71,rep,name=punt_tohosts,json=puntTohosts,proto3" json:"punt_tohosts,omitempty"`
+	PuntExceptions           []*punt.Exception               `protobuf:"bytes,72,rep,name=punt_exceptions,json=puntExceptions,proto3" json:"punt_exceptions,omitempty"`
+	Srv6Global               *srv6.SRv6Global                `protobuf:"bytes,83,opt,name=srv6_global,json=srv6Global,proto3" json:"srv6_global,omitempty"`
+	Srv6Localsids            []*srv6.LocalSID                `protobuf:"bytes,80,rep,name=srv6_localsids,json=srv6Localsids,proto3" json:"srv6_localsids,omitempty"`
+	Srv6Policies             []*srv6.Policy                  `protobuf:"bytes,81,rep,name=srv6_policies,json=srv6Policies,proto3" json:"srv6_policies,omitempty"`
+	Srv6Steerings            []*srv6.Steering                `protobuf:"bytes,82,rep,name=srv6_steerings,json=srv6Steerings,proto3" json:"srv6_steerings,omitempty"`
+	IpfixGlobal              *ipfix.IPFIX                    `protobuf:"bytes,90,opt,name=ipfix_global,json=ipfixGlobal,proto3" json:"ipfix_global,omitempty"`
+	IpfixFlowprobeParams     *ipfix.FlowProbeParams          `protobuf:"bytes,91,opt,name=ipfix_flowprobe_params,json=ipfixFlowprobeParams,proto3" json:"ipfix_flowprobe_params,omitempty"`
+	IpfixFlowprobes          []*ipfix.FlowProbeFeature       `protobuf:"bytes,92,rep,name=ipfix_flowprobes,json=ipfixFlowprobes,proto3" json:"ipfix_flowprobes,omitempty"`
+	WgPeers                  []*wireguard.Peer               `protobuf:"bytes,93,rep,name=wg_peers,json=wgPeers,proto3" json:"wg_peers,omitempty"`
+	DnsCache                 *dns.DNSCache                   `protobuf:"bytes,100,opt,name=dns_cache,json=dnsCache,proto3" json:"dns_cache,omitempty"`
+	CnatTranslations         []*cnat.Translation             `protobuf:"bytes,110,rep,name=cnat_translations,json=cnatTranslations,proto3" json:"cnat_translations,omitempty"`
+	CnatSnatPolicy           *cnat.SnatPolicy                `protobuf:"bytes,111,opt,name=cnat_snat_policy,json=cnatSnatPolicy,proto3" json:"cnat_snat_policy,omitempty"`
+	CnatSnatPolicyInterfaces []*cnat.SnatPolicyInterface     `protobuf:"bytes,112,rep,name=cnat_snat_policy_interfaces,json=cnatSnatPolicyInterfaces,proto3" json:"cnat_snat_policy_interfaces,omitempty"`
+	ClassifyTables           []*classify.Table               `protobuf:"bytes,120,rep,name=classify_tables,json=classifyTables,proto3" json:"classify_tables,omitempty"`
+	ClassifySessions         []*classify.Session             `protobuf:"bytes,121,rep,name=classify_sessions,json=classifySessions,proto3" json:"classify_sessions,omitempty"`
+	LbGlobal                 *lb.GlobalConfig                `protobuf:"bytes,130,opt,name=lb_global,json=lbGlobal,proto3" json:"lb_global,omitempty"`
+	LbVips                   []*lb.VIP                       `protobuf:"bytes,131,rep,name=lb_vips,json=lbVips,proto3" json:"lb_vips,omitempty"`
+	IgmpInterfaces           []*igmp.Interface               `protobuf:"bytes,140,rep,name=igmp_interfaces,json=igmpInterfaces,proto3" json:"igmp_interfaces,omitempty"`
+	IgmpProxyDevices         []*igmp.ProxyDevice             `protobuf:"bytes,141,rep,name=igmp_proxy_devices,json=igmpProxyDevices,proto3" json:"igmp_proxy_devices,omitempty"`
 }
 
 func (x *ConfigData) Reset() {
@@ -212,6 +231,13 @@ func (x *ConfigData) GetTeibEntries() []*l3.TeibEntry {
 	return nil
 }
 
+func (x *ConfigData) GetMroutes() []*l3.MRoute {
+	if x != nil {
+		return x.Mroutes
+	}
+	return nil
+}
+
 func (x *ConfigData) GetNat44Global() *nat.Nat44Global {
 	if x != nil {
 		return x.Nat44Global
@@ -240,6 +266,34 @@ func (x *ConfigData) GetNat44Pools() []*nat.Nat44AddressPool {
 	return nil
 }
 
+func (x *ConfigData) GetNat64Global() *nat.Nat64Global {
+	if x != nil {
+		return x.Nat64Global
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Prefixes() []*nat.Nat64IPv6Prefix {
+	if x != nil {
+		return x.Nat64Prefixes
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Interfaces() []*nat.Nat64Interface {
+	if x != nil {
+		return x.Nat64Interfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Pools() []*nat.Nat64AddressPool {
+	if x != nil {
+		return x.Nat64Pools
+	}
+	return nil
+}
+
 func (x *ConfigData) GetIpsecSpds() []*ipsec.SecurityPolicyDatabase {
 	if x != nil {
 		return x.IpsecSpds
@@ -268,6 +322,13 @@ func (x *ConfigData) GetIpsecSps() []*ipsec.SecurityPolicy {
 	return nil
 }
 
+func (x *ConfigData) GetIpsecIkev2Profiles() []*ipsec.IKEv2Profile {
+	if x != nil {
+		return x.IpsecIkev2Profiles
+	}
+	return nil
+}
+
 func (x *ConfigData) GetPuntIpredirects() []*punt.IPRedirect {
 	if x != nil {
 		return x.PuntIpredirects
@@ -352,6 +413,69 @@ func (x *ConfigData) GetDnsCache() *dns.DNSCache {
 	return nil
 }
 
+func (x *ConfigData) GetCnatTranslations() []*cnat.Translation {
+	if x != nil {
+		return x.CnatTranslations
+	}
+	return nil
+}
+
+func (x *ConfigData) GetCnatSnatPolicy() *cnat.SnatPolicy {
+	if x != nil {
+		return x.CnatSnatPolicy
+	}
+	return nil
+}
+
+func (x *ConfigData) GetCnatSnatPolicyInterfaces() []*cnat.SnatPolicyInterface {
+	if x != nil {
+		return x.CnatSnatPolicyInterfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetClassifyTables() []*classify.Table {
+	if x != nil {
+		return x.ClassifyTables
+	}
+	return nil
+}
+
+func (x *ConfigData) GetClassifySessions() []*classify.Session {
+	if x != nil {
+		return x.ClassifySessions
+	}
+	return nil
+}
+
+func (x *ConfigData) GetLbGlobal() *lb.GlobalConfig {
+	if x != nil {
+		return x.LbGlobal
+	}
+	return nil
+}
+
+func (x *ConfigData) GetLbVips() []*lb.VIP {
+	if x != nil {
+		return x.LbVips
+	}
+	return nil
+}
+
+func (x *ConfigData) GetIgmpInterfaces() []*igmp.Interface {
+	if x != nil {
+		return x.IgmpInterfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetIgmpProxyDevices() []*igmp.ProxyDevice {
+	if x != nil {
+		return x.IgmpProxyDevices
+	}
+	return nil
+}
+
 type Notification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -404,7 +528,9 @@ type Stats struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interface *interfaces.InterfaceStats `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Interface     *interfaces.InterfaceStats `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Acl           *acl.ACLStats              `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	WireguardPeer *wireguard.PeerState       `protobuf:"bytes,3,opt,name=wireguard_peer,json=wireguardPeer,proto3" json:"wireguard_peer,omitempty"`
 }
 
 func (x *Stats) Reset() {
@@ -446,6 +572,20 @@ func (x *Stats) GetInterface() *interfaces.InterfaceStats {
 	return nil
 }
 
+func (x *Stats) GetAcl() *acl.ACLStats {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+func (x *Stats) GetWireguardPeer() *wireguard.PeerState {
+	if x != nil {
+		return x.WireguardPeer
+	}
+	return nil
+}
+
 var File_ligato_vpp_vpp_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_vpp_proto_rawDesc = []byte{
@@ -454,195 +594,284 @@ var file_ligato_vpp_vpp_proto_rawDesc = []byte{
 	0x70, 0x70, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61,
 	0x62, 0x66, 0x2f, 0x61, 0x62, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c, 0x2f, 0x61, 0x63, 0x6c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2f, 0x64, 0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66,
-	0x69, 0x78, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78,
-	0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x25, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
-	0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x73,
-	0x70, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2f, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x66, 0x69, 0x62,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x6c, 0x33, 0x2f, 0x61, 0x72, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
-	0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x78, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74, 0x65, 0x69, 0x62, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x6c, 0x33, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
-	0x76, 0x70, 0x70, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x73, 0x72, 0x76, 0x36, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x24, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x11, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70,
-	0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c,
-	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63,
-	0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62, 0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62,
-	0x66, 0x2e, 0x41, 0x42, 0x46, 0x52, 0x04, 0x61, 0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x04, 0x66, 0x69, 0x62, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49,
-	0x42, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e,
-	0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x0d, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b,
-	0x0a, 0x04, 0x61, 0x72, 0x70, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x72, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x41, 0x52, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72,
-	0x70, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66,
-	0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78,
-	0x69, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x52, 0x0b, 0x64, 0x68, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73,
-	0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x69, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x2f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x54, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x74, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a,
-	0x0c, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a,
-	0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x22, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x63, 0x6e, 0x61, 0x74, 0x2f, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x64,
+	0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x67, 0x6d, 0x70, 0x2f, 0x69, 0x67,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2f, 0x69, 0x6b, 0x65, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69,
+	0x70, 0x73, 0x65, 0x63, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
+	0x6c, 0x32, 0x2f, 0x66, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x78, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x61, 0x72, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
+	0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x78, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x19, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33,
+	0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74, 0x65, 0x69, 0x62,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x16, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x62, 0x2f, 0x6c,
+	0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61,
+	0x74, 0x2f, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70,
+	0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x77, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x19,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c,
+	0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62,
+	0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62, 0x66, 0x2e, 0x41, 0x42, 0x46, 0x52, 0x04, 0x61,
+	0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x69, 0x62, 0x73, 0x18,
+	0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49, 0x42, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x58, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x78, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x72, 0x70, 0x73, 0x18, 0x29,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61,
+	0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x72, 0x70,
+	0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x52, 0x50, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x70, 0x73,
+	0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e,
+	0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x2d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0b,
+	0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x64,
+	0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
+	0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0b, 0x64, 0x68, 0x63,
+	0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x69, 0x62,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x54,
+	0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74, 0x65, 0x69, 0x62, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x07, 0x6d,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61,
+	0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34,
+	0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x52,
+	0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x34,
+	0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x34, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x34,
+	0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61,
+	0x74, 0x36, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x36, 0x34,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0e, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
+	0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
+	0x0d, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x49,
+	0x0a, 0x10, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x38, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
+	0x36, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x39, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
-	0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x52, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12,
-	0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61,
-	0x74, 0x34, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74,
-	0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a,
-	0x0a, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x69, 0x70, 0x73,
-	0x65, 0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f,
-	0x73, 0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x69, 0x70,
-	0x73, 0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65,
-	0x63, 0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
-	0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x0f,
-	0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x0b,
-	0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70,
-	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x48,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18,
-	0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x49,
-	0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x50, 0x46, 0x49, 0x58, 0x52,
-	0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x16,
-	0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e,
-	0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66,
-	0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64,
-	0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x64, 0x6e, 0x73, 0x2e,
-	0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x4c,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x2c, 0x5a, 0x2a,
-	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x4e, 0x61, 0x74, 0x36, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x52, 0x0a, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a,
+	0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x69, 0x70, 0x73, 0x65,
+	0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73,
+	0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x14, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f,
+	0x69, 0x6b, 0x65, 0x76, 0x32, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x40,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x4b, 0x45, 0x76, 0x32, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x12, 0x69, 0x70, 0x73, 0x65, 0x63, 0x49, 0x6b, 0x65, 0x76, 0x32,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x46, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x70, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52,
+	0x0f, 0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x0b, 0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f,
+	0x70, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x48, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x18, 0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12,
+	0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64,
+	0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53,
+	0x49, 0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64,
+	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x50, 0x46, 0x49, 0x58,
+	0x52, 0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a,
+	0x16, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78,
+	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f,
+	0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x66, 0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x64, 0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x64, 0x6e, 0x73,
+	0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6e, 0x61, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x63, 0x6e,
+	0x61, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45,
+	0x0a, 0x10, 0x63, 0x6e, 0x61, 0x74, 0x5f, 0x73, 0x6e, 0x61, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x53, 0x6e, 0x61, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x63, 0x6e, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x63, 0x0a, 0x1b, 0x63, 0x6e, 0x61, 0x74, 0x5f, 0x73, 0x6e,
+	0x61, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x70, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6e, 0x61, 0x74, 0x2e, 0x53, 0x6e, 0x61,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x18, 0x63, 0x6e, 0x61, 0x74, 0x53, 0x6e, 0x61, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x78, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x49, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x79, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x6c, 0x62,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6c, 0x62, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x07, 0x6c, 0x62, 0x5f, 0x76, 0x69, 0x70, 0x73,
+	0x18, 0x83, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x62, 0x2e, 0x56, 0x49, 0x50, 0x52, 0x06, 0x6c, 0x62, 0x56,
+	0x69, 0x70, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x69, 0x67, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x8c, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x67, 0x6d, 0x70, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0e, 0x69, 0x67, 0x6d, 0x70, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x69, 0x67, 0x6d,
+	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x8d, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x67, 0x6d, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x10, 0x69, 0x67, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x2a, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e,
+	0x41, 0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12, 0x46, 0x0a,
+	0x0e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -677,28 +906,45 @@ var file_ligato_vpp_vpp_proto_goTypes = []interface{}{
 	(*l3.L3XConnect)(nil),                    // 15: ligato.vpp.l3.L3XConnect
 	(*l3.DHCPProxy)(nil),                     // 16: ligato.vpp.l3.DHCPProxy
 	(*l3.TeibEntry)(nil),                     // 17: ligato.vpp.l3.TeibEntry
-	(*nat.Nat44Global)(nil),                  // 18: ligato.vpp.nat.Nat44Global
-	(*nat.DNat44)(nil),                       // 19: ligato.vpp.nat.DNat44
-	(*nat.Nat44Interface)(nil),               // 20: ligato.vpp.nat.Nat44Interface
-	(*nat.Nat44AddressPool)(nil),             // 21: ligato.vpp.nat.Nat44AddressPool
-	(*ipsec.SecurityPolicyDatabase)(nil),     // 22: ligato.vpp.ipsec.SecurityPolicyDatabase
-	(*ipsec.SecurityAssociation)(nil),        // 23: ligato.vpp.ipsec.SecurityAssociation
-	(*ipsec.TunnelProtection)(nil),           // 24: ligato.vpp.ipsec.TunnelProtection
-	(*ipsec.SecurityPolicy)(nil),             // 25: ligato.vpp.ipsec.SecurityPolicy
-	(*punt.IPRedirect)(nil),                  // 26: ligato.vpp.punt.IPRedirect
-	(*punt.ToHost)(nil),                      // 27: ligato.vpp.punt.ToHost
-	(*punt.Exception)(nil),                   // 28: ligato.vpp.punt.Exception
-	(*srv6.SRv6Global)(nil),                  // 29: ligato.vpp.srv6.SRv6Global
-	(*srv6.LocalSID)(nil),                    // 30: ligato.vpp.srv6.LocalSID
-	(*srv6.Policy)(nil),                      // 31: ligato.vpp.srv6.Policy
-	(*srv6.Steering)(nil),                    // 32: ligato.vpp.srv6.Steering
-	(*ipfix.IPFIX)(nil),                      // 33: ligato.vpp.ipfix.IPFIX
-	(*ipfix.FlowProbeParams)(nil),            // 34: ligato.vpp.ipfix.FlowProbeParams
-	(*ipfix.FlowProbeFeature)(nil),           // 35: ligato.vpp.ipfix.FlowProbeFeature
-	(*wireguard.Peer)(nil),                   // 36: ligato.vpp.wireguard.Peer
-	(*dns.DNSCache)(nil),                     // 37: ligato.vpp.dns.DNSCache
-	(*interfaces.InterfaceNotification)(nil), // 38: ligato.vpp.interfaces.InterfaceNotification
-	(*interfaces.InterfaceStats)(nil),        // 39: ligato.vpp.interfaces.InterfaceStats
+	(*l3.MRoute)(nil),                        // 18: ligato.vpp.l3.MRoute
+	(*nat.Nat44Global)(nil),                  // 19: ligato.vpp.nat.Nat44Global
+	(*nat.DNat44)(nil),                       // 20: ligato.vpp.nat.DNat44
+	(*nat.Nat44Interface)(nil),               // 21: ligato.vpp.nat.Nat44Interface
+	(*nat.Nat44AddressPool)(nil),             // 22: ligato.vpp.nat.Nat44AddressPool
+	(*nat.Nat64Global)(nil),                  // 23: ligato.vpp.nat.Nat64Global
+	(*nat.Nat64IPv6Prefix)(nil),              // 24: ligato.vpp.nat.Nat64IPv6Prefix
+	(*nat.Nat64Interface)(nil),               // 25: ligato.vpp.nat.Nat64Interface
+	(*nat.Nat64AddressPool)(nil),             // 26: ligato.vpp.nat.Nat64AddressPool
+	(*ipsec.SecurityPolicyDatabase)(nil),     // 27: ligato.vpp.ipsec.SecurityPolicyDatabase
+	(*ipsec.SecurityAssociation)(nil),        // 28: ligato.vpp.ipsec.SecurityAssociation
+	(*ipsec.TunnelProtection)(nil),           // 29: ligato.vpp.ipsec.TunnelProtection
+	(*ipsec.SecurityPolicy)(nil),             // 30: ligato.vpp.ipsec.SecurityPolicy
+	(*ipsec.IKEv2Profile)(nil),               // 31: ligato.vpp.ipsec.IKEv2Profile
+	(*punt.IPRedirect)(nil),                  // 32: ligato.vpp.punt.IPRedirect
+	(*punt.ToHost)(nil),                      // 33: ligato.vpp.punt.ToHost
+	(*punt.Exception)(nil),                   // 34: ligato.vpp.punt.Exception
+	(*srv6.SRv6Global)(nil),                  // 35: ligato.vpp.srv6.SRv6Global
+	(*srv6.LocalSID)(nil),                    // 36: ligato.vpp.srv6.LocalSID
+	(*srv6.Policy)(nil),                      // 37: ligato.vpp.srv6.Policy
+	(*srv6.Steering)(nil),                    // 38: ligato.vpp.srv6.Steering
+	(*ipfix.IPFIX)(nil),                      // 39: ligato.vpp.ipfix.IPFIX
+	(*ipfix.FlowProbeParams)(nil),            // 40: ligato.vpp.ipfix.FlowProbeParams
+	(*ipfix.FlowProbeFeature)(nil),           // 41: ligato.vpp.ipfix.FlowProbeFeature
+	(*wireguard.Peer)(nil),                   // 42: ligato.vpp.wireguard.Peer
+	(*dns.DNSCache)(nil),                     // 43: ligato.vpp.dns.DNSCache
+	(*cnat.Translation)(nil),                 // 44: ligato.vpp.cnat.Translation
+	(*cnat.SnatPolicy)(nil),                  // 45: ligato.vpp.cnat.SnatPolicy
+	(*cnat.SnatPolicyInterface)(nil),         // 46: ligato.vpp.cnat.SnatPolicyInterface
+	(*classify.Table)(nil),                   // 47: ligato.vpp.classify.Table
+	(*classify.Session)(nil),                 // 48: ligato.vpp.classify.Session
+	(*lb.GlobalConfig)(nil),                  // 49: ligato.vpp.lb.GlobalConfig
+	(*lb.VIP)(nil),                           // 50: ligato.vpp.lb.VIP
+	(*igmp.Interface)(nil),                   // 51: ligato.vpp.igmp.Interface
+	(*igmp.ProxyDevice)(nil),                 // 52: ligato.vpp.igmp.ProxyDevice
+	(*interfaces.InterfaceNotification)(nil), // 53: ligato.vpp.interfaces.InterfaceNotification
+	(*interfaces.InterfaceStats)(nil),        // 54: ligato.vpp.interfaces.InterfaceStats
+	(*acl.ACLStats)(nil),                     // 55: ligato.vpp.acl.ACLStats
+	(*wireguard.PeerState)(nil),              // 56: ligato.vpp.wireguard.PeerState
 }
 var file_ligato_vpp_vpp_proto_depIdxs = []int32{
 	3,  // 0: ligato.vpp.ConfigData.interfaces:type_name -> ligato.vpp.interfaces.Interface
@@ -716,33 +962,50 @@ var file_ligato_vpp_vpp_proto_depIdxs = []int32{
 	15, // 12: ligato.vpp.ConfigData.l3xconnects:type_name -> ligato.vpp.l3.L3XConnect
 	16, // 13: ligato.vpp.ConfigData.dhcp_proxies:type_name -> ligato.vpp.l3.DHCPProxy
 	17, // 14: ligato.vpp.ConfigData.teib_entries:type_name -> ligato.vpp.l3.TeibEntry
-	18, // 15: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
-	19, // 16: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
-	20, // 17: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
-	21, // 18: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
-	22, // 19: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
-	23, // 20: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
-	24, // 21: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
-	25, // 22: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
-	26, // 23: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
-	27, // 24: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
-	28, // 25: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
-	29, // 26: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
-	30, // 27: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
-	31, // 28: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
-	32, // 29: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
-	33, // 30: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
-	34, // 31: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
-	35, // 32: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
-	36, // 33: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
-	37, // 34: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
-	38, // 35: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
-	39, // 36: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	18, // 15: ligato.vpp.ConfigData.mroutes:type_name -> ligato.vpp.l3.MRoute
+	19, // 16: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
+	20, // 17: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
+	21, // 18: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
+	22, // 19: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
+	23, // 20: ligato.vpp.ConfigData.nat64_global:type_name -> ligato.vpp.nat.Nat64Global
+	24, // 21: ligato.vpp.ConfigData.nat64_prefixes:type_name -> ligato.vpp.nat.Nat64IPv6Prefix
+	25, // 22: ligato.vpp.ConfigData.nat64_interfaces:type_name -> ligato.vpp.nat.Nat64Interface
+	26, // 23: ligato.vpp.ConfigData.nat64_pools:type_name -> ligato.vpp.nat.Nat64AddressPool
+	27, // 24: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
+	28, // 25: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
+	29, // 26: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
+	30, // 27: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
+	31, // 28: ligato.vpp.ConfigData.ipsec_ikev2_profiles:type_name -> ligato.vpp.ipsec.IKEv2Profile
+	32, // 29: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
+	33, // 30: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
+	34, // 31: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
+	35, // 32: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
+	36, // 33: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
+	37, // 34: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
+	38, // 35: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
+	39, // 36: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
+	40, // 37: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
+	41, // 38: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
+	42, // 39: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
+	43, // 40: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
+	44, // 41: ligato.vpp.ConfigData.cnat_translations:type_name -> ligato.vpp.cnat.Translation
+	45, // 42: ligato.vpp.ConfigData.cnat_snat_policy:type_name -> ligato.vpp.cnat.SnatPolicy
+	46, // 43: ligato.vpp.ConfigData.cnat_snat_policy_interfaces:type_name -> ligato.vpp.cnat.SnatPolicyInterface
+	47, // 44: ligato.vpp.ConfigData.classify_tables:type_name -> ligato.vpp.classify.Table
+	48, // 45: ligato.vpp.ConfigData.classify_sessions:type_name -> ligato.vpp.classify.Session
+	49, // 46: ligato.vpp.ConfigData.lb_global:type_name -> ligato.vpp.lb.GlobalConfig
+	50, // 47: ligato.vpp.ConfigData.lb_vips:type_name -> ligato.vpp.lb.VIP
+	51, // 48: ligato.vpp.ConfigData.igmp_interfaces:type_name -> ligato.vpp.igmp.Interface
+	52, // 49: ligato.vpp.ConfigData.igmp_proxy_devices:type_name -> ligato.vpp.igmp.ProxyDevice
+	53, // 50: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
+	54, // 51: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
+	55, // 52: ligato.vpp.Stats.acl:type_name -> ligato.vpp.acl.ACLStats
+	56, // 53: ligato.vpp.Stats.wireguard_peer:type_name -> ligato.vpp.wireguard.PeerState
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_vpp_proto_init() }
@@ -17,10 +17,14 @@ package vpp
 import (
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+	vpp_cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	vpp_igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
 	vpp_srv6 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/srv6"
@@ -50,17 +54,23 @@ type (
 	VRFTable    = vpp_l3.VrfTable
 	L3XConnect  = vpp_l3.L3XConnect
 	DHCPProxy   = vpp_l3.DHCPProxy
+	MRoute      = vpp_l3.MRoute
 
 	// NAT
 	NAT44Global      = vpp_nat.Nat44Global
 	DNAT44           = vpp_nat.DNat44
 	Nat44AddressPool = vpp_nat.Nat44AddressPool
 	Nat44Interface   = vpp_nat.Nat44Interface
+	NAT64Global      = vpp_nat.Nat64Global
+	Nat64IPv6Prefix  = vpp_nat.Nat64IPv6Prefix
+	Nat64AddressPool = vpp_nat.Nat64AddressPool
+	Nat64Interface   = vpp_nat.Nat64Interface
 
 	// IPSec
-	IPSecSPD = vpp_ipsec.SecurityPolicyDatabase
-	IPSecSP  = vpp_ipsec.SecurityPolicy
-	IPSecSA  = vpp_ipsec.SecurityAssociation
+	IPSecSPD     = vpp_ipsec.SecurityPolicyDatabase
+	IPSecSP      = vpp_ipsec.SecurityPolicy
+	IPSecSA      = vpp_ipsec.SecurityAssociation
+	IKEv2Profile = vpp_ipsec.IKEv2Profile
 
 	// Punt
 	PuntIPRedirect = vpp_punt.IPRedirect
@@ -78,4 +88,21 @@ type (
 
 	// Wireguard
 	WgPeer = vpp_wg.Peer
+
+	// CNAT
+	CNATTranslation         = vpp_cnat.Translation
+	CNATSnatPolicy          = vpp_cnat.SnatPolicy
+	CNATSnatPolicyInterface = vpp_cnat.SnatPolicyInterface
+
+	// Classifier
+	ClassifyTable   = vpp_classify.Table
+	ClassifySession = vpp_classify.Session
+
+	// Load-balancer
+	LBGlobalConfig = vpp_lb.GlobalConfig
+	LBVIP          = vpp_lb.VIP
+
+	// IGMP
+	IGMPInterface   = vpp_igmp.Interface
+	IGMPProxyDevice = vpp_igmp.ProxyDevice
 )
@@ -15,6 +15,8 @@
 package vpp_wg
 
 import (
+	"strings"
+
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 )
 
@@ -27,4 +29,10 @@ var (
 		Version: "v1",
 		Type:    "peer",
 	}, models.WithNameTemplate("{{.WgIfName}}/endpoint/{{.Endpoint}}/{{.Port}}"))
-)
\ No newline at end of file
+)
+
+// PeerStateKey returns the key used to publish the runtime state of the peer
+// with the given name.
+func PeerStateKey(name string) string {
+	return strings.Replace(ModelPeer.KeyPrefix()+name, "config/", "status/", 1)
+}
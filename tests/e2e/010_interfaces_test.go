@@ -17,9 +17,12 @@ package e2e
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
+	"go.ligato.io/vpp-agent/v3/client"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
@@ -549,3 +552,53 @@ func TestInterfaceAfPacketWithLogicalReference(t *testing.T) {
 	ctx.Expect(ctx.PingFromMs(msName, afPacketIP)).To(Succeed())
 	ctx.Expect(ctx.AgentInSync()).To(BeTrue())
 }
+
+// subscribe for status notifications of VPP interface
+func TestInterfaceStatusSubscription(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	subCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	notifs := make(chan *client.Notification, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ctx.GenericClient().Subscribe(subCtx, notifs, &client.Subscription{
+			Id: &generic.Item_ID{Model: "vpp.interfaces", Name: "loop-sub"},
+		})
+	}()
+	nextNotification := func() *client.Notification {
+		select {
+		case n := <-notifs:
+			return n
+		case err := <-errCh:
+			t.Fatalf("subscription failed: %v", err)
+		case <-subCtx.Done():
+			t.Fatal("notification not received")
+		}
+		return nil
+	}
+
+	loop := &vpp_interfaces.Interface{
+		Name:    "loop-sub",
+		Type:    vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled: true,
+	}
+	// give the subscription some time to be established
+	time.Sleep(time.Second)
+	err := ctx.GenericClient().ChangeRequest().Update(loop).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred())
+
+	notif := nextNotification()
+	for notif.GetStatus().GetStatus() != kvscheduler.ValueState_CONFIGURED.String() {
+		notif = nextNotification()
+	}
+	ctx.Expect(notif.GetItem().GetData()).ToNot(BeNil())
+
+	err = ctx.GenericClient().ChangeRequest().Delete(loop).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred())
+
+	notif = nextNotification()
+	ctx.Expect(notif.GetItem().GetId().GetName()).To(Equal("loop-sub"))
+	ctx.Expect(notif.GetStatus().GetStatus()).To(Equal(kvscheduler.ValueState_REMOVED.String()))
+}
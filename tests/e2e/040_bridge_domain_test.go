@@ -513,3 +513,89 @@ func TestBridgeDomainWithAfPackets(t *testing.T) {
 	ctx.Expect(ctx.PingFromVPP(veth2IP)).To(Succeed())
 	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
 }
+
+// configure bridge domain with per-interface overrides of L2 features,
+// VLAN tag rewrite and MAC learn limits.
+func TestBridgeDomainInterfaceFeatures(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	if ctx.VppRelease() < "21.06" {
+		t.Skipf("bridge domain MAC learn limit: skipped for VPP < 21.06 (%s)", ctx.VppRelease())
+	}
+
+	const (
+		loop1Name = "loop1"
+		loop2Name = "loop2"
+		bdName    = "metro-bd"
+	)
+
+	loop1 := &vpp_interfaces.Interface{
+		Name:    loop1Name,
+		Type:    vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled: true,
+	}
+	loop2 := &vpp_interfaces.Interface{
+		Name:    loop2Name,
+		Type:    vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled: true,
+	}
+	bd := &vpp_l2.BridgeDomain{
+		Name:                bdName,
+		Flood:               true,
+		Forward:             true,
+		Learn:               true,
+		UnknownUnicastFlood: true,
+		MacLearnLimit:       1000,
+		Interfaces: []*vpp_l2.BridgeDomain_Interface{
+			{
+				Name:                loop1Name,
+				UnknownUnicastFlood: vpp_l2.BridgeDomain_Interface_DISABLED,
+				MacLearnLimit:       10,
+				MacLimitAction:      vpp_l2.BridgeDomain_Interface_DISABLE_FORWARDING,
+				TagRewrite: &vpp_l2.BridgeDomain_Interface_TagRewrite{
+					Operation: vpp_l2.BridgeDomain_Interface_TagRewrite_PUSH1,
+					PushDot1Q: true,
+					Tag1:      100,
+				},
+			},
+			{
+				Name:  loop2Name,
+				Learn: vpp_l2.BridgeDomain_Interface_DISABLED,
+			},
+		},
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		loop1,
+		loop2,
+		bd,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating BD failed")
+
+	ctx.Expect(ctx.GetValueState(bd)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.GetDerivedValueState(bd, vpp_l2.BDInterfaceKey(bdName, loop1Name))).
+		To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.GetDerivedValueState(bd, vpp_l2.BDInterfaceKey(bdName, loop2Name))).
+		To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.ExecVppctl("show", "bridge-domain", "1", "detail")).
+		Should(ContainSubstring("push-1"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// change tag rewrite and MAC learn limits in-place
+	bd.MacLearnLimit = 2000
+	bd.Interfaces[0].MacLearnLimit = 20
+	bd.Interfaces[0].TagRewrite = &vpp_l2.BridgeDomain_Interface_TagRewrite{
+		Operation: vpp_l2.BridgeDomain_Interface_TagRewrite_POP1,
+	}
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Update(bd).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction updating BD failed")
+
+	ctx.Expect(ctx.GetDerivedValueState(bd, vpp_l2.BDInterfaceKey(bdName, loop1Name))).
+		To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.ExecVppctl("show", "bridge-domain", "1", "detail")).
+		Should(ContainSubstring("pop-1"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
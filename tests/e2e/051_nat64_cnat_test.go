@@ -0,0 +1,181 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_cnat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/cnat"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Test configuration of NAT64 (prefix, interfaces and address pool).
+func TestNAT64(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	const (
+		ipv6Iface  = "loop-ipv6"
+		ipv4Iface  = "loop-ipv4"
+		nat64Pfx   = "64:ff9b::/96"
+		poolFirst  = "80.80.80.100"
+		poolLast   = "80.80.80.102"
+		poolMiddle = "80.80.80.101"
+	)
+
+	loopIPv6 := &vpp_interfaces.Interface{
+		Name:        ipv6Iface,
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"2001:db8::1/64"},
+	}
+	loopIPv4 := &vpp_interfaces.Interface{
+		Name:        ipv4Iface,
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"80.80.80.1/24"},
+	}
+	nat64Global := &vpp_nat.Nat64Global{}
+	nat64Prefix := &vpp_nat.Nat64IPv6Prefix{
+		Prefix: nat64Pfx,
+	}
+	nat64Inside := &vpp_nat.Nat64Interface{
+		Name: ipv6Iface,
+		Type: vpp_nat.Nat64Interface_IPV6_INSIDE,
+	}
+	nat64Outside := &vpp_nat.Nat64Interface{
+		Name: ipv4Iface,
+		Type: vpp_nat.Nat64Interface_IPV4_OUTSIDE,
+	}
+	nat64Pool := &vpp_nat.Nat64AddressPool{
+		FirstIp: poolFirst,
+		LastIp:  poolLast,
+	}
+
+	nat64Prefixes := func() (string, error) {
+		return ctx.ExecVppctl("show", "nat64", "prefix")
+	}
+	nat64Pools := func() (string, error) {
+		return ctx.ExecVppctl("show", "nat64", "pool")
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		loopIPv6,
+		loopIPv4,
+		nat64Global,
+		nat64Prefix,
+		nat64Inside,
+		nat64Outside,
+		nat64Pool,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating NAT64 configuration failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(nat64Inside)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"NAT64 inside interface should be configured")
+	ctx.Eventually(ctx.GetValueStateClb(nat64Outside)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"NAT64 outside interface should be configured")
+	ctx.Expect(nat64Prefixes()).Should(ContainSubstring(nat64Pfx))
+	ctx.Expect(nat64Pools()).Should(SatisfyAll(
+		ContainSubstring(poolFirst), ContainSubstring(poolMiddle), ContainSubstring(poolLast)))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// remove NAT64 configuration
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(
+		nat64Prefix,
+		nat64Inside,
+		nat64Outside,
+		nat64Pool,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction removing NAT64 configuration failed")
+
+	ctx.Expect(nat64Prefixes()).ShouldNot(ContainSubstring(nat64Pfx))
+	ctx.Expect(nat64Pools()).ShouldNot(SatisfyAny(
+		ContainSubstring(poolFirst), ContainSubstring(poolMiddle), ContainSubstring(poolLast)))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
+
+// Test configuration of CNAT translation and source-NAT policy.
+func TestCNAT(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	const (
+		loopName    = "loop-cnat"
+		vipAddr     = "10.10.10.1"
+		backendAddr = "10.10.20.5"
+		snatAddr    = "10.10.20.1"
+	)
+
+	loop := &vpp_interfaces.Interface{
+		Name:        loopName,
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{snatAddr + "/24"},
+	}
+	translation := &vpp_cnat.Translation{
+		Name:     "web",
+		Vip:      &vpp_cnat.Endpoint{Address: vipAddr, Port: 80},
+		Protocol: vpp_cnat.Translation_TCP,
+		Paths: []*vpp_cnat.Translation_Path{
+			{
+				Dst: &vpp_cnat.Endpoint{Address: backendAddr, Port: 8080},
+			},
+		},
+	}
+	snatPolicy := &vpp_cnat.SnatPolicy{
+		Policy:          vpp_cnat.SnatPolicy_IF_PFX,
+		SnatIpv4:        snatAddr,
+		ExcludePrefixes: []string{"10.10.30.0/24"},
+	}
+	snatPolicyIface := &vpp_cnat.SnatPolicyInterface{
+		Interface: loopName,
+		Table:     vpp_cnat.SnatPolicyInterface_INCLUDE_V4,
+	}
+
+	cnatTranslations := func() (string, error) {
+		return ctx.ExecVppctl("show", "cnat", "translation")
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		loop,
+		translation,
+		snatPolicy,
+		snatPolicyIface,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating CNAT configuration failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(translation)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"CNAT translation should be configured")
+	ctx.Expect(ctx.GetValueState(snatPolicyIface)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(cnatTranslations()).Should(SatisfyAll(
+		ContainSubstring(vipAddr), ContainSubstring(backendAddr)))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// remove the translation
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(translation).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction removing CNAT translation failed")
+
+	ctx.Expect(cnatTranslations()).ShouldNot(ContainSubstring(vipAddr))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
@@ -0,0 +1,103 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_classify "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classify"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// Test configuration of chained classifier tables with a session and input ACL
+// attachment to an interface.
+func TestClassifier(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	const loopName = "loop-classify"
+
+	loop := &vpp_interfaces.Interface{
+		Name:        loopName,
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.20.0.1/24"},
+	}
+	// match destination IPv4 address (skip the first vector of IPv4 header)
+	dstTable := &vpp_classify.Table{
+		Name:          "dst-ip4",
+		Mask:          "00000000ffffffff",
+		SkipNVectors:  1,
+		MissNextIndex: ^uint32(0),
+	}
+	// match source IPv4 address, chained to the destination table
+	srcTable := &vpp_classify.Table{
+		Name:          "src-ip4",
+		Mask:          "000000000000000000000000ffffffff",
+		NextTable:     dstTable.Name,
+		MissNextIndex: ^uint32(0),
+		Interfaces: []*vpp_classify.Table_Interface{
+			{
+				Name:     loopName,
+				Type:     vpp_classify.Table_Interface_INPUT_ACL,
+				Protocol: vpp_classify.Table_Interface_IP4,
+			},
+		},
+	}
+	denySession := &vpp_classify.Session{
+		Table:        srcTable.Name,
+		Match:        "000000000000000000000000" + "0a140005",
+		HitNextIndex: 0,
+	}
+
+	classifyTables := func() (string, error) {
+		return ctx.ExecVppctl("show", "classify", "tables", "verbose")
+	}
+	inputACL := func() (string, error) {
+		return ctx.ExecVppctl("show", "inacl", "type", "ip4")
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		loop,
+		srcTable,
+		dstTable,
+		denySession,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating classifier configuration failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(denySession)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"classifier session should be configured")
+	ctx.Expect(ctx.GetDerivedValueState(srcTable,
+		vpp_classify.TableInterfaceKey(srcTable.Name, srcTable.Interfaces[0]))).
+		To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(classifyTables()).Should(ContainSubstring("0a140005"))
+	ctx.Expect(inputACL()).Should(ContainSubstring(loopName))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// removing the next table removes the chained table as well
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(dstTable).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction removing classifier table failed")
+
+	ctx.Expect(ctx.GetValueState(srcTable)).To(Equal(kvscheduler.ValueState_PENDING))
+	ctx.Expect(ctx.GetValueState(denySession)).To(Equal(kvscheduler.ValueState_PENDING))
+	ctx.Expect(classifyTables()).ShouldNot(ContainSubstring("0a140005"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
@@ -0,0 +1,93 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_lb "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/lb"
+)
+
+// Test configuration of load-balancer VIP with application servers. Changes
+// in the set of application servers must not re-create the VIP.
+func TestLoadBalancer(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	globalConfig := &vpp_lb.GlobalConfig{
+		Ip4SrcAddress: "10.30.0.1",
+		FlowTimeout:   60,
+	}
+	vip := &vpp_lb.VIP{
+		Prefix:   "10.96.0.10/32",
+		Protocol: vpp_lb.VIP_TCP,
+		Port:     80,
+		Encap:    vpp_lb.VIP_GRE4,
+		ApplicationServers: []*vpp_lb.VIP_ApplicationServer{
+			{Address: "10.30.1.1"},
+			{Address: "10.30.1.2"},
+		},
+	}
+
+	lbVips := func() (string, error) {
+		return ctx.ExecVppctl("show", "lb", "vips", "verbose")
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		globalConfig,
+		vip,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating LB configuration failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(vip)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"LB VIP should be configured")
+	ctx.Expect(ctx.GetValueState(globalConfig)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	for _, as := range vip.ApplicationServers {
+		ctx.Expect(ctx.GetDerivedValueState(vip, vpp_lb.VIPApplicationServerKey(vip, as.Address))).
+			To(Equal(kvscheduler.ValueState_CONFIGURED))
+	}
+	ctx.Expect(lbVips()).Should(SatisfyAll(
+		ContainSubstring("10.96.0.10/32"),
+		ContainSubstring("10.30.1.1"),
+		ContainSubstring("10.30.1.2")))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// replace one application server
+	vip.ApplicationServers = []*vpp_lb.VIP_ApplicationServer{
+		{Address: "10.30.1.1"},
+		{Address: "10.30.1.3"},
+	}
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Update(vip).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction updating LB application servers failed")
+
+	ctx.Expect(ctx.GetValueState(vip)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.GetDerivedValueState(vip, vpp_lb.VIPApplicationServerKey(vip, "10.30.1.3"))).
+		To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(lbVips()).Should(ContainSubstring("10.30.1.3"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(vip, globalConfig).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction removing LB configuration failed")
+
+	ctx.Expect(lbVips()).ShouldNot(ContainSubstring("10.96.0.10/32"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
@@ -0,0 +1,175 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_igmp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/igmp"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// Test configuration of multicast route with accepting and forwarding interfaces.
+// Changes in the paths must not re-create the route.
+func TestMulticastRoute(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	upstream := &vpp_interfaces.Interface{
+		Name:        "upstream",
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.40.1.1/24"},
+	}
+	downstream1 := &vpp_interfaces.Interface{
+		Name:        "downstream1",
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.40.2.1/24"},
+	}
+	downstream2 := &vpp_interfaces.Interface{
+		Name:        "downstream2",
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.40.3.1/24"},
+	}
+	mroute := &vpp_l3.MRoute{
+		Group:         "232.1.1.1/32",
+		SourceAddress: "10.40.1.10",
+		Paths: []*vpp_l3.MRoute_Path{
+			{Interface: upstream.Name, Accept: true},
+			{Interface: downstream1.Name, Forward: true},
+		},
+	}
+
+	mfib := func() (string, error) {
+		return ctx.ExecVppctl("show", "ip", "mfib", "232.1.1.1")
+	}
+
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		upstream,
+		downstream1,
+		downstream2,
+		mroute,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating multicast route failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(mroute)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"multicast route should be configured")
+	ctx.Expect(mfib()).Should(SatisfyAll(
+		ContainSubstring("(10.40.1.10, 232.1.1.1/32)"),
+		ContainSubstring("upstream"),
+		ContainSubstring("downstream1")))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// forward to another interface
+	mroute.Paths[1].Interface = downstream2.Name
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Update(mroute).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction updating multicast route failed")
+
+	ctx.Expect(ctx.GetValueState(mroute)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(mfib()).Should(SatisfyAll(
+		ContainSubstring("downstream2"),
+		Not(ContainSubstring("downstream1"))))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// removing the interface makes the route pending
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(downstream2).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction removing interface failed")
+	ctx.Expect(ctx.GetValueState(mroute)).To(Equal(kvscheduler.ValueState_PENDING))
+	ctx.Expect(mfib()).ShouldNot(ContainSubstring("232.1.1.1"))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
+
+// Test IGMP proxy device with upstream interface in the host mode (joining
+// a source-specific group) and downstream interface in the router mode.
+func TestIGMPProxy(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	upstream := &vpp_interfaces.Interface{
+		Name:        "upstream",
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.40.1.1/24"},
+	}
+	downstream := &vpp_interfaces.Interface{
+		Name:        "downstream",
+		Type:        vpp_interfaces.Interface_SOFTWARE_LOOPBACK,
+		Enabled:     true,
+		IpAddresses: []string{"10.40.2.1/24"},
+	}
+	igmpUpstream := &vpp_igmp.Interface{
+		Interface: upstream.Name,
+		Mode:      vpp_igmp.Interface_HOST,
+		Groups: []*vpp_igmp.Interface_Group{
+			{GroupAddress: "232.1.1.1", SourceAddresses: []string{"10.40.1.10"}},
+		},
+	}
+	igmpDownstream := &vpp_igmp.Interface{
+		Interface: downstream.Name,
+		Mode:      vpp_igmp.Interface_ROUTER,
+	}
+	proxy := &vpp_igmp.ProxyDevice{
+		UpstreamInterface:    upstream.Name,
+		DownstreamInterfaces: []string{downstream.Name},
+	}
+
+	igmpConfig := func() (string, error) {
+		return ctx.ExecVppctl("show", "igmp", "config")
+	}
+
+	// proxy device is pending until IGMP is enabled on the interfaces
+	req := ctx.GenericClient().ChangeRequest()
+	err := req.Update(
+		upstream,
+		downstream,
+		proxy,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction creating interfaces failed")
+	ctx.Expect(ctx.GetValueState(proxy)).To(Equal(kvscheduler.ValueState_PENDING))
+
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Update(
+		igmpUpstream,
+		igmpDownstream,
+	).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction enabling IGMP failed")
+
+	ctx.Eventually(ctx.GetValueStateClb(proxy)).Should(Equal(kvscheduler.ValueState_CONFIGURED),
+		"IGMP proxy device should be configured")
+	ctx.Expect(ctx.GetValueState(igmpUpstream)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(ctx.GetValueState(igmpDownstream)).To(Equal(kvscheduler.ValueState_CONFIGURED))
+	ctx.Expect(igmpConfig()).Should(SatisfyAll(
+		ContainSubstring("host"),
+		ContainSubstring("router"),
+		ContainSubstring("232.1.1.1")))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+
+	// disabling IGMP on the downstream interface removes the proxy device
+	req = ctx.GenericClient().ChangeRequest()
+	err = req.Delete(igmpDownstream).Send(context.Background())
+	ctx.Expect(err).ToNot(HaveOccurred(), "Transaction disabling IGMP failed")
+	ctx.Expect(ctx.GetValueState(proxy)).To(Equal(kvscheduler.ValueState_PENDING))
+	ctx.Expect(ctx.AgentInSync()).To(BeTrue(), "Agent is not in-sync")
+}
@@ -15,10 +15,15 @@
 package e2e
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -64,3 +69,160 @@ func TestJsonschema(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestOpenAPI(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	res, err := ctx.Agent.Client().HTTPClient().Get("http://" + ctx.Agent.Client().AgentHost() + ":9191/info/openapi")
+	ctx.Expect(err).ToNot(HaveOccurred())
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	ctx.Expect(err).ToNot(HaveOccurred())
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusOK), string(body))
+
+	var doc struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	ctx.Expect(json.Unmarshal(body, &doc)).To(Succeed())
+	ctx.Expect(doc.OpenAPI).To(HavePrefix("3."))
+	ctx.Expect(doc.Paths).To(HaveKey("/configuration"))
+	ctx.Expect(doc.Paths).To(HaveKey("/scheduler/txn-history"))
+	ctx.Expect(doc.Paths).To(HaveKey("/vpp/telemetry/memory"))
+	ctx.Expect(doc.Paths).To(HaveKey("/dump/vpp/v2/interfaces"))
+	ctx.Expect(doc.Paths["/dump/vpp/v2/interfaces"]).To(HaveKey("get"))
+	ctx.Expect(doc.Paths).To(HaveKey("/configuration/vpp.interfaces/{name}"))
+	ctx.Expect(doc.Paths["/configuration/vpp.interfaces/{name}"]).To(HaveKey("patch"))
+	ctx.Expect(doc.Components.Schemas).To(HaveKey("ligato.vpp.interfaces.Interface"))
+	ctx.Expect(doc.Components.Schemas).To(HaveKey("plugins.vpp.ifplugin.vppcalls.InterfaceDetails"))
+}
+
+func TestConfigurationItemCRUD(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	itemURL := "http://" + ctx.Agent.Client().AgentHost() + ":9191/configuration/vpp.interfaces/loop-rest"
+	do := func(method string, body string, header http.Header) (*http.Response, []byte) {
+		req, err := http.NewRequest(method, itemURL, bytes.NewBufferString(body))
+		ctx.Expect(err).ToNot(HaveOccurred())
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		res, err := ctx.Agent.Client().HTTPClient().Do(req)
+		ctx.Expect(err).ToNot(HaveOccurred())
+		b, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		ctx.Expect(err).ToNot(HaveOccurred())
+		return res, b
+	}
+
+	// create
+	res, body := do(http.MethodPut, `{"name":"loop-rest","type":"SOFTWARE_LOOPBACK","enabled":true}`,
+		http.Header{"If-None-Match": {"*"}})
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusCreated), string(body))
+	etag := res.Header.Get("ETag")
+	ctx.Expect(etag).ToNot(BeEmpty())
+
+	// create-only request fails for existing item
+	res, _ = do(http.MethodPut, `{"name":"loop-rest","type":"SOFTWARE_LOOPBACK"}`,
+		http.Header{"If-None-Match": {"*"}})
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusPreconditionFailed))
+
+	// read
+	res, body = do(http.MethodGet, "", nil)
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusOK), string(body))
+	ctx.Expect(res.Header.Get("ETag")).To(Equal(etag))
+	var item struct {
+		Key    string                 `json:"key"`
+		Value  map[string]interface{} `json:"value"`
+		Status map[string]interface{} `json:"status"`
+	}
+	ctx.Expect(json.Unmarshal(body, &item)).To(Succeed())
+	ctx.Expect(item.Key).To(Equal("config/vpp/v2/interfaces/loop-rest"))
+	ctx.Expect(item.Value).To(HaveKeyWithValue("enabled", true))
+	ctx.Expect(item.Status).To(HaveKeyWithValue("state", "CONFIGURED"))
+
+	// patch with stale entity tag is rejected
+	res, _ = do(http.MethodPatch, `{"mtu":1400}`, http.Header{"If-Match": {`"stale"`}})
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusPreconditionFailed))
+
+	// patch keeps fields not present in request body
+	res, body = do(http.MethodPatch, `{"mtu":1400}`, http.Header{"If-Match": {etag}})
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusOK), string(body))
+	ctx.Expect(json.Unmarshal(body, &item)).To(Succeed())
+	ctx.Expect(item.Value).To(HaveKeyWithValue("enabled", true))
+	ctx.Expect(item.Value).To(HaveKeyWithValue("mtu", BeNumerically("==", 1400)))
+
+	// name in body must match URL
+	res, _ = do(http.MethodPut, `{"name":"other","type":"SOFTWARE_LOOPBACK"}`, nil)
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// semantic validation errors
+	res, _ = do(http.MethodPut, `{"name":"loop-rest","type":"SUB_INTERFACE"}`, nil)
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// delete
+	res, _ = do(http.MethodDelete, "", nil)
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusNoContent))
+	res, _ = do(http.MethodGet, "", nil)
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+}
+
+func TestStatusStream(t *testing.T) {
+	ctx := Setup(t)
+	defer ctx.Teardown()
+
+	agentURL := "http://" + ctx.Agent.Client().AgentHost() + ":9191"
+	streamCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet,
+		agentURL+"/stream/status?prefix=config/vpp/v2/interfaces/&state=configured", nil)
+	ctx.Expect(err).ToNot(HaveOccurred())
+	res, err := ctx.Agent.Client().HTTPClient().Do(req)
+	ctx.Expect(err).ToNot(HaveOccurred())
+	defer res.Body.Close()
+	ctx.Expect(res.StatusCode).To(Equal(http.StatusOK))
+	ctx.Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+	putReq, err := http.NewRequest(http.MethodPut, agentURL+"/configuration/vpp.interfaces/loop-stream",
+		bytes.NewBufferString(`{"name":"loop-stream","type":"SOFTWARE_LOOPBACK","enabled":true}`))
+	ctx.Expect(err).ToNot(HaveOccurred())
+	putRes, err := ctx.Agent.Client().HTTPClient().Do(putReq)
+	ctx.Expect(err).ToNot(HaveOccurred())
+	putRes.Body.Close()
+	ctx.Expect(putRes.StatusCode).To(Equal(http.StatusCreated))
+
+	// read events until the status of the created interface arrives
+	var event struct {
+		id, typ string
+		status  struct {
+			Value struct {
+				Key   string `json:"key"`
+				State string `json:"state"`
+			} `json:"value"`
+		}
+	}
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ctx.Expect(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event.status)).To(Succeed())
+		}
+		if line == "" && event.status.Value.Key == "config/vpp/v2/interfaces/loop-stream" {
+			break
+		}
+	}
+	ctx.Expect(scanner.Err()).ToNot(HaveOccurred())
+	ctx.Expect(event.id).ToNot(BeEmpty())
+	ctx.Expect(event.typ).To(Equal("status"))
+	ctx.Expect(event.status.Value.State).To(Equal("CONFIGURED"))
+}
@@ -0,0 +1,149 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// TestMultipleVPPInstances tests configuration of two VPP instances managed by single agent.
+// The second VPP instance (vpp1) is started in the agent container next to the default one
+// and both instances are connected with memif interfaces configured via etcd.
+func TestMultipleVPPInstances(t *testing.T) {
+	ctx := Setup(t,
+		WithEtcd(),
+		WithoutVPPAgent(),
+	)
+	defer ctx.Teardown()
+
+	const (
+		vpp1Instance   = "vpp1"
+		vpp1CLISocket  = "/run/vpp/vpp1-cli.sock"
+		memifSocket    = "/run/vpp/memif-vpp1.sock"
+		defaultMemifIP = "10.10.1.1"
+		vpp1MemifIP    = "10.10.1.2"
+	)
+
+	// configuration of the second VPP (default VPP uses /run/vpp/*.sock)
+	vpp1Config := `unix {
+    nodaemon
+    cli-listen /run/vpp/vpp1-cli.sock
+    cli-no-pager
+}
+api-segment {
+    prefix vpp1
+}
+plugins {
+    plugin dpdk_plugin.so {
+        disable
+    }
+}
+socksvr {
+    socket-name /run/vpp/vpp1-api.sock
+}
+statseg {
+    socket-name /run/vpp/vpp1-stats.sock
+}
+`
+	vpp1ConfigFile := CreateFileOnSharedVolume(ctx, "vpp1.conf", vpp1Config)
+
+	supervisorConfig := `programs:
+  - name: "vpp"
+    executable-path: "/usr/bin/vpp"
+    executable-args: ["-c", "/etc/vpp/vpp.conf"]
+  - name: "vpp1"
+    executable-path: "/usr/bin/vpp"
+    executable-args: ["-c", "%v"]
+  - name: "agent"
+    executable-path: "/bin/vpp-agent"
+    executable-args: ["--config-dir=/opt/vpp-agent/dev"]
+hooks:
+  - cmd: "/usr/bin/init_hook.sh"
+`
+	supervisorConfig = fmt.Sprintf(supervisorConfig, vpp1ConfigFile)
+
+	govppmuxConfig := `binapi-socket-path: /run/vpp/api.sock
+stats-socket-path: /run/vpp/stats.sock
+instances:
+  - name: vpp1
+    binapi-socket-path: /run/vpp/vpp1-api.sock
+    stats-socket-path: /run/vpp/vpp1-stats.sock
+`
+
+	etcdConfig := `insecure-transport: true
+dial-timeout: 1s
+endpoints:
+    - "%v:2379"
+`
+	etcdIPAddress := ctx.Etcd.IPAddress()
+	ctx.Expect(etcdIPAddress).ShouldNot(BeNil())
+	etcdConfig = fmt.Sprintf(etcdConfig, etcdIPAddress)
+
+	// memif master in the default instance and memif slave in vpp1 connected to it
+	const memifKey = "config/vpp/v2/interfaces/memif-vpp1"
+	memifValue := `{
+"name":"memif-vpp1",
+"type":"MEMIF",
+"enabled":true,
+"ip_addresses":["%s/24"],
+"memif": {
+		"master": %t,
+		"id": 1,
+		"socket_filename": "%s"
+	}
+}`
+	vpp1MemifKey, err := models.InstanceKey(vpp1Instance, memifKey)
+	ctx.Expect(err).ToNot(HaveOccurred())
+	agentPrefix := fmt.Sprintf("/vnf-agent/%v/", AgentInstanceName(ctx))
+	ctx.Expect(ctx.Etcd.Put(agentPrefix+memifKey,
+		fmt.Sprintf(memifValue, defaultMemifIP, true, memifSocket))).
+		To(Succeed(), "can't insert memif of the default instance into ETCD")
+	ctx.Expect(ctx.Etcd.Put(agentPrefix+vpp1MemifKey,
+		fmt.Sprintf(memifValue, vpp1MemifIP, false, memifSocket))).
+		To(Succeed(), "can't insert memif of vpp1 instance into ETCD")
+
+	agent := ctx.StartAgent(
+		mainAgentName,
+		WithAdditionalAgentCmdParams(
+			"SUPERVISOR_CONFIG="+CreateFileOnSharedVolume(ctx, "supervisor.conf", supervisorConfig),
+			WithPluginConfigArg(ctx, "govppmux", govppmuxConfig),
+			WithPluginConfigArg(ctx, "etcd", etcdConfig)),
+		WithoutManualInitialAgentResync(),
+	)
+
+	// values of both instances are configured independently
+	ctx.Eventually(agent.GetValueStateByKeyClb(memifKey)).
+		Should(Equal(kvscheduler.ValueState_CONFIGURED), "memif of the default instance was not configured")
+	ctx.Eventually(agent.GetValueStateByKeyClb(vpp1MemifKey)).
+		Should(Equal(kvscheduler.ValueState_CONFIGURED), "memif of vpp1 instance was not configured")
+
+	// each memif was created in its VPP
+	ctx.Eventually(func() (string, error) {
+		return agent.ExecVppctl("show", "interface", "addr")
+	}).Should(ContainSubstring(defaultMemifIP))
+	ctx.Eventually(func() (string, error) {
+		stdout, _, err := agent.ExecCmd("vppctl", "-s", vpp1CLISocket, "show", "interface", "addr")
+		return stdout, err
+	}).Should(ContainSubstring(vpp1MemifIP))
+
+	// instances are connected with each other
+	ctx.Eventually(agent.PingFromVPPAsCallback(vpp1MemifIP)).Should(Succeed())
+}
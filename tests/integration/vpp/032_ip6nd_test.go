@@ -15,6 +15,7 @@
 package vpp
 
 import (
+	"net"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -24,6 +25,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	ifplugin_vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
@@ -60,3 +62,56 @@ func TestIP6ND(t *testing.T) {
 
 	t.Logf("CLI output: %s", out)
 }
+
+func TestIP6NDRouterAdvertisement(t *testing.T) {
+	test := setupVPP(t)
+	defer test.teardownVPP()
+
+	ih := ifplugin_vppcalls.CompatibleInterfaceVppHandler(test.vppClient, logrus.NewLogger("test"))
+	const ifName = "loop1"
+	ifIdx, err := ih.AddLoopbackInterface(ifName)
+	if err != nil {
+		t.Fatalf("creating interface failed: %v", err)
+	}
+	if err = ih.InterfaceAdminUp(test.Ctx, ifIdx); err != nil {
+		t.Fatalf("setting interface up failed: %v", err)
+	}
+	// router advertisements require IPv6 enabled on the interface
+	_, ipNet, _ := net.ParseCIDR("2001:db8::1/64")
+	ipNet.IP = net.ParseIP("2001:db8::1")
+	if err = ih.AddInterfaceIP(ifIdx, ipNet); err != nil {
+		t.Fatalf("adding IPv6 address failed: %v", err)
+	}
+
+	err = ih.SetIP6ndRouterAdvertisement(test.Ctx, ifIdx, &vpp_interfaces.Interface_IP6ND_RouterAdvertisement{
+		Managed:     true,
+		Other:       true,
+		MaxInterval: 30,
+		MinInterval: 10,
+	})
+	Expect(err).To(Succeed())
+
+	prefix := &vpp_interfaces.Interface_IP6ND_Prefix{
+		Prefix:            "2001:db8:ffff::/64",
+		ValidLifetime:     3600,
+		PreferredLifetime: 1800,
+	}
+	err = ih.AddIP6ndPrefix(test.Ctx, ifIdx, prefix)
+	Expect(err).To(Succeed())
+
+	out, err := test.vpp.RunCli(test.Ctx, "show ip6 interface "+ifName)
+	Expect(err).To(Succeed())
+	t.Logf("CLI output: %s", out)
+	Expect(out).To(ContainSubstring("2001:db8:ffff::"))
+	Expect(out).To(ContainSubstring("sent every 30 seconds"))
+
+	err = ih.DeleteIP6ndPrefix(test.Ctx, ifIdx, prefix)
+	Expect(err).To(Succeed())
+	err = ih.SetIP6ndRouterAdvertisement(test.Ctx, ifIdx, nil)
+	Expect(err).To(Succeed())
+
+	out, err = test.vpp.RunCli(test.Ctx, "show ip6 interface "+ifName)
+	Expect(err).To(Succeed())
+	Expect(out).NotTo(ContainSubstring("2001:db8:ffff::"))
+	Expect(out).NotTo(ContainSubstring("sent every 30 seconds"))
+}